@@ -0,0 +1,127 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+	"github.com/insolar/insolar/network/consensus/common/capacity"
+	"github.com/insolar/insolar/network/servicenetwork"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+// SetPowerArgs is arguments that Admin service accepts for changing node power.
+type SetPowerArgs struct {
+	Power     string `json:"power"`
+	Seed      string `json:"seed"`
+	Signature string `json:"signature"`
+}
+
+// SetPowerReply is reply for Admin service requests for changing node power.
+type SetPowerReply struct {
+	Power   string `json:"power"`
+	TraceID string `json:"traceID"`
+}
+
+// AdminService is a service that provides API for node operators.
+// Requests must be signed by the private key of the node.
+type AdminService struct {
+	runner *Runner
+}
+
+// NewAdminService creates new Admin service instance.
+func NewAdminService(runner *Runner) *AdminService {
+	return &AdminService{runner: runner}
+}
+
+// SetPower changes capacity of the node in consensus, zero power drains the node.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "admin.setPower",
+//     "params": {
+//       "power": str, // zero, minimal, reduced, normal or extended
+//       "seed": str, // seed from node.getSeed
+//       "signature": str // signature of "admin.setPower:<seed>:<power>" by the node private key
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"power": str, // requested power
+// 			"traceID": str
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *AdminService) SetPower(r *http.Request, args *SetPowerArgs, reply *SetPowerReply) error {
+	traceID := utils.RandTraceID()
+	ctx, inslog := inslogger.WithTraceField(context.Background(), traceID)
+
+	inslog.Infof("[ AdminService.SetPower ] Incoming request: %s", r.RequestURI)
+
+	err := s.checkSignature(args.Seed, args.Signature, requester.AdminSignData("admin.setPower", args.Seed, args.Power))
+	if err != nil {
+		return errors.Wrap(err, "authentication failed")
+	}
+
+	level, ok := capacity.ParseLevel(args.Power)
+	if !ok {
+		return errors.Errorf("unknown power %s", args.Power)
+	}
+
+	sn, ok := s.runner.ServiceNetwork.(*servicenetwork.ServiceNetwork)
+	if !ok {
+		return errors.New("node power can't be changed")
+	}
+	err = sn.ChangePower(ctx, level)
+	if err != nil {
+		return errors.Wrap(err, "failed to change node power")
+	}
+
+	reply.Power = level.String()
+	reply.TraceID = traceID
+
+	return nil
+}
+
+// checkSignature verifies that the request is signed by the node and the seed is not used yet.
+func (s *AdminService) checkSignature(seed string, signature string, data []byte) error {
+	_, err := s.runner.checkSeed(seed)
+	if err != nil {
+		return err
+	}
+
+	publicKey := s.runner.CertificateManager.GetCertificate().GetPublicKey()
+	publicPEM, err := platformpolicy.NewKeyProcessor().ExportPublicKeyPEM(publicKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to export node public key")
+	}
+
+	return foundation.VerifySignature(data, signature, string(publicPEM), string(publicPEM), false)
+}
@@ -0,0 +1,89 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/api/seedmanager"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestAdminService_SetPower(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	kp := platformpolicy.NewKeyProcessor()
+	privateKey, err := kp.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	sm := seedmanager.New()
+	defer sm.Stop()
+
+	runner := &Runner{
+		SeedManager: sm,
+		CertificateManager: testutils.NewCertificateManagerMock(mc).GetCertificateMock.Return(
+			testutils.NewCertificateMock(mc).GetPublicKeyMock.Return(kp.ExtractPublicKey(privateKey)),
+		),
+	}
+	service := NewAdminService(runner)
+
+	newArgs := func(power string) *SetPowerArgs {
+		generator := seedmanager.SeedGenerator{}
+		seed, err := generator.Next()
+		require.NoError(t, err)
+		sm.Add(*seed, gen.PulseNumber())
+
+		args := &SetPowerArgs{Power: power, Seed: base64.StdEncoding.EncodeToString(seed[:])}
+		args.Signature, err = requester.Sign(privateKey, requester.AdminSignData("admin.setPower", args.Seed, power))
+		require.NoError(t, err)
+		return args
+	}
+
+	t.Run("not signed by node", func(t *testing.T) {
+		args := newArgs("zero")
+		args.Power = "normal"
+		err := service.SetPower(&http.Request{}, args, &SetPowerReply{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "authentication failed")
+	})
+
+	t.Run("seed is reused", func(t *testing.T) {
+		args := newArgs("zero")
+		err := service.SetPower(&http.Request{}, args, &SetPowerReply{})
+		require.Error(t, err)
+		require.NotContains(t, err.Error(), "authentication failed")
+
+		err = service.SetPower(&http.Request{}, args, &SetPowerReply{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "authentication failed")
+	})
+
+	t.Run("unknown power", func(t *testing.T) {
+		err := service.SetPower(&http.Request{}, newArgs("maximal"), &SetPowerReply{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unknown power")
+	})
+}
@@ -200,6 +200,9 @@ func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 	return func(response http.ResponseWriter, req *http.Request) {
 		traceID := utils.RandTraceID()
 		ctx, insLog := inslogger.WithTraceField(context.Background(), traceID)
+		if instracer.DebugRequested(req.Header) {
+			ctx = instracer.ForceSample(ctx)
+		}
 
 		ctx, span := instracer.StartSpan(ctx, "ApiCallHandler.callHandler")
 		defer span.End()
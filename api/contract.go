@@ -99,6 +99,62 @@ func (s *ContractService) Upload(r *http.Request, args *UploadArgs, reply *Uploa
 	return nil
 }
 
+// UpgradeArgs is arguments that Contract.Upgrade accepts.
+type UpgradeArgs struct {
+	Code string
+	Name string
+	// PreviousPrototypeRefs are prototypes which objects can be switched to the new code.
+	PreviousPrototypeRefs []string
+}
+
+// Upgrade builds new version of the contract and return new prototype ref
+func (s *ContractService) Upgrade(r *http.Request, args *UpgradeArgs, reply *UploadReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+	reply.TraceID = utils.TraceID(ctx)
+
+	inslog.Infof("[ ContractService.Upgrade ] Incoming request: %s", r.RequestURI)
+
+	if len(args.Name) == 0 {
+		return errors.New("params.name is missing")
+	}
+
+	if len(args.Code) == 0 {
+		return errors.New("params.code is missing")
+	}
+
+	if len(args.PreviousPrototypeRefs) == 0 {
+		return errors.New("params.PreviousPrototypeRefs is missing")
+	}
+
+	previous := make([]insolar.Reference, 0, len(args.PreviousPrototypeRefs))
+	for _, refStr := range args.PreviousPrototypeRefs {
+		ref, err := insolar.NewReferenceFromBase58(refStr)
+		if err != nil {
+			return errors.Wrap(err, "can't get previous protoRef")
+		}
+		previous = append(previous, *ref)
+	}
+
+	if s.cb == nil {
+		insgocc, err := goplugintestutils.BuildPreprocessor()
+		if err != nil {
+			inslog.Infof("[ ContractService.Upgrade ] can't build preprocessor %#v", err)
+			return errors.Wrap(err, "can't build preprocessor")
+		}
+		s.cb = goplugintestutils.NewContractBuilder(
+			insgocc, s.runner.ArtifactManager, s.runner.PulseAccessor, s.runner.JetCoordinator,
+		)
+	}
+
+	err := s.cb.BuildUpgrade(ctx, args.Name, args.Code, previous...)
+	if err != nil {
+		return errors.Wrap(err, "can't build contract")
+	}
+	reference := *s.cb.Prototypes[args.Name]
+	reply.PrototypeRef = reference.String()
+	return nil
+}
+
 // CallConstructorArgs is arguments that Contract.CallConstructor accepts.
 type CallConstructorArgs struct {
 	PrototypeRefString string
@@ -51,3 +51,7 @@ func (s *ContractService) CallConstructor(r *http.Request, args *DummyArgs, repl
 func (s *ContractService) CallMethod(r *http.Request, args *DummyArgs, reply *DummyReply) error {
 	return errors.New("method allowed only in build with functest tag")
 }
+
+func (s *ContractService) Upgrade(r *http.Request, args *DummyArgs, reply *DummyReply) error {
+	return errors.New("method allowed only in build with functest tag")
+}
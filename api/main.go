@@ -41,6 +41,8 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/logicrunner"
 	"github.com/insolar/insolar/logicrunner/artifacts"
 	"github.com/insolar/insolar/platformpolicy"
 )
@@ -55,6 +57,7 @@ type Runner struct {
 	PulseAccessor       pulse.Accessor              `inject:""`
 	ArtifactManager     artifacts.Client            `inject:""`
 	JetCoordinator      jet.Coordinator             `inject:""`
+	TerminationHandler  insolar.TerminationHandler  `inject:""`
 	server              *http.Server
 	rpcServer           *rpc.Server
 	cfg                 *configuration.APIRunner
@@ -63,6 +66,13 @@ type Runner struct {
 	timeout             time.Duration
 	SeedManager         *seedmanager.SeedManager
 	SeedGenerator       seedmanager.SeedGenerator
+	// SagaAccessor is set on virtual nodes only.
+	SagaAccessor logicrunner.SagaAccessor
+	// Handoff and Retention are set on light material nodes only.
+	Handoff   HandoffReporter
+	Retention RetentionReporter
+	// Mismatches is set on heavy material nodes only.
+	Mismatches object.MismatchAccessor
 }
 
 func checkConfig(cfg *configuration.APIRunner) error {
@@ -98,6 +108,26 @@ func (ar *Runner) registerServices(rpcServer *rpc.Server) error {
 		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: cert")
 	}
 
+	err = rpcServer.RegisterService(NewSagaService(ar), "saga")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: saga")
+	}
+
+	err = rpcServer.RegisterService(NewMisbehaviorService(ar), "misbehavior")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: misbehavior")
+	}
+
+	err = rpcServer.RegisterService(NewValidationService(ar), "validation")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: validation")
+	}
+
+	err = rpcServer.RegisterService(NewAdminService(ar), "admin")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: admin")
+	}
+
 	err = rpcServer.RegisterService(NewContractService(ar), "contract")
 	if err != nil {
 		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: contract")
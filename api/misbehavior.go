@@ -0,0 +1,134 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/consensus/adapters"
+	"github.com/insolar/insolar/network/servicenetwork"
+)
+
+// MisbehaviorArgs is arguments that Misbehavior service accepts.
+type MisbehaviorArgs struct {
+	PulseNumber uint32 `json:"pulseNumber"`
+}
+
+// MisbehaviorReportReply describes misbehavior of a node detected by consensus.
+type MisbehaviorReportReply struct {
+	NodeID  uint32 `json:"nodeID"`
+	Host    string `json:"host,omitempty"`
+	Fraud   bool   `json:"fraud"`
+	Type    int    `json:"type"`
+	Details string `json:"details"`
+}
+
+// MisbehaviorReply is reply for Misbehavior service requests.
+type MisbehaviorReply struct {
+	Reports       []MisbehaviorReportReply `json:"reports"`
+	ExcludedNodes []uint32                 `json:"excludedNodes"`
+	TraceID       string                   `json:"traceID"`
+}
+
+// MisbehaviorService is a service that provides API for inspecting consensus misbehavior reports.
+type MisbehaviorService struct {
+	runner *Runner
+}
+
+// NewMisbehaviorService creates new Misbehavior service instance.
+func NewMisbehaviorService(runner *Runner) *MisbehaviorService {
+	return &MisbehaviorService{runner: runner}
+}
+
+// GetReports returns misbehavior reports registered in the pulse and nodes excluded from consensus by penalty policy.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "misbehavior.getReports",
+//     "params": {
+//       "pulseNumber": int // pulse in which misbehavior was reported
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"reports": [{
+// 				"nodeID": int, // short id of the violator
+// 				"host": str, // address of the violator, set if the node is unknown
+// 				"fraud": bool, // fraud or blame
+// 				"type": int, // type of the fraud or blame
+// 				"details": str
+// 			}],
+// 			"excludedNodes": [int], // short ids of nodes excluded by penalty policy
+// 			"traceID": str
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *MisbehaviorService) GetReports(r *http.Request, args *MisbehaviorArgs, reply *MisbehaviorReply) error {
+	traceID := utils.RandTraceID()
+	_, inslog := inslogger.WithTraceField(context.Background(), traceID)
+
+	inslog.Infof("[ MisbehaviorService.GetReports ] Incoming request: %s", r.RequestURI)
+
+	registry := s.registry()
+	if registry == nil {
+		return errors.New("misbehavior registry is not available")
+	}
+
+	reports, err := registry.Reports(insolar.PulseNumber(args.PulseNumber))
+	if err != nil {
+		return errors.Wrap(err, "failed to get misbehavior reports")
+	}
+
+	reply.Reports = make([]MisbehaviorReportReply, 0, len(reports))
+	for _, report := range reports {
+		reply.Reports = append(reply.Reports, MisbehaviorReportReply{
+			NodeID:  uint32(report.NodeID),
+			Host:    report.Host,
+			Fraud:   report.Fraud,
+			Type:    report.Type,
+			Details: report.Details,
+		})
+	}
+
+	reply.ExcludedNodes = []uint32{}
+	for _, id := range registry.ExcludedNodes() {
+		reply.ExcludedNodes = append(reply.ExcludedNodes, uint32(id))
+	}
+	reply.TraceID = traceID
+
+	return nil
+}
+
+func (s *MisbehaviorService) registry() *adapters.MisbehaviorRegistry {
+	sn, ok := s.runner.ServiceNetwork.(*servicenetwork.ServiceNetwork)
+	if !ok {
+		return nil
+	}
+	return sn.MisbehaviorRegistry
+}
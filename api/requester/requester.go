@@ -31,6 +31,7 @@ import (
 	"math/big"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -319,3 +320,45 @@ func Status(url string) (*StatusResponse, error) {
 
 	return &statusResp.Result, nil
 }
+
+// AdminSignData returns data which is signed by node private key for calls of admin methods.
+func AdminSignData(method string, seed string, params ...string) []byte {
+	return []byte(strings.Join(append([]string{method, seed}, params...), ":"))
+}
+
+// SetPower makes rpc request to admin.setPower method signed by node private key and extracts it
+func SetPower(url string, nodeCfg *UserConfigJSON, power string) (*SetPowerResponse, error) {
+	seed, err := GetSeed(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SetPower ]")
+	}
+
+	signature, err := Sign(nodeCfg.privateKeyObject, AdminSignData("admin.setPower", seed, power))
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SetPower ] Problem with signing request")
+	}
+
+	params := getDefaultRPCParams("admin.setPower")
+	params.PlatformParams = map[string]string{
+		"power":     power,
+		"seed":      seed,
+		"signature": signature,
+	}
+
+	body, err := GetResponseBodyPlatform(url+"/rpc", params)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SetPower ]")
+	}
+
+	powerResp := rpcSetPowerResponse{}
+
+	err = json.Unmarshal(body, &powerResp)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SetPower ] Can't unmarshal")
+	}
+	if powerResp.Error != nil {
+		return nil, errors.New("[ SetPower ] Field 'error' is not nil: " + fmt.Sprint(powerResp.Error))
+	}
+
+	return &powerResp.Result, nil
+}
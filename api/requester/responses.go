@@ -54,3 +54,14 @@ type rpcInfoResponse struct {
 	rpcResponse
 	Result InfoResponse `json:"result"`
 }
+
+// SetPowerResponse represents response from rpc on admin.setPower method
+type SetPowerResponse struct {
+	Power   string `json:"power"`
+	TraceID string `json:"traceID"`
+}
+
+type rpcSetPowerResponse struct {
+	rpcResponse
+	Result SetPowerResponse `json:"result"`
+}
@@ -0,0 +1,130 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// SagasArgs is arguments that Saga service accepts.
+type SagasArgs struct {
+	Object string `json:"object"`
+}
+
+// SagaReply describes saga call that is not completed yet.
+type SagaReply struct {
+	Request   string `json:"request"`
+	Rollback  string `json:"rollback,omitempty"`
+	Method    string `json:"method"`
+	Status    string `json:"status"`
+	Attempts  int    `json:"attempts"`
+	RetryIn   int    `json:"retryIn"`
+	LastError string `json:"lastError,omitempty"`
+}
+
+// SagasReply is reply for Saga service requests.
+type SagasReply struct {
+	Sagas   []SagaReply `json:"sagas"`
+	TraceID string      `json:"traceID"`
+}
+
+// SagaService is a service that provides API for inspecting saga calls.
+type SagaService struct {
+	runner *Runner
+}
+
+// NewSagaService creates new Saga service instance.
+func NewSagaService(runner *Runner) *SagaService {
+	return &SagaService{runner: runner}
+}
+
+// GetOutstanding returns saga calls of the object which are failed or being retried by its current executor.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "saga.getOutstanding",
+//     "params": {
+//       "object": str // reference of the object that made saga calls
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"sagas": [{
+// 				"request": str, // reference of the saga call request
+// 				"rollback": str, // reference of the rollback request
+// 				"method": str, // saga method
+// 				"status": str, // SagaAccepting, SagaRollingBack or SagaRollbackFailed
+// 				"attempts": int, // amount of failed attempts
+// 				"retryIn": int, // amount of pulses before next attempt
+// 				"lastError": str
+// 			}],
+// 			"traceID": str
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *SagaService) GetOutstanding(r *http.Request, args *SagasArgs, reply *SagasReply) error {
+	traceID := utils.RandTraceID()
+	ctx, inslog := inslogger.WithTraceField(context.Background(), traceID)
+
+	inslog.Infof("[ SagaService.GetOutstanding ] Incoming request: %s", r.RequestURI)
+
+	if s.runner.SagaAccessor == nil {
+		return errors.New("sagas are tracked only by virtual nodes")
+	}
+
+	object, err := insolar.NewReferenceFromBase58(args.Object)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse object reference")
+	}
+
+	sagas, err := s.runner.SagaAccessor.OutstandingSagas(ctx, *object)
+	if err != nil {
+		return errors.Wrap(err, "failed to get sagas")
+	}
+
+	reply.Sagas = []SagaReply{}
+	for _, saga := range sagas {
+		sr := SagaReply{
+			Request:   saga.Request.String(),
+			Method:    saga.Method,
+			Status:    saga.Status.String(),
+			Attempts:  saga.Attempts,
+			RetryIn:   saga.RetryIn,
+			LastError: saga.LastError,
+		}
+		if saga.Rollback != nil {
+			sr.Rollback = saga.Rollback.String()
+		}
+		reply.Sagas = append(reply.Sagas, sr)
+	}
+	reply.TraceID = traceID
+
+	return nil
+}
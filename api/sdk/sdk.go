@@ -222,6 +222,25 @@ func (sdk *SDK) AddBurnAddresses(burnAddresses []string) (string, error) {
 	return response.TraceID, nil
 }
 
+// UpgradeObject switches object to the upgraded prototype, request is signed by root member as the domain owner
+func (sdk *SDK) UpgradeObject(object string, prototype string) (string, error) {
+	userConfig, err := requester.CreateUserConfig(sdk.rootMember.Caller, sdk.rootMember.PrivateKey, sdk.rootMember.PublicKey)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create user config for request")
+	}
+
+	response, err := sdk.DoRequest(
+		userConfig,
+		"contract.upgradeObject",
+		map[string]interface{}{"reference": object, "prototype": prototype},
+	)
+	if err != nil {
+		return "", errors.Wrap(err, "request was failed ")
+	}
+
+	return response.TraceID, nil
+}
+
 // Transfer method send money from one member to another
 func (sdk *SDK) Transfer(amount string, from *Member, to *Member) (string, error) {
 	userConfig, err := requester.CreateUserConfig(from.Reference, from.PrivateKey, from.PublicKey)
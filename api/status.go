@@ -19,10 +19,12 @@ package api
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/utils"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/light/executor"
 	"github.com/insolar/insolar/network/servicenetwork"
 	"github.com/insolar/insolar/version"
 )
@@ -46,6 +48,52 @@ type StatusReply struct {
 	Entropy            []byte
 	NodeState          string
 	Version            string
+	// DrainStage is a stage of draining before the node leaves the network.
+	DrainStage string
+	// DrainPending holds amount of not finished or not handed off work per component while draining.
+	DrainPending map[string]int
+	// Handoff is a progress of hot data handoff, it's set on light material nodes only.
+	Handoff *HandoffStatus `json:",omitempty"`
+	// Retention describes pulses which data is kept locally, it's set on light material nodes only.
+	Retention *RetentionStatus `json:",omitempty"`
+}
+
+// HandoffReporter reports progress of hot data handoff on light material node.
+type HandoffReporter interface {
+	HandoffProgress() executor.HandoffProgress
+}
+
+// RetentionReporter reports pulses which data is kept on light material node.
+type RetentionReporter interface {
+	Retention() executor.RetentionWindow
+}
+
+// JetHandoffStatus describes sending of hot data for one jet to the next executor.
+type JetHandoffStatus struct {
+	JetID    string
+	Indexes  int
+	Started  time.Time
+	Finished time.Time `json:",omitempty"`
+	Error    string    `json:",omitempty"`
+}
+
+// HandoffStatus describes hot data handoff for the current pulse.
+type HandoffStatus struct {
+	PulseNumber uint32
+	// Pending is amount of jets hot data is still being sent for.
+	Pending int
+	Jets    []JetHandoffStatus
+	// Waiting holds amount of requests waiting for incoming hot data per jet.
+	Waiting map[string]int
+}
+
+// RetentionStatus describes pulses which data is kept locally.
+type RetentionStatus struct {
+	// Oldest is the oldest pulse which data is kept, zero if nothing was cleaned yet.
+	Oldest uint32
+	Latest uint32
+	// Depth is amount of pulses kept before the latest one.
+	Depth int
 }
 
 // Get returns status info
@@ -98,5 +146,45 @@ func (s *NodeService) GetStatus(r *http.Request, args *interface{}, reply *Statu
 	reply.Entropy = np.Entropy[:]
 	reply.Version = version.Version
 
+	if s.runner.TerminationHandler != nil {
+		drain := s.runner.TerminationHandler.DrainStatus()
+		reply.DrainStage = drain.Stage.String()
+		reply.DrainPending = drain.Pending
+	}
+
+	if s.runner.Handoff != nil {
+		reply.Handoff = handoffStatus(s.runner.Handoff.HandoffProgress())
+	}
+	if s.runner.Retention != nil {
+		retention := s.runner.Retention.Retention()
+		reply.Retention = &RetentionStatus{
+			Oldest: uint32(retention.Oldest),
+			Latest: uint32(retention.Latest),
+			Depth:  retention.Depth,
+		}
+	}
+
 	return nil
 }
+
+func handoffStatus(progress executor.HandoffProgress) *HandoffStatus {
+	status := &HandoffStatus{
+		PulseNumber: uint32(progress.Pulse),
+		Pending:     progress.Pending(),
+		Jets:        make([]JetHandoffStatus, 0, len(progress.Outgoing)),
+		Waiting:     make(map[string]int, len(progress.Waiting)),
+	}
+	for _, h := range progress.Outgoing {
+		status.Jets = append(status.Jets, JetHandoffStatus{
+			JetID:    h.JetID.DebugString(),
+			Indexes:  h.Indexes,
+			Started:  h.Started,
+			Finished: h.Finished,
+			Error:    h.Error,
+		})
+	}
+	for jetID, n := range progress.Waiting {
+		status.Waiting[jetID.DebugString()] = n
+	}
+	return status
+}
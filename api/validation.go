@@ -0,0 +1,128 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// MismatchesArgs is arguments that Validation service accepts.
+type MismatchesArgs struct {
+	Object string `json:"object"`
+}
+
+// MismatchReply describes result of request execution that didn't match validator's re-execution.
+type MismatchReply struct {
+	Request   string `json:"request"`
+	Pulse     uint32 `json:"pulse"`
+	Executor  string `json:"executor"`
+	Validator string `json:"validator"`
+	Expected  string `json:"expected"`
+	Actual    string `json:"actual,omitempty"`
+	Reason    string `json:"reason"`
+}
+
+// MismatchesReply is reply for Validation service requests.
+type MismatchesReply struct {
+	Mismatches []MismatchReply `json:"mismatches"`
+	TraceID    string          `json:"traceID"`
+}
+
+// ValidationService is a service that provides API for inspecting validation results of virtual executors.
+type ValidationService struct {
+	runner *Runner
+}
+
+// NewValidationService creates new Validation service instance.
+func NewValidationService(runner *Runner) *ValidationService {
+	return &ValidationService{runner: runner}
+}
+
+// GetMismatches returns validation mismatches reported for the object by virtual validators.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "validation.getMismatches",
+//     "params": {
+//       "object": str // reference of the validated object
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"mismatches": [{
+// 				"request": str, // reference of the validated request
+// 				"pulse": int, // pulse the request was executed in
+// 				"executor": str, // reference of the virtual executor
+// 				"validator": str, // reference of the virtual validator reported the mismatch
+// 				"expected": str, // hex encoded hash of the result saved by executor
+// 				"actual": str, // hex encoded hash of the result of re-execution, empty if it failed
+// 				"reason": str
+// 			}],
+// 			"traceID": str
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *ValidationService) GetMismatches(r *http.Request, args *MismatchesArgs, reply *MismatchesReply) error {
+	traceID := utils.RandTraceID()
+	ctx, inslog := inslogger.WithTraceField(context.Background(), traceID)
+
+	inslog.Infof("[ ValidationService.GetMismatches ] Incoming request: %s", r.RequestURI)
+
+	if s.runner.Mismatches == nil {
+		return errors.New("validation mismatches are stored only by heavy material nodes")
+	}
+
+	object, err := insolar.NewReferenceFromBase58(args.Object)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse object reference")
+	}
+
+	mismatches, err := s.runner.Mismatches.ForObject(ctx, *object)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch validation mismatches")
+	}
+
+	reply.Mismatches = make([]MismatchReply, 0, len(mismatches))
+	for _, m := range mismatches {
+		reply.Mismatches = append(reply.Mismatches, MismatchReply{
+			Request:   m.Request.String(),
+			Pulse:     uint32(m.Pulse),
+			Executor:  m.Executor.String(),
+			Validator: m.Validator.String(),
+			Expected:  hex.EncodeToString(m.Expected),
+			Actual:    hex.EncodeToString(m.Actual),
+			Reason:    m.Reason,
+		})
+	}
+	reply.TraceID = traceID
+
+	return nil
+}
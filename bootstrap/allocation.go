@@ -0,0 +1,76 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bootstrap
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// readAllocationFile reads list of members which should be created in genesis.
+//
+// File is a JSON array:
+//
+//	[
+//	  {
+//	    "public_key": "-----BEGIN PUBLIC KEY-----...",
+//	    "balance": "1000",
+//	    "migration_address": "0x...",
+//	    "deposits": [{"tx_hash": "0x...", "amount": "100", "hold_release_pulse": 0}]
+//	  }
+//	]
+func readAllocationFile(path string) ([]insolar.GenesisMemberAllocation, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v", path)
+	}
+	var allocations []insolar.GenesisMemberAllocation
+	err = json.Unmarshal(b, &allocations)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %v", path)
+	}
+
+	for i, a := range allocations {
+		if a.PublicKey == "" {
+			return nil, errors.Errorf("allocation %d: public key is empty", i)
+		}
+		if a.Balance == "" {
+			allocations[i].Balance = "0"
+		} else if !isAmount(a.Balance) {
+			return nil, errors.Errorf("allocation %d: bad balance %v", i, a.Balance)
+		}
+		for _, d := range a.Deposits {
+			if d.TxHash == "" {
+				return nil, errors.Errorf("allocation %d: deposit tx hash is empty", i)
+			}
+			if !isAmount(d.Amount) {
+				return nil, errors.Errorf("allocation %d: bad deposit amount %v", i, d.Amount)
+			}
+		}
+	}
+	return allocations, nil
+}
+
+func isAmount(s string) bool {
+	amount, ok := new(big.Int).SetString(s, 10)
+	return ok && amount.Sign() >= 0
+}
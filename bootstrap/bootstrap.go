@@ -113,6 +113,13 @@ func (g *Generator) Run(ctx context.Context) error {
 		MigrationAdminPublicKey:   migrationAdminPublicKey,
 		MigrationDaemonPublicKeys: migrationDaemonPublicKeys,
 	}
+	if g.config.AllocationFile != "" {
+		inslog.Infof("[ bootstrap ] read allocation file %v", g.config.AllocationFile)
+		contractsConfig.Allocations, err = readAllocationFile(g.config.AllocationFile)
+		if err != nil {
+			return errors.Wrap(err, "couldn't read allocation file")
+		}
+	}
 	err = g.makeHeavyGenesisConfig(discoveryNodes, contractsConfig)
 	if err != nil {
 		return errors.Wrap(err, "generate heavy genesis config failed")
@@ -60,7 +60,10 @@ type Config struct {
 	RootBalance string `mapstructure:"root_balance"`
 	// MDBalance is a start balance for the migration admin member's wallet.
 	MDBalance string `mapstructure:"md_balance"`
-	Contracts Contracts
+	// AllocationFile is the path to JSON file with members, balances, deposits and migration addresses
+	// which should be created in genesis.
+	AllocationFile string `mapstructure:"allocation_file"`
+	Contracts      Contracts
 
 	// Discovery settings.
 
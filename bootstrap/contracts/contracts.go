@@ -22,12 +22,14 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/genesisrefs"
 	"github.com/insolar/insolar/logicrunner/builtin/contract/costcenter"
+	"github.com/insolar/insolar/logicrunner/builtin/contract/deposit"
 	"github.com/insolar/insolar/logicrunner/builtin/contract/member"
 	"github.com/insolar/insolar/logicrunner/builtin/contract/migrationshard"
 	"github.com/insolar/insolar/logicrunner/builtin/contract/nodedomain"
 	"github.com/insolar/insolar/logicrunner/builtin/contract/pkshard"
 	"github.com/insolar/insolar/logicrunner/builtin/contract/rootdomain"
 	"github.com/insolar/insolar/logicrunner/builtin/contract/wallet"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
 )
 
 func RootDomain() insolar.GenesisContractState {
@@ -110,11 +112,65 @@ func GetCostCenterGenesisContractState() insolar.GenesisContractState {
 	}
 }
 
-func GetPKShardGenesisContractState(name string) insolar.GenesisContractState {
+func GetAllocationMemberGenesisContractState(
+	allocation insolar.GenesisMemberAllocation,
+	name string,
+	parent string,
+	walletRef insolar.Reference,
+	deposits map[string]insolar.Reference,
+) insolar.GenesisContractState {
+	m, err := member.New(genesisrefs.ContractRootDomain, name, allocation.PublicKey, allocation.MigrationAddress, walletRef)
+	if err != nil {
+		panic(fmt.Sprintf("'%s' member constructor failed", name))
+	}
+
+	for txHash, ref := range deposits {
+		m.Deposits[txHash] = ref
+	}
+
+	return insolar.GenesisContractState{
+		Name:       name,
+		Prototype:  insolar.GenesisNameMember,
+		ParentName: parent,
+		Memory:     mustGenMemory(m),
+	}
+}
+
+func GetDepositGenesisContractState(allocation insolar.GenesisDepositAllocation, name string, parent string) insolar.GenesisContractState {
+	// Deposit constructor requires logical context, so deposit is created as already confirmed by active daemons.
+	var confirms [insolar.GenesisAmountActiveMigrationDaemonMembers]string
+	for i := range confirms {
+		confirms[i] = genesisrefs.ContractMigrationDaemonMembers[i].String()
+	}
+	unHold := allocation.HoldReleasePulse
+	if unHold == 0 {
+		unHold = insolar.GenesisPulse.PulseNumber
+	}
+
+	return insolar.GenesisContractState{
+		Name:       name,
+		Prototype:  insolar.GenesisNameDeposit,
+		ParentName: parent,
+		Delegate:   true,
+		Memory: mustGenMemory(&deposit.Deposit{
+			PulseDepositCreate:      insolar.GenesisPulse.PulseNumber,
+			PulseDepositHold:        insolar.GenesisPulse.PulseNumber,
+			PulseDepositUnHold:      unHold,
+			MigrationDaemonConfirms: confirms,
+			Amount:                  allocation.Amount,
+			TxHash:                  allocation.TxHash,
+		}),
+	}
+}
+
+func GetPKShardGenesisContractState(name string, members foundation.StableMap) insolar.GenesisContractState {
 	s, err := pkshard.New()
 	if err != nil {
 		panic(fmt.Sprintf("'%s' shard constructor failed", name))
 	}
+	for key, ref := range members {
+		s.Map[key] = ref
+	}
 
 	return insolar.GenesisContractState{
 		Name:       name,
@@ -124,11 +180,14 @@ func GetPKShardGenesisContractState(name string) insolar.GenesisContractState {
 	}
 }
 
-func GetMigrationShardGenesisContractState(name string) insolar.GenesisContractState {
+func GetMigrationShardGenesisContractState(name string, members foundation.StableMap) insolar.GenesisContractState {
 	s, err := migrationshard.New()
 	if err != nil {
 		panic(fmt.Sprintf("'%s' shard constructor failed", name))
 	}
+	for address, ref := range members {
+		s.Map[address] = ref
+	}
 
 	return insolar.GenesisContractState{
 		Name:       name,
@@ -0,0 +1,98 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/log"
+	"github.com/insolar/insolar/network/consensus/simulator"
+)
+
+func main() {
+	scenarioPath := pflag.StringP("scenario", "s", "", "path to scenario YAML file")
+	outputPath := pflag.StringP("output", "o", "", "path to report file, stdout by default")
+	asJSON := pflag.Bool("json", false, "write report in JSON")
+	logLevel := pflag.StringP("log-level", "l", "error", "log level of emulated nodes")
+	pflag.Parse()
+
+	if *scenarioPath == "" {
+		log.Error("need to provide scenario")
+		pflag.Usage()
+		os.Exit(2)
+	}
+
+	scenario, err := simulator.LoadScenario(*scenarioPath)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(2)
+	}
+
+	ctx, err := initLogger(*logLevel)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(2)
+	}
+
+	report := simulator.NewSimulator(scenario).Run(ctx)
+
+	if err := writeReport(report, *outputPath, *asJSON); err != nil {
+		log.Error(err.Error())
+		os.Exit(2)
+	}
+}
+
+// writeReport writes report to the file at path or to stdout if path is empty.
+func writeReport(report *simulator.Report, path string, asJSON bool) error {
+	var out io.Writer = os.Stdout
+	if path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if asJSON {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+	return report.WriteText(out)
+}
+
+func initLogger(level string) (context.Context, error) {
+	ctx := context.Background()
+	logger := inslogger.FromContext(ctx).WithCaller(false)
+	logger, err := logger.WithLevel(level)
+	if err != nil {
+		return nil, err
+	}
+	logger, err = logger.WithFormat(insolar.TextFormat)
+	if err != nil {
+		return nil, err
+	}
+	return inslogger.SetLogger(ctx, logger), nil
+}
@@ -307,6 +307,80 @@ func main() {
 	cmdImports.Flags().VarP(output, "output", "o", "output file (use - for STDOUT)")
 	cmdImports.Flags().VarP(machineType, "machine-type", "m", "machine type (one of builtin/go)")
 
+	var cmdABI = &cobra.Command{
+		Use:   "abi [flags] <file name to process>",
+		Short: "Generate contract's ABI in JSON",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			parsed, err := preprocessor.ParseFile(args[0], machineType.Value())
+			if err != nil {
+				fmt.Println(errors.Wrap(err, "couldn't parse"))
+				os.Exit(1)
+			}
+
+			err = parsed.WriteABI(output.writer)
+			checkError(err)
+		},
+	}
+	cmdABI.Flags().VarP(output, "output", "o", "output file (use - for STDOUT)")
+	cmdABI.Flags().VarP(machineType, "machine-type", "m", "machine type (one of builtin/go)")
+
+	var clientLanguage, clientPackage string
+	var cmdClient = &cobra.Command{
+		Use:   "client [flags] <ABI file name>",
+		Short: "Generate typed client calling contract through /api/call",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			in, err := os.Open(args[0])
+			checkError(err)
+			defer in.Close()
+
+			abi, err := preprocessor.ReadABI(in)
+			checkError(err)
+
+			switch clientLanguage {
+			case "go":
+				if clientPackage == "" {
+					clientPackage = abi.Contract
+				}
+				err = preprocessor.WriteGoClient(output.writer, abi, clientPackage)
+			case "ts":
+				err = preprocessor.WriteTypeScriptClient(output.writer, abi)
+			default:
+				err = errors.Errorf("unsupported client language %q", clientLanguage)
+			}
+			checkError(err)
+		},
+	}
+	cmdClient.Flags().VarP(output, "output", "o", "output file (use - for STDOUT)")
+	cmdClient.Flags().StringVarP(&clientLanguage, "language", "l", "go", "client language (one of go/ts)")
+	cmdClient.Flags().StringVarP(&clientPackage, "package", "p", "", "package of Go client (contract name by default)")
+
+	var cmdCheck = &cobra.Command{
+		Use:   "check [flags] <file names to process>",
+		Short: "Check contracts for non-deterministic code",
+		Args:  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			failed := false
+			for _, fileName := range args {
+				parsed, err := preprocessor.ParseFile(fileName, machineType.Value())
+				if err != nil {
+					fmt.Println(errors.Wrapf(err, "couldn't parse %s", fileName))
+					os.Exit(1)
+				}
+
+				for _, diagnostic := range parsed.CheckDeterminism() {
+					fmt.Println(diagnostic)
+					failed = true
+				}
+			}
+			if failed {
+				os.Exit(1)
+			}
+		},
+	}
+	cmdCheck.Flags().VarP(machineType, "machine-type", "m", "machine type (one of builtin/go)")
+
 	var cmdGenerateBuiltins = &cobra.Command{
 		Use:   "regen-builtin [flags] <dir path to builtin contracts>",
 		Short: "Build builtin proxy, wrappers and initializator",
@@ -374,7 +448,7 @@ func main() {
 
 	var rootCmd = &cobra.Command{Use: "insgocc"}
 	rootCmd.AddCommand(
-		cmdProxy, cmdWrapper, cmdImports, cmdGenerateBuiltins, genesisCompile())
+		cmdProxy, cmdWrapper, cmdImports, cmdCheck, cmdABI, cmdClient, cmdGenerateBuiltins, genesisCompile())
 	err := rootCmd.Execute()
 	if err != nil {
 		fmt.Println(err)
@@ -143,6 +143,20 @@ func main() {
 		&certFile, "node-cert", "c", "cert.json", "The OUT file the node certificate")
 	rootCmd.AddCommand(certgenCmd)
 
+	var nodeKeysFile string
+	var setPowerCmd = &cobra.Command{
+		Use:   "set-power",
+		Short: "sets node power: zero, minimal, reduced, normal or extended",
+		Args:  cobra.ExactArgs(1), // power
+		Run: func(cmd *cobra.Command, args []string) {
+			setPower(sendURL, nodeKeysFile, args[0])
+		},
+	}
+	addURLFlag(setPowerCmd.Flags())
+	setPowerCmd.Flags().StringVarP(
+		&nodeKeysFile, "node-keys", "k", "keys.json", "path to json with private key of the node")
+	rootCmd.AddCommand(setPowerCmd)
+
 	rootCmd.AddCommand(bootstrapCommand())
 
 	if err := rootCmd.Execute(); err != nil {
@@ -280,6 +294,16 @@ func sendRequest(sendURL string, rootKeysFile string, paramsPath string, rootAsC
 	mustWrite(os.Stdout, string(response))
 }
 
+func setPower(url string, nodeKeysFile string, power string) {
+	nodeCfg, err := requester.ReadUserConfigFromFile(nodeKeysFile)
+	check("[ setPower ]", err)
+
+	resp, err := requester.SetPower(url, nodeCfg, power)
+	check("[ setPower ]", err)
+	fmt.Printf("TraceID : %s\n", resp.TraceID)
+	fmt.Printf("Power   : %s\n", resp.Power)
+}
+
 func getInfo(url string) {
 	info, err := requester.Info(url)
 	check("[ sendRequest ]", err)
@@ -6,10 +6,32 @@ import (
 
 type Bus struct {
 	ReplyTimeout time.Duration
+	// ChunkSize is a max size of a message sent as a whole, bigger messages are streamed in chunks of this size.
+	// Zero disables chunking.
+	ChunkSize int
+	// ChunkWindow is a number of chunks sent without waiting for acknowledgement.
+	ChunkWindow int
+	// ChunkTimeout is a time to wait for chunk acknowledgement before resending from the last acknowledged offset.
+	ChunkTimeout time.Duration
+	// MaxStreamSize is a max size of a chunked message accepted by node.
+	MaxStreamSize int
+	// MaxSenderStreams is a max number of chunked messages received from one node at the same time.
+	MaxSenderStreams int
+	// MaxSenderStreamBytes is a max number of bytes buffered for chunked messages received from one node.
+	MaxSenderStreamBytes int
+	// MaxStreamBytes is a max number of bytes buffered for chunked messages received from all nodes.
+	MaxStreamBytes int
 }
 
 func NewBus() Bus {
 	return Bus{
-		ReplyTimeout: 15 * time.Second,
+		ReplyTimeout:         15 * time.Second,
+		ChunkSize:            1 << 20,
+		ChunkWindow:          8,
+		ChunkTimeout:         5 * time.Second,
+		MaxStreamSize:        64 << 20,
+		MaxSenderStreams:     4,
+		MaxSenderStreamBytes: 128 << 20,
+		MaxStreamBytes:       512 << 20,
 	}
 }
@@ -16,19 +16,71 @@
 
 package configuration
 
+import (
+	"time"
+)
+
 // Transport holds transport protocol configuration for HostNetwork
 type Transport struct {
-	// protocol type
+	// protocol type: TCP or QUIC, all nodes of the network must use the same protocol
 	Protocol string
 	// Address to listen
 	Address string
 	// if not empty - this should be public address of instance (to connect from the "other" side to)
 	FixedPublicAddress string
+	// if not empty and FixedPublicAddress is empty - public IP is discovered by asking these nodes (STUN-like)
+	Reflectors []string
+	// relaying traffic to nodes that are not reachable directly
+	Relay Relay
+}
+
+// Relay holds configuration of relaying traffic to nodes behind NAT
+type Relay struct {
+	// if not empty - address of a relay node this node receives traffic through
+	Server string
+	// if not empty - address to serve relay clients on
+	Listen string
+	// range of ports allocated for relay clients
+	MinPort int
+	MaxPort int
+}
+
+// Compression holds configuration of payload compression negotiated between peers
+type Compression struct {
+	// codecs this node accepts in order of preference (snappy, flate), empty list disables compression
+	Codecs []string
+	// payloads smaller than Threshold bytes are sent uncompressed
+	Threshold int
+	// decompressed payloads bigger than MaxSize bytes are rejected
+	MaxSize int
+}
+
+// PeerGuard holds configuration of request rate limits, connection limits and reputation of remote peers
+type PeerGuard struct {
+	// requests per second accepted from a single peer for packet types without own rate, 0 disables rate limiting
+	Rate float64
+	// requests accepted from a single peer for specific packet types (e.g. authorize) per second
+	PacketRates map[string]float64
+	// requests accepted from all peers together for specific packet types per second
+	GlobalPacketRates map[string]float64
+	// peers are allowed to send requests for BurstInterval at once without delays between them
+	BurstInterval time.Duration
+	// maximum number of connections kept in pool, least recently used connection is closed on overflow, 0 is unlimited
+	MaxConnections int
+	// maximum number of inbound connections served at once, new connections are closed on overflow, 0 is unlimited
+	MaxInboundConnections int
+	// peer is banned for BanDuration when its penalty score for malformed or unauthenticated packets reaches BanScore
+	BanScore    float64
+	BanDuration time.Duration
+	// penalty score forgiven per second
+	ScoreDecay float64
 }
 
 // HostNetwork holds configuration for HostNetwork
 type HostNetwork struct {
 	Transport           Transport
+	Compression         Compression
+	PeerGuard           PeerGuard
 	MinTimeout          int   // bootstrap timeout min
 	MaxTimeout          int   // bootstrap timeout max
 	TimeoutMult         int   // bootstrap timout multiplier
@@ -40,9 +92,23 @@ type HostNetwork struct {
 func NewHostNetwork() HostNetwork {
 	// IP address should not be 0.0.0.0!!!
 	transport := Transport{Protocol: "TCP", Address: "127.0.0.1:0"}
+	compression := Compression{Codecs: []string{"snappy", "flate"}, Threshold: 1024, MaxSize: 64 * 1024 * 1024}
+	peerGuard := PeerGuard{
+		Rate:                  1000,
+		PacketRates:           map[string]float64{"authorize": 1, "bootstrap": 1},
+		GlobalPacketRates:     map[string]float64{"authorize": 50},
+		BurstInterval:         5 * time.Second,
+		MaxConnections:        1000,
+		MaxInboundConnections: 1000,
+		BanScore:              100,
+		BanDuration:           10 * time.Minute,
+		ScoreDecay:            1,
+	}
 
 	return HostNetwork{
 		Transport:           transport,
+		Compression:         compression,
+		PeerGuard:           peerGuard,
 		MinTimeout:          1,
 		MaxTimeout:          60,
 		TimeoutMult:         2,
@@ -50,9 +50,33 @@ type Ledger struct {
 	// IMPORTANT: It should be the same on ALL nodes.
 	LightChainLimit int
 
+	// HeavyReplicas is amount of heavy material nodes every finalized drop is stored on.
+	// Value is capped by amount of active heavy nodes.
+	//
+	// IMPORTANT: It should be the same on ALL nodes.
+	HeavyReplicas int
+
 	// CleanerDelay holds value of pulses, that should happen before end of LightChainLimit and start
 	// of LME's data cleaning
 	CleanerDelay int
+
+	// HotWaitersLimit is maximum amount of requests waiting for hot data of one jet on light node.
+	// Requests over the limit are rejected and should be retried by sender. Zero means no limit.
+	HotWaitersLimit int
+
+	// Retention configures how long light node keeps data locally.
+	// Reads for pulses within LightChainLimit + Retention.KeepPulses are served by light nodes.
+	//
+	// IMPORTANT: Retention.KeepPulses should be the same on ALL nodes.
+	Retention LightRetention
+}
+
+// LightRetention configures retention and archival of light node's data.
+type LightRetention struct {
+	// KeepPulses is amount of pulses kept locally in addition to LightChainLimit and CleanerDelay.
+	KeepPulses int
+	// ArchiveDirectory is a directory where evicted pulses are exported to. Empty value disables archiving.
+	ArchiveDirectory string
 }
 
 // NewLedger creates new default Ledger configuration.
@@ -70,6 +94,9 @@ func NewLedger() Ledger {
 			DepthLimit:             10, // limit to 1024 jets
 		},
 		LightChainLimit: 5, // 5 pulses
+		HeavyReplicas:   1,
 		CleanerDelay:    3, // 3 pulses
+
+		HotWaitersLimit: 5000,
 	}
 }
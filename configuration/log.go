@@ -16,14 +16,75 @@
 
 package configuration
 
+import (
+	"time"
+)
+
 // Log holds configuration for logging
 type Log struct {
 	Level     string
 	Adapter   string
 	Formatter string
+
+	// Components overrides Level for particular named loggers (component name -> level).
+	Components map[string]string
+	// Sampling configures sampling of frequent log messages.
+	Sampling LogSampling
+
+	// OutputType is a primary log destination: "stderr" or "file".
+	OutputType string
+	// File configures file output, used when OutputType is "file".
+	File LogFile
+	// Syslog configures optional secondary syslog sink.
+	Syslog LogSyslog
+}
+
+// LogFile holds configuration for log file output with rotation.
+type LogFile struct {
+	// Path is a path to current log file, rotated files are placed next to it.
+	Path string
+	// MaxSize is a size of log file in megabytes after which it is rotated, 0 disables size based rotation.
+	MaxSize int
+	// RotationInterval is a time after which log file is rotated, 0 disables time based rotation.
+	RotationInterval time.Duration
+	// MaxAge is a time to keep rotated files, 0 keeps files regardless of age.
+	MaxAge time.Duration
+	// MaxBackups is a number of rotated files to keep, 0 keeps all files.
+	MaxBackups int
+	// Compress enables gzip compression of rotated files.
+	Compress bool
+}
+
+// LogSyslog holds configuration for syslog sink.
+type LogSyslog struct {
+	Enabled bool
+	// Network and Address of syslog daemon, empty values mean local syslog socket.
+	Network string
+	Address string
+	// Tag is a syslog tag of messages.
+	Tag string
+}
+
+// LogSampling holds configuration for log sampling.
+type LogSampling struct {
+	// DebugEvery writes only every N-th Debug message, values lower than 2 disable sampling.
+	DebugEvery uint32
 }
 
 // NewLog creates new default configuration for logging
 func NewLog() Log {
-	return Log{Level: "Info", Adapter: "zerolog", Formatter: "json"}
+	return Log{
+		Level:      "Info",
+		Adapter:    "zerolog",
+		Formatter:  "json",
+		OutputType: "stderr",
+		File: LogFile{
+			Path:    "insolar.log",
+			MaxSize: 100,
+		},
+		Syslog: LogSyslog{
+			Tag: "insolar",
+		},
+		Components: map[string]string{},
+	}
 }
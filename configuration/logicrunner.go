@@ -27,11 +27,23 @@ type LogicRunner struct {
 	BuiltIn *BuiltIn
 	// GoPlugin - configuration of executor based on Go plugins
 	GoPlugin *GoPlugin
+	// ExecutionQueue - configuration of queues of requests waiting for execution on objects
+	ExecutionQueue *ExecutionQueue
 }
 
 // BuiltIn configuration, no options at the moment
 type BuiltIn struct{}
 
+// ExecutionQueue configuration
+type ExecutionQueue struct {
+	// MaxLength - maximum amount of requests queued for one object, new requests
+	// above the limit are rejected. Zero means no limit.
+	MaxLength int
+	// PriorityLanes - lists of methods whose requests are executed before requests
+	// of other methods, first lane has the highest priority
+	PriorityLanes [][]string
+}
+
 // GoPlugin configuration
 type GoPlugin struct {
 	// RunnerListen - address Go plugins executor listens to
@@ -51,5 +63,8 @@ func NewLogicRunner() LogicRunner {
 			RunnerListen:   "127.0.0.1:7777",
 			RunnerProtocol: "tcp",
 		},
+		ExecutionQueue: &ExecutionQueue{
+			MaxLength: 10000,
+		},
 	}
 }
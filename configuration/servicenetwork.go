@@ -16,10 +16,42 @@
 
 package configuration
 
+import (
+	"time"
+)
+
 // ServiceNetwork is configuration for ServiceNetwork.
 type ServiceNetwork struct {
 	CacheDirectory   string
 	ConsensusEnabled bool
+	Misbehavior      Misbehavior
+	Reconnect        Reconnect
+}
+
+// Misbehavior is configuration of consensus misbehavior registry and penalty policy.
+type Misbehavior struct {
+	// Persist enables storing of reports in CacheDirectory, otherwise reports are kept in memory.
+	// Persisted reports of the last Window pulses are counted again after restart.
+	Persist bool
+	// FraudLimit is an amount of fraud reports on a node within Window pulses
+	// that excludes the node from consensus. Zero disables the penalty.
+	FraudLimit int
+	// BlameLimit is the same as FraudLimit, but for blame reports. Blames carry no evidence,
+	// so the limit only marks the node as excluded in API and metrics.
+	BlameLimit int
+	// Window is an amount of last pulses in which reports are counted.
+	Window int
+}
+
+// Reconnect is configuration of network state persistence used for fast node restart.
+type Reconnect struct {
+	// Persist enables storing of pulses, snapshots and cloud hashes in CacheDirectory,
+	// otherwise network state is kept in memory and lost on restart.
+	Persist bool
+	// MaxAge is a maximum age of the last stored pulse which still allows to rejoin the network by reconnect to
+	// discovery nodes active in its snapshot, discovery nodes accept reconnects of the same age.
+	// Stored state of pulses older than MaxAge is removed.
+	MaxAge time.Duration
 }
 
 // NewServiceNetwork creates a new ServiceNetwork configuration.
@@ -27,5 +59,11 @@ func NewServiceNetwork() ServiceNetwork {
 	return ServiceNetwork{
 		CacheDirectory:   "network_cache",
 		ConsensusEnabled: true,
+		Misbehavior: Misbehavior{
+			Window: 10,
+		},
+		Reconnect: Reconnect{
+			MaxAge: time.Minute,
+		},
 	}
 }
@@ -16,11 +16,35 @@
 
 package configuration
 
+import (
+	"time"
+)
+
 // Tracer configures tracer.
 type Tracer struct {
-	Jaeger JaegerConfig
-	// TODO: add SamplingRules configuration
-	SamplingRules struct{}
+	Jaeger        JaegerConfig
+	OTLP          OTLPConfig
+	SamplingRules SamplingRules
+}
+
+// SamplingRules configures which traces are sampled in addition to Jaeger.ProbabilityRate.
+type SamplingRules struct {
+	// DebugHeader is a name of API request header which forces sampling of request trace, empty disables rule.
+	DebugHeader string
+	// PayloadTypes maps message payload type name to sampling probability in range [0, 1].
+	PayloadTypes map[string]float64
+	// Errors forces export of spans marked with error even if their trace is not sampled.
+	Errors bool
+}
+
+// OTLPConfig holds OpenTelemetry protocol (OTLP/HTTP JSON) exporter settings.
+type OTLPConfig struct {
+	// Endpoint is a traces URL of collector, e.g. http://localhost:4318/v1/traces. Empty disables exporter.
+	Endpoint string
+	// BatchSize is a maximum number of spans in one export request.
+	BatchSize int
+	// FlushInterval is a maximum time spans are buffered before export.
+	FlushInterval time.Duration
 }
 
 // JaegerConfig holds Jaeger settings.
@@ -37,5 +61,12 @@ func NewTracer() Tracer {
 			AgentEndpoint:   "",
 			ProbabilityRate: 1,
 		},
+		OTLP: OTLPConfig{
+			BatchSize:     512,
+			FlushInterval: 5 * time.Second,
+		},
+		SamplingRules: SamplingRules{
+			PayloadTypes: map[string]float64{},
+		},
 	}
 }
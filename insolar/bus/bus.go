@@ -103,6 +103,22 @@ type Bus struct {
 
 	repliesMutex sync.RWMutex
 	replies      map[payload.MessageHash]*lockedReply
+
+	chunkSize            int
+	chunkWindow          int
+	chunkTimeout         time.Duration
+	maxStreamSize        int
+	maxSenderStreams     int
+	maxSenderStreamBytes int
+	maxStreamBytes       int
+
+	streamsMutex sync.Mutex
+	outgoing     map[string]*outgoingStream
+	incoming     map[streamKey]*incomingStream
+	// buffered is a number of bytes buffered by incoming streams of sender
+	buffered map[insolar.Reference]int
+	// bufferedTotal is a number of bytes buffered by incoming streams of all senders
+	bufferedTotal int
 }
 
 // NewBus creates Bus instance with provided values.
@@ -113,6 +129,10 @@ func NewBus(
 	jc jet.Coordinator,
 	pcs insolar.PlatformCryptographyScheme,
 ) *Bus {
+	chunkWindow := cfg.ChunkWindow
+	if chunkWindow < 1 {
+		chunkWindow = 1
+	}
 	return &Bus{
 		timeout:     cfg.ReplyTimeout,
 		pub:         pub,
@@ -120,6 +140,17 @@ func NewBus(
 		pulses:      pulses,
 		coordinator: jc,
 		pcs:         pcs,
+
+		chunkSize:            cfg.ChunkSize,
+		chunkWindow:          chunkWindow,
+		chunkTimeout:         cfg.ChunkTimeout,
+		maxStreamSize:        cfg.MaxStreamSize,
+		maxSenderStreams:     cfg.MaxSenderStreams,
+		maxSenderStreamBytes: cfg.MaxSenderStreamBytes,
+		maxStreamBytes:       cfg.MaxStreamBytes,
+		outgoing:             make(map[string]*outgoingStream),
+		incoming:             make(map[streamKey]*incomingStream),
+		buffered:             make(map[insolar.Reference]int),
 	}
 }
 
@@ -151,6 +182,9 @@ func ReplyAsMessage(ctx context.Context, rep insolar.Reply) *message.Message {
 func (b *Bus) SendRole(
 	ctx context.Context, msg *message.Message, role insolar.DynamicRole, object insolar.Reference,
 ) (<-chan *message.Message, func()) {
+	if payloadType, err := payload.UnmarshalType(msg.Payload); err == nil {
+		ctx = instracer.WithPayloadType(ctx, payloadType.String())
+	}
 	ctx, span := instracer.StartSpan(ctx, "Bus.SendRole")
 	span.AddAttributes(
 		trace.StringAttribute("type", "bus"),
@@ -185,6 +219,13 @@ func (b *Bus) SendRole(
 func (b *Bus) SendTarget(
 	ctx context.Context, msg *message.Message, target insolar.Reference,
 ) (<-chan *message.Message, func()) {
+	ctx, _ = inslogger.WithField(ctx, "sending_type", msg.Metadata.Get(MetaType))
+	payloadType, err := payload.UnmarshalType(msg.Payload)
+	if err == nil {
+		ctx, _ = inslogger.WithField(ctx, "sending_type", payloadType.String())
+		ctx = instracer.WithPayloadType(ctx, payloadType.String())
+	}
+
 	ctx, span := instracer.StartSpan(ctx, "Bus.SendTarget")
 	span.AddAttributes(
 		trace.StringAttribute("type", "bus"),
@@ -198,11 +239,6 @@ func (b *Bus) SendTarget(
 		close(res)
 		return res, func() {}
 	}
-	ctx, _ = inslogger.WithField(ctx, "sending_type", msg.Metadata.Get(MetaType))
-	payloadType, err := payload.UnmarshalType(msg.Payload)
-	if err == nil {
-		ctx, _ = inslogger.WithField(ctx, "sending_type", payloadType.String())
-	}
 	logger := inslogger.FromContext(ctx)
 	span.AddAttributes(
 		trace.StringAttribute("sending_type", msg.Metadata.Get(MetaType)),
@@ -244,6 +280,20 @@ func (b *Bus) SendTarget(
 	b.replies[msgHash] = reply
 	b.repliesMutex.Unlock()
 
+	if b.isStreamed(msg, target) {
+		logger.Debugf("streaming message %s", msgHash.String())
+		go func() {
+			err := b.sendStream(ctx, msg, target)
+			if err != nil {
+				logger.Error(errors.Wrapf(err, "can't stream message with hash %s", msgHash.String()))
+				done()
+				return
+			}
+			b.waitReply(ctx, msgHash, reply, done)
+		}()
+		return reply.messages, done
+	}
+
 	logger.Debugf("sending message %s", msgHash.String())
 	err = b.pub.Publish(TopicOutgoing, msg)
 	if err != nil {
@@ -252,26 +302,30 @@ func (b *Bus) SendTarget(
 		return handleError(errors.Wrapf(err, "can't publish message to %s topic", TopicOutgoing))
 	}
 
-	go func() {
-		logger.Debug("waiting for reply")
-		select {
-		case <-reply.done:
-			logger.Debugf("Done waiting replies for message with hash %s", msgHash.String())
-		case <-time.After(b.timeout):
-			logger.Error(
-				errors.Errorf(
-					"can't return result for message with hash %s: timeout for reading (%s) was exceeded",
-					msgHash.String(),
-					b.timeout,
-				),
-			)
-			done()
-		}
-	}()
+	go b.waitReply(ctx, msgHash, reply, done)
 
 	return reply.messages, done
 }
 
+// waitReply closes reply channel if replies are not read in timeout.
+func (b *Bus) waitReply(ctx context.Context, msgHash payload.MessageHash, reply *lockedReply, done func()) {
+	logger := inslogger.FromContext(ctx)
+	logger.Debug("waiting for reply")
+	select {
+	case <-reply.done:
+		logger.Debugf("Done waiting replies for message with hash %s", msgHash.String())
+	case <-time.After(b.timeout):
+		logger.Error(
+			errors.Errorf(
+				"can't return result for message with hash %s: timeout for reading (%s) was exceeded",
+				msgHash.String(),
+				b.timeout,
+			),
+		)
+		done()
+	}
+}
+
 // Reply sends message in response to another message.
 func (b *Bus) Reply(ctx context.Context, origin payload.Meta, reply *message.Message) {
 	logger := inslogger.FromContext(ctx)
@@ -312,6 +366,17 @@ func (b *Bus) Reply(ctx context.Context, origin payload.Meta, reply *message.Mes
 
 	reply.SetContext(ctx)
 
+	if b.isStreamed(reply, origin.Sender) {
+		logger.Debugf("streaming reply %s", base58.Encode(replyHash))
+		go func() {
+			err := b.sendStream(ctx, reply, origin.Sender)
+			if err != nil {
+				logger.Error(errors.Wrapf(err, "can't stream reply %s", base58.Encode(replyHash)))
+			}
+		}()
+		return
+	}
+
 	logger.Debugf("sending reply %s", base58.Encode(replyHash))
 	err = b.pub.Publish(TopicOutgoing, reply)
 	if err != nil {
@@ -356,6 +421,28 @@ func (b *Bus) IncomingMessageRouter(handle message.HandlerFunc) message.HandlerF
 
 		msg.Metadata.Set("pulse", meta.Pulse.String())
 
+		payloadType, err := payload.UnmarshalType(meta.Payload)
+		if err == nil && payloadType == payload.TypeChunk {
+			span.End()
+			assembled, err := b.handleChunk(ctx, msg, meta)
+			if err != nil {
+				logger.Error(errors.Wrap(err, "failed to receive chunk"))
+				return nil, nil
+			}
+			if assembled == nil {
+				return nil, nil
+			}
+			return b.IncomingMessageRouter(handle)(assembled)
+		}
+		if err == nil && payloadType == payload.TypeChunkAck {
+			span.End()
+			err = b.handleChunkAck(meta)
+			if err != nil {
+				logger.Error(errors.Wrap(err, "failed to receive chunk ack"))
+			}
+			return nil, nil
+		}
+
 		if meta.OriginHash.IsZero() {
 			logger.Debug("not a reply")
 			return handle(msg)
@@ -55,7 +55,7 @@ func (r *RetrySender) Reply(ctx context.Context, origin payload.Meta, reply *mes
 }
 
 // SendRole sends message to specified role, using provided Sender.SendRole. If error with CodeFlowCanceled
-// was received, it retries request after pulse on current node will be changed.
+// or CodeBackpressure was received, it retries request after pulse on current node will be changed.
 // Replies will be written to the returned channel. Always read from the channel using multiple assignment
 // (rep, ok := <-ch) because the channel will be closed on timeout.
 func (r *RetrySender) SendRole(
@@ -156,5 +156,9 @@ func isRetryableError(ctx context.Context, rep *message.Message) bool {
 		inslogger.FromContext(ctx).Errorf("flow cancelled, retrying (error message - %s)", p.Text)
 		return true
 	}
+	if ok && (p.Code == payload.CodeBackpressure) {
+		inslogger.FromContext(ctx).Warnf("receiver is overloaded, retrying (error message - %s)", p.Text)
+		return true
+	}
 	return false
 }
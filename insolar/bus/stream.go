@@ -0,0 +1,358 @@
+//
+// Copyright 2019 Insolar Technologies GbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bus
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// chunkRetries is a number of resends from the last acknowledged offset before stream is aborted.
+const chunkRetries = 3
+
+// streamKey identifies incoming stream, stream IDs are unique only per sender.
+type streamKey struct {
+	sender insolar.Reference
+	id     string
+}
+
+type outgoingStream struct {
+	receiver insolar.Reference
+
+	lock   sync.Mutex
+	acked  uint64
+	notify chan struct{}
+}
+
+func newOutgoingStream(receiver insolar.Reference) *outgoingStream {
+	return &outgoingStream{receiver: receiver, notify: make(chan struct{}, 1)}
+}
+
+func (s *outgoingStream) ack(offset uint64) {
+	s.lock.Lock()
+	if offset > s.acked {
+		s.acked = offset
+	}
+	s.lock.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *outgoingStream) ackedOffset() uint64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.acked
+}
+
+// incomingStream assembles message from chunks. It's guarded by Bus.streamsMutex.
+type incomingStream struct {
+	// total is a size of the whole message.
+	total uint64
+	// data is a contiguous part of the message, it grows as chunks are delivered and is released
+	// after the message is assembled.
+	data []byte
+	// pending holds chunks received after the gap, only chunks within window after the gap are kept.
+	pending  map[uint64][]byte
+	window   uint64
+	buffered int
+	done     bool
+	updated  time.Time
+}
+
+func newIncomingStream(total uint64, window uint64) *incomingStream {
+	return &incomingStream{
+		total:   total,
+		pending: make(map[uint64][]byte),
+		window:  window,
+		updated: time.Now(),
+	}
+}
+
+// put stores chunk data. It returns offset of the first missing byte, the whole message if the chunk completes it
+// and change of the number of bytes buffered by the stream.
+func (s *incomingStream) put(offset uint64, data []byte) (uint64, []byte, int, error) {
+	s.updated = time.Now()
+	if s.done {
+		// duplicate of already assembled message, acknowledge it again
+		return s.total, nil, 0, nil
+	}
+	if len(data) == 0 || offset+uint64(len(data)) > s.total {
+		return s.expected(), nil, 0, errors.Errorf(
+			"chunk [%d, %d) is out of stream bounds %d", offset, offset+uint64(len(data)), s.total,
+		)
+	}
+
+	expected := s.expected()
+	if _, ok := s.pending[offset]; ok || offset < expected || offset >= expected+s.window {
+		// chunk is either a duplicate or too far ahead, sender resends it after the gap is acknowledged
+		return expected, nil, 0, nil
+	}
+	s.pending[offset] = data
+	delta := len(data)
+
+	for {
+		chunk, ok := s.pending[s.expected()]
+		if !ok {
+			break
+		}
+		delete(s.pending, s.expected())
+		s.data = append(s.data, chunk...)
+	}
+	s.buffered += delta
+	if s.expected() < s.total {
+		return s.expected(), nil, delta, nil
+	}
+
+	data, s.data, s.pending = s.data, nil, nil
+	delta -= s.buffered
+	s.buffered = 0
+	s.done = true
+	return s.total, data, delta, nil
+}
+
+func (s *incomingStream) expected() uint64 {
+	return uint64(len(s.data))
+}
+
+func (s *incomingStream) expired(now time.Time, ttl time.Duration) bool {
+	return now.Sub(s.updated) > ttl
+}
+
+func (b *Bus) isStreamed(msg *message.Message, receiver insolar.Reference) bool {
+	return b.chunkSize > 0 && len(msg.Payload) > b.chunkSize && !receiver.Equal(b.coordinator.Me())
+}
+
+// sendStream sends message already wrapped in meta as a stream of chunks. No more than chunkWindow chunks are sent
+// without acknowledgement, if receiver doesn't acknowledge anything in chunkTimeout, stream resumes from the last
+// acknowledged offset.
+func (b *Bus) sendStream(ctx context.Context, msg *message.Message, receiver insolar.Reference) error {
+	logger := inslogger.FromContext(ctx)
+	id := msg.UUID
+	stream := newOutgoingStream(receiver)
+
+	b.streamsMutex.Lock()
+	b.outgoing[id] = stream
+	b.streamsMutex.Unlock()
+	defer func() {
+		b.streamsMutex.Lock()
+		delete(b.outgoing, id)
+		b.streamsMutex.Unlock()
+	}()
+
+	data := msg.Payload
+	total := uint64(len(data))
+	window := uint64(b.chunkSize * b.chunkWindow)
+	logger.Debugf("streaming message %s of %d bytes to %s", id, total, receiver)
+
+	var next, lastAcked uint64
+	retries := 0
+	for {
+		acked := stream.ackedOffset()
+		if acked >= total {
+			return nil
+		}
+		if acked > lastAcked {
+			lastAcked = acked
+			retries = 0
+		}
+		if next < acked {
+			next = acked
+		}
+
+		for next < total && next-acked < window {
+			end := next + uint64(b.chunkSize)
+			if end > total {
+				end = total
+			}
+			chunk := &payload.Chunk{
+				StreamID:  []byte(id),
+				TotalSize: total,
+				Offset:    next,
+				Data:      data[next:end],
+			}
+			err := b.sendService(ctx, msg.Metadata, receiver, chunk)
+			if err != nil {
+				return errors.Wrapf(err, "failed to send chunk at offset %d", next)
+			}
+			next = end
+		}
+
+		select {
+		case <-stream.notify:
+		case <-time.After(b.chunkTimeout):
+			retries++
+			if retries > chunkRetries {
+				return errors.Errorf("stream %s is not acknowledged after offset %d", id, acked)
+			}
+			logger.Warnf("stream %s is not acknowledged in %s, resuming from offset %d", id, b.chunkTimeout, acked)
+			next = acked
+		}
+	}
+}
+
+// sendService sends bus service payload (e.g. stream chunk) to receiver.
+func (b *Bus) sendService(ctx context.Context, metadata message.Metadata, receiver insolar.Reference, pl payload.Payload) error {
+	buf, err := payload.Marshal(pl)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal payload")
+	}
+	msg := message.NewMessage(watermill.NewUUID(), buf)
+	for k, v := range metadata {
+		msg.Metadata.Set(k, v)
+	}
+	msg.SetContext(ctx)
+
+	_, msg, err = b.wrapMeta(ctx, msg, receiver, payload.MessageHash{})
+	if err != nil {
+		return errors.Wrap(err, "can't wrap meta message")
+	}
+	return b.pub.Publish(TopicOutgoing, msg)
+}
+
+// handleChunk stores chunk of incoming stream and acknowledges it. When the last missing chunk is received, it returns
+// assembled message.
+func (b *Bus) handleChunk(ctx context.Context, msg *message.Message, meta payload.Meta) (*message.Message, error) {
+	pl, err := payload.Unmarshal(meta.Payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal chunk")
+	}
+	chunk, ok := pl.(*payload.Chunk)
+	if !ok {
+		return nil, errors.Errorf("unexpected payload %T", pl)
+	}
+	if chunk.TotalSize > uint64(b.maxStreamSize) {
+		return nil, errors.Errorf("stream size %d exceeds limit %d", chunk.TotalSize, b.maxStreamSize)
+	}
+
+	key := streamKey{sender: meta.Sender, id: string(chunk.StreamID)}
+	offset, data, err := b.putChunk(key, chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	ack := &payload.ChunkAck{StreamID: chunk.StreamID, Offset: offset}
+	err = b.sendService(ctx, msg.Metadata, meta.Sender, ack)
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to acknowledge chunk"))
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	assembled := message.NewMessage(watermill.NewUUID(), data)
+	for k, v := range msg.Metadata {
+		assembled.Metadata.Set(k, v)
+	}
+	return assembled, nil
+}
+
+// putChunk passes chunk to incoming stream, number of streams and bytes buffered for one sender are limited,
+// bytes buffered for all senders are limited too.
+func (b *Bus) putChunk(key streamKey, chunk *payload.Chunk) (uint64, []byte, error) {
+	now := time.Now()
+	ttl := b.chunkTimeout * (chunkRetries + 1)
+
+	b.streamsMutex.Lock()
+	defer b.streamsMutex.Unlock()
+
+	for k, s := range b.incoming {
+		if s.expired(now, ttl) {
+			b.release(k.sender, s.buffered)
+			delete(b.incoming, k)
+		}
+	}
+
+	stream, ok := b.incoming[key]
+	if !ok {
+		if b.senderStreams(key.sender) >= b.maxSenderStreams {
+			return 0, nil, errors.Errorf("too many streams from %s", key.sender)
+		}
+		stream = newIncomingStream(chunk.TotalSize, uint64(b.chunkSize*b.chunkWindow))
+		b.incoming[key] = stream
+	}
+	if stream.total != chunk.TotalSize {
+		return 0, nil, errors.Errorf("stream size changed from %d to %d", stream.total, chunk.TotalSize)
+	}
+	if b.buffered[key.sender]+len(chunk.Data) > b.maxSenderStreamBytes {
+		return 0, nil, errors.Errorf("streams from %s exceed limit of %d bytes", key.sender, b.maxSenderStreamBytes)
+	}
+	if b.bufferedTotal+len(chunk.Data) > b.maxStreamBytes {
+		return 0, nil, errors.Errorf("streams exceed limit of %d bytes", b.maxStreamBytes)
+	}
+
+	offset, data, delta, err := stream.put(chunk.Offset, chunk.Data)
+	if err != nil {
+		return 0, nil, err
+	}
+	b.release(key.sender, -delta)
+	return offset, data, nil
+}
+
+// senderStreams returns number of incoming streams from sender which are not assembled yet.
+func (b *Bus) senderStreams(sender insolar.Reference) int {
+	count := 0
+	for k, s := range b.incoming {
+		if !s.done && k.sender.Equal(sender) {
+			count++
+		}
+	}
+	return count
+}
+
+func (b *Bus) release(sender insolar.Reference, size int) {
+	b.bufferedTotal -= size
+	b.buffered[sender] -= size
+	if b.buffered[sender] <= 0 {
+		delete(b.buffered, sender)
+	}
+}
+
+// handleChunkAck passes acknowledged offset to outgoing stream.
+func (b *Bus) handleChunkAck(meta payload.Meta) error {
+	pl, err := payload.Unmarshal(meta.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal chunk ack")
+	}
+	ack, ok := pl.(*payload.ChunkAck)
+	if !ok {
+		return errors.Errorf("unexpected payload %T", pl)
+	}
+
+	b.streamsMutex.Lock()
+	stream, ok := b.outgoing[string(ack.StreamID)]
+	b.streamsMutex.Unlock()
+	if !ok {
+		return nil
+	}
+	if !stream.receiver.Equal(meta.Sender) {
+		return errors.Errorf("chunk ack from %s for stream to %s", meta.Sender, stream.receiver)
+	}
+	stream.ack(ack.Offset)
+	return nil
+}
@@ -0,0 +1,300 @@
+//
+// Copyright 2019 Insolar Technologies GbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package bus
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestIncomingStream_Put(t *testing.T) {
+	data := sizedSlice(10)
+	s := newIncomingStream(10, 10)
+
+	offset, res, delta, err := s.put(4, data[4:8])
+	require.NoError(t, err)
+	require.Nil(t, res)
+	require.Equal(t, uint64(0), offset)
+	require.Equal(t, 4, delta)
+	require.Empty(t, s.data)
+
+	offset, res, delta, err = s.put(0, data[0:4])
+	require.NoError(t, err)
+	require.Nil(t, res)
+	require.Equal(t, uint64(8), offset)
+	require.Equal(t, 4, delta)
+	// buffer grows with contiguous data only
+	require.Equal(t, data[:8], s.data)
+
+	// duplicate of received chunk doesn't move offset
+	offset, res, delta, err = s.put(4, data[4:8])
+	require.NoError(t, err)
+	require.Nil(t, res)
+	require.Equal(t, uint64(8), offset)
+	require.Equal(t, 0, delta)
+
+	_, _, delta, err = s.put(8, data[8:10])
+	require.NoError(t, err)
+	// assembled message is not buffered by stream anymore
+	require.Equal(t, -8, delta)
+
+	// duplicate of the last chunk is acknowledged, but message is not returned again
+	offset, res, delta, err = s.put(8, data[8:10])
+	require.NoError(t, err)
+	require.Nil(t, res)
+	require.Equal(t, uint64(10), offset)
+	require.Equal(t, 0, delta)
+}
+
+func TestIncomingStream_Put_Assembled(t *testing.T) {
+	data := sizedSlice(10)
+	s := newIncomingStream(10, 10)
+
+	_, _, _, err := s.put(5, data[5:])
+	require.NoError(t, err)
+	offset, res, _, err := s.put(0, data[:5])
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), offset)
+	require.Equal(t, data, res)
+}
+
+func TestIncomingStream_Put_OutOfWindow(t *testing.T) {
+	data := sizedSlice(10)
+	s := newIncomingStream(10, 4)
+
+	offset, _, delta, err := s.put(4, data[4:8])
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), offset)
+	require.Equal(t, 0, delta)
+	require.Empty(t, s.pending)
+
+	_, _, _, err = s.put(0, data[:4])
+	require.NoError(t, err)
+	offset, _, delta, err = s.put(4, data[4:8])
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), offset)
+	require.Equal(t, 4, delta)
+}
+
+func TestIncomingStream_Put_OutOfBounds(t *testing.T) {
+	s := newIncomingStream(10, 10)
+
+	_, _, _, err := s.put(8, make([]byte, 4))
+	require.Error(t, err)
+
+	_, _, _, err = s.put(0, nil)
+	require.Error(t, err)
+}
+
+// streamNetwork delivers outgoing messages of buses to incoming routers of their receivers.
+type streamNetwork struct {
+	t       *testing.T
+	lock    sync.Mutex
+	routers map[insolar.Reference]message.HandlerFunc
+	drop    func(pl payload.Payload) bool
+}
+
+func (n *streamNetwork) Publish(topic string, messages ...*message.Message) error {
+	for _, msg := range messages {
+		meta := payload.Meta{}
+		require.NoError(n.t, meta.Unmarshal(msg.Payload))
+
+		n.lock.Lock()
+		router := n.routers[meta.Receiver]
+		dropped := false
+		if n.drop != nil {
+			if pl, err := payload.Unmarshal(meta.Payload); err == nil {
+				dropped = n.drop(pl)
+			}
+		}
+		n.lock.Unlock()
+
+		if !dropped && router != nil {
+			go router(msg) // nolint: errcheck
+		}
+	}
+	return nil
+}
+
+func (n *streamNetwork) Close() error {
+	return nil
+}
+
+func newStreamBus(t *testing.T, net *streamNetwork, me insolar.Reference) *Bus {
+	cfg := configuration.Bus{
+		ReplyTimeout:         15 * time.Second,
+		ChunkSize:            1024,
+		ChunkWindow:          4,
+		ChunkTimeout:         100 * time.Millisecond,
+		MaxStreamSize:        1 << 20,
+		MaxSenderStreams:     2,
+		MaxSenderStreamBytes: 1 << 20,
+		MaxStreamBytes:       1 << 20,
+	}
+	pulseMock := pulse.NewAccessorMock(t)
+	pulseMock.LatestMock.Return(*insolar.GenesisPulse, nil)
+	coordinatorMock := jet.NewCoordinatorMock(t)
+	coordinatorMock.MeMock.Return(me)
+
+	return NewBus(cfg, net, pulseMock, coordinatorMock, testutils.NewPlatformCryptographyScheme())
+}
+
+func TestMessageBus_Stream_RequestAndReply(t *testing.T) {
+	ctx := context.Background()
+
+	droppedOnce := false
+	net := &streamNetwork{
+		t:       t,
+		routers: map[insolar.Reference]message.HandlerFunc{},
+		drop: func(pl payload.Payload) bool {
+			chunk, ok := pl.(*payload.Chunk)
+			if ok && chunk.Offset == 2048 && !droppedOnce {
+				droppedOnce = true
+				return true
+			}
+			return false
+		},
+	}
+
+	senderRef, receiverRef := gen.Reference(), gen.Reference()
+	sender := newStreamBus(t, net, senderRef)
+	receiver := newStreamBus(t, net, receiverRef)
+
+	request := sizedSlice(10*1024 + 100)
+	response := sizedSlice(5*1024 + 10)
+
+	received := make(chan []byte, 1)
+	net.routers[senderRef] = sender.IncomingMessageRouter(func(msg *message.Message) ([]*message.Message, error) {
+		t.Error("unexpected request to sender")
+		return nil, nil
+	})
+	net.routers[receiverRef] = receiver.IncomingMessageRouter(func(msg *message.Message) ([]*message.Message, error) {
+		meta := payload.Meta{}
+		require.NoError(t, meta.Unmarshal(msg.Payload))
+		received <- meta.Payload
+		receiver.Reply(ctx, meta, message.NewMessage(watermill.NewUUID(), response))
+		return nil, nil
+	})
+
+	replies, done := sender.SendTarget(ctx, message.NewMessage(watermill.NewUUID(), request), receiverRef)
+	defer done()
+
+	select {
+	case data := <-received:
+		require.Equal(t, request, data)
+	case <-time.After(10 * time.Second):
+		t.Fatal("request is not received")
+	}
+
+	select {
+	case reply, ok := <-replies:
+		require.True(t, ok)
+		meta := payload.Meta{}
+		require.NoError(t, meta.Unmarshal(reply.Payload))
+		require.Equal(t, response, meta.Payload)
+	case <-time.After(10 * time.Second):
+		t.Fatal("reply is not received")
+	}
+
+	net.lock.Lock()
+	require.True(t, droppedOnce)
+	net.lock.Unlock()
+}
+
+func TestMessageBus_Stream_SizeLimit(t *testing.T) {
+	net := &streamNetwork{t: t, routers: map[insolar.Reference]message.HandlerFunc{}}
+	b := newStreamBus(t, net, gen.Reference())
+
+	chunk, err := payload.Marshal(&payload.Chunk{
+		StreamID:  []byte(watermill.NewUUID()),
+		TotalSize: 2 << 20,
+		Data:      []byte{1},
+	})
+	require.NoError(t, err)
+	meta := payload.Meta{Payload: chunk, Sender: gen.Reference(), ID: []byte(watermill.NewUUID())}
+
+	_, err = b.handleChunk(context.Background(), message.NewMessage(watermill.NewUUID(), nil), meta)
+	require.Error(t, err)
+	require.Empty(t, b.incoming)
+}
+
+func chunkMeta(t *testing.T, sender insolar.Reference, streamID string, total uint64, offset uint64, size int) payload.Meta {
+	chunk, err := payload.Marshal(&payload.Chunk{
+		StreamID:  []byte(streamID),
+		TotalSize: total,
+		Offset:    offset,
+		Data:      make([]byte, size),
+	})
+	require.NoError(t, err)
+	return payload.Meta{Payload: chunk, Sender: sender, ID: []byte(watermill.NewUUID())}
+}
+
+func TestMessageBus_Stream_SenderLimits(t *testing.T) {
+	ctx := context.Background()
+	net := &streamNetwork{t: t, routers: map[insolar.Reference]message.HandlerFunc{}}
+	b := newStreamBus(t, net, gen.Reference())
+	handle := func(meta payload.Meta) error {
+		_, err := b.handleChunk(ctx, message.NewMessage(watermill.NewUUID(), nil), meta)
+		return err
+	}
+
+	sender, other := gen.Reference(), gen.Reference()
+	first, second := watermill.NewUUID(), watermill.NewUUID()
+	require.NoError(t, handle(chunkMeta(t, sender, first, 4096, 0, 1024)))
+	require.NoError(t, handle(chunkMeta(t, sender, second, 4096, 0, 1024)))
+	require.Equal(t, 2048, b.buffered[sender])
+
+	// streams limit is per sender
+	require.Error(t, handle(chunkMeta(t, sender, watermill.NewUUID(), 4096, 0, 1024)))
+	third := watermill.NewUUID()
+	require.NoError(t, handle(chunkMeta(t, other, third, 4096, 0, 1024)))
+
+	// assembled stream releases its slot and bytes
+	for offset := uint64(1024); offset < 4096; offset += 1024 {
+		require.NoError(t, handle(chunkMeta(t, sender, first, 4096, offset, 1024)))
+	}
+	require.Equal(t, 1024, b.buffered[sender])
+	require.NoError(t, handle(chunkMeta(t, sender, watermill.NewUUID(), 4096, 0, 1024)))
+
+	// bytes limit
+	b.maxSenderStreamBytes = 3000
+	require.Error(t, handle(chunkMeta(t, sender, second, 4096, 1024, 1024)))
+	require.Equal(t, 2048, b.buffered[sender])
+
+	// bytes limit is shared by all senders
+	b.maxSenderStreamBytes = 1 << 20
+	b.maxStreamBytes = 4096
+	require.Equal(t, 3072, b.bufferedTotal)
+	require.NoError(t, handle(chunkMeta(t, sender, second, 4096, 1024, 1024)))
+	require.Error(t, handle(chunkMeta(t, other, third, 4096, 1024, 1024)))
+	require.Equal(t, 1024, b.buffered[other])
+	require.Equal(t, 4096, b.bufferedTotal)
+}
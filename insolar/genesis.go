@@ -71,6 +71,21 @@ var GenesisNamePublicKeyShards = func() (result [GenesisAmountPublicKeyShards]st
 	return
 }()
 
+// GenesisNameAllocationMember returns name of member contract created from genesis allocation.
+func GenesisNameAllocationMember(i int) string {
+	return "allocation_" + strconv.Itoa(i) + "_" + GenesisNameMember
+}
+
+// GenesisNameAllocationWallet returns name of wallet contract created from genesis allocation.
+func GenesisNameAllocationWallet(i int) string {
+	return "allocation_" + strconv.Itoa(i) + "_" + GenesisNameWallet
+}
+
+// GenesisNameAllocationDeposit returns name of deposit contract created from genesis allocation.
+func GenesisNameAllocationDeposit(i, j int) string {
+	return "allocation_" + strconv.Itoa(i) + "_" + GenesisNameDeposit + "_" + strconv.Itoa(j)
+}
+
 type genesisBinary []byte
 
 // GenesisRecord is initial chain record.
@@ -108,6 +123,25 @@ type GenesisContractsConfig struct {
 	RootPublicKey             string
 	MigrationAdminPublicKey   string
 	MigrationDaemonPublicKeys []string
+	// Allocations is the list of members created with funds directly in genesis.
+	Allocations []GenesisMemberAllocation
+}
+
+// GenesisMemberAllocation carries data required for member creation via genesis.
+type GenesisMemberAllocation struct {
+	PublicKey        string                     `json:"public_key"`
+	Balance          string                     `json:"balance"`
+	MigrationAddress string                     `json:"migration_address,omitempty"`
+	Deposits         []GenesisDepositAllocation `json:"deposits,omitempty"`
+}
+
+// GenesisDepositAllocation carries data required for member's deposit creation via genesis.
+type GenesisDepositAllocation struct {
+	TxHash string `json:"tx_hash"`
+	Amount string `json:"amount"`
+	// HoldReleasePulse is the pulse when deposit becomes available for transfer.
+	// Zero value means deposit is available right after genesis.
+	HoldReleasePulse PulseNumber `json:"hold_release_pulse,omitempty"`
 }
 
 // GenesisHeavyConfig carries data required for initial genesis on heavy node.
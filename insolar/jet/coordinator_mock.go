@@ -16,8 +16,14 @@ import (
 type CoordinatorMock struct {
 	t minimock.Tester
 
-	funcHeavy          func(ctx context.Context) (rp1 *insolar.Reference, err error)
-	inspectFuncHeavy   func(ctx context.Context)
+	funcHeavies          func(ctx context.Context, pulse insolar.PulseNumber) (ra1 []insolar.Reference, err error)
+	inspectFuncHeavies   func(ctx context.Context, pulse insolar.PulseNumber)
+	afterHeaviesCounter  uint64
+	beforeHeaviesCounter uint64
+	HeaviesMock          mCoordinatorMockHeavies
+
+	funcHeavy          func(ctx context.Context, pulse insolar.PulseNumber) (rp1 *insolar.Reference, err error)
+	inspectFuncHeavy   func(ctx context.Context, pulse insolar.PulseNumber)
 	afterHeavyCounter  uint64
 	beforeHeavyCounter uint64
 	HeavyMock          mCoordinatorMockHeavy
@@ -108,6 +114,9 @@ func NewCoordinatorMock(t minimock.Tester) *CoordinatorMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.HeaviesMock = mCoordinatorMockHeavies{mock: m}
+	m.HeaviesMock.callArgs = []*CoordinatorMockHeaviesParams{}
+
 	m.HeavyMock = mCoordinatorMockHeavy{mock: m}
 	m.HeavyMock.callArgs = []*CoordinatorMockHeavyParams{}
 
@@ -152,6 +161,223 @@ func NewCoordinatorMock(t minimock.Tester) *CoordinatorMock {
 	return m
 }
 
+type mCoordinatorMockHeavies struct {
+	mock               *CoordinatorMock
+	defaultExpectation *CoordinatorMockHeaviesExpectation
+	expectations       []*CoordinatorMockHeaviesExpectation
+
+	callArgs []*CoordinatorMockHeaviesParams
+	mutex    sync.RWMutex
+}
+
+// CoordinatorMockHeaviesExpectation specifies expectation struct of the Coordinator.Heavies
+type CoordinatorMockHeaviesExpectation struct {
+	mock    *CoordinatorMock
+	params  *CoordinatorMockHeaviesParams
+	results *CoordinatorMockHeaviesResults
+	Counter uint64
+}
+
+// CoordinatorMockHeaviesParams contains parameters of the Coordinator.Heavies
+type CoordinatorMockHeaviesParams struct {
+	ctx   context.Context
+	pulse insolar.PulseNumber
+}
+
+// CoordinatorMockHeaviesResults contains results of the Coordinator.Heavies
+type CoordinatorMockHeaviesResults struct {
+	ra1 []insolar.Reference
+	err error
+}
+
+// Expect sets up expected params for Coordinator.Heavies
+func (mmHeavies *mCoordinatorMockHeavies) Expect(ctx context.Context, pulse insolar.PulseNumber) *mCoordinatorMockHeavies {
+	if mmHeavies.mock.funcHeavies != nil {
+		mmHeavies.mock.t.Fatalf("CoordinatorMock.Heavies mock is already set by Set")
+	}
+
+	if mmHeavies.defaultExpectation == nil {
+		mmHeavies.defaultExpectation = &CoordinatorMockHeaviesExpectation{}
+	}
+
+	mmHeavies.defaultExpectation.params = &CoordinatorMockHeaviesParams{ctx, pulse}
+	for _, e := range mmHeavies.expectations {
+		if minimock.Equal(e.params, mmHeavies.defaultExpectation.params) {
+			mmHeavies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHeavies.defaultExpectation.params)
+		}
+	}
+
+	return mmHeavies
+}
+
+// Inspect accepts an inspector function that has same arguments as the Coordinator.Heavies
+func (mmHeavies *mCoordinatorMockHeavies) Inspect(f func(ctx context.Context, pulse insolar.PulseNumber)) *mCoordinatorMockHeavies {
+	if mmHeavies.mock.inspectFuncHeavies != nil {
+		mmHeavies.mock.t.Fatalf("Inspect function is already set for CoordinatorMock.Heavies")
+	}
+
+	mmHeavies.mock.inspectFuncHeavies = f
+
+	return mmHeavies
+}
+
+// Return sets up results that will be returned by Coordinator.Heavies
+func (mmHeavies *mCoordinatorMockHeavies) Return(ra1 []insolar.Reference, err error) *CoordinatorMock {
+	if mmHeavies.mock.funcHeavies != nil {
+		mmHeavies.mock.t.Fatalf("CoordinatorMock.Heavies mock is already set by Set")
+	}
+
+	if mmHeavies.defaultExpectation == nil {
+		mmHeavies.defaultExpectation = &CoordinatorMockHeaviesExpectation{mock: mmHeavies.mock}
+	}
+	mmHeavies.defaultExpectation.results = &CoordinatorMockHeaviesResults{ra1, err}
+	return mmHeavies.mock
+}
+
+// Set uses given function f to mock the Coordinator.Heavies method
+func (mmHeavies *mCoordinatorMockHeavies) Set(f func(ctx context.Context, pulse insolar.PulseNumber) (ra1 []insolar.Reference, err error)) *CoordinatorMock {
+	if mmHeavies.defaultExpectation != nil {
+		mmHeavies.mock.t.Fatalf("Default expectation is already set for the Coordinator.Heavies method")
+	}
+
+	if len(mmHeavies.expectations) > 0 {
+		mmHeavies.mock.t.Fatalf("Some expectations are already set for the Coordinator.Heavies method")
+	}
+
+	mmHeavies.mock.funcHeavies = f
+	return mmHeavies.mock
+}
+
+// When sets expectation for the Coordinator.Heavies which will trigger the result defined by the following
+// Then helper
+func (mmHeavies *mCoordinatorMockHeavies) When(ctx context.Context, pulse insolar.PulseNumber) *CoordinatorMockHeaviesExpectation {
+	if mmHeavies.mock.funcHeavies != nil {
+		mmHeavies.mock.t.Fatalf("CoordinatorMock.Heavies mock is already set by Set")
+	}
+
+	expectation := &CoordinatorMockHeaviesExpectation{
+		mock:   mmHeavies.mock,
+		params: &CoordinatorMockHeaviesParams{ctx, pulse},
+	}
+	mmHeavies.expectations = append(mmHeavies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Coordinator.Heavies return parameters for the expectation previously defined by the When method
+func (e *CoordinatorMockHeaviesExpectation) Then(ra1 []insolar.Reference, err error) *CoordinatorMock {
+	e.results = &CoordinatorMockHeaviesResults{ra1, err}
+	return e.mock
+}
+
+// Heavies implements Coordinator
+func (mmHeavies *CoordinatorMock) Heavies(ctx context.Context, pulse insolar.PulseNumber) (ra1 []insolar.Reference, err error) {
+	mm_atomic.AddUint64(&mmHeavies.beforeHeaviesCounter, 1)
+	defer mm_atomic.AddUint64(&mmHeavies.afterHeaviesCounter, 1)
+
+	if mmHeavies.inspectFuncHeavies != nil {
+		mmHeavies.inspectFuncHeavies(ctx, pulse)
+	}
+
+	mm_params := &CoordinatorMockHeaviesParams{ctx, pulse}
+
+	// Record call args
+	mmHeavies.HeaviesMock.mutex.Lock()
+	mmHeavies.HeaviesMock.callArgs = append(mmHeavies.HeaviesMock.callArgs, mm_params)
+	mmHeavies.HeaviesMock.mutex.Unlock()
+
+	for _, e := range mmHeavies.HeaviesMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmHeavies.HeaviesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHeavies.HeaviesMock.defaultExpectation.Counter, 1)
+		mm_want := mmHeavies.HeaviesMock.defaultExpectation.params
+		mm_got := CoordinatorMockHeaviesParams{ctx, pulse}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHeavies.t.Errorf("CoordinatorMock.Heavies got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHeavies.HeaviesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHeavies.t.Fatal("No results are set for the CoordinatorMock.Heavies")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmHeavies.funcHeavies != nil {
+		return mmHeavies.funcHeavies(ctx, pulse)
+	}
+	mmHeavies.t.Fatalf("Unexpected call to CoordinatorMock.Heavies. %v %v", ctx, pulse)
+	return
+}
+
+// HeaviesAfterCounter returns a count of finished CoordinatorMock.Heavies invocations
+func (mmHeavies *CoordinatorMock) HeaviesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHeavies.afterHeaviesCounter)
+}
+
+// HeaviesBeforeCounter returns a count of CoordinatorMock.Heavies invocations
+func (mmHeavies *CoordinatorMock) HeaviesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHeavies.beforeHeaviesCounter)
+}
+
+// Calls returns a list of arguments used in each call to CoordinatorMock.Heavies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHeavies *mCoordinatorMockHeavies) Calls() []*CoordinatorMockHeaviesParams {
+	mmHeavies.mutex.RLock()
+
+	argCopy := make([]*CoordinatorMockHeaviesParams, len(mmHeavies.callArgs))
+	copy(argCopy, mmHeavies.callArgs)
+
+	mmHeavies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHeaviesDone returns true if the count of the Heavies invocations corresponds
+// the number of defined expectations
+func (m *CoordinatorMock) MinimockHeaviesDone() bool {
+	for _, e := range m.HeaviesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HeaviesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterHeaviesCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHeavies != nil && mm_atomic.LoadUint64(&m.afterHeaviesCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockHeaviesInspect logs each unmet expectation
+func (m *CoordinatorMock) MinimockHeaviesInspect() {
+	for _, e := range m.HeaviesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CoordinatorMock.Heavies with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HeaviesMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterHeaviesCounter) < 1 {
+		if m.HeaviesMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to CoordinatorMock.Heavies")
+		} else {
+			m.t.Errorf("Expected call to CoordinatorMock.Heavies with params: %#v", *m.HeaviesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHeavies != nil && mm_atomic.LoadUint64(&m.afterHeaviesCounter) < 1 {
+		m.t.Error("Expected call to CoordinatorMock.Heavies")
+	}
+}
+
 type mCoordinatorMockHeavy struct {
 	mock               *CoordinatorMock
 	defaultExpectation *CoordinatorMockHeavyExpectation
@@ -171,7 +397,8 @@ type CoordinatorMockHeavyExpectation struct {
 
 // CoordinatorMockHeavyParams contains parameters of the Coordinator.Heavy
 type CoordinatorMockHeavyParams struct {
-	ctx context.Context
+	ctx   context.Context
+	pulse insolar.PulseNumber
 }
 
 // CoordinatorMockHeavyResults contains results of the Coordinator.Heavy
@@ -181,7 +408,7 @@ type CoordinatorMockHeavyResults struct {
 }
 
 // Expect sets up expected params for Coordinator.Heavy
-func (mmHeavy *mCoordinatorMockHeavy) Expect(ctx context.Context) *mCoordinatorMockHeavy {
+func (mmHeavy *mCoordinatorMockHeavy) Expect(ctx context.Context, pulse insolar.PulseNumber) *mCoordinatorMockHeavy {
 	if mmHeavy.mock.funcHeavy != nil {
 		mmHeavy.mock.t.Fatalf("CoordinatorMock.Heavy mock is already set by Set")
 	}
@@ -190,7 +417,7 @@ func (mmHeavy *mCoordinatorMockHeavy) Expect(ctx context.Context) *mCoordinatorM
 		mmHeavy.defaultExpectation = &CoordinatorMockHeavyExpectation{}
 	}
 
-	mmHeavy.defaultExpectation.params = &CoordinatorMockHeavyParams{ctx}
+	mmHeavy.defaultExpectation.params = &CoordinatorMockHeavyParams{ctx, pulse}
 	for _, e := range mmHeavy.expectations {
 		if minimock.Equal(e.params, mmHeavy.defaultExpectation.params) {
 			mmHeavy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHeavy.defaultExpectation.params)
@@ -201,7 +428,7 @@ func (mmHeavy *mCoordinatorMockHeavy) Expect(ctx context.Context) *mCoordinatorM
 }
 
 // Inspect accepts an inspector function that has same arguments as the Coordinator.Heavy
-func (mmHeavy *mCoordinatorMockHeavy) Inspect(f func(ctx context.Context)) *mCoordinatorMockHeavy {
+func (mmHeavy *mCoordinatorMockHeavy) Inspect(f func(ctx context.Context, pulse insolar.PulseNumber)) *mCoordinatorMockHeavy {
 	if mmHeavy.mock.inspectFuncHeavy != nil {
 		mmHeavy.mock.t.Fatalf("Inspect function is already set for CoordinatorMock.Heavy")
 	}
@@ -224,8 +451,8 @@ func (mmHeavy *mCoordinatorMockHeavy) Return(rp1 *insolar.Reference, err error)
 	return mmHeavy.mock
 }
 
-//Set uses given function f to mock the Coordinator.Heavy method
-func (mmHeavy *mCoordinatorMockHeavy) Set(f func(ctx context.Context) (rp1 *insolar.Reference, err error)) *CoordinatorMock {
+// Set uses given function f to mock the Coordinator.Heavy method
+func (mmHeavy *mCoordinatorMockHeavy) Set(f func(ctx context.Context, pulse insolar.PulseNumber) (rp1 *insolar.Reference, err error)) *CoordinatorMock {
 	if mmHeavy.defaultExpectation != nil {
 		mmHeavy.mock.t.Fatalf("Default expectation is already set for the Coordinator.Heavy method")
 	}
@@ -240,14 +467,14 @@ func (mmHeavy *mCoordinatorMockHeavy) Set(f func(ctx context.Context) (rp1 *inso
 
 // When sets expectation for the Coordinator.Heavy which will trigger the result defined by the following
 // Then helper
-func (mmHeavy *mCoordinatorMockHeavy) When(ctx context.Context) *CoordinatorMockHeavyExpectation {
+func (mmHeavy *mCoordinatorMockHeavy) When(ctx context.Context, pulse insolar.PulseNumber) *CoordinatorMockHeavyExpectation {
 	if mmHeavy.mock.funcHeavy != nil {
 		mmHeavy.mock.t.Fatalf("CoordinatorMock.Heavy mock is already set by Set")
 	}
 
 	expectation := &CoordinatorMockHeavyExpectation{
 		mock:   mmHeavy.mock,
-		params: &CoordinatorMockHeavyParams{ctx},
+		params: &CoordinatorMockHeavyParams{ctx, pulse},
 	}
 	mmHeavy.expectations = append(mmHeavy.expectations, expectation)
 	return expectation
@@ -260,23 +487,23 @@ func (e *CoordinatorMockHeavyExpectation) Then(rp1 *insolar.Reference, err error
 }
 
 // Heavy implements Coordinator
-func (mmHeavy *CoordinatorMock) Heavy(ctx context.Context) (rp1 *insolar.Reference, err error) {
+func (mmHeavy *CoordinatorMock) Heavy(ctx context.Context, pulse insolar.PulseNumber) (rp1 *insolar.Reference, err error) {
 	mm_atomic.AddUint64(&mmHeavy.beforeHeavyCounter, 1)
 	defer mm_atomic.AddUint64(&mmHeavy.afterHeavyCounter, 1)
 
 	if mmHeavy.inspectFuncHeavy != nil {
-		mmHeavy.inspectFuncHeavy(ctx)
+		mmHeavy.inspectFuncHeavy(ctx, pulse)
 	}
 
-	params := &CoordinatorMockHeavyParams{ctx}
+	mm_params := &CoordinatorMockHeavyParams{ctx, pulse}
 
 	// Record call args
 	mmHeavy.HeavyMock.mutex.Lock()
-	mmHeavy.HeavyMock.callArgs = append(mmHeavy.HeavyMock.callArgs, params)
+	mmHeavy.HeavyMock.callArgs = append(mmHeavy.HeavyMock.callArgs, mm_params)
 	mmHeavy.HeavyMock.mutex.Unlock()
 
 	for _, e := range mmHeavy.HeavyMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.rp1, e.results.err
 		}
@@ -284,22 +511,22 @@ func (mmHeavy *CoordinatorMock) Heavy(ctx context.Context) (rp1 *insolar.Referen
 
 	if mmHeavy.HeavyMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmHeavy.HeavyMock.defaultExpectation.Counter, 1)
-		want := mmHeavy.HeavyMock.defaultExpectation.params
-		got := CoordinatorMockHeavyParams{ctx}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmHeavy.t.Errorf("CoordinatorMock.Heavy got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmHeavy.HeavyMock.defaultExpectation.params
+		mm_got := CoordinatorMockHeavyParams{ctx, pulse}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHeavy.t.Errorf("CoordinatorMock.Heavy got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmHeavy.HeavyMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmHeavy.HeavyMock.defaultExpectation.results
+		if mm_results == nil {
 			mmHeavy.t.Fatal("No results are set for the CoordinatorMock.Heavy")
 		}
-		return (*results).rp1, (*results).err
+		return (*mm_results).rp1, (*mm_results).err
 	}
 	if mmHeavy.funcHeavy != nil {
-		return mmHeavy.funcHeavy(ctx)
+		return mmHeavy.funcHeavy(ctx, pulse)
 	}
-	mmHeavy.t.Fatalf("Unexpected call to CoordinatorMock.Heavy. %v", ctx)
+	mmHeavy.t.Fatalf("Unexpected call to CoordinatorMock.Heavy. %v %v", ctx, pulse)
 	return
 }
 
@@ -444,7 +671,7 @@ func (mmIsAuthorized *mCoordinatorMockIsAuthorized) Return(b1 bool, err error) *
 	return mmIsAuthorized.mock
 }
 
-//Set uses given function f to mock the Coordinator.IsAuthorized method
+// Set uses given function f to mock the Coordinator.IsAuthorized method
 func (mmIsAuthorized *mCoordinatorMockIsAuthorized) Set(f func(ctx context.Context, role insolar.DynamicRole, obj insolar.ID, pulse insolar.PulseNumber, node insolar.Reference) (b1 bool, err error)) *CoordinatorMock {
 	if mmIsAuthorized.defaultExpectation != nil {
 		mmIsAuthorized.mock.t.Fatalf("Default expectation is already set for the Coordinator.IsAuthorized method")
@@ -488,15 +715,15 @@ func (mmIsAuthorized *CoordinatorMock) IsAuthorized(ctx context.Context, role in
 		mmIsAuthorized.inspectFuncIsAuthorized(ctx, role, obj, pulse, node)
 	}
 
-	params := &CoordinatorMockIsAuthorizedParams{ctx, role, obj, pulse, node}
+	mm_params := &CoordinatorMockIsAuthorizedParams{ctx, role, obj, pulse, node}
 
 	// Record call args
 	mmIsAuthorized.IsAuthorizedMock.mutex.Lock()
-	mmIsAuthorized.IsAuthorizedMock.callArgs = append(mmIsAuthorized.IsAuthorizedMock.callArgs, params)
+	mmIsAuthorized.IsAuthorizedMock.callArgs = append(mmIsAuthorized.IsAuthorizedMock.callArgs, mm_params)
 	mmIsAuthorized.IsAuthorizedMock.mutex.Unlock()
 
 	for _, e := range mmIsAuthorized.IsAuthorizedMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.b1, e.results.err
 		}
@@ -504,17 +731,17 @@ func (mmIsAuthorized *CoordinatorMock) IsAuthorized(ctx context.Context, role in
 
 	if mmIsAuthorized.IsAuthorizedMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmIsAuthorized.IsAuthorizedMock.defaultExpectation.Counter, 1)
-		want := mmIsAuthorized.IsAuthorizedMock.defaultExpectation.params
-		got := CoordinatorMockIsAuthorizedParams{ctx, role, obj, pulse, node}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmIsAuthorized.t.Errorf("CoordinatorMock.IsAuthorized got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmIsAuthorized.IsAuthorizedMock.defaultExpectation.params
+		mm_got := CoordinatorMockIsAuthorizedParams{ctx, role, obj, pulse, node}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsAuthorized.t.Errorf("CoordinatorMock.IsAuthorized got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmIsAuthorized.IsAuthorizedMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmIsAuthorized.IsAuthorizedMock.defaultExpectation.results
+		if mm_results == nil {
 			mmIsAuthorized.t.Fatal("No results are set for the CoordinatorMock.IsAuthorized")
 		}
-		return (*results).b1, (*results).err
+		return (*mm_results).b1, (*mm_results).err
 	}
 	if mmIsAuthorized.funcIsAuthorized != nil {
 		return mmIsAuthorized.funcIsAuthorized(ctx, role, obj, pulse, node)
@@ -661,7 +888,7 @@ func (mmIsBeyondLimit *mCoordinatorMockIsBeyondLimit) Return(b1 bool, err error)
 	return mmIsBeyondLimit.mock
 }
 
-//Set uses given function f to mock the Coordinator.IsBeyondLimit method
+// Set uses given function f to mock the Coordinator.IsBeyondLimit method
 func (mmIsBeyondLimit *mCoordinatorMockIsBeyondLimit) Set(f func(ctx context.Context, targetPN insolar.PulseNumber) (b1 bool, err error)) *CoordinatorMock {
 	if mmIsBeyondLimit.defaultExpectation != nil {
 		mmIsBeyondLimit.mock.t.Fatalf("Default expectation is already set for the Coordinator.IsBeyondLimit method")
@@ -705,15 +932,15 @@ func (mmIsBeyondLimit *CoordinatorMock) IsBeyondLimit(ctx context.Context, targe
 		mmIsBeyondLimit.inspectFuncIsBeyondLimit(ctx, targetPN)
 	}
 
-	params := &CoordinatorMockIsBeyondLimitParams{ctx, targetPN}
+	mm_params := &CoordinatorMockIsBeyondLimitParams{ctx, targetPN}
 
 	// Record call args
 	mmIsBeyondLimit.IsBeyondLimitMock.mutex.Lock()
-	mmIsBeyondLimit.IsBeyondLimitMock.callArgs = append(mmIsBeyondLimit.IsBeyondLimitMock.callArgs, params)
+	mmIsBeyondLimit.IsBeyondLimitMock.callArgs = append(mmIsBeyondLimit.IsBeyondLimitMock.callArgs, mm_params)
 	mmIsBeyondLimit.IsBeyondLimitMock.mutex.Unlock()
 
 	for _, e := range mmIsBeyondLimit.IsBeyondLimitMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.b1, e.results.err
 		}
@@ -721,17 +948,17 @@ func (mmIsBeyondLimit *CoordinatorMock) IsBeyondLimit(ctx context.Context, targe
 
 	if mmIsBeyondLimit.IsBeyondLimitMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmIsBeyondLimit.IsBeyondLimitMock.defaultExpectation.Counter, 1)
-		want := mmIsBeyondLimit.IsBeyondLimitMock.defaultExpectation.params
-		got := CoordinatorMockIsBeyondLimitParams{ctx, targetPN}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmIsBeyondLimit.t.Errorf("CoordinatorMock.IsBeyondLimit got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmIsBeyondLimit.IsBeyondLimitMock.defaultExpectation.params
+		mm_got := CoordinatorMockIsBeyondLimitParams{ctx, targetPN}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsBeyondLimit.t.Errorf("CoordinatorMock.IsBeyondLimit got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmIsBeyondLimit.IsBeyondLimitMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmIsBeyondLimit.IsBeyondLimitMock.defaultExpectation.results
+		if mm_results == nil {
 			mmIsBeyondLimit.t.Fatal("No results are set for the CoordinatorMock.IsBeyondLimit")
 		}
-		return (*results).b1, (*results).err
+		return (*mm_results).b1, (*mm_results).err
 	}
 	if mmIsBeyondLimit.funcIsBeyondLimit != nil {
 		return mmIsBeyondLimit.funcIsBeyondLimit(ctx, targetPN)
@@ -879,7 +1106,7 @@ func (mmIsMeAuthorizedNow *mCoordinatorMockIsMeAuthorizedNow) Return(b1 bool, er
 	return mmIsMeAuthorizedNow.mock
 }
 
-//Set uses given function f to mock the Coordinator.IsMeAuthorizedNow method
+// Set uses given function f to mock the Coordinator.IsMeAuthorizedNow method
 func (mmIsMeAuthorizedNow *mCoordinatorMockIsMeAuthorizedNow) Set(f func(ctx context.Context, role insolar.DynamicRole, obj insolar.ID) (b1 bool, err error)) *CoordinatorMock {
 	if mmIsMeAuthorizedNow.defaultExpectation != nil {
 		mmIsMeAuthorizedNow.mock.t.Fatalf("Default expectation is already set for the Coordinator.IsMeAuthorizedNow method")
@@ -923,15 +1150,15 @@ func (mmIsMeAuthorizedNow *CoordinatorMock) IsMeAuthorizedNow(ctx context.Contex
 		mmIsMeAuthorizedNow.inspectFuncIsMeAuthorizedNow(ctx, role, obj)
 	}
 
-	params := &CoordinatorMockIsMeAuthorizedNowParams{ctx, role, obj}
+	mm_params := &CoordinatorMockIsMeAuthorizedNowParams{ctx, role, obj}
 
 	// Record call args
 	mmIsMeAuthorizedNow.IsMeAuthorizedNowMock.mutex.Lock()
-	mmIsMeAuthorizedNow.IsMeAuthorizedNowMock.callArgs = append(mmIsMeAuthorizedNow.IsMeAuthorizedNowMock.callArgs, params)
+	mmIsMeAuthorizedNow.IsMeAuthorizedNowMock.callArgs = append(mmIsMeAuthorizedNow.IsMeAuthorizedNowMock.callArgs, mm_params)
 	mmIsMeAuthorizedNow.IsMeAuthorizedNowMock.mutex.Unlock()
 
 	for _, e := range mmIsMeAuthorizedNow.IsMeAuthorizedNowMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.b1, e.results.err
 		}
@@ -939,17 +1166,17 @@ func (mmIsMeAuthorizedNow *CoordinatorMock) IsMeAuthorizedNow(ctx context.Contex
 
 	if mmIsMeAuthorizedNow.IsMeAuthorizedNowMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmIsMeAuthorizedNow.IsMeAuthorizedNowMock.defaultExpectation.Counter, 1)
-		want := mmIsMeAuthorizedNow.IsMeAuthorizedNowMock.defaultExpectation.params
-		got := CoordinatorMockIsMeAuthorizedNowParams{ctx, role, obj}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmIsMeAuthorizedNow.t.Errorf("CoordinatorMock.IsMeAuthorizedNow got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmIsMeAuthorizedNow.IsMeAuthorizedNowMock.defaultExpectation.params
+		mm_got := CoordinatorMockIsMeAuthorizedNowParams{ctx, role, obj}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsMeAuthorizedNow.t.Errorf("CoordinatorMock.IsMeAuthorizedNow got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmIsMeAuthorizedNow.IsMeAuthorizedNowMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmIsMeAuthorizedNow.IsMeAuthorizedNowMock.defaultExpectation.results
+		if mm_results == nil {
 			mmIsMeAuthorizedNow.t.Fatal("No results are set for the CoordinatorMock.IsMeAuthorizedNow")
 		}
-		return (*results).b1, (*results).err
+		return (*mm_results).b1, (*mm_results).err
 	}
 	if mmIsMeAuthorizedNow.funcIsMeAuthorizedNow != nil {
 		return mmIsMeAuthorizedNow.funcIsMeAuthorizedNow(ctx, role, obj)
@@ -1097,7 +1324,7 @@ func (mmLightExecutorForJet *mCoordinatorMockLightExecutorForJet) Return(rp1 *in
 	return mmLightExecutorForJet.mock
 }
 
-//Set uses given function f to mock the Coordinator.LightExecutorForJet method
+// Set uses given function f to mock the Coordinator.LightExecutorForJet method
 func (mmLightExecutorForJet *mCoordinatorMockLightExecutorForJet) Set(f func(ctx context.Context, jetID insolar.ID, pulse insolar.PulseNumber) (rp1 *insolar.Reference, err error)) *CoordinatorMock {
 	if mmLightExecutorForJet.defaultExpectation != nil {
 		mmLightExecutorForJet.mock.t.Fatalf("Default expectation is already set for the Coordinator.LightExecutorForJet method")
@@ -1141,15 +1368,15 @@ func (mmLightExecutorForJet *CoordinatorMock) LightExecutorForJet(ctx context.Co
 		mmLightExecutorForJet.inspectFuncLightExecutorForJet(ctx, jetID, pulse)
 	}
 
-	params := &CoordinatorMockLightExecutorForJetParams{ctx, jetID, pulse}
+	mm_params := &CoordinatorMockLightExecutorForJetParams{ctx, jetID, pulse}
 
 	// Record call args
 	mmLightExecutorForJet.LightExecutorForJetMock.mutex.Lock()
-	mmLightExecutorForJet.LightExecutorForJetMock.callArgs = append(mmLightExecutorForJet.LightExecutorForJetMock.callArgs, params)
+	mmLightExecutorForJet.LightExecutorForJetMock.callArgs = append(mmLightExecutorForJet.LightExecutorForJetMock.callArgs, mm_params)
 	mmLightExecutorForJet.LightExecutorForJetMock.mutex.Unlock()
 
 	for _, e := range mmLightExecutorForJet.LightExecutorForJetMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.rp1, e.results.err
 		}
@@ -1157,17 +1384,17 @@ func (mmLightExecutorForJet *CoordinatorMock) LightExecutorForJet(ctx context.Co
 
 	if mmLightExecutorForJet.LightExecutorForJetMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmLightExecutorForJet.LightExecutorForJetMock.defaultExpectation.Counter, 1)
-		want := mmLightExecutorForJet.LightExecutorForJetMock.defaultExpectation.params
-		got := CoordinatorMockLightExecutorForJetParams{ctx, jetID, pulse}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmLightExecutorForJet.t.Errorf("CoordinatorMock.LightExecutorForJet got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmLightExecutorForJet.LightExecutorForJetMock.defaultExpectation.params
+		mm_got := CoordinatorMockLightExecutorForJetParams{ctx, jetID, pulse}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLightExecutorForJet.t.Errorf("CoordinatorMock.LightExecutorForJet got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmLightExecutorForJet.LightExecutorForJetMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmLightExecutorForJet.LightExecutorForJetMock.defaultExpectation.results
+		if mm_results == nil {
 			mmLightExecutorForJet.t.Fatal("No results are set for the CoordinatorMock.LightExecutorForJet")
 		}
-		return (*results).rp1, (*results).err
+		return (*mm_results).rp1, (*mm_results).err
 	}
 	if mmLightExecutorForJet.funcLightExecutorForJet != nil {
 		return mmLightExecutorForJet.funcLightExecutorForJet(ctx, jetID, pulse)
@@ -1315,7 +1542,7 @@ func (mmLightExecutorForObject *mCoordinatorMockLightExecutorForObject) Return(r
 	return mmLightExecutorForObject.mock
 }
 
-//Set uses given function f to mock the Coordinator.LightExecutorForObject method
+// Set uses given function f to mock the Coordinator.LightExecutorForObject method
 func (mmLightExecutorForObject *mCoordinatorMockLightExecutorForObject) Set(f func(ctx context.Context, objID insolar.ID, pulse insolar.PulseNumber) (rp1 *insolar.Reference, err error)) *CoordinatorMock {
 	if mmLightExecutorForObject.defaultExpectation != nil {
 		mmLightExecutorForObject.mock.t.Fatalf("Default expectation is already set for the Coordinator.LightExecutorForObject method")
@@ -1359,15 +1586,15 @@ func (mmLightExecutorForObject *CoordinatorMock) LightExecutorForObject(ctx cont
 		mmLightExecutorForObject.inspectFuncLightExecutorForObject(ctx, objID, pulse)
 	}
 
-	params := &CoordinatorMockLightExecutorForObjectParams{ctx, objID, pulse}
+	mm_params := &CoordinatorMockLightExecutorForObjectParams{ctx, objID, pulse}
 
 	// Record call args
 	mmLightExecutorForObject.LightExecutorForObjectMock.mutex.Lock()
-	mmLightExecutorForObject.LightExecutorForObjectMock.callArgs = append(mmLightExecutorForObject.LightExecutorForObjectMock.callArgs, params)
+	mmLightExecutorForObject.LightExecutorForObjectMock.callArgs = append(mmLightExecutorForObject.LightExecutorForObjectMock.callArgs, mm_params)
 	mmLightExecutorForObject.LightExecutorForObjectMock.mutex.Unlock()
 
 	for _, e := range mmLightExecutorForObject.LightExecutorForObjectMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.rp1, e.results.err
 		}
@@ -1375,17 +1602,17 @@ func (mmLightExecutorForObject *CoordinatorMock) LightExecutorForObject(ctx cont
 
 	if mmLightExecutorForObject.LightExecutorForObjectMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmLightExecutorForObject.LightExecutorForObjectMock.defaultExpectation.Counter, 1)
-		want := mmLightExecutorForObject.LightExecutorForObjectMock.defaultExpectation.params
-		got := CoordinatorMockLightExecutorForObjectParams{ctx, objID, pulse}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmLightExecutorForObject.t.Errorf("CoordinatorMock.LightExecutorForObject got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmLightExecutorForObject.LightExecutorForObjectMock.defaultExpectation.params
+		mm_got := CoordinatorMockLightExecutorForObjectParams{ctx, objID, pulse}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLightExecutorForObject.t.Errorf("CoordinatorMock.LightExecutorForObject got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmLightExecutorForObject.LightExecutorForObjectMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmLightExecutorForObject.LightExecutorForObjectMock.defaultExpectation.results
+		if mm_results == nil {
 			mmLightExecutorForObject.t.Fatal("No results are set for the CoordinatorMock.LightExecutorForObject")
 		}
-		return (*results).rp1, (*results).err
+		return (*mm_results).rp1, (*mm_results).err
 	}
 	if mmLightExecutorForObject.funcLightExecutorForObject != nil {
 		return mmLightExecutorForObject.funcLightExecutorForObject(ctx, objID, pulse)
@@ -1533,7 +1760,7 @@ func (mmLightValidatorsForJet *mCoordinatorMockLightValidatorsForJet) Return(ra1
 	return mmLightValidatorsForJet.mock
 }
 
-//Set uses given function f to mock the Coordinator.LightValidatorsForJet method
+// Set uses given function f to mock the Coordinator.LightValidatorsForJet method
 func (mmLightValidatorsForJet *mCoordinatorMockLightValidatorsForJet) Set(f func(ctx context.Context, jetID insolar.ID, pulse insolar.PulseNumber) (ra1 []insolar.Reference, err error)) *CoordinatorMock {
 	if mmLightValidatorsForJet.defaultExpectation != nil {
 		mmLightValidatorsForJet.mock.t.Fatalf("Default expectation is already set for the Coordinator.LightValidatorsForJet method")
@@ -1577,15 +1804,15 @@ func (mmLightValidatorsForJet *CoordinatorMock) LightValidatorsForJet(ctx contex
 		mmLightValidatorsForJet.inspectFuncLightValidatorsForJet(ctx, jetID, pulse)
 	}
 
-	params := &CoordinatorMockLightValidatorsForJetParams{ctx, jetID, pulse}
+	mm_params := &CoordinatorMockLightValidatorsForJetParams{ctx, jetID, pulse}
 
 	// Record call args
 	mmLightValidatorsForJet.LightValidatorsForJetMock.mutex.Lock()
-	mmLightValidatorsForJet.LightValidatorsForJetMock.callArgs = append(mmLightValidatorsForJet.LightValidatorsForJetMock.callArgs, params)
+	mmLightValidatorsForJet.LightValidatorsForJetMock.callArgs = append(mmLightValidatorsForJet.LightValidatorsForJetMock.callArgs, mm_params)
 	mmLightValidatorsForJet.LightValidatorsForJetMock.mutex.Unlock()
 
 	for _, e := range mmLightValidatorsForJet.LightValidatorsForJetMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.ra1, e.results.err
 		}
@@ -1593,17 +1820,17 @@ func (mmLightValidatorsForJet *CoordinatorMock) LightValidatorsForJet(ctx contex
 
 	if mmLightValidatorsForJet.LightValidatorsForJetMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmLightValidatorsForJet.LightValidatorsForJetMock.defaultExpectation.Counter, 1)
-		want := mmLightValidatorsForJet.LightValidatorsForJetMock.defaultExpectation.params
-		got := CoordinatorMockLightValidatorsForJetParams{ctx, jetID, pulse}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmLightValidatorsForJet.t.Errorf("CoordinatorMock.LightValidatorsForJet got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmLightValidatorsForJet.LightValidatorsForJetMock.defaultExpectation.params
+		mm_got := CoordinatorMockLightValidatorsForJetParams{ctx, jetID, pulse}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLightValidatorsForJet.t.Errorf("CoordinatorMock.LightValidatorsForJet got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmLightValidatorsForJet.LightValidatorsForJetMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmLightValidatorsForJet.LightValidatorsForJetMock.defaultExpectation.results
+		if mm_results == nil {
 			mmLightValidatorsForJet.t.Fatal("No results are set for the CoordinatorMock.LightValidatorsForJet")
 		}
-		return (*results).ra1, (*results).err
+		return (*mm_results).ra1, (*mm_results).err
 	}
 	if mmLightValidatorsForJet.funcLightValidatorsForJet != nil {
 		return mmLightValidatorsForJet.funcLightValidatorsForJet(ctx, jetID, pulse)
@@ -1751,7 +1978,7 @@ func (mmLightValidatorsForObject *mCoordinatorMockLightValidatorsForObject) Retu
 	return mmLightValidatorsForObject.mock
 }
 
-//Set uses given function f to mock the Coordinator.LightValidatorsForObject method
+// Set uses given function f to mock the Coordinator.LightValidatorsForObject method
 func (mmLightValidatorsForObject *mCoordinatorMockLightValidatorsForObject) Set(f func(ctx context.Context, objID insolar.ID, pulse insolar.PulseNumber) (ra1 []insolar.Reference, err error)) *CoordinatorMock {
 	if mmLightValidatorsForObject.defaultExpectation != nil {
 		mmLightValidatorsForObject.mock.t.Fatalf("Default expectation is already set for the Coordinator.LightValidatorsForObject method")
@@ -1795,15 +2022,15 @@ func (mmLightValidatorsForObject *CoordinatorMock) LightValidatorsForObject(ctx
 		mmLightValidatorsForObject.inspectFuncLightValidatorsForObject(ctx, objID, pulse)
 	}
 
-	params := &CoordinatorMockLightValidatorsForObjectParams{ctx, objID, pulse}
+	mm_params := &CoordinatorMockLightValidatorsForObjectParams{ctx, objID, pulse}
 
 	// Record call args
 	mmLightValidatorsForObject.LightValidatorsForObjectMock.mutex.Lock()
-	mmLightValidatorsForObject.LightValidatorsForObjectMock.callArgs = append(mmLightValidatorsForObject.LightValidatorsForObjectMock.callArgs, params)
+	mmLightValidatorsForObject.LightValidatorsForObjectMock.callArgs = append(mmLightValidatorsForObject.LightValidatorsForObjectMock.callArgs, mm_params)
 	mmLightValidatorsForObject.LightValidatorsForObjectMock.mutex.Unlock()
 
 	for _, e := range mmLightValidatorsForObject.LightValidatorsForObjectMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.ra1, e.results.err
 		}
@@ -1811,17 +2038,17 @@ func (mmLightValidatorsForObject *CoordinatorMock) LightValidatorsForObject(ctx
 
 	if mmLightValidatorsForObject.LightValidatorsForObjectMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmLightValidatorsForObject.LightValidatorsForObjectMock.defaultExpectation.Counter, 1)
-		want := mmLightValidatorsForObject.LightValidatorsForObjectMock.defaultExpectation.params
-		got := CoordinatorMockLightValidatorsForObjectParams{ctx, objID, pulse}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmLightValidatorsForObject.t.Errorf("CoordinatorMock.LightValidatorsForObject got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmLightValidatorsForObject.LightValidatorsForObjectMock.defaultExpectation.params
+		mm_got := CoordinatorMockLightValidatorsForObjectParams{ctx, objID, pulse}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLightValidatorsForObject.t.Errorf("CoordinatorMock.LightValidatorsForObject got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmLightValidatorsForObject.LightValidatorsForObjectMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmLightValidatorsForObject.LightValidatorsForObjectMock.defaultExpectation.results
+		if mm_results == nil {
 			mmLightValidatorsForObject.t.Fatal("No results are set for the CoordinatorMock.LightValidatorsForObject")
 		}
-		return (*results).ra1, (*results).err
+		return (*mm_results).ra1, (*mm_results).err
 	}
 	if mmLightValidatorsForObject.funcLightValidatorsForObject != nil {
 		return mmLightValidatorsForObject.funcLightValidatorsForObject(ctx, objID, pulse)
@@ -1951,7 +2178,7 @@ func (mmMe *mCoordinatorMockMe) Return(r1 insolar.Reference) *CoordinatorMock {
 	return mmMe.mock
 }
 
-//Set uses given function f to mock the Coordinator.Me method
+// Set uses given function f to mock the Coordinator.Me method
 func (mmMe *mCoordinatorMockMe) Set(f func() (r1 insolar.Reference)) *CoordinatorMock {
 	if mmMe.defaultExpectation != nil {
 		mmMe.mock.t.Fatalf("Default expectation is already set for the Coordinator.Me method")
@@ -1977,11 +2204,11 @@ func (mmMe *CoordinatorMock) Me() (r1 insolar.Reference) {
 	if mmMe.MeMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmMe.MeMock.defaultExpectation.Counter, 1)
 
-		results := mmMe.MeMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmMe.MeMock.defaultExpectation.results
+		if mm_results == nil {
 			mmMe.t.Fatal("No results are set for the CoordinatorMock.Me")
 		}
-		return (*results).r1
+		return (*mm_results).r1
 	}
 	if mmMe.funcMe != nil {
 		return mmMe.funcMe()
@@ -2112,7 +2339,7 @@ func (mmNodeForJet *mCoordinatorMockNodeForJet) Return(rp1 *insolar.Reference, e
 	return mmNodeForJet.mock
 }
 
-//Set uses given function f to mock the Coordinator.NodeForJet method
+// Set uses given function f to mock the Coordinator.NodeForJet method
 func (mmNodeForJet *mCoordinatorMockNodeForJet) Set(f func(ctx context.Context, jetID insolar.ID, targetPN insolar.PulseNumber) (rp1 *insolar.Reference, err error)) *CoordinatorMock {
 	if mmNodeForJet.defaultExpectation != nil {
 		mmNodeForJet.mock.t.Fatalf("Default expectation is already set for the Coordinator.NodeForJet method")
@@ -2156,15 +2383,15 @@ func (mmNodeForJet *CoordinatorMock) NodeForJet(ctx context.Context, jetID insol
 		mmNodeForJet.inspectFuncNodeForJet(ctx, jetID, targetPN)
 	}
 
-	params := &CoordinatorMockNodeForJetParams{ctx, jetID, targetPN}
+	mm_params := &CoordinatorMockNodeForJetParams{ctx, jetID, targetPN}
 
 	// Record call args
 	mmNodeForJet.NodeForJetMock.mutex.Lock()
-	mmNodeForJet.NodeForJetMock.callArgs = append(mmNodeForJet.NodeForJetMock.callArgs, params)
+	mmNodeForJet.NodeForJetMock.callArgs = append(mmNodeForJet.NodeForJetMock.callArgs, mm_params)
 	mmNodeForJet.NodeForJetMock.mutex.Unlock()
 
 	for _, e := range mmNodeForJet.NodeForJetMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.rp1, e.results.err
 		}
@@ -2172,17 +2399,17 @@ func (mmNodeForJet *CoordinatorMock) NodeForJet(ctx context.Context, jetID insol
 
 	if mmNodeForJet.NodeForJetMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmNodeForJet.NodeForJetMock.defaultExpectation.Counter, 1)
-		want := mmNodeForJet.NodeForJetMock.defaultExpectation.params
-		got := CoordinatorMockNodeForJetParams{ctx, jetID, targetPN}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmNodeForJet.t.Errorf("CoordinatorMock.NodeForJet got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmNodeForJet.NodeForJetMock.defaultExpectation.params
+		mm_got := CoordinatorMockNodeForJetParams{ctx, jetID, targetPN}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmNodeForJet.t.Errorf("CoordinatorMock.NodeForJet got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmNodeForJet.NodeForJetMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmNodeForJet.NodeForJetMock.defaultExpectation.results
+		if mm_results == nil {
 			mmNodeForJet.t.Fatal("No results are set for the CoordinatorMock.NodeForJet")
 		}
-		return (*results).rp1, (*results).err
+		return (*mm_results).rp1, (*mm_results).err
 	}
 	if mmNodeForJet.funcNodeForJet != nil {
 		return mmNodeForJet.funcNodeForJet(ctx, jetID, targetPN)
@@ -2330,7 +2557,7 @@ func (mmNodeForObject *mCoordinatorMockNodeForObject) Return(rp1 *insolar.Refere
 	return mmNodeForObject.mock
 }
 
-//Set uses given function f to mock the Coordinator.NodeForObject method
+// Set uses given function f to mock the Coordinator.NodeForObject method
 func (mmNodeForObject *mCoordinatorMockNodeForObject) Set(f func(ctx context.Context, objectID insolar.ID, targetPN insolar.PulseNumber) (rp1 *insolar.Reference, err error)) *CoordinatorMock {
 	if mmNodeForObject.defaultExpectation != nil {
 		mmNodeForObject.mock.t.Fatalf("Default expectation is already set for the Coordinator.NodeForObject method")
@@ -2374,15 +2601,15 @@ func (mmNodeForObject *CoordinatorMock) NodeForObject(ctx context.Context, objec
 		mmNodeForObject.inspectFuncNodeForObject(ctx, objectID, targetPN)
 	}
 
-	params := &CoordinatorMockNodeForObjectParams{ctx, objectID, targetPN}
+	mm_params := &CoordinatorMockNodeForObjectParams{ctx, objectID, targetPN}
 
 	// Record call args
 	mmNodeForObject.NodeForObjectMock.mutex.Lock()
-	mmNodeForObject.NodeForObjectMock.callArgs = append(mmNodeForObject.NodeForObjectMock.callArgs, params)
+	mmNodeForObject.NodeForObjectMock.callArgs = append(mmNodeForObject.NodeForObjectMock.callArgs, mm_params)
 	mmNodeForObject.NodeForObjectMock.mutex.Unlock()
 
 	for _, e := range mmNodeForObject.NodeForObjectMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.rp1, e.results.err
 		}
@@ -2390,17 +2617,17 @@ func (mmNodeForObject *CoordinatorMock) NodeForObject(ctx context.Context, objec
 
 	if mmNodeForObject.NodeForObjectMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmNodeForObject.NodeForObjectMock.defaultExpectation.Counter, 1)
-		want := mmNodeForObject.NodeForObjectMock.defaultExpectation.params
-		got := CoordinatorMockNodeForObjectParams{ctx, objectID, targetPN}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmNodeForObject.t.Errorf("CoordinatorMock.NodeForObject got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmNodeForObject.NodeForObjectMock.defaultExpectation.params
+		mm_got := CoordinatorMockNodeForObjectParams{ctx, objectID, targetPN}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmNodeForObject.t.Errorf("CoordinatorMock.NodeForObject got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmNodeForObject.NodeForObjectMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmNodeForObject.NodeForObjectMock.defaultExpectation.results
+		if mm_results == nil {
 			mmNodeForObject.t.Fatal("No results are set for the CoordinatorMock.NodeForObject")
 		}
-		return (*results).rp1, (*results).err
+		return (*mm_results).rp1, (*mm_results).err
 	}
 	if mmNodeForObject.funcNodeForObject != nil {
 		return mmNodeForObject.funcNodeForObject(ctx, objectID, targetPN)
@@ -2549,7 +2776,7 @@ func (mmQueryRole *mCoordinatorMockQueryRole) Return(ra1 []insolar.Reference, er
 	return mmQueryRole.mock
 }
 
-//Set uses given function f to mock the Coordinator.QueryRole method
+// Set uses given function f to mock the Coordinator.QueryRole method
 func (mmQueryRole *mCoordinatorMockQueryRole) Set(f func(ctx context.Context, role insolar.DynamicRole, obj insolar.ID, pulse insolar.PulseNumber) (ra1 []insolar.Reference, err error)) *CoordinatorMock {
 	if mmQueryRole.defaultExpectation != nil {
 		mmQueryRole.mock.t.Fatalf("Default expectation is already set for the Coordinator.QueryRole method")
@@ -2593,15 +2820,15 @@ func (mmQueryRole *CoordinatorMock) QueryRole(ctx context.Context, role insolar.
 		mmQueryRole.inspectFuncQueryRole(ctx, role, obj, pulse)
 	}
 
-	params := &CoordinatorMockQueryRoleParams{ctx, role, obj, pulse}
+	mm_params := &CoordinatorMockQueryRoleParams{ctx, role, obj, pulse}
 
 	// Record call args
 	mmQueryRole.QueryRoleMock.mutex.Lock()
-	mmQueryRole.QueryRoleMock.callArgs = append(mmQueryRole.QueryRoleMock.callArgs, params)
+	mmQueryRole.QueryRoleMock.callArgs = append(mmQueryRole.QueryRoleMock.callArgs, mm_params)
 	mmQueryRole.QueryRoleMock.mutex.Unlock()
 
 	for _, e := range mmQueryRole.QueryRoleMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.ra1, e.results.err
 		}
@@ -2609,17 +2836,17 @@ func (mmQueryRole *CoordinatorMock) QueryRole(ctx context.Context, role insolar.
 
 	if mmQueryRole.QueryRoleMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmQueryRole.QueryRoleMock.defaultExpectation.Counter, 1)
-		want := mmQueryRole.QueryRoleMock.defaultExpectation.params
-		got := CoordinatorMockQueryRoleParams{ctx, role, obj, pulse}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmQueryRole.t.Errorf("CoordinatorMock.QueryRole got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmQueryRole.QueryRoleMock.defaultExpectation.params
+		mm_got := CoordinatorMockQueryRoleParams{ctx, role, obj, pulse}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmQueryRole.t.Errorf("CoordinatorMock.QueryRole got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmQueryRole.QueryRoleMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmQueryRole.QueryRoleMock.defaultExpectation.results
+		if mm_results == nil {
 			mmQueryRole.t.Fatal("No results are set for the CoordinatorMock.QueryRole")
 		}
-		return (*results).ra1, (*results).err
+		return (*mm_results).ra1, (*mm_results).err
 	}
 	if mmQueryRole.funcQueryRole != nil {
 		return mmQueryRole.funcQueryRole(ctx, role, obj, pulse)
@@ -2767,7 +2994,7 @@ func (mmVirtualExecutorForObject *mCoordinatorMockVirtualExecutorForObject) Retu
 	return mmVirtualExecutorForObject.mock
 }
 
-//Set uses given function f to mock the Coordinator.VirtualExecutorForObject method
+// Set uses given function f to mock the Coordinator.VirtualExecutorForObject method
 func (mmVirtualExecutorForObject *mCoordinatorMockVirtualExecutorForObject) Set(f func(ctx context.Context, objID insolar.ID, pulse insolar.PulseNumber) (rp1 *insolar.Reference, err error)) *CoordinatorMock {
 	if mmVirtualExecutorForObject.defaultExpectation != nil {
 		mmVirtualExecutorForObject.mock.t.Fatalf("Default expectation is already set for the Coordinator.VirtualExecutorForObject method")
@@ -2811,15 +3038,15 @@ func (mmVirtualExecutorForObject *CoordinatorMock) VirtualExecutorForObject(ctx
 		mmVirtualExecutorForObject.inspectFuncVirtualExecutorForObject(ctx, objID, pulse)
 	}
 
-	params := &CoordinatorMockVirtualExecutorForObjectParams{ctx, objID, pulse}
+	mm_params := &CoordinatorMockVirtualExecutorForObjectParams{ctx, objID, pulse}
 
 	// Record call args
 	mmVirtualExecutorForObject.VirtualExecutorForObjectMock.mutex.Lock()
-	mmVirtualExecutorForObject.VirtualExecutorForObjectMock.callArgs = append(mmVirtualExecutorForObject.VirtualExecutorForObjectMock.callArgs, params)
+	mmVirtualExecutorForObject.VirtualExecutorForObjectMock.callArgs = append(mmVirtualExecutorForObject.VirtualExecutorForObjectMock.callArgs, mm_params)
 	mmVirtualExecutorForObject.VirtualExecutorForObjectMock.mutex.Unlock()
 
 	for _, e := range mmVirtualExecutorForObject.VirtualExecutorForObjectMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.rp1, e.results.err
 		}
@@ -2827,17 +3054,17 @@ func (mmVirtualExecutorForObject *CoordinatorMock) VirtualExecutorForObject(ctx
 
 	if mmVirtualExecutorForObject.VirtualExecutorForObjectMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmVirtualExecutorForObject.VirtualExecutorForObjectMock.defaultExpectation.Counter, 1)
-		want := mmVirtualExecutorForObject.VirtualExecutorForObjectMock.defaultExpectation.params
-		got := CoordinatorMockVirtualExecutorForObjectParams{ctx, objID, pulse}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmVirtualExecutorForObject.t.Errorf("CoordinatorMock.VirtualExecutorForObject got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmVirtualExecutorForObject.VirtualExecutorForObjectMock.defaultExpectation.params
+		mm_got := CoordinatorMockVirtualExecutorForObjectParams{ctx, objID, pulse}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVirtualExecutorForObject.t.Errorf("CoordinatorMock.VirtualExecutorForObject got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmVirtualExecutorForObject.VirtualExecutorForObjectMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmVirtualExecutorForObject.VirtualExecutorForObjectMock.defaultExpectation.results
+		if mm_results == nil {
 			mmVirtualExecutorForObject.t.Fatal("No results are set for the CoordinatorMock.VirtualExecutorForObject")
 		}
-		return (*results).rp1, (*results).err
+		return (*mm_results).rp1, (*mm_results).err
 	}
 	if mmVirtualExecutorForObject.funcVirtualExecutorForObject != nil {
 		return mmVirtualExecutorForObject.funcVirtualExecutorForObject(ctx, objID, pulse)
@@ -2985,7 +3212,7 @@ func (mmVirtualValidatorsForObject *mCoordinatorMockVirtualValidatorsForObject)
 	return mmVirtualValidatorsForObject.mock
 }
 
-//Set uses given function f to mock the Coordinator.VirtualValidatorsForObject method
+// Set uses given function f to mock the Coordinator.VirtualValidatorsForObject method
 func (mmVirtualValidatorsForObject *mCoordinatorMockVirtualValidatorsForObject) Set(f func(ctx context.Context, objID insolar.ID, pulse insolar.PulseNumber) (ra1 []insolar.Reference, err error)) *CoordinatorMock {
 	if mmVirtualValidatorsForObject.defaultExpectation != nil {
 		mmVirtualValidatorsForObject.mock.t.Fatalf("Default expectation is already set for the Coordinator.VirtualValidatorsForObject method")
@@ -3029,15 +3256,15 @@ func (mmVirtualValidatorsForObject *CoordinatorMock) VirtualValidatorsForObject(
 		mmVirtualValidatorsForObject.inspectFuncVirtualValidatorsForObject(ctx, objID, pulse)
 	}
 
-	params := &CoordinatorMockVirtualValidatorsForObjectParams{ctx, objID, pulse}
+	mm_params := &CoordinatorMockVirtualValidatorsForObjectParams{ctx, objID, pulse}
 
 	// Record call args
 	mmVirtualValidatorsForObject.VirtualValidatorsForObjectMock.mutex.Lock()
-	mmVirtualValidatorsForObject.VirtualValidatorsForObjectMock.callArgs = append(mmVirtualValidatorsForObject.VirtualValidatorsForObjectMock.callArgs, params)
+	mmVirtualValidatorsForObject.VirtualValidatorsForObjectMock.callArgs = append(mmVirtualValidatorsForObject.VirtualValidatorsForObjectMock.callArgs, mm_params)
 	mmVirtualValidatorsForObject.VirtualValidatorsForObjectMock.mutex.Unlock()
 
 	for _, e := range mmVirtualValidatorsForObject.VirtualValidatorsForObjectMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.ra1, e.results.err
 		}
@@ -3045,17 +3272,17 @@ func (mmVirtualValidatorsForObject *CoordinatorMock) VirtualValidatorsForObject(
 
 	if mmVirtualValidatorsForObject.VirtualValidatorsForObjectMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmVirtualValidatorsForObject.VirtualValidatorsForObjectMock.defaultExpectation.Counter, 1)
-		want := mmVirtualValidatorsForObject.VirtualValidatorsForObjectMock.defaultExpectation.params
-		got := CoordinatorMockVirtualValidatorsForObjectParams{ctx, objID, pulse}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmVirtualValidatorsForObject.t.Errorf("CoordinatorMock.VirtualValidatorsForObject got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmVirtualValidatorsForObject.VirtualValidatorsForObjectMock.defaultExpectation.params
+		mm_got := CoordinatorMockVirtualValidatorsForObjectParams{ctx, objID, pulse}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVirtualValidatorsForObject.t.Errorf("CoordinatorMock.VirtualValidatorsForObject got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmVirtualValidatorsForObject.VirtualValidatorsForObjectMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmVirtualValidatorsForObject.VirtualValidatorsForObjectMock.defaultExpectation.results
+		if mm_results == nil {
 			mmVirtualValidatorsForObject.t.Fatal("No results are set for the CoordinatorMock.VirtualValidatorsForObject")
 		}
-		return (*results).ra1, (*results).err
+		return (*mm_results).ra1, (*mm_results).err
 	}
 	if mmVirtualValidatorsForObject.funcVirtualValidatorsForObject != nil {
 		return mmVirtualValidatorsForObject.funcVirtualValidatorsForObject(ctx, objID, pulse)
@@ -3132,6 +3359,8 @@ func (m *CoordinatorMock) MinimockVirtualValidatorsForObjectInspect() {
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *CoordinatorMock) MinimockFinish() {
 	if !m.minimockDone() {
+		m.MinimockHeaviesInspect()
+
 		m.MinimockHeavyInspect()
 
 		m.MinimockIsAuthorizedInspect()
@@ -3182,6 +3411,7 @@ func (m *CoordinatorMock) MinimockWait(timeout mm_time.Duration) {
 func (m *CoordinatorMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockHeaviesDone() &&
 		m.MinimockHeavyDone() &&
 		m.MinimockIsAuthorizedDone() &&
 		m.MinimockIsBeyondLimitDone() &&
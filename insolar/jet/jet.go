@@ -88,7 +88,10 @@ type Coordinator interface {
 	LightExecutorForJet(ctx context.Context, jetID insolar.ID, pulse insolar.PulseNumber) (*insolar.Reference, error)
 	LightValidatorsForJet(ctx context.Context, jetID insolar.ID, pulse insolar.PulseNumber) ([]insolar.Reference, error)
 
-	Heavy(ctx context.Context) (*insolar.Reference, error)
+	// Heavy returns the first heavy node which stores replica of finalized data for provided pulse.
+	Heavy(ctx context.Context, pulse insolar.PulseNumber) (*insolar.Reference, error)
+	// Heavies returns all heavy nodes which store replicas of finalized data for provided pulse.
+	Heavies(ctx context.Context, pulse insolar.PulseNumber) ([]insolar.Reference, error)
 
 	IsBeyondLimit(ctx context.Context, targetPN insolar.PulseNumber) (bool, error)
 	NodeForJet(ctx context.Context, jetID insolar.ID, targetPN insolar.PulseNumber) (*insolar.Reference, error)
@@ -44,11 +44,25 @@ type Coordinator struct {
 	Nodes       node.Accessor `inject:""`
 
 	lightChainLimit int
+	keepPulses      int
+	heavyReplicas   int
 }
 
-// NewJetCoordinator creates new coordinator instance.
-func NewJetCoordinator(lightChainLimit int) *Coordinator {
-	return &Coordinator{lightChainLimit: lightChainLimit}
+// NewJetCoordinator creates new coordinator instance. Data of keepPulses pulses beyond lightChainLimit
+// is retained on light nodes, so reads for them are routed to light nodes too.
+func NewJetCoordinator(lightChainLimit int, keepPulses int, heavyReplicas int) *Coordinator {
+	if heavyReplicas < 1 {
+		heavyReplicas = 1
+	}
+	if keepPulses < 0 {
+		keepPulses = 0
+	}
+	return &Coordinator{lightChainLimit: lightChainLimit, keepPulses: keepPulses, heavyReplicas: heavyReplicas}
+}
+
+// RetentionLimit returns amount of pulses which data is read from light nodes.
+func (jc *Coordinator) RetentionLimit() int {
+	return jc.lightChainLimit + jc.keepPulses
 }
 
 // Hardcoded roles count for validation and execution
@@ -139,11 +153,11 @@ func (jc *Coordinator) QueryRole(
 		return ref, nil
 
 	case insolar.DynamicRoleHeavyExecutor:
-		n, err := jc.Heavy(ctx)
+		refs, err := jc.Heavies(ctx, pulse)
 		if err != nil {
 			return nil, errors.Wrapf(err, "calc DynamicRoleHeavyExecutor for pulse %v failed", pulse.String())
 		}
-		return []insolar.Reference{*n}, nil
+		return refs, nil
 	}
 
 	panic("unexpected role")
@@ -214,12 +228,45 @@ func (jc *Coordinator) LightValidatorsForObject(
 }
 
 // Heavy returns *insolar.RecorRef to heavy
-func (jc *Coordinator) Heavy(ctx context.Context) (*insolar.Reference, error) {
+// It is the first of replicas returned by Heavies. Use Heavies to fail over to other replicas.
+func (jc *Coordinator) Heavy(ctx context.Context, pn insolar.PulseNumber) (*insolar.Reference, error) {
+	refs, err := jc.Heavies(ctx, pn)
+	if err != nil {
+		return nil, err
+	}
+	return &refs[0], nil
+}
+
+// Heavies returns heavy nodes which store replicas of finalized data for provided pulse.
+// Replicas are chosen by entropy of provided pulse among heavies active in that pulse, so the set is fixed
+// once the pulse is known. Amount of returned nodes is the configured replicas count capped by amount of
+// active heavies.
+// If provided pulse or its active nodes are unknown to the node (e.g. they were cleaned), the replica set
+// can't be calculated and all heavies active in the latest pulse are returned. Readers should try every
+// returned node in this case, because only some of them hold the data.
+func (jc *Coordinator) Heavies(ctx context.Context, pn insolar.PulseNumber) ([]insolar.Reference, error) {
+	ent, err := jc.entropy(ctx, pn)
+	if err != nil && errors.Cause(err) != pulse.ErrNotFound {
+		return nil, errors.Wrapf(err, "failed to fetch entropy for pulse %v", pn)
+	}
+	if err == nil {
+		candidates, err := jc.Nodes.InRole(pn, insolar.StaticRoleHeavyMaterial)
+		if err != nil && err != node.ErrNoNodes {
+			return nil, errors.Wrapf(err, "failed to fetch active heavy nodes for pulse %v", pn)
+		}
+		if len(candidates) > 0 {
+			count := jc.heavyReplicas
+			if count > len(candidates) {
+				count = len(candidates)
+			}
+			return getRefs(jc.PlatformCryptographyScheme, ent[:], candidates, count)
+		}
+	}
+
 	latest, err := jc.PulseAccessor.Latest(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to fetch pulse")
 	}
-
 	candidates, err := jc.Nodes.InRole(latest.PulseNumber, insolar.StaticRoleHeavyMaterial)
 	if err == node.ErrNoNodes {
 		return nil, err
@@ -230,24 +277,10 @@ func (jc *Coordinator) Heavy(ctx context.Context) (*insolar.Reference, error) {
 	if len(candidates) == 0 {
 		return nil, errors.New(fmt.Sprintf("no active heavy nodes for pulse %d", latest.PulseNumber))
 	}
-	ent, err := jc.entropy(ctx, latest.PulseNumber)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to fetch entropy for pulse %v", latest.PulseNumber)
-	}
-
-	refs, err := getRefs(
-		jc.PlatformCryptographyScheme,
-		ent[:],
-		candidates,
-		1,
-	)
-	if err != nil {
-		return nil, err
-	}
-	return &refs[0], nil
+	return getRefs(jc.PlatformCryptographyScheme, latest.Entropy[:], candidates, len(candidates))
 }
 
-// IsBeyondLimit calculates if target pulse is behind clean-up limit
+// IsBeyondLimit calculates if target pulse is behind retention limit (lightChainLimit and retained pulses)
 // or if currentPN|targetPN didn't found in in-memory pulse-storage.
 func (jc *Coordinator) IsBeyondLimit(ctx context.Context, targetPN insolar.PulseNumber) (bool, error) {
 	// Genesis case. When there is no any data on a lme
@@ -266,7 +299,8 @@ func (jc *Coordinator) IsBeyondLimit(ctx context.Context, targetPN insolar.Pulse
 	}
 
 	iter := latest.PulseNumber
-	for i := 1; i <= jc.lightChainLimit; i++ {
+	limit := jc.RetentionLimit()
+	for i := 1; i <= limit; i++ {
 		stepBack, err := jc.PulseCalculator.Backwards(ctx, latest.PulseNumber, i)
 		// We could not reach our target and ran out of known pulses. It means it's beyond limit.
 		if err == pulse.ErrNotFound {
@@ -294,7 +328,7 @@ func (jc *Coordinator) NodeForJet(ctx context.Context, jetID insolar.ID, targetP
 	}
 
 	if toHeavy {
-		return jc.Heavy(ctx)
+		return jc.Heavy(ctx, targetPN)
 	}
 	return jc.LightExecutorForJet(ctx, jetID, targetPN)
 }
@@ -307,7 +341,7 @@ func (jc *Coordinator) NodeForObject(ctx context.Context, objectID insolar.ID, t
 	}
 
 	if toHeavy {
-		return jc.Heavy(ctx)
+		return jc.Heavy(ctx, targetPN)
 	}
 	return jc.LightExecutorForObject(ctx, objectID, targetPN)
 }
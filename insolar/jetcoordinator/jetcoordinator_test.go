@@ -72,7 +72,7 @@ func (s *jetCoordinatorSuite) BeforeTest(suiteName, testName string) {
 	storage := jet.NewStore()
 	s.jetStorage = storage
 	s.nodeStorage = node.NewAccessorMock(s.T())
-	s.coordinator = NewJetCoordinator(5)
+	s.coordinator = NewJetCoordinator(5, 0, 1)
 	s.coordinator.OriginProvider = network.NewOriginProviderMock(s.T())
 
 	s.cm.Inject(
@@ -134,7 +134,7 @@ func TestJetCoordinator_Me(t *testing.T) {
 	node := network.NewNetworkNodeMock(t)
 	nodeNet.GetOriginMock.Return(node)
 	node.IDMock.Return(expectedID)
-	jc := NewJetCoordinator(1)
+	jc := NewJetCoordinator(1, 0, 1)
 	jc.OriginProvider = nodeNet
 
 	// Act
@@ -147,7 +147,7 @@ func TestJetCoordinator_Me(t *testing.T) {
 func TestNewJetCoordinator(t *testing.T) {
 	t.Parallel()
 	// Act
-	calc := NewJetCoordinator(12)
+	calc := NewJetCoordinator(12, 0, 1)
 
 	// Assert
 	require.NotNil(t, calc)
@@ -162,7 +162,7 @@ func TestJetCoordinator_IsBeyondLimit_ProblemsWithTracker(t *testing.T) {
 	pulseCalculator.BackwardsMock.Return(insolar.Pulse{}, errors.New("it's expected"))
 	pulseAccessor := pulse.NewAccessorMock(t)
 	pulseAccessor.LatestMock.Return(insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 2}, nil)
-	calc := NewJetCoordinator(12)
+	calc := NewJetCoordinator(12, 0, 1)
 	calc.PulseCalculator = pulseCalculator
 	calc.PulseAccessor = pulseAccessor
 
@@ -179,7 +179,7 @@ func TestJetCoordinator_IsBeyondLimit_OutsideOfLightChainLimit(t *testing.T) {
 	// Arrange
 	ctx := inslogger.TestContext(t)
 
-	coord := NewJetCoordinator(25)
+	coord := NewJetCoordinator(25, 0, 1)
 	pulseCalculator := pulse.NewCalculatorMock(t)
 	pulseCalculator.BackwardsMock.Expect(ctx, insolar.FirstPulseNumber, 25).Return(insolar.Pulse{PulseNumber: 34}, nil)
 	pulseAccessor := pulse.NewAccessorMock(t)
@@ -202,7 +202,7 @@ func TestJetCoordinator_IsBeyondLimit_PulseNotFoundIsNotBeyondLimit(t *testing.T
 	mc := minimock.NewController(t)
 	defer mc.Finish()
 
-	coord := NewJetCoordinator(25)
+	coord := NewJetCoordinator(25, 0, 1)
 	pulseCalculator := pulse.NewCalculatorMock(mc)
 	pulseCalculator.BackwardsMock.Expect(ctx, insolar.FirstPulseNumber+2, 1).Return(insolar.Pulse{}, pulse.ErrNotFound)
 	pulseAccessor := pulse.NewAccessorMock(mc)
@@ -222,7 +222,7 @@ func TestJetCoordinator_IsBeyondLimit_InsideOfLightChainLimit(t *testing.T) {
 	t.Parallel()
 	// Arrange
 	ctx := inslogger.TestContext(t)
-	coord := NewJetCoordinator(25)
+	coord := NewJetCoordinator(25, 0, 1)
 	pulseCalculator := pulse.NewCalculatorMock(t)
 	pulseCalculator.BackwardsMock.Expect(ctx, insolar.FirstPulseNumber+1, 25).Return(insolar.Pulse{PulseNumber: 15}, nil)
 	pulseAccessor := pulse.NewAccessorMock(t)
@@ -238,6 +238,34 @@ func TestJetCoordinator_IsBeyondLimit_InsideOfLightChainLimit(t *testing.T) {
 	require.Equal(t, false, res)
 }
 
+func TestJetCoordinator_IsBeyondLimit_InsideOfRetention(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	latest := insolar.PulseNumber(insolar.FirstPulseNumber + 100)
+	target := latest - 30
+
+	check := func(lightChainLimit, keepPulses int) bool {
+		coord := NewJetCoordinator(lightChainLimit, keepPulses, 1)
+		pulseCalculator := pulse.NewCalculatorMock(t)
+		pulseCalculator.BackwardsMock.Set(func(_ context.Context, pn insolar.PulseNumber, steps int) (insolar.Pulse, error) {
+			return insolar.Pulse{PulseNumber: pn - insolar.PulseNumber(10*steps)}, nil
+		})
+		pulseAccessor := pulse.NewAccessorMock(t)
+		pulseAccessor.LatestMock.Return(insolar.Pulse{PulseNumber: latest}, nil)
+		coord.PulseCalculator = pulseCalculator
+		coord.PulseAccessor = pulseAccessor
+
+		res, err := coord.IsBeyondLimit(ctx, target)
+		require.NoError(t, err)
+		return res
+	}
+
+	require.True(t, check(2, 0), "target is beyond light chain limit")
+	require.False(t, check(2, 2), "target is retained on light")
+	require.Equal(t, 4, NewJetCoordinator(2, 2, 1).RetentionLimit())
+}
+
 func TestJetCoordinator_NodeForJet_CheckLimitFailed(t *testing.T) {
 	t.Parallel()
 	// Arrange
@@ -246,7 +274,7 @@ func TestJetCoordinator_NodeForJet_CheckLimitFailed(t *testing.T) {
 	pulseCalculator.BackwardsMock.Return(insolar.Pulse{}, errors.New("it's expected"))
 	pulseAccessor := pulse.NewAccessorMock(t)
 	pulseAccessor.LatestMock.Return(insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 2}, nil)
-	calc := NewJetCoordinator(12)
+	calc := NewJetCoordinator(12, 0, 1)
 	calc.PulseCalculator = pulseCalculator
 	calc.PulseAccessor = pulseAccessor
 
@@ -267,17 +295,18 @@ func TestJetCoordinator_NodeForJet_GoToHeavy(t *testing.T) {
 	pulseAccessor := pulse.NewAccessorMock(t)
 	generator := entropygenerator.StandardEntropyGenerator{}
 	pulseAccessor.LatestMock.Return(insolar.Pulse{PulseNumber: insolar.FirstPulseNumber, Entropy: generator.GenerateEntropy()}, nil)
+	pulseAccessor.ForPulseNumberMock.Return(insolar.Pulse{PulseNumber: 10, Entropy: generator.GenerateEntropy()}, nil)
 
 	expectedID := insolar.NewReference(testutils.RandomID())
 	activeNodesStorageMock := node.NewAccessorMock(t)
 	activeNodesStorageMock.InRoleMock.Set(func(p insolar.PulseNumber, p1 insolar.StaticRole) (r []insolar.Node, r1 error) {
-		require.Equal(t, insolar.FirstPulseNumber, int(p))
+		require.Equal(t, 10, int(p))
 		require.Equal(t, insolar.StaticRoleHeavyMaterial, p1)
 
 		return []insolar.Node{{ID: *expectedID}}, nil
 	})
 
-	coord := NewJetCoordinator(25)
+	coord := NewJetCoordinator(25, 0, 1)
 	coord.PulseCalculator = pulseCalculator
 	coord.Nodes = activeNodesStorageMock
 	coord.PlatformCryptographyScheme = platformpolicy.NewPlatformCryptographyScheme()
@@ -291,6 +320,67 @@ func TestJetCoordinator_NodeForJet_GoToHeavy(t *testing.T) {
 	require.Equal(t, expectedID, resNode)
 }
 
+func TestJetCoordinator_Heavies(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	stored := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber, Entropy: insolar.Entropy{1, 2, 3}}
+	cleaned := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 5, Entropy: insolar.Entropy{7, 8, 9}}
+	latest := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 10, Entropy: insolar.Entropy{4, 5, 6}}
+	pulses := pulse.NewStorageMem()
+	require.NoError(t, pulses.Append(ctx, stored))
+	require.NoError(t, pulses.Append(ctx, cleaned))
+	require.NoError(t, pulses.Append(ctx, latest))
+
+	var heavies []insolar.Node
+	for i := 0; i < 10; i++ {
+		heavies = append(heavies, insolar.Node{ID: gen.Reference(), Role: insolar.StaticRoleHeavyMaterial})
+	}
+	// Half of heavies joined after stored pulse.
+	storedHeavies := heavies[:5]
+	nodes := node.NewAccessorMock(t)
+	nodes.InRoleMock.Set(func(pn insolar.PulseNumber, role insolar.StaticRole) ([]insolar.Node, error) {
+		require.Equal(t, insolar.StaticRoleHeavyMaterial, role)
+		switch pn {
+		case stored.PulseNumber:
+			return append([]insolar.Node{}, storedHeavies...), nil
+		case latest.PulseNumber:
+			return append([]insolar.Node{}, heavies...), nil
+		}
+		return nil, node.ErrNoNodes
+	})
+
+	coord := NewJetCoordinator(5, 0, 2)
+	coord.PulseAccessor = pulses
+	coord.Nodes = nodes
+	coord.PlatformCryptographyScheme = platformpolicy.NewPlatformCryptographyScheme()
+
+	t.Run("replicas are chosen among heavies of stored pulse", func(t *testing.T) {
+		expected, err := getRefs(coord.PlatformCryptographyScheme, stored.Entropy[:], append([]insolar.Node{}, storedHeavies...), 2)
+		require.NoError(t, err)
+
+		refs, err := coord.Heavies(ctx, stored.PulseNumber)
+		require.NoError(t, err)
+		require.Equal(t, expected, refs)
+
+		ref, err := coord.Heavy(ctx, stored.PulseNumber)
+		require.NoError(t, err)
+		require.Equal(t, expected[0], *ref)
+	})
+
+	t.Run("all heavies for unknown pulse", func(t *testing.T) {
+		refs, err := coord.Heavies(ctx, stored.PulseNumber+1)
+		require.NoError(t, err)
+		require.Len(t, refs, len(heavies))
+	})
+
+	t.Run("all heavies for pulse with unknown nodes", func(t *testing.T) {
+		refs, err := coord.Heavies(ctx, cleaned.PulseNumber)
+		require.NoError(t, err)
+		require.Len(t, refs, len(heavies))
+	})
+}
+
 func TestJetCoordinator_NodeForJet_GoToLight(t *testing.T) {
 	t.Parallel()
 	// Arrange
@@ -311,7 +401,7 @@ func TestJetCoordinator_NodeForJet_GoToLight(t *testing.T) {
 		return []insolar.Node{{ID: *expectedID}}, nil
 	})
 
-	coord := NewJetCoordinator(25)
+	coord := NewJetCoordinator(25, 0, 1)
 	coord.PulseAccessor = pulseAccessor
 	coord.PulseCalculator = pulseCalculator
 	coord.Nodes = activeNodesStorageMock
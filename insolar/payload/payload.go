@@ -67,6 +67,8 @@ const (
 	TypeLightInitialState
 	TypeGetIndex
 	TypeUpdateJet
+	TypeGetPulseReplica
+	TypePulseReplica
 
 	TypeReturnResults
 	TypeCallMethod
@@ -74,6 +76,13 @@ const (
 	TypePendingFinished
 	TypeAdditionalCallFromPreviousExecutor
 	TypeStillExecuting
+	TypeValidate
+	TypeValidationMismatch
+	TypeChunk
+	TypeChunkAck
+	TypeGetResult
+	TypeSagaStates
+	TypeGetSagaStates
 
 	// should be the last (required by TypesMap)
 	_latestType
@@ -304,6 +313,33 @@ func Marshal(payload Payload) ([]byte, error) {
 	case *UpdateJet:
 		pl.Polymorph = uint32(TypeUpdateJet)
 		return pl.Marshal()
+	case *GetPulseReplica:
+		pl.Polymorph = uint32(TypeGetPulseReplica)
+		return pl.Marshal()
+	case *PulseReplica:
+		pl.Polymorph = uint32(TypePulseReplica)
+		return pl.Marshal()
+	case *Validate:
+		pl.Polymorph = uint32(TypeValidate)
+		return pl.Marshal()
+	case *ValidationMismatch:
+		pl.Polymorph = uint32(TypeValidationMismatch)
+		return pl.Marshal()
+	case *Chunk:
+		pl.Polymorph = uint32(TypeChunk)
+		return pl.Marshal()
+	case *ChunkAck:
+		pl.Polymorph = uint32(TypeChunkAck)
+		return pl.Marshal()
+	case *GetResult:
+		pl.Polymorph = uint32(TypeGetResult)
+		return pl.Marshal()
+	case *SagaStates:
+		pl.Polymorph = uint32(TypeSagaStates)
+		return pl.Marshal()
+	case *GetSagaStates:
+		pl.Polymorph = uint32(TypeGetSagaStates)
+		return pl.Marshal()
 	}
 
 	return nil, errors.New("unknown payload type")
@@ -491,6 +527,42 @@ func Unmarshal(data []byte) (Payload, error) {
 		pl := UpdateJet{}
 		err := pl.Unmarshal(data)
 		return &pl, err
+	case TypeGetPulseReplica:
+		pl := GetPulseReplica{}
+		err := pl.Unmarshal(data)
+		return &pl, err
+	case TypePulseReplica:
+		pl := PulseReplica{}
+		err := pl.Unmarshal(data)
+		return &pl, err
+	case TypeValidate:
+		pl := Validate{}
+		err := pl.Unmarshal(data)
+		return &pl, err
+	case TypeValidationMismatch:
+		pl := ValidationMismatch{}
+		err := pl.Unmarshal(data)
+		return &pl, err
+	case TypeChunk:
+		pl := Chunk{}
+		err := pl.Unmarshal(data)
+		return &pl, err
+	case TypeChunkAck:
+		pl := ChunkAck{}
+		err := pl.Unmarshal(data)
+		return &pl, err
+	case TypeGetResult:
+		pl := GetResult{}
+		err := pl.Unmarshal(data)
+		return &pl, err
+	case TypeSagaStates:
+		pl := SagaStates{}
+		err := pl.Unmarshal(data)
+		return &pl, err
+	case TypeGetSagaStates:
+		pl := GetSagaStates{}
+		err := pl.Unmarshal(data)
+		return &pl, err
 	}
 
 	return nil, errors.New("unknown payload type")
@@ -154,6 +154,7 @@ type GetObject struct {
 	Polymorph       uint32                                `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
 	ObjectID        github_com_insolar_insolar_insolar.ID `protobuf:"bytes,20,opt,name=ObjectID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"ObjectID"`
 	ObjectRequestID github_com_insolar_insolar_insolar.ID `protobuf:"bytes,21,opt,name=ObjectRequestID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"ObjectRequestID"`
+	StateID         github_com_insolar_insolar_insolar.ID `protobuf:"bytes,22,opt,name=StateID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"StateID"`
 }
 
 func (m *GetObject) Reset()      { *m = GetObject{} }
@@ -1240,6 +1241,51 @@ func (m *RequestInfo) GetResult() []byte {
 	return nil
 }
 
+type GetResult struct {
+	Polymorph uint32                                `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	ObjectID  github_com_insolar_insolar_insolar.ID `protobuf:"bytes,20,opt,name=ObjectID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"ObjectID"`
+	RequestID github_com_insolar_insolar_insolar.ID `protobuf:"bytes,21,opt,name=RequestID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"RequestID"`
+}
+
+func (m *GetResult) Reset()      { *m = GetResult{} }
+func (*GetResult) ProtoMessage() {}
+func (*GetResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{23}
+}
+func (m *GetResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResult.Merge(m, src)
+}
+func (m *GetResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResult proto.InternalMessageInfo
+
+func (m *GetResult) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
 type GotHotConfirmation struct {
 	Polymorph uint32                                         `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
 	JetID     github_com_insolar_insolar_insolar.JetID       `protobuf:"bytes,20,opt,name=JetID,proto3,customtype=github.com/insolar/insolar/insolar.JetID" json:"JetID"`
@@ -1250,7 +1296,7 @@ type GotHotConfirmation struct {
 func (m *GotHotConfirmation) Reset()      { *m = GotHotConfirmation{} }
 func (*GotHotConfirmation) ProtoMessage() {}
 func (*GotHotConfirmation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{23}
+	return fileDescriptor_33334fec96407f54, []int{24}
 }
 func (m *GotHotConfirmation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1303,7 +1349,7 @@ type ResultInfo struct {
 func (m *ResultInfo) Reset()      { *m = ResultInfo{} }
 func (*ResultInfo) ProtoMessage() {}
 func (*ResultInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{24}
+	return fileDescriptor_33334fec96407f54, []int{25}
 }
 func (m *ResultInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1357,7 +1403,7 @@ type HotObjects struct {
 func (m *HotObjects) Reset()      { *m = HotObjects{} }
 func (*HotObjects) ProtoMessage() {}
 func (*HotObjects) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{25}
+	return fileDescriptor_33334fec96407f54, []int{26}
 }
 func (m *HotObjects) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1462,7 @@ type GetRequest struct {
 func (m *GetRequest) Reset()      { *m = GetRequest{} }
 func (*GetRequest) ProtoMessage() {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{26}
+	return fileDescriptor_33334fec96407f54, []int{27}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1461,7 +1507,7 @@ type Request struct {
 func (m *Request) Reset()      { *m = Request{} }
 func (*Request) ProtoMessage() {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{27}
+	return fileDescriptor_33334fec96407f54, []int{28}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1514,7 +1560,7 @@ type ServiceData struct {
 func (m *ServiceData) Reset()      { *m = ServiceData{} }
 func (*ServiceData) ProtoMessage() {}
 func (*ServiceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{28}
+	return fileDescriptor_33334fec96407f54, []int{29}
 }
 func (m *ServiceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1574,7 +1620,7 @@ type ExecutionQueueElement struct {
 func (m *ExecutionQueueElement) Reset()      { *m = ExecutionQueueElement{} }
 func (*ExecutionQueueElement) ProtoMessage() {}
 func (*ExecutionQueueElement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{29}
+	return fileDescriptor_33334fec96407f54, []int{30}
 }
 func (m *ExecutionQueueElement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1681,7 @@ type ReturnResults struct {
 func (m *ReturnResults) Reset()      { *m = ReturnResults{} }
 func (*ReturnResults) ProtoMessage() {}
 func (*ReturnResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{30}
+	return fileDescriptor_33334fec96407f54, []int{31}
 }
 func (m *ReturnResults) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1694,7 +1740,7 @@ type CallMethod struct {
 func (m *CallMethod) Reset()      { *m = CallMethod{} }
 func (*CallMethod) ProtoMessage() {}
 func (*CallMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{31}
+	return fileDescriptor_33334fec96407f54, []int{32}
 }
 func (m *CallMethod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1749,7 +1795,7 @@ type ExecutorResults struct {
 func (m *ExecutorResults) Reset()      { *m = ExecutorResults{} }
 func (*ExecutorResults) ProtoMessage() {}
 func (*ExecutorResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{32}
+	return fileDescriptor_33334fec96407f54, []int{33}
 }
 func (m *ExecutorResults) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1807,7 +1853,7 @@ type PendingFinished struct {
 func (m *PendingFinished) Reset()      { *m = PendingFinished{} }
 func (*PendingFinished) ProtoMessage() {}
 func (*PendingFinished) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{33}
+	return fileDescriptor_33334fec96407f54, []int{34}
 }
 func (m *PendingFinished) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1855,7 +1901,7 @@ type AdditionalCallFromPreviousExecutor struct {
 func (m *AdditionalCallFromPreviousExecutor) Reset()      { *m = AdditionalCallFromPreviousExecutor{} }
 func (*AdditionalCallFromPreviousExecutor) ProtoMessage() {}
 func (*AdditionalCallFromPreviousExecutor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{34}
+	return fileDescriptor_33334fec96407f54, []int{35}
 }
 func (m *AdditionalCallFromPreviousExecutor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1913,7 +1959,7 @@ type StillExecuting struct {
 func (m *StillExecuting) Reset()      { *m = StillExecuting{} }
 func (*StillExecuting) ProtoMessage() {}
 func (*StillExecuting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{35}
+	return fileDescriptor_33334fec96407f54, []int{36}
 }
 func (m *StillExecuting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1957,7 +2003,7 @@ type GetPendings struct {
 func (m *GetPendings) Reset()      { *m = GetPendings{} }
 func (*GetPendings) ProtoMessage() {}
 func (*GetPendings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{36}
+	return fileDescriptor_33334fec96407f54, []int{37}
 }
 func (m *GetPendings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2001,7 +2047,7 @@ type HasPendings struct {
 func (m *HasPendings) Reset()      { *m = HasPendings{} }
 func (*HasPendings) ProtoMessage() {}
 func (*HasPendings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{37}
+	return fileDescriptor_33334fec96407f54, []int{38}
 }
 func (m *HasPendings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2045,7 +2091,7 @@ type PendingsInfo struct {
 func (m *PendingsInfo) Reset()      { *m = PendingsInfo{} }
 func (*PendingsInfo) ProtoMessage() {}
 func (*PendingsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{38}
+	return fileDescriptor_33334fec96407f54, []int{39}
 }
 func (m *PendingsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2095,12 +2141,14 @@ type Replication struct {
 	Indexes   []record.Index                                 `protobuf:"bytes,22,rep,name=Indexes,proto3" json:"Indexes"`
 	Records   []record.Material                              `protobuf:"bytes,23,rep,name=Records,proto3" json:"Records"`
 	Drop      []byte                                         `protobuf:"bytes,24,opt,name=Drop,proto3" json:"Drop,omitempty"`
+	// Confirm asks heavy to reply with GotHotConfirmation after data is stored.
+	Confirm bool `protobuf:"varint,25,opt,name=Confirm,proto3" json:"Confirm,omitempty"`
 }
 
 func (m *Replication) Reset()      { *m = Replication{} }
 func (*Replication) ProtoMessage() {}
 func (*Replication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{39}
+	return fileDescriptor_33334fec96407f54, []int{40}
 }
 func (m *Replication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2157,6 +2205,13 @@ func (m *Replication) GetDrop() []byte {
 	return nil
 }
 
+func (m *Replication) GetConfirm() bool {
+	if m != nil {
+		return m.Confirm
+	}
+	return false
+}
+
 type GetJet struct {
 	Polymorph   uint32                                         `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
 	ObjectID    github_com_insolar_insolar_insolar.ID          `protobuf:"bytes,20,opt,name=ObjectID,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"ObjectID"`
@@ -2166,7 +2221,7 @@ type GetJet struct {
 func (m *GetJet) Reset()      { *m = GetJet{} }
 func (*GetJet) ProtoMessage() {}
 func (*GetJet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{40}
+	return fileDescriptor_33334fec96407f54, []int{41}
 }
 func (m *GetJet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2210,7 +2265,7 @@ type AbandonedRequestsNotification struct {
 func (m *AbandonedRequestsNotification) Reset()      { *m = AbandonedRequestsNotification{} }
 func (*AbandonedRequestsNotification) ProtoMessage() {}
 func (*AbandonedRequestsNotification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{41}
+	return fileDescriptor_33334fec96407f54, []int{42}
 }
 func (m *AbandonedRequestsNotification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2254,7 +2309,7 @@ type GetLightInitialState struct {
 func (m *GetLightInitialState) Reset()      { *m = GetLightInitialState{} }
 func (*GetLightInitialState) ProtoMessage() {}
 func (*GetLightInitialState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{42}
+	return fileDescriptor_33334fec96407f54, []int{43}
 }
 func (m *GetLightInitialState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2302,7 +2357,7 @@ type LightInitialState struct {
 func (m *LightInitialState) Reset()      { *m = LightInitialState{} }
 func (*LightInitialState) ProtoMessage() {}
 func (*LightInitialState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{43}
+	return fileDescriptor_33334fec96407f54, []int{44}
 }
 func (m *LightInitialState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2374,7 +2429,7 @@ type GetIndex struct {
 func (m *GetIndex) Reset()      { *m = GetIndex{} }
 func (*GetIndex) ProtoMessage() {}
 func (*GetIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{44}
+	return fileDescriptor_33334fec96407f54, []int{45}
 }
 func (m *GetIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2474,7 @@ type UpdateJet struct {
 func (m *UpdateJet) Reset()      { *m = UpdateJet{} }
 func (*UpdateJet) ProtoMessage() {}
 func (*UpdateJet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33334fec96407f54, []int{45}
+	return fileDescriptor_33334fec96407f54, []int{46}
 }
 func (m *UpdateJet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2455,416 +2510,873 @@ func (m *UpdateJet) GetPolymorph() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Meta)(nil), "payload.Meta")
-	proto.RegisterType((*Error)(nil), "payload.Error")
-	proto.RegisterType((*GetObject)(nil), "payload.GetObject")
-	proto.RegisterType((*GetCode)(nil), "payload.GetCode")
-	proto.RegisterType((*PassState)(nil), "payload.PassState")
-	proto.RegisterType((*Pass)(nil), "payload.Pass")
-	proto.RegisterType((*SetCode)(nil), "payload.SetCode")
-	proto.RegisterType((*Index)(nil), "payload.Index")
-	proto.RegisterType((*Code)(nil), "payload.Code")
-	proto.RegisterType((*State)(nil), "payload.State")
-	proto.RegisterType((*ID)(nil), "payload.ID")
-	proto.RegisterType((*IDs)(nil), "payload.IDs")
-	proto.RegisterType((*Jet)(nil), "payload.Jet")
-	proto.RegisterType((*SetIncomingRequest)(nil), "payload.SetIncomingRequest")
-	proto.RegisterType((*SetOutgoingRequest)(nil), "payload.SetOutgoingRequest")
-	proto.RegisterType((*SagaCallAcceptNotification)(nil), "payload.SagaCallAcceptNotification")
-	proto.RegisterType((*SetResult)(nil), "payload.SetResult")
-	proto.RegisterType((*Activate)(nil), "payload.Activate")
-	proto.RegisterType((*Deactivate)(nil), "payload.Deactivate")
-	proto.RegisterType((*Update)(nil), "payload.Update")
-	proto.RegisterType((*GetFilament)(nil), "payload.GetFilament")
-	proto.RegisterType((*FilamentSegment)(nil), "payload.FilamentSegment")
-	proto.RegisterType((*RequestInfo)(nil), "payload.RequestInfo")
-	proto.RegisterType((*GotHotConfirmation)(nil), "payload.GotHotConfirmation")
-	proto.RegisterType((*ResultInfo)(nil), "payload.ResultInfo")
-	proto.RegisterType((*HotObjects)(nil), "payload.HotObjects")
-	proto.RegisterType((*GetRequest)(nil), "payload.GetRequest")
-	proto.RegisterType((*Request)(nil), "payload.Request")
-	proto.RegisterType((*ServiceData)(nil), "payload.ServiceData")
-	proto.RegisterType((*ExecutionQueueElement)(nil), "payload.ExecutionQueueElement")
-	proto.RegisterType((*ReturnResults)(nil), "payload.ReturnResults")
-	proto.RegisterType((*CallMethod)(nil), "payload.CallMethod")
-	proto.RegisterType((*ExecutorResults)(nil), "payload.ExecutorResults")
-	proto.RegisterType((*PendingFinished)(nil), "payload.PendingFinished")
-	proto.RegisterType((*AdditionalCallFromPreviousExecutor)(nil), "payload.AdditionalCallFromPreviousExecutor")
-	proto.RegisterType((*StillExecuting)(nil), "payload.StillExecuting")
-	proto.RegisterType((*GetPendings)(nil), "payload.GetPendings")
-	proto.RegisterType((*HasPendings)(nil), "payload.HasPendings")
-	proto.RegisterType((*PendingsInfo)(nil), "payload.PendingsInfo")
-	proto.RegisterType((*Replication)(nil), "payload.Replication")
-	proto.RegisterType((*GetJet)(nil), "payload.GetJet")
-	proto.RegisterType((*AbandonedRequestsNotification)(nil), "payload.AbandonedRequestsNotification")
-	proto.RegisterType((*GetLightInitialState)(nil), "payload.GetLightInitialState")
-	proto.RegisterType((*LightInitialState)(nil), "payload.LightInitialState")
-	proto.RegisterType((*GetIndex)(nil), "payload.GetIndex")
-	proto.RegisterType((*UpdateJet)(nil), "payload.UpdateJet")
+type GetPulseReplica struct {
+	Polymorph uint32                                         `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	Pulse     github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,20,opt,name=Pulse,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"Pulse"`
 }
 
-func init() { proto.RegisterFile("insolar/payload/payload.proto", fileDescriptor_33334fec96407f54) }
-
-var fileDescriptor_33334fec96407f54 = []byte{
-	// 1711 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x4f, 0x3b, 0xf1, 0xd7, 0x73, 0xb2, 0xd9, 0x69, 0x6c, 0xa7, 0x89, 0x58, 0x4f, 0xd4, 0x02,
-	0x29, 0x12, 0x24, 0x59, 0x26, 0xd1, 0x70, 0x00, 0x34, 0x4a, 0xe2, 0xc4, 0xf1, 0xe2, 0x24, 0xde,
-	0x72, 0x66, 0x59, 0x81, 0x84, 0xd4, 0x69, 0xbf, 0x38, 0x0d, 0xed, 0x2e, 0xd3, 0x5d, 0x0e, 0x93,
-	0x1b, 0x62, 0x2f, 0x88, 0xd3, 0x5e, 0xf7, 0x0f, 0x40, 0xe2, 0x2f, 0x58, 0x0e, 0x1c, 0x90, 0xf6,
-	0xb4, 0x12, 0x42, 0x9a, 0xe3, 0x88, 0xc3, 0x88, 0xc9, 0x5c, 0x38, 0x0e, 0x07, 0x24, 0x0e, 0x20,
-	0xa1, 0xfa, 0x68, 0xbb, 0x1d, 0x06, 0xba, 0xc7, 0xf6, 0x78, 0xe6, 0x92, 0x74, 0x55, 0xbf, 0xf7,
-	0x7b, 0xf5, 0x3e, 0xfb, 0xd5, 0x33, 0xbc, 0xe7, 0x78, 0x01, 0x75, 0x2d, 0x7f, 0xab, 0x67, 0x5d,
-	0xbb, 0xd4, 0x6a, 0x87, 0xff, 0x37, 0x7b, 0x3e, 0x65, 0x54, 0xcf, 0xaa, 0xe5, 0xea, 0x46, 0xc7,
-	0x61, 0x97, 0xfd, 0xf3, 0x4d, 0x9b, 0x76, 0xb7, 0x3a, 0xb4, 0x43, 0xb7, 0xc4, 0xfb, 0xf3, 0xfe,
-	0x85, 0x58, 0x89, 0x85, 0x78, 0x92, 0x7c, 0xab, 0xf7, 0x23, 0xe4, 0xa1, 0x84, 0xdb, 0xff, 0x7d,
-	0xb4, 0xa9, 0xdf, 0x56, 0xff, 0x14, 0xdf, 0x4e, 0x02, 0xbe, 0x5e, 0xdf, 0x0d, 0x50, 0xfe, 0x95,
-	0x5c, 0xe6, 0xdf, 0x53, 0xb0, 0x70, 0x8c, 0xcc, 0xd2, 0xbf, 0x06, 0xf9, 0x26, 0x75, 0xaf, 0xbb,
-	0xd4, 0xef, 0x5d, 0x1a, 0xef, 0xae, 0x69, 0xeb, 0x4b, 0x64, 0xb8, 0xa1, 0x1b, 0x90, 0x6d, 0x4a,
-	0x75, 0x8c, 0xe2, 0x9a, 0xb6, 0xbe, 0x48, 0xc2, 0xa5, 0xde, 0x80, 0x4c, 0x0b, 0xbd, 0x36, 0xfa,
-	0x46, 0x89, 0xbf, 0xd8, 0xdb, 0xf9, 0xf2, 0xe9, 0xdd, 0xb9, 0xbf, 0x3c, 0xbd, 0xfb, 0xad, 0xf8,
-	0xe3, 0x6c, 0x12, 0xbc, 0x40, 0x1f, 0x3d, 0x1b, 0x89, 0xc2, 0xd0, 0x9b, 0x90, 0x23, 0x68, 0xa3,
-	0x73, 0x85, 0xbe, 0x51, 0x9e, 0x00, 0x6f, 0x80, 0xa2, 0x37, 0x20, 0xdd, 0xe4, 0xfa, 0x1a, 0x2b,
-	0x02, 0xee, 0xbe, 0x82, 0xdb, 0x4c, 0x00, 0x27, 0xf8, 0x4e, 0xfa, 0xdd, 0x73, 0xf4, 0x89, 0x04,
-	0xd1, 0xdf, 0x81, 0x54, 0xbd, 0x6a, 0x18, 0xc2, 0x04, 0xa9, 0x7a, 0x55, 0xdf, 0x06, 0x38, 0xf5,
-	0x9d, 0x8e, 0xe3, 0x1d, 0x59, 0xc1, 0xa5, 0xf1, 0x55, 0x21, 0xe2, 0x2b, 0x4a, 0x44, 0xe1, 0x18,
-	0x83, 0xc0, 0xea, 0x20, 0x7f, 0x45, 0x22, 0x64, 0xe6, 0x31, 0xa4, 0x0f, 0x7c, 0x9f, 0xfa, 0x31,
-	0x36, 0xd7, 0x61, 0x61, 0x9f, 0xb6, 0x51, 0x18, 0x7c, 0x89, 0x88, 0x67, 0xbe, 0x77, 0x86, 0x8f,
-	0x98, 0xb0, 0x75, 0x9e, 0x88, 0x67, 0xf3, 0xcf, 0x1a, 0xe4, 0x6b, 0xc8, 0x4e, 0xcf, 0x7f, 0x8a,
-	0x36, 0x8b, 0xc1, 0xac, 0x43, 0x4e, 0xd2, 0xd5, 0xab, 0xd2, 0x91, 0x7b, 0x1b, 0xea, 0xb4, 0xdf,
-	0x48, 0x60, 0x90, 0x7a, 0x95, 0x0c, 0xd8, 0xf5, 0x1f, 0xc2, 0xb2, 0x7c, 0x26, 0xf8, 0xf3, 0x3e,
-	0x06, 0x1c, 0xb1, 0x34, 0x0e, 0xe2, 0x6d, 0x14, 0xd3, 0x83, 0x6c, 0x0d, 0x99, 0x50, 0xf7, 0xff,
-	0x2b, 0x73, 0x00, 0x19, 0x4e, 0x35, 0xae, 0x2a, 0x8a, 0xd9, 0xfc, 0x8d, 0x06, 0xf9, 0xa6, 0x15,
-	0x04, 0x2d, 0x66, 0xb1, 0x38, 0x91, 0x65, 0xc8, 0x48, 0x47, 0xaa, 0x34, 0x50, 0x2b, 0xbd, 0x06,
-	0x59, 0xc1, 0x3e, 0xae, 0x11, 0x42, 0x6e, 0xf3, 0x7b, 0xb0, 0xc0, 0xcf, 0x32, 0xde, 0x31, 0xcc,
-	0x07, 0x90, 0x6d, 0x25, 0x32, 0x5d, 0x19, 0x32, 0x44, 0x14, 0x8f, 0x10, 0x40, 0xae, 0xcc, 0xef,
-	0x42, 0xba, 0xee, 0xb5, 0xf1, 0x51, 0x0c, 0x7b, 0x51, 0x91, 0x29, 0x6e, 0xb9, 0xe0, 0x67, 0x9f,
-	0x40, 0xf4, 0x43, 0x48, 0x27, 0xf4, 0xc0, 0xcb, 0xd8, 0xf9, 0xfe, 0x31, 0x76, 0xa9, 0x7f, 0x2d,
-	0x1d, 0x40, 0xd4, 0xca, 0xb4, 0x78, 0xc6, 0xc6, 0x60, 0x7e, 0x5f, 0x64, 0xf5, 0x58, 0x41, 0x94,
-	0xaa, 0x57, 0xcd, 0x36, 0xcc, 0xd7, 0xab, 0x71, 0x2e, 0x7b, 0x20, 0x88, 0x8c, 0xe2, 0xda, 0xfc,
-	0xab, 0x0b, 0xe1, 0x9c, 0xe6, 0x27, 0x1a, 0xcc, 0x7f, 0x80, 0x71, 0x09, 0x7e, 0x08, 0xe9, 0x0f,
-	0x70, 0x98, 0xdd, 0xef, 0x2b, 0x41, 0xeb, 0x09, 0x04, 0x09, 0x3e, 0x22, 0xd9, 0xb9, 0x39, 0x77,
-	0x6d, 0xd6, 0xb7, 0x5c, 0x61, 0xce, 0x1c, 0x51, 0x2b, 0xd3, 0x06, 0xbd, 0x85, 0xac, 0xee, 0xd9,
-	0xb4, 0xeb, 0x78, 0x1d, 0x95, 0xb4, 0x31, 0x67, 0xda, 0x82, 0xac, 0x22, 0x14, 0xa7, 0x2a, 0xdc,
-	0x5b, 0xde, 0x54, 0x5f, 0xae, 0x8f, 0x1c, 0x9f, 0xa3, 0xee, 0x2d, 0xf0, 0x63, 0x92, 0x90, 0x4a,
-	0x09, 0x39, 0xed, 0xb3, 0x0e, 0x7d, 0x7d, 0x42, 0xfe, 0xa5, 0xc1, 0x6a, 0xcb, 0xea, 0x58, 0xfb,
-	0x96, 0xeb, 0xee, 0xda, 0x36, 0xf6, 0xd8, 0x09, 0x65, 0xce, 0x85, 0x63, 0x5b, 0xcc, 0xa1, 0xde,
-	0xec, 0xea, 0xe8, 0x8f, 0xe1, 0x4e, 0x15, 0x99, 0x65, 0x5f, 0x62, 0x7b, 0xc2, 0x4a, 0xfa, 0xdf,
-	0x38, 0xfc, 0xbb, 0x1d, 0x5a, 0xa5, 0x2c, 0xbf, 0xdb, 0xa1, 0xfa, 0xbb, 0x90, 0x6f, 0x21, 0x23,
-	0x18, 0xf4, 0x5d, 0x96, 0x24, 0xe5, 0x38, 0xdd, 0x30, 0xe5, 0xf8, 0xca, 0xfc, 0x18, 0x72, 0xbb,
-	0x36, 0x73, 0xae, 0x26, 0x4a, 0x5a, 0x85, 0x5c, 0x1a, 0x41, 0xfe, 0x11, 0x40, 0x15, 0xad, 0xd7,
-	0x83, 0xfd, 0x11, 0x64, 0x1e, 0xf6, 0xda, 0xd3, 0xc7, 0xfd, 0x2c, 0x05, 0x85, 0x1a, 0xb2, 0x43,
-	0xc7, 0xb5, 0xba, 0xe8, 0xcd, 0xf0, 0x43, 0xfc, 0x03, 0xc8, 0xb7, 0x98, 0xe5, 0xb3, 0x43, 0x9f,
-	0x76, 0xc7, 0x0b, 0x9c, 0x21, 0xbf, 0x7e, 0x06, 0x79, 0x82, 0x56, 0xfb, 0xa1, 0xc7, 0x1c, 0x57,
-	0x75, 0x60, 0xe3, 0xb6, 0x4c, 0x43, 0x20, 0xf3, 0x0f, 0x1a, 0x2c, 0x87, 0x86, 0x69, 0x61, 0x67,
-	0xb6, 0xf6, 0x79, 0xc0, 0x73, 0x80, 0xbb, 0x2e, 0x30, 0x4a, 0x6b, 0xf3, 0xeb, 0x85, 0x7b, 0x77,
-	0xc3, 0xca, 0xb0, 0x4f, 0xbb, 0x3d, 0x1a, 0x38, 0x0c, 0xc3, 0xb3, 0x49, 0xba, 0x61, 0xa5, 0x10,
-	0x5c, 0xe6, 0x3f, 0x34, 0x28, 0x84, 0x29, 0xe5, 0x5d, 0xd0, 0x99, 0x7a, 0x76, 0xc2, 0x92, 0x90,
-	0x4f, 0x50, 0x0a, 0x22, 0x11, 0xbd, 0x32, 0x12, 0xd1, 0x4f, 0x35, 0xd0, 0x6b, 0x94, 0x1d, 0x51,
-	0xb6, 0x4f, 0xbd, 0x0b, 0xc7, 0xef, 0x26, 0xa9, 0x8c, 0xd3, 0xfa, 0x00, 0x0d, 0xfa, 0xf6, 0xd2,
-	0x34, 0xfa, 0xf6, 0x22, 0xa4, 0x5b, 0x3d, 0xd7, 0x91, 0xaa, 0xe7, 0x88, 0x5c, 0x98, 0x4f, 0x34,
-	0x00, 0xa9, 0xeb, 0x6c, 0xfd, 0x5a, 0xe7, 0xb7, 0x1c, 0x21, 0x76, 0x4c, 0xb7, 0x0e, 0xd8, 0x23,
-	0xbe, 0x2b, 0x8f, 0xf8, 0xee, 0x93, 0x14, 0xc0, 0x11, 0x55, 0x97, 0x82, 0x60, 0xd6, 0x3e, 0x2b,
-	0x4f, 0xc3, 0x67, 0x3a, 0x2c, 0x54, 0x7d, 0xda, 0x53, 0x65, 0x56, 0x3c, 0xeb, 0x1b, 0x90, 0x15,
-	0xbd, 0x26, 0x06, 0xc6, 0x8a, 0xc8, 0xe5, 0xa5, 0x30, 0x97, 0xc5, 0x76, 0x98, 0xb9, 0x8a, 0xc6,
-	0xfc, 0x42, 0x03, 0xa8, 0x21, 0x4b, 0xd6, 0x41, 0xbc, 0xa5, 0x89, 0x6b, 0xfe, 0x56, 0x1b, 0x64,
-	0x6e, 0x8c, 0x06, 0x23, 0x62, 0x8b, 0x13, 0xd6, 0x8b, 0x48, 0x43, 0x55, 0x4a, 0xd4, 0x50, 0x7d,
-	0xa1, 0x41, 0xa1, 0x85, 0xfe, 0x95, 0x63, 0x63, 0xd5, 0x8a, 0x9d, 0x28, 0x54, 0x00, 0x1a, 0xb4,
-	0x73, 0xe6, 0x5b, 0x76, 0x78, 0x81, 0xcb, 0x93, 0xc8, 0x8e, 0x7e, 0x0a, 0xb9, 0x06, 0xed, 0x34,
-	0xf0, 0x0a, 0x65, 0x0b, 0xba, 0xb4, 0xb7, 0xad, 0x54, 0xf9, 0x66, 0x02, 0x55, 0x42, 0x56, 0x32,
-	0x00, 0xd1, 0xbf, 0x0e, 0x4b, 0x02, 0xbb, 0xd5, 0xb3, 0x3c, 0x7e, 0x3e, 0x95, 0x30, 0xa3, 0x9b,
-	0xe6, 0x3f, 0x35, 0x28, 0x1d, 0x3c, 0x42, 0xbb, 0xcf, 0x4b, 0xdd, 0x87, 0x7d, 0xec, 0xe3, 0x81,
-	0x8b, 0x09, 0xbe, 0x57, 0x67, 0xbc, 0x92, 0x08, 0x3b, 0x10, 0xbc, 0x50, 0xb6, 0x1f, 0x6f, 0x74,
-	0x11, 0xc1, 0xd1, 0xb7, 0x21, 0x17, 0xb6, 0xda, 0xca, 0x09, 0x2b, 0xc3, 0x78, 0x1f, 0x69, 0xc1,
-	0xc9, 0x80, 0x50, 0xbf, 0x3f, 0xe2, 0x06, 0xa1, 0x66, 0xe1, 0x5e, 0x71, 0x33, 0x9c, 0x4e, 0x45,
-	0xde, 0x91, 0x28, 0xa1, 0xf9, 0x6f, 0x0d, 0x96, 0x08, 0xb2, 0xbe, 0xef, 0xc9, 0x1a, 0x12, 0x57,
-	0x35, 0x1a, 0x90, 0x39, 0xb3, 0xfc, 0x0e, 0xb2, 0x89, 0xd4, 0x55, 0x18, 0xb7, 0x0c, 0x58, 0x9a,
-	0x92, 0x01, 0x8b, 0x90, 0x26, 0xd8, 0x73, 0xaf, 0x95, 0xb3, 0xe5, 0x82, 0xef, 0x8a, 0x01, 0x8c,
-	0xf8, 0xde, 0xe5, 0x89, 0x5c, 0x98, 0xbf, 0xd7, 0x00, 0xf8, 0x65, 0xe0, 0x18, 0xd9, 0x25, 0x6d,
-	0xc7, 0x28, 0xff, 0xed, 0xdb, 0xd7, 0x8d, 0xff, 0xe9, 0x98, 0x41, 0xee, 0x7e, 0x0c, 0x85, 0x48,
-	0x95, 0x53, 0x41, 0x3d, 0x6e, 0x8d, 0x8c, 0x42, 0x99, 0x9f, 0xce, 0xc3, 0xb2, 0x0c, 0x5a, 0xea,
-	0x27, 0xf6, 0x1d, 0x57, 0x15, 0xfd, 0xc9, 0x7c, 0x27, 0x31, 0x74, 0xc2, 0xeb, 0x0e, 0x57, 0x7e,
-	0x52, 0xd7, 0x0d, 0x61, 0xf4, 0x1d, 0x48, 0x8b, 0xf4, 0x33, 0xca, 0xa2, 0xce, 0x57, 0x06, 0xf1,
-	0xfb, 0xd2, 0xec, 0x24, 0x92, 0x58, 0xdf, 0x81, 0x52, 0x03, 0xdb, 0x1d, 0xf4, 0x8f, 0xac, 0xe0,
-	0x98, 0xfa, 0xa8, 0x6c, 0x1f, 0x08, 0x4f, 0xe7, 0xc8, 0xcb, 0x5f, 0xea, 0x1f, 0x42, 0xb6, 0x89,
-	0x5e, 0x9b, 0x67, 0x99, 0xb1, 0xa6, 0xad, 0xa7, 0xf7, 0xbe, 0xa3, 0x4e, 0xbf, 0x95, 0xc4, 0x2b,
-	0x92, 0x53, 0xcc, 0x2e, 0x48, 0x88, 0xc3, 0x6f, 0xeb, 0xcb, 0xea, 0xf9, 0xd0, 0xf1, 0x9c, 0xe0,
-	0x12, 0xe3, 0x22, 0x8a, 0x40, 0x3e, 0x9c, 0x84, 0x4d, 0x56, 0x40, 0x86, 0x30, 0xe6, 0xe7, 0xf3,
-	0x60, 0xee, 0xb6, 0xdb, 0x0e, 0x37, 0x97, 0xe5, 0x72, 0x6f, 0xf1, 0x26, 0xbf, 0xe9, 0xe3, 0x95,
-	0x43, 0xfb, 0x41, 0x18, 0x32, 0x31, 0x07, 0xfb, 0xc9, 0x70, 0xd0, 0xa7, 0x44, 0x4c, 0x74, 0xbc,
-	0xdb, 0x60, 0x51, 0xeb, 0x97, 0xa6, 0x63, 0xfd, 0x5b, 0xc5, 0xa4, 0x3c, 0xa5, 0x62, 0x12, 0xc9,
-	0xf9, 0x95, 0x84, 0x39, 0x7f, 0xab, 0x16, 0x1b, 0x49, 0x6b, 0xf1, 0xaf, 0x34, 0x78, 0xa7, 0xc5,
-	0x1c, 0xd7, 0x55, 0xd1, 0xee, 0x75, 0xde, 0x40, 0xf4, 0x5c, 0x89, 0x0b, 0xad, 0xb2, 0x69, 0x30,
-	0xb3, 0xee, 0x89, 0xcb, 0x3d, 0xb2, 0x82, 0xd9, 0xcb, 0x3d, 0x81, 0xc5, 0x50, 0x68, 0x82, 0xfb,
-	0xc0, 0xda, 0xc8, 0x29, 0x85, 0xec, 0x1c, 0x89, 0x6e, 0x99, 0x9f, 0xa7, 0xf8, 0xbd, 0xb1, 0xe7,
-	0x26, 0x1b, 0x29, 0xbd, 0x9d, 0x17, 0xa7, 0x48, 0xc3, 0x5d, 0x8e, 0x6f, 0xb8, 0xf5, 0xf7, 0x87,
-	0x77, 0x6d, 0xd9, 0x9f, 0xbf, 0x1b, 0x92, 0x1f, 0x5b, 0x0c, 0x7d, 0x27, 0xda, 0x35, 0x0a, 0xb2,
-	0x41, 0x97, 0x6f, 0x0c, 0xbb, 0x7c, 0xf3, 0x4f, 0x1a, 0x64, 0x6a, 0xc8, 0xe2, 0xa7, 0x9d, 0x53,
-	0x6c, 0xd9, 0x5f, 0xdf, 0xd7, 0xf9, 0xd7, 0x1a, 0xbc, 0xb7, 0x7b, 0x6e, 0x79, 0x6d, 0xea, 0x0d,
-	0x46, 0x73, 0xc1, 0x1b, 0x99, 0x35, 0xf2, 0xb2, 0x52, 0xac, 0x21, 0x6b, 0x38, 0x9d, 0x4b, 0x56,
-	0xf7, 0x1c, 0xe6, 0x58, 0x6e, 0x92, 0x99, 0xfb, 0x54, 0x43, 0xca, 0xfc, 0x2c, 0x05, 0x77, 0x5e,
-	0xf5, 0x04, 0x26, 0x2c, 0x9e, 0x20, 0xfb, 0x05, 0xf5, 0x7f, 0x26, 0x46, 0x55, 0x2a, 0xdb, 0x46,
-	0xf6, 0xf4, 0x23, 0xc8, 0x88, 0x0c, 0x90, 0x63, 0x9e, 0x71, 0x32, 0x48, 0xf1, 0xeb, 0xab, 0x90,
-	0xe6, 0x71, 0x28, 0x43, 0x7e, 0x51, 0x45, 0xac, 0xdc, 0x52, 0x09, 0xe1, 0xd8, 0xf1, 0x37, 0x50,
-	0x4e, 0xa3, 0x6f, 0x84, 0xa6, 0x93, 0xa5, 0xff, 0xce, 0xa6, 0xfc, 0xf1, 0x55, 0xec, 0x35, 0x7d,
-	0xca, 0x68, 0x88, 0x2e, 0x6d, 0x13, 0x40, 0xae, 0x86, 0x2c, 0xc9, 0x4f, 0x30, 0x53, 0x8c, 0x8a,
-	0x3f, 0x6a, 0x90, 0x97, 0x23, 0xd1, 0xf8, 0x8c, 0x1b, 0x84, 0x42, 0x71, 0x1a, 0xd5, 0x65, 0x50,
-	0xf3, 0x4a, 0x13, 0xd5, 0xbc, 0xbd, 0x9d, 0xc7, 0xcf, 0x2a, 0x73, 0x4f, 0x9e, 0x55, 0xe6, 0x5e,
-	0x3c, 0xab, 0x68, 0xbf, 0xbc, 0xa9, 0x68, 0xbf, 0xbb, 0xa9, 0x68, 0x5f, 0xde, 0x54, 0xb4, 0xc7,
-	0x37, 0x15, 0xed, 0xaf, 0x37, 0x15, 0xed, 0x6f, 0x37, 0x95, 0xb9, 0x17, 0x37, 0x15, 0xed, 0xd3,
-	0xe7, 0x95, 0xb9, 0xc7, 0xcf, 0x2b, 0x73, 0x4f, 0x9e, 0x57, 0xe6, 0xce, 0x33, 0xe2, 0x27, 0xf0,
-	0xed, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x42, 0xa1, 0xad, 0x12, 0xc9, 0x1f, 0x00, 0x00,
+func (m *GetPulseReplica) Reset()      { *m = GetPulseReplica{} }
+func (*GetPulseReplica) ProtoMessage() {}
+func (*GetPulseReplica) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{47}
+}
+func (m *GetPulseReplica) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPulseReplica) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPulseReplica.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPulseReplica) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPulseReplica.Merge(m, src)
+}
+func (m *GetPulseReplica) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPulseReplica) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPulseReplica.DiscardUnknown(m)
 }
 
-func (this *Meta) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_GetPulseReplica proto.InternalMessageInfo
+
+func (m *GetPulseReplica) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
 	}
+	return 0
+}
 
-	that1, ok := that.(*Meta)
-	if !ok {
-		that2, ok := that.(Meta)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type PulseReplica struct {
+	Polymorph uint32                                         `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	Pulse     github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,20,opt,name=Pulse,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"Pulse"`
+	Drops     [][]byte                                       `protobuf:"bytes,21,rep,name=Drops,proto3" json:"Drops,omitempty"`
+	Indexes   []record.Index                                 `protobuf:"bytes,22,rep,name=Indexes,proto3" json:"Indexes"`
+	Records   []record.Material                              `protobuf:"bytes,23,rep,name=Records,proto3" json:"Records"`
+}
+
+func (m *PulseReplica) Reset()      { *m = PulseReplica{} }
+func (*PulseReplica) ProtoMessage() {}
+func (*PulseReplica) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{48}
+}
+func (m *PulseReplica) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PulseReplica) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PulseReplica.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Polymorph != that1.Polymorph {
-		return false
-	}
-	if !bytes.Equal(this.Payload, that1.Payload) {
-		return false
-	}
-	if !this.Sender.Equal(that1.Sender) {
-		return false
-	}
-	if !this.Receiver.Equal(that1.Receiver) {
-		return false
-	}
-	if !this.Pulse.Equal(that1.Pulse) {
-		return false
+}
+func (m *PulseReplica) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PulseReplica.Merge(m, src)
+}
+func (m *PulseReplica) XXX_Size() int {
+	return m.Size()
+}
+func (m *PulseReplica) XXX_DiscardUnknown() {
+	xxx_messageInfo_PulseReplica.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PulseReplica proto.InternalMessageInfo
+
+func (m *PulseReplica) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
 	}
-	if !bytes.Equal(this.ID, that1.ID) {
-		return false
+	return 0
+}
+
+func (m *PulseReplica) GetDrops() [][]byte {
+	if m != nil {
+		return m.Drops
 	}
-	if !this.OriginHash.Equal(that1.OriginHash) {
-		return false
+	return nil
+}
+
+func (m *PulseReplica) GetIndexes() []record.Index {
+	if m != nil {
+		return m.Indexes
 	}
-	return true
+	return nil
 }
-func (this *Error) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PulseReplica) GetRecords() []record.Material {
+	if m != nil {
+		return m.Records
 	}
+	return nil
+}
 
-	that1, ok := that.(*Error)
-	if !ok {
-		that2, ok := that.(Error)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type Validate struct {
+	Polymorph   uint32                                         `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	Object      github_com_insolar_insolar_insolar.Reference   `protobuf:"bytes,20,opt,name=Object,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Object"`
+	Pulse       github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,21,opt,name=Pulse,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"Pulse"`
+	Transcripts []ValidationTranscript                         `protobuf:"bytes,22,rep,name=Transcripts,proto3" json:"Transcripts"`
+}
+
+func (m *Validate) Reset()      { *m = Validate{} }
+func (*Validate) ProtoMessage() {}
+func (*Validate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{49}
+}
+func (m *Validate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Validate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Validate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Polymorph != that1.Polymorph {
-		return false
-	}
-	if this.Code != that1.Code {
-		return false
-	}
-	if this.Text != that1.Text {
-		return false
-	}
-	return true
 }
-func (this *GetObject) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *Validate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Validate.Merge(m, src)
+}
+func (m *Validate) XXX_Size() int {
+	return m.Size()
+}
+func (m *Validate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Validate.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*GetObject)
-	if !ok {
-		that2, ok := that.(GetObject)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Polymorph != that1.Polymorph {
-		return false
-	}
-	if !this.ObjectID.Equal(that1.ObjectID) {
-		return false
-	}
-	if !this.ObjectRequestID.Equal(that1.ObjectRequestID) {
-		return false
+var xxx_messageInfo_Validate proto.InternalMessageInfo
+
+func (m *Validate) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
 	}
-	return true
+	return 0
 }
-func (this *GetCode) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Validate) GetTranscripts() []ValidationTranscript {
+	if m != nil {
+		return m.Transcripts
 	}
+	return nil
+}
 
-	that1, ok := that.(*GetCode)
-	if !ok {
-		that2, ok := that.(GetCode)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ValidationTranscript struct {
+	Request   github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,20,opt,name=Request,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Request"`
+	Outgoings []ValidationOutgoing                         `protobuf:"bytes,22,rep,name=Outgoings,proto3" json:"Outgoings"`
+}
+
+func (m *ValidationTranscript) Reset()      { *m = ValidationTranscript{} }
+func (*ValidationTranscript) ProtoMessage() {}
+func (*ValidationTranscript) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{50}
+}
+func (m *ValidationTranscript) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidationTranscript) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidationTranscript.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Polymorph != that1.Polymorph {
-		return false
-	}
-	if !this.CodeID.Equal(that1.CodeID) {
-		return false
-	}
-	return true
 }
-func (this *PassState) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ValidationTranscript) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationTranscript.Merge(m, src)
+}
+func (m *ValidationTranscript) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidationTranscript) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationTranscript.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidationTranscript proto.InternalMessageInfo
+
+func (m *ValidationTranscript) GetOutgoings() []ValidationOutgoing {
+	if m != nil {
+		return m.Outgoings
 	}
+	return nil
+}
 
-	that1, ok := that.(*PassState)
-	if !ok {
-		that2, ok := that.(PassState)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ValidationOutgoing struct {
+	Request   record.IncomingRequest                        `protobuf:"bytes,20,opt,name=Request,proto3" json:"Request"`
+	Response  []byte                                        `protobuf:"bytes,21,opt,name=Response,proto3" json:"Response,omitempty"`
+	NewObject *github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,22,opt,name=NewObject,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"NewObject,omitempty"`
+	Error     string                                        `protobuf:"bytes,23,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *ValidationOutgoing) Reset()      { *m = ValidationOutgoing{} }
+func (*ValidationOutgoing) ProtoMessage() {}
+func (*ValidationOutgoing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{51}
+}
+func (m *ValidationOutgoing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidationOutgoing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidationOutgoing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Polymorph != that1.Polymorph {
-		return false
-	}
-	if !bytes.Equal(this.Origin, that1.Origin) {
-		return false
+}
+func (m *ValidationOutgoing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationOutgoing.Merge(m, src)
+}
+func (m *ValidationOutgoing) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidationOutgoing) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationOutgoing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidationOutgoing proto.InternalMessageInfo
+
+func (m *ValidationOutgoing) GetRequest() record.IncomingRequest {
+	if m != nil {
+		return m.Request
 	}
-	if !this.StateID.Equal(that1.StateID) {
-		return false
+	return record.IncomingRequest{}
+}
+
+func (m *ValidationOutgoing) GetResponse() []byte {
+	if m != nil {
+		return m.Response
 	}
-	return true
+	return nil
 }
-func (this *Pass) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ValidationOutgoing) GetError() string {
+	if m != nil {
+		return m.Error
 	}
+	return ""
+}
 
-	that1, ok := that.(*Pass)
-	if !ok {
-		that2, ok := that.(Pass)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ValidationMismatch struct {
+	Polymorph uint32                                         `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	Object    github_com_insolar_insolar_insolar.Reference   `protobuf:"bytes,20,opt,name=Object,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Object"`
+	Request   github_com_insolar_insolar_insolar.Reference   `protobuf:"bytes,21,opt,name=Request,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Request"`
+	Pulse     github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,22,opt,name=Pulse,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"Pulse"`
+	Executor  github_com_insolar_insolar_insolar.Reference   `protobuf:"bytes,23,opt,name=Executor,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Executor"`
+	Expected  []byte                                         `protobuf:"bytes,24,opt,name=Expected,proto3" json:"Expected,omitempty"`
+	Actual    []byte                                         `protobuf:"bytes,25,opt,name=Actual,proto3" json:"Actual,omitempty"`
+	Reason    string                                         `protobuf:"bytes,26,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Validator github_com_insolar_insolar_insolar.Reference   `protobuf:"bytes,27,opt,name=Validator,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Validator"`
+}
+
+func (m *ValidationMismatch) Reset()      { *m = ValidationMismatch{} }
+func (*ValidationMismatch) ProtoMessage() {}
+func (*ValidationMismatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{52}
+}
+func (m *ValidationMismatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidationMismatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidationMismatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ValidationMismatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationMismatch.Merge(m, src)
+}
+func (m *ValidationMismatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidationMismatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationMismatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidationMismatch proto.InternalMessageInfo
+
+func (m *ValidationMismatch) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
 	}
-	if this.Polymorph != that1.Polymorph {
-		return false
+	return 0
+}
+
+func (m *ValidationMismatch) GetExpected() []byte {
+	if m != nil {
+		return m.Expected
 	}
-	if !bytes.Equal(this.Origin, that1.Origin) {
-		return false
+	return nil
+}
+
+func (m *ValidationMismatch) GetActual() []byte {
+	if m != nil {
+		return m.Actual
 	}
-	return true
+	return nil
 }
-func (this *SetCode) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ValidationMismatch) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
+	return ""
+}
 
-	that1, ok := that.(*SetCode)
-	if !ok {
-		that2, ok := that.(SetCode)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type Chunk struct {
+	Polymorph uint32 `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	StreamID  []byte `protobuf:"bytes,20,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	TotalSize uint64 `protobuf:"varint,21,opt,name=TotalSize,proto3" json:"TotalSize,omitempty"`
+	Offset    uint64 `protobuf:"varint,22,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Data      []byte `protobuf:"bytes,23,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (m *Chunk) Reset()      { *m = Chunk{} }
+func (*Chunk) ProtoMessage() {}
+func (*Chunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{53}
+}
+func (m *Chunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Chunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Chunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Polymorph != that1.Polymorph {
-		return false
+}
+func (m *Chunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Chunk.Merge(m, src)
+}
+func (m *Chunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *Chunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_Chunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Chunk proto.InternalMessageInfo
+
+func (m *Chunk) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
 	}
-	if !bytes.Equal(this.Record, that1.Record) {
-		return false
+	return 0
+}
+
+func (m *Chunk) GetStreamID() []byte {
+	if m != nil {
+		return m.StreamID
 	}
-	return true
+	return nil
 }
-func (this *Index) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *Chunk) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
 	}
+	return 0
+}
 
-	that1, ok := that.(*Index)
-	if !ok {
-		that2, ok := that.(Index)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *Chunk) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *Chunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ChunkAck struct {
+	Polymorph uint32 `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	StreamID  []byte `protobuf:"bytes,20,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	Offset    uint64 `protobuf:"varint,21,opt,name=Offset,proto3" json:"Offset,omitempty"`
+}
+
+func (m *ChunkAck) Reset()      { *m = ChunkAck{} }
+func (*ChunkAck) ProtoMessage() {}
+func (*ChunkAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{54}
+}
+func (m *ChunkAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChunkAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChunkAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ChunkAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChunkAck.Merge(m, src)
+}
+func (m *ChunkAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChunkAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChunkAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChunkAck proto.InternalMessageInfo
+
+func (m *ChunkAck) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
 	}
-	if this.Polymorph != that1.Polymorph {
-		return false
+	return 0
+}
+
+func (m *ChunkAck) GetStreamID() []byte {
+	if m != nil {
+		return m.StreamID
 	}
-	if !bytes.Equal(this.Index, that1.Index) {
-		return false
+	return nil
+}
+
+func (m *ChunkAck) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
 	}
-	return true
+	return 0
 }
-func (this *Code) Equal(that interface{}) bool {
+
+type SagaState struct {
+	Polymorph uint32                                       `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	Request   github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,20,opt,name=Request,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Request"`
+	Rollback  github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,21,opt,name=Rollback,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Rollback"`
+	Method    string                                       `protobuf:"bytes,22,opt,name=Method,proto3" json:"Method,omitempty"`
+	Status    int32                                        `protobuf:"varint,23,opt,name=Status,proto3" json:"Status,omitempty"`
+	Attempts  int32                                        `protobuf:"varint,24,opt,name=Attempts,proto3" json:"Attempts,omitempty"`
+	RetryIn   int32                                        `protobuf:"varint,25,opt,name=RetryIn,proto3" json:"RetryIn,omitempty"`
+	LastError string                                       `protobuf:"bytes,26,opt,name=LastError,proto3" json:"LastError,omitempty"`
+}
+
+func (m *SagaState) Reset()      { *m = SagaState{} }
+func (*SagaState) ProtoMessage() {}
+func (*SagaState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{55}
+}
+func (m *SagaState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SagaState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SagaState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SagaState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SagaState.Merge(m, src)
+}
+func (m *SagaState) XXX_Size() int {
+	return m.Size()
+}
+func (m *SagaState) XXX_DiscardUnknown() {
+	xxx_messageInfo_SagaState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SagaState proto.InternalMessageInfo
+
+func (m *SagaState) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
+func (m *SagaState) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *SagaState) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *SagaState) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *SagaState) GetRetryIn() int32 {
+	if m != nil {
+		return m.RetryIn
+	}
+	return 0
+}
+
+func (m *SagaState) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type SagaStates struct {
+	Polymorph uint32                                       `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	Object    github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,20,opt,name=Object,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Object"`
+	Sagas     []SagaState                                  `protobuf:"bytes,21,rep,name=Sagas,proto3" json:"Sagas"`
+}
+
+func (m *SagaStates) Reset()      { *m = SagaStates{} }
+func (*SagaStates) ProtoMessage() {}
+func (*SagaStates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{56}
+}
+func (m *SagaStates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SagaStates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SagaStates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SagaStates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SagaStates.Merge(m, src)
+}
+func (m *SagaStates) XXX_Size() int {
+	return m.Size()
+}
+func (m *SagaStates) XXX_DiscardUnknown() {
+	xxx_messageInfo_SagaStates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SagaStates proto.InternalMessageInfo
+
+func (m *SagaStates) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
+func (m *SagaStates) GetSagas() []SagaState {
+	if m != nil {
+		return m.Sagas
+	}
+	return nil
+}
+
+type GetSagaStates struct {
+	Polymorph uint32                                       `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	Object    github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,20,opt,name=Object,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Object"`
+}
+
+func (m *GetSagaStates) Reset()      { *m = GetSagaStates{} }
+func (*GetSagaStates) ProtoMessage() {}
+func (*GetSagaStates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33334fec96407f54, []int{57}
+}
+func (m *GetSagaStates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSagaStates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSagaStates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSagaStates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSagaStates.Merge(m, src)
+}
+func (m *GetSagaStates) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSagaStates) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSagaStates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSagaStates proto.InternalMessageInfo
+
+func (m *GetSagaStates) GetPolymorph() uint32 {
+	if m != nil {
+		return m.Polymorph
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Meta)(nil), "payload.Meta")
+	proto.RegisterType((*Error)(nil), "payload.Error")
+	proto.RegisterType((*GetObject)(nil), "payload.GetObject")
+	proto.RegisterType((*GetCode)(nil), "payload.GetCode")
+	proto.RegisterType((*PassState)(nil), "payload.PassState")
+	proto.RegisterType((*Pass)(nil), "payload.Pass")
+	proto.RegisterType((*SetCode)(nil), "payload.SetCode")
+	proto.RegisterType((*Index)(nil), "payload.Index")
+	proto.RegisterType((*Code)(nil), "payload.Code")
+	proto.RegisterType((*State)(nil), "payload.State")
+	proto.RegisterType((*ID)(nil), "payload.ID")
+	proto.RegisterType((*IDs)(nil), "payload.IDs")
+	proto.RegisterType((*Jet)(nil), "payload.Jet")
+	proto.RegisterType((*SetIncomingRequest)(nil), "payload.SetIncomingRequest")
+	proto.RegisterType((*SetOutgoingRequest)(nil), "payload.SetOutgoingRequest")
+	proto.RegisterType((*SagaCallAcceptNotification)(nil), "payload.SagaCallAcceptNotification")
+	proto.RegisterType((*SetResult)(nil), "payload.SetResult")
+	proto.RegisterType((*Activate)(nil), "payload.Activate")
+	proto.RegisterType((*Deactivate)(nil), "payload.Deactivate")
+	proto.RegisterType((*Update)(nil), "payload.Update")
+	proto.RegisterType((*GetFilament)(nil), "payload.GetFilament")
+	proto.RegisterType((*FilamentSegment)(nil), "payload.FilamentSegment")
+	proto.RegisterType((*RequestInfo)(nil), "payload.RequestInfo")
+	proto.RegisterType((*GetResult)(nil), "payload.GetResult")
+	proto.RegisterType((*GotHotConfirmation)(nil), "payload.GotHotConfirmation")
+	proto.RegisterType((*ResultInfo)(nil), "payload.ResultInfo")
+	proto.RegisterType((*HotObjects)(nil), "payload.HotObjects")
+	proto.RegisterType((*GetRequest)(nil), "payload.GetRequest")
+	proto.RegisterType((*Request)(nil), "payload.Request")
+	proto.RegisterType((*ServiceData)(nil), "payload.ServiceData")
+	proto.RegisterType((*ExecutionQueueElement)(nil), "payload.ExecutionQueueElement")
+	proto.RegisterType((*ReturnResults)(nil), "payload.ReturnResults")
+	proto.RegisterType((*CallMethod)(nil), "payload.CallMethod")
+	proto.RegisterType((*ExecutorResults)(nil), "payload.ExecutorResults")
+	proto.RegisterType((*PendingFinished)(nil), "payload.PendingFinished")
+	proto.RegisterType((*AdditionalCallFromPreviousExecutor)(nil), "payload.AdditionalCallFromPreviousExecutor")
+	proto.RegisterType((*StillExecuting)(nil), "payload.StillExecuting")
+	proto.RegisterType((*GetPendings)(nil), "payload.GetPendings")
+	proto.RegisterType((*HasPendings)(nil), "payload.HasPendings")
+	proto.RegisterType((*PendingsInfo)(nil), "payload.PendingsInfo")
+	proto.RegisterType((*Replication)(nil), "payload.Replication")
+	proto.RegisterType((*GetJet)(nil), "payload.GetJet")
+	proto.RegisterType((*AbandonedRequestsNotification)(nil), "payload.AbandonedRequestsNotification")
+	proto.RegisterType((*GetLightInitialState)(nil), "payload.GetLightInitialState")
+	proto.RegisterType((*LightInitialState)(nil), "payload.LightInitialState")
+	proto.RegisterType((*GetIndex)(nil), "payload.GetIndex")
+	proto.RegisterType((*UpdateJet)(nil), "payload.UpdateJet")
+	proto.RegisterType((*GetPulseReplica)(nil), "payload.GetPulseReplica")
+	proto.RegisterType((*PulseReplica)(nil), "payload.PulseReplica")
+	proto.RegisterType((*Validate)(nil), "payload.Validate")
+	proto.RegisterType((*ValidationTranscript)(nil), "payload.ValidationTranscript")
+	proto.RegisterType((*ValidationOutgoing)(nil), "payload.ValidationOutgoing")
+	proto.RegisterType((*ValidationMismatch)(nil), "payload.ValidationMismatch")
+	proto.RegisterType((*Chunk)(nil), "payload.Chunk")
+	proto.RegisterType((*ChunkAck)(nil), "payload.ChunkAck")
+	proto.RegisterType((*SagaState)(nil), "payload.SagaState")
+	proto.RegisterType((*SagaStates)(nil), "payload.SagaStates")
+	proto.RegisterType((*GetSagaStates)(nil), "payload.GetSagaStates")
+}
+
+func init() { proto.RegisterFile("insolar/payload/payload.proto", fileDescriptor_33334fec96407f54) }
+
+var fileDescriptor_33334fec96407f54 = []byte{
+	// 2164 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x1a, 0xcf, 0x6f, 0x1b, 0x59,
+	0x39, 0xe3, 0xc4, 0xbf, 0xbe, 0x34, 0x9b, 0xed, 0x60, 0x3b, 0x43, 0x96, 0xba, 0xd5, 0x08, 0xa4,
+	0x4a, 0xd0, 0x64, 0x69, 0xa3, 0xee, 0x01, 0x50, 0x95, 0xc4, 0x69, 0xe2, 0x25, 0x49, 0xb3, 0xcf,
+	0x69, 0x59, 0x01, 0x42, 0x7a, 0x19, 0xbf, 0x38, 0x43, 0xc7, 0xf3, 0xcc, 0xcc, 0x73, 0xb6, 0x01,
+	0x21, 0x21, 0xf6, 0x82, 0xd8, 0x4b, 0x4f, 0x48, 0xfc, 0x01, 0x88, 0xfd, 0x0b, 0xe0, 0xc0, 0x01,
+	0xb1, 0xa7, 0x95, 0x38, 0x50, 0x89, 0x4b, 0xc5, 0xa1, 0xa2, 0xe9, 0x85, 0xe3, 0xee, 0x01, 0x89,
+	0x03, 0x48, 0xe8, 0xfd, 0x1a, 0x8f, 0xb3, 0xd9, 0x9d, 0xa9, 0xc7, 0x75, 0x7b, 0x49, 0xfc, 0x3d,
+	0x7f, 0xbf, 0xde, 0xf7, 0x6b, 0xbe, 0xef, 0x1b, 0xc3, 0x25, 0xd7, 0x0f, 0xa9, 0x87, 0x83, 0xe5,
+	0x1e, 0x3e, 0xf1, 0x28, 0x6e, 0xeb, 0xff, 0x4b, 0xbd, 0x80, 0x32, 0x6a, 0x16, 0x15, 0xb8, 0x78,
+	0xad, 0xe3, 0xb2, 0xa3, 0xfe, 0xc1, 0x92, 0x43, 0xbb, 0xcb, 0x1d, 0xda, 0xa1, 0xcb, 0xe2, 0xfb,
+	0x83, 0xfe, 0xa1, 0x80, 0x04, 0x20, 0x3e, 0x49, 0xba, 0xc5, 0x9b, 0x31, 0x74, 0x2d, 0xe1, 0xec,
+	0xff, 0x80, 0x38, 0x34, 0x68, 0xab, 0x7f, 0x8a, 0x6e, 0x25, 0x05, 0x5d, 0xaf, 0xef, 0x85, 0x44,
+	0xfe, 0x95, 0x54, 0xf6, 0xa7, 0x39, 0x98, 0xd9, 0x21, 0x0c, 0x9b, 0x5f, 0x81, 0xf2, 0x1e, 0xf5,
+	0x4e, 0xba, 0x34, 0xe8, 0x1d, 0x59, 0xaf, 0x5f, 0x31, 0xae, 0xce, 0xa1, 0xc1, 0x81, 0x69, 0x41,
+	0x71, 0x4f, 0x5e, 0xc7, 0xaa, 0x5c, 0x31, 0xae, 0x5e, 0x40, 0x1a, 0x34, 0xb7, 0xa1, 0xd0, 0x22,
+	0x7e, 0x9b, 0x04, 0x56, 0x95, 0x7f, 0xb1, 0xb6, 0xf2, 0xf1, 0x93, 0xcb, 0x53, 0xff, 0x78, 0x72,
+	0xf9, 0x1b, 0xc9, 0xea, 0x2c, 0x21, 0x72, 0x48, 0x02, 0xe2, 0x3b, 0x04, 0x29, 0x1e, 0xe6, 0x1e,
+	0x94, 0x10, 0x71, 0x88, 0x7b, 0x4c, 0x02, 0xab, 0x96, 0x81, 0x5f, 0xc4, 0xc5, 0xdc, 0x86, 0xfc,
+	0x1e, 0xbf, 0xaf, 0xb5, 0x20, 0xd8, 0xdd, 0x54, 0xec, 0x96, 0x52, 0xb0, 0x13, 0x74, 0xbb, 0xfd,
+	0xee, 0x01, 0x09, 0x90, 0x64, 0x62, 0xbe, 0x06, 0xb9, 0x66, 0xc3, 0xb2, 0x84, 0x09, 0x72, 0xcd,
+	0x86, 0x79, 0x03, 0xe0, 0x4e, 0xe0, 0x76, 0x5c, 0x7f, 0x0b, 0x87, 0x47, 0xd6, 0x97, 0x85, 0x88,
+	0x2f, 0x29, 0x11, 0xb3, 0x3b, 0x24, 0x0c, 0x71, 0x87, 0xf0, 0xaf, 0x50, 0x0c, 0xcd, 0xde, 0x81,
+	0xfc, 0x46, 0x10, 0xd0, 0x20, 0xc1, 0xe6, 0x26, 0xcc, 0xac, 0xd3, 0x36, 0x11, 0x06, 0x9f, 0x43,
+	0xe2, 0x33, 0x3f, 0xdb, 0x27, 0x0f, 0x98, 0xb0, 0x75, 0x19, 0x89, 0xcf, 0xf6, 0x6f, 0x72, 0x50,
+	0xde, 0x24, 0xec, 0xce, 0xc1, 0x8f, 0x89, 0xc3, 0x12, 0x78, 0x36, 0xa1, 0x24, 0xf1, 0x9a, 0x0d,
+	0xe9, 0xc8, 0xb5, 0x6b, 0x4a, 0xdb, 0xaf, 0xa5, 0x30, 0x48, 0xb3, 0x81, 0x22, 0x72, 0xf3, 0x7b,
+	0x30, 0x2f, 0x3f, 0x23, 0xf2, 0x93, 0x3e, 0x09, 0x39, 0xc7, 0xea, 0x28, 0x1c, 0xcf, 0x72, 0x31,
+	0x37, 0xa1, 0xd8, 0x62, 0x98, 0x91, 0x66, 0xc3, 0xaa, 0x8d, 0xc2, 0x50, 0x53, 0xdb, 0x3e, 0x14,
+	0x37, 0x09, 0x13, 0x76, 0xfb, 0x62, 0xab, 0x6c, 0x40, 0x81, 0x63, 0x8d, 0x6a, 0x13, 0x45, 0x6c,
+	0xff, 0xda, 0x80, 0xf2, 0x1e, 0x0e, 0x43, 0x21, 0x3f, 0x41, 0x64, 0x0d, 0x0a, 0x32, 0x22, 0x54,
+	0x3e, 0x29, 0x28, 0x7e, 0xf9, 0x6a, 0xa6, 0xcb, 0x7f, 0x1b, 0x66, 0xb8, 0x2e, 0xa3, 0xa9, 0x61,
+	0xdf, 0x82, 0x62, 0x2b, 0x95, 0xe9, 0x6a, 0x50, 0x40, 0xa2, 0x0a, 0x69, 0x06, 0x12, 0xb2, 0xbf,
+	0x05, 0xf9, 0xa6, 0xdf, 0x26, 0x0f, 0x12, 0xc8, 0x2b, 0x0a, 0x4d, 0x51, 0x4b, 0x80, 0xeb, 0x9e,
+	0x41, 0xf4, 0x5d, 0xc8, 0xa7, 0xf4, 0xc0, 0x79, 0xe4, 0xfc, 0x7c, 0x87, 0x74, 0x69, 0x70, 0x22,
+	0x1d, 0x80, 0x14, 0x64, 0x63, 0x9e, 0xfa, 0x09, 0x3c, 0xbf, 0x23, 0xca, 0xc3, 0x48, 0x41, 0x94,
+	0x6b, 0x36, 0xec, 0x36, 0x4c, 0x37, 0x1b, 0x49, 0x2e, 0xbb, 0x25, 0x90, 0xac, 0xca, 0x95, 0xe9,
+	0xe7, 0x17, 0xc2, 0x29, 0xed, 0xf7, 0x0d, 0x98, 0x7e, 0x9b, 0x24, 0x55, 0x8a, 0xdb, 0x90, 0x7f,
+	0x9b, 0x0c, 0xca, 0xc4, 0x9b, 0x4a, 0xd0, 0xd5, 0x14, 0x82, 0x04, 0x1d, 0x92, 0xe4, 0xdc, 0x9c,
+	0xab, 0x0e, 0xeb, 0x63, 0x4f, 0x98, 0xb3, 0x84, 0x14, 0x64, 0x3b, 0x60, 0xb6, 0x08, 0x6b, 0xfa,
+	0x0e, 0xed, 0xba, 0x7e, 0x47, 0x65, 0x7f, 0x82, 0x4e, 0xcb, 0x50, 0x54, 0x88, 0x42, 0xab, 0xd9,
+	0xeb, 0xf3, 0x4b, 0xea, 0x11, 0x78, 0xcf, 0x0d, 0x38, 0xd7, 0xb5, 0x19, 0xae, 0x26, 0xd2, 0x58,
+	0x4a, 0xc8, 0x9d, 0x3e, 0xeb, 0xd0, 0x17, 0x27, 0xe4, 0xbf, 0x06, 0x2c, 0xb6, 0x70, 0x07, 0xaf,
+	0x63, 0xcf, 0x5b, 0x75, 0x1c, 0xd2, 0x63, 0xbb, 0x94, 0xb9, 0x87, 0xae, 0x83, 0x99, 0x4b, 0xfd,
+	0xc9, 0x15, 0xe4, 0x1f, 0xc0, 0xc5, 0x06, 0x61, 0xd8, 0x39, 0x22, 0xed, 0x8c, 0x25, 0xf9, 0xb3,
+	0x7c, 0x78, 0x03, 0xa0, 0xad, 0x52, 0x93, 0x0d, 0x80, 0xbe, 0xfe, 0x2a, 0x94, 0x5b, 0x84, 0x21,
+	0x12, 0xf6, 0x3d, 0x96, 0x26, 0xe5, 0x38, 0xde, 0x20, 0xe5, 0x38, 0x64, 0xbf, 0x0b, 0xa5, 0x55,
+	0x87, 0xb9, 0xc7, 0x99, 0x92, 0x56, 0x71, 0xae, 0x0e, 0x71, 0xfe, 0x3e, 0x40, 0x83, 0xe0, 0x17,
+	0xc3, 0xfb, 0x1e, 0x14, 0xee, 0xf6, 0xda, 0xe3, 0xe7, 0xfb, 0xdb, 0x1c, 0xcc, 0x6e, 0x12, 0x76,
+	0xdb, 0xf5, 0x70, 0x97, 0xf8, 0x13, 0x7c, 0xa2, 0x7f, 0x17, 0xca, 0x2d, 0x86, 0x03, 0x76, 0x3b,
+	0xa0, 0xdd, 0xd1, 0x02, 0x67, 0x40, 0x6f, 0xee, 0x43, 0x19, 0x11, 0xdc, 0xbe, 0xeb, 0x33, 0xd7,
+	0xb3, 0x6a, 0x99, 0x7a, 0xaf, 0x01, 0x23, 0xfb, 0x4f, 0x06, 0xcc, 0x6b, 0xc3, 0xb4, 0x48, 0x67,
+	0xb2, 0xf6, 0xb9, 0x05, 0x45, 0xe9, 0xba, 0xd0, 0xaa, 0x5e, 0x99, 0xbe, 0x3a, 0x7b, 0xfd, 0xb2,
+	0xae, 0x0c, 0xeb, 0xb4, 0xdb, 0xa3, 0xa1, 0xcb, 0x88, 0xd6, 0x4d, 0xe2, 0x0d, 0x2a, 0x85, 0xa0,
+	0xb2, 0xff, 0x6d, 0xc0, 0xac, 0x4e, 0x29, 0xff, 0x90, 0x4e, 0xd4, 0xb3, 0x19, 0x4b, 0x42, 0x39,
+	0x45, 0x29, 0x88, 0x45, 0xf4, 0xc2, 0x50, 0x44, 0xff, 0xc5, 0x10, 0x1d, 0x6a, 0xaa, 0x1a, 0xf1,
+	0x8a, 0xde, 0xda, 0x7e, 0x62, 0x80, 0xb9, 0x49, 0xd9, 0x16, 0x65, 0xeb, 0xd4, 0x3f, 0x74, 0x83,
+	0x6e, 0x9a, 0xea, 0x3e, 0xae, 0x87, 0x68, 0x34, 0xc4, 0x54, 0xc7, 0x31, 0xc4, 0x54, 0x20, 0xdf,
+	0xea, 0x79, 0xae, 0x74, 0x5f, 0x09, 0x49, 0xc0, 0x7e, 0x6c, 0x00, 0x48, 0x0f, 0x4d, 0x36, 0x36,
+	0x9b, 0x50, 0x52, 0x62, 0x47, 0x74, 0x52, 0x44, 0x1e, 0x8b, 0xbf, 0xda, 0x50, 0xfc, 0xbd, 0x9f,
+	0x03, 0xd8, 0xa2, 0x6a, 0x42, 0x0a, 0x27, 0xed, 0xb3, 0xda, 0x38, 0x7c, 0x66, 0xc2, 0x4c, 0x23,
+	0xa0, 0x3d, 0xf5, 0xa8, 0x10, 0x9f, 0xcd, 0x6b, 0x50, 0x14, 0xfd, 0x32, 0x09, 0xad, 0x05, 0x51,
+	0x8f, 0xe6, 0x74, 0x3d, 0x12, 0xc7, 0xba, 0xfa, 0x28, 0x1c, 0xfb, 0x23, 0x03, 0x40, 0x64, 0x61,
+	0x9a, 0x2e, 0xe8, 0x55, 0x4d, 0xc3, 0xdf, 0x19, 0x50, 0x4c, 0x77, 0x83, 0x21, 0xb1, 0x95, 0x8c,
+	0x35, 0x2f, 0xd6, 0x14, 0x56, 0x53, 0x35, 0x85, 0x1f, 0x19, 0x30, 0xdb, 0x22, 0xc1, 0xb1, 0xeb,
+	0x90, 0x06, 0x4e, 0x5c, 0xaf, 0xd4, 0x01, 0xb6, 0x69, 0x67, 0x3f, 0xc0, 0x8e, 0x1e, 0x42, 0xcb,
+	0x28, 0x76, 0x62, 0xde, 0x81, 0xd2, 0x36, 0xed, 0x6c, 0x93, 0x63, 0x22, 0xdb, 0xe8, 0xb9, 0xb5,
+	0x1b, 0xea, 0x2a, 0x5f, 0x4f, 0x71, 0x15, 0x4d, 0x8a, 0x22, 0x26, 0xe6, 0x57, 0x61, 0x4e, 0xf0,
+	0x6e, 0xf5, 0xb0, 0xcf, 0xf5, 0x53, 0x09, 0x33, 0x7c, 0x68, 0xff, 0xc7, 0x80, 0xea, 0xc6, 0x03,
+	0xe2, 0xf4, 0x79, 0xa9, 0x7b, 0xa7, 0x4f, 0xfa, 0x64, 0xc3, 0x23, 0x29, 0x9e, 0xb9, 0xfb, 0x00,
+	0xca, 0x0e, 0x88, 0x1c, 0x5a, 0x95, 0x0c, 0x7b, 0x9c, 0x18, 0x1f, 0xf3, 0x06, 0x94, 0xf4, 0xb8,
+	0xa0, 0x9c, 0xb0, 0x30, 0x88, 0xf7, 0xa1, 0x31, 0x02, 0x45, 0x88, 0xe6, 0xcd, 0x21, 0x37, 0x88,
+	0x6b, 0xce, 0x5e, 0xaf, 0x2c, 0xe9, 0x55, 0x5d, 0xec, 0x3b, 0x14, 0x47, 0xb4, 0xff, 0x67, 0xc0,
+	0x1c, 0x22, 0xac, 0x1f, 0xf8, 0xb2, 0x86, 0x24, 0x55, 0x8d, 0x6d, 0x28, 0xec, 0xe3, 0xa0, 0x43,
+	0x58, 0xa6, 0xeb, 0x2a, 0x1e, 0x67, 0x0c, 0x58, 0x1d, 0x93, 0x01, 0x2b, 0x90, 0x47, 0xa4, 0xe7,
+	0x9d, 0x28, 0x67, 0x4b, 0xc0, 0xac, 0xa8, 0x6d, 0x94, 0x78, 0x66, 0x97, 0x91, 0x04, 0xec, 0x3f,
+	0x1a, 0x00, 0x7c, 0xa0, 0xd9, 0x21, 0xec, 0x88, 0xb6, 0x13, 0x2e, 0xff, 0xcd, 0xb3, 0x23, 0xd3,
+	0xe7, 0x3a, 0x26, 0xca, 0xdd, 0x77, 0x61, 0x36, 0x56, 0xe5, 0x54, 0x50, 0x8f, 0x5a, 0x23, 0xe3,
+	0xac, 0xec, 0x87, 0xd3, 0x30, 0x2f, 0x83, 0x96, 0x06, 0xa9, 0x7d, 0xc7, 0xaf, 0x4a, 0x82, 0x6c,
+	0xbe, 0x93, 0x3c, 0x4c, 0xc4, 0xeb, 0x0e, 0xbf, 0x7c, 0x56, 0xd7, 0x0d, 0xd8, 0x98, 0x2b, 0x90,
+	0x17, 0xe9, 0x67, 0xd5, 0x44, 0x9d, 0xaf, 0x47, 0xf1, 0x7b, 0x6e, 0x76, 0x22, 0x89, 0x6c, 0xae,
+	0x40, 0x75, 0x9b, 0xb4, 0x3b, 0x24, 0xd8, 0xc2, 0xe1, 0x0e, 0x0d, 0x88, 0xb2, 0x7d, 0x28, 0x3c,
+	0x5d, 0x42, 0xe7, 0x7f, 0x69, 0xbe, 0x03, 0xc5, 0x3d, 0xe2, 0xb7, 0x79, 0x96, 0xf1, 0x3d, 0x67,
+	0x7e, 0xed, 0x2d, 0xa5, 0xfd, 0x72, 0x1a, 0xaf, 0x48, 0x4a, 0xb1, 0x7f, 0x41, 0x9a, 0x0f, 0xdf,
+	0x38, 0xcc, 0xab, 0xcf, 0xb7, 0x5d, 0xdf, 0x0d, 0x8f, 0x48, 0x52, 0x44, 0x21, 0x28, 0xeb, 0xb5,
+	0x60, 0xb6, 0x02, 0x32, 0x60, 0x63, 0xff, 0x61, 0x1a, 0xec, 0xd5, 0x76, 0xdb, 0xe5, 0xe6, 0xc2,
+	0x1e, 0xf7, 0x16, 0x1f, 0x54, 0xf6, 0x02, 0x72, 0xec, 0xd2, 0x7e, 0xa8, 0x43, 0x26, 0x41, 0xb1,
+	0x1f, 0x0d, 0xb6, 0x9e, 0x4a, 0x44, 0x26, 0xf5, 0xce, 0x32, 0x8b, 0x5b, 0xbf, 0x3a, 0x1e, 0xeb,
+	0x9f, 0x29, 0x26, 0xb5, 0x31, 0x15, 0x93, 0x58, 0xce, 0x2f, 0xa4, 0xcc, 0xf9, 0x33, 0xb5, 0xd8,
+	0x4a, 0x5b, 0x8b, 0x7f, 0x69, 0xc0, 0x6b, 0x2d, 0xe6, 0x7a, 0x9e, 0x8a, 0x76, 0xbf, 0xf3, 0x12,
+	0xa2, 0xe7, 0x58, 0x0c, 0xe5, 0xca, 0xa6, 0xe1, 0xc4, 0xba, 0x27, 0x2e, 0x77, 0x0b, 0x87, 0x93,
+	0x97, 0xbb, 0x0b, 0x17, 0xb4, 0xd0, 0x14, 0xf3, 0xc0, 0x95, 0x21, 0x2d, 0x85, 0xec, 0x12, 0x8a,
+	0x1f, 0xd9, 0x8f, 0x72, 0x7c, 0xf6, 0xed, 0x79, 0xe9, 0xd6, 0x62, 0xaf, 0xe6, 0xe0, 0x14, 0x6b,
+	0xb8, 0x6b, 0xc9, 0x0d, 0xb7, 0xf9, 0xe6, 0x60, 0x5f, 0x20, 0xfb, 0xf3, 0xd7, 0x35, 0xfa, 0x0e,
+	0x66, 0x24, 0x70, 0xe3, 0x5d, 0xa3, 0x40, 0x8b, 0xba, 0x7c, 0x2b, 0xd6, 0xe5, 0x5b, 0x50, 0x54,
+	0x13, 0xa7, 0x78, 0xbf, 0x54, 0x42, 0x1a, 0xb4, 0xff, 0x6a, 0x40, 0x61, 0x93, 0xb0, 0xe4, 0x5d,
+	0xee, 0x18, 0x9b, 0xf9, 0x17, 0xf7, 0xdc, 0xfe, 0x95, 0x01, 0x97, 0x56, 0x0f, 0xb0, 0xdf, 0xa6,
+	0x7e, 0xb4, 0x78, 0x0c, 0x5f, 0xca, 0x26, 0x95, 0x17, 0x9c, 0xca, 0x26, 0x61, 0xdb, 0x6e, 0xe7,
+	0x88, 0x35, 0x7d, 0x97, 0xb9, 0xd8, 0x4b, 0xf3, 0x46, 0x61, 0xac, 0xc1, 0xc6, 0xd7, 0x80, 0x17,
+	0x9f, 0x57, 0x03, 0x1b, 0x2e, 0xec, 0x12, 0xf6, 0x1e, 0x0d, 0xee, 0x8b, 0x45, 0x9c, 0xca, 0xc3,
+	0xa1, 0x33, 0x73, 0x0b, 0x0a, 0x22, 0x37, 0xe4, 0x12, 0x6b, 0x94, 0xdc, 0x52, 0xf4, 0xe6, 0x22,
+	0xe4, 0x79, 0x84, 0xca, 0x64, 0xb8, 0xa0, 0x62, 0x59, 0x1e, 0xa9, 0x54, 0x71, 0x9d, 0xe4, 0xd9,
+	0x94, 0xe3, 0x98, 0xd7, 0xb4, 0xe9, 0xe4, 0x43, 0xe1, 0xe2, 0x92, 0x7c, 0x47, 0x2d, 0xce, 0xf6,
+	0x02, 0xca, 0xa8, 0xe6, 0x2e, 0x6d, 0x13, 0x42, 0x69, 0x93, 0xb0, 0x34, 0x2f, 0x98, 0xc6, 0x18,
+	0x15, 0x7f, 0x36, 0xa0, 0x2c, 0x17, 0xbe, 0xc9, 0x19, 0x17, 0x85, 0x42, 0x65, 0x1c, 0x75, 0x27,
+	0xaa, 0x86, 0xd5, 0x4c, 0xd5, 0xd0, 0xfe, 0x39, 0xcc, 0xf3, 0x67, 0x18, 0xe7, 0xa9, 0x4a, 0xf1,
+	0x24, 0xaf, 0x61, 0x7f, 0x6a, 0xc0, 0x85, 0x97, 0x25, 0x9c, 0x8f, 0x39, 0x32, 0x58, 0x45, 0xd4,
+	0x0f, 0x87, 0xe9, 0x8b, 0xab, 0xe8, 0xf6, 0x07, 0x39, 0x28, 0xdd, 0xc3, 0x9e, 0xdb, 0x4e, 0x53,
+	0x3e, 0x0a, 0x32, 0xd6, 0xb2, 0x8d, 0x21, 0x92, 0xc7, 0x98, 0x9f, 0x7c, 0x1b, 0x30, 0xbb, 0x1f,
+	0x60, 0x3f, 0x74, 0x02, 0xb7, 0xc7, 0xb4, 0xad, 0x2e, 0x45, 0xad, 0x9b, 0xba, 0xa1, 0x4b, 0xfd,
+	0x01, 0x96, 0xb2, 0x44, 0x9c, 0xce, 0xfe, 0xbd, 0x01, 0x95, 0xf3, 0x70, 0xcd, 0xdd, 0xe1, 0x09,
+	0x72, 0xd4, 0xcb, 0x47, 0xad, 0xe6, 0x2d, 0x28, 0xeb, 0xb7, 0x7e, 0x5a, 0xdb, 0x37, 0xce, 0xd1,
+	0x56, 0xe3, 0x28, 0x5d, 0x07, 0x34, 0xf6, 0xdf, 0x0d, 0x30, 0x3f, 0x8b, 0x67, 0xbe, 0x95, 0x76,
+	0xd2, 0x3d, 0xb3, 0x0f, 0x32, 0x17, 0xc5, 0x96, 0xb3, 0x47, 0x7d, 0xed, 0x11, 0x14, 0xc1, 0xbc,
+	0x5d, 0xdd, 0x25, 0xef, 0x29, 0xdf, 0x0f, 0xfa, 0x73, 0xe3, 0xf9, 0xdb, 0xd5, 0x88, 0xcd, 0xe7,
+	0x4c, 0xf5, 0x0f, 0x67, 0xe2, 0xb7, 0xda, 0x71, 0xc3, 0x2e, 0x66, 0xce, 0xd1, 0x44, 0xe3, 0x72,
+	0x77, 0x78, 0x93, 0x96, 0xd9, 0xd3, 0xe3, 0x5d, 0xb3, 0xee, 0x41, 0x49, 0x0f, 0x82, 0xd6, 0x42,
+	0x06, 0xf5, 0x22, 0x2e, 0xdc, 0xf1, 0x1b, 0x0f, 0x7a, 0xc4, 0x61, 0xa4, 0xad, 0xda, 0xba, 0x08,
+	0x8e, 0xbd, 0x1b, 0x17, 0xbf, 0x1c, 0xd2, 0xef, 0xc6, 0xe5, 0x1e, 0x1b, 0x87, 0xd4, 0xb7, 0x16,
+	0x85, 0xf7, 0x14, 0xc4, 0x03, 0x45, 0x79, 0x8f, 0x06, 0xd6, 0x1b, 0x59, 0xe6, 0x9a, 0x88, 0x8d,
+	0xfd, 0x81, 0x01, 0xf9, 0xf5, 0xa3, 0xbe, 0x7f, 0x3f, 0x21, 0x0a, 0x16, 0xa1, 0xd4, 0x62, 0x01,
+	0xc1, 0x5d, 0xfd, 0x20, 0x45, 0x11, 0xcc, 0x29, 0xf7, 0x29, 0xc3, 0x5e, 0xcb, 0xfd, 0xa9, 0x8c,
+	0xee, 0x19, 0x34, 0x38, 0x10, 0xbf, 0x31, 0x39, 0x3c, 0x0c, 0x89, 0x8c, 0xed, 0x19, 0xa4, 0x20,
+	0xd1, 0xec, 0xf2, 0x39, 0x70, 0x41, 0x35, 0xbb, 0x7c, 0xd4, 0xfb, 0x21, 0x94, 0x84, 0x32, 0xab,
+	0x4e, 0x16, 0x7d, 0x06, 0x12, 0xab, 0x71, 0x89, 0xf6, 0xdf, 0x72, 0x50, 0xe6, 0x6f, 0xea, 0xd3,
+	0xb4, 0x52, 0xe3, 0xae, 0x48, 0xfc, 0x97, 0x6d, 0xd4, 0xf3, 0x0e, 0xb0, 0x73, 0x3f, 0x53, 0xe0,
+	0x47, 0x5c, 0xe4, 0x0f, 0x55, 0xf8, 0x76, 0x4e, 0xd8, 0xb5, 0x8c, 0x14, 0xc4, 0xcf, 0xf9, 0x05,
+	0xfb, 0x72, 0xcf, 0x93, 0x47, 0x0a, 0xe2, 0x16, 0x5b, 0x65, 0x8c, 0x74, 0x79, 0x01, 0x17, 0x9b,
+	0x1d, 0x14, 0xc1, 0xf2, 0x9d, 0x1e, 0x0b, 0x4e, 0x9a, 0xbe, 0x08, 0xc5, 0x3c, 0xd2, 0x20, 0xb7,
+	0xd2, 0x36, 0x0e, 0x99, 0x2c, 0x26, 0x32, 0x1c, 0x07, 0x07, 0xf6, 0x87, 0x06, 0x40, 0x64, 0xd1,
+	0x70, 0xa2, 0x85, 0x64, 0x09, 0xf2, 0x5c, 0xb2, 0x7e, 0x17, 0x6b, 0x0e, 0xf6, 0x08, 0x5a, 0x1f,
+	0xdd, 0x33, 0x0a, 0x34, 0xfb, 0x67, 0x30, 0xb7, 0x49, 0xd8, 0xcb, 0x51, 0x76, 0x6d, 0xe5, 0xd1,
+	0xd3, 0xfa, 0xd4, 0xe3, 0xa7, 0xf5, 0xa9, 0x4f, 0x9e, 0xd6, 0x8d, 0x5f, 0x9c, 0xd6, 0x8d, 0x0f,
+	0x4f, 0xeb, 0xc6, 0xc7, 0xa7, 0x75, 0xe3, 0xd1, 0x69, 0xdd, 0xf8, 0xe7, 0x69, 0xdd, 0xf8, 0xd7,
+	0x69, 0x7d, 0xea, 0x93, 0xd3, 0xba, 0xf1, 0xf0, 0x59, 0x7d, 0xea, 0xd1, 0xb3, 0xfa, 0xd4, 0xe3,
+	0x67, 0xf5, 0xa9, 0x83, 0x82, 0xf8, 0x8d, 0xe6, 0x8d, 0xff, 0x0f, 0x00, 0x31, 0xa1, 0xac, 0xef,
+	0x6a, 0x2a, 0x00, 0x00,
+}
+
+func (this *Meta) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Code)
+	that1, ok := that.(*Meta)
 	if !ok {
-		that2, ok := that.(Code)
+		that2, ok := that.(Meta)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2879,19 +3391,34 @@ func (this *Code) Equal(that interface{}) bool {
 	if this.Polymorph != that1.Polymorph {
 		return false
 	}
-	if !bytes.Equal(this.Record, that1.Record) {
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if !this.Sender.Equal(that1.Sender) {
+		return false
+	}
+	if !this.Receiver.Equal(that1.Receiver) {
+		return false
+	}
+	if !this.Pulse.Equal(that1.Pulse) {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if !this.OriginHash.Equal(that1.OriginHash) {
 		return false
 	}
 	return true
 }
-func (this *State) Equal(that interface{}) bool {
+func (this *Error) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*State)
+	that1, ok := that.(*Error)
 	if !ok {
-		that2, ok := that.(State)
+		that2, ok := that.(Error)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2906,7 +3433,235 @@ func (this *State) Equal(that interface{}) bool {
 	if this.Polymorph != that1.Polymorph {
 		return false
 	}
-	if !bytes.Equal(this.Record, that1.Record) {
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.Text != that1.Text {
+		return false
+	}
+	return true
+}
+func (this *GetObject) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetObject)
+	if !ok {
+		that2, ok := that.(GetObject)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.ObjectID.Equal(that1.ObjectID) {
+		return false
+	}
+	if !this.ObjectRequestID.Equal(that1.ObjectRequestID) {
+		return false
+	}
+	if !this.StateID.Equal(that1.StateID) {
+		return false
+	}
+	return true
+}
+func (this *GetCode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetCode)
+	if !ok {
+		that2, ok := that.(GetCode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.CodeID.Equal(that1.CodeID) {
+		return false
+	}
+	return true
+}
+func (this *PassState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PassState)
+	if !ok {
+		that2, ok := that.(PassState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !bytes.Equal(this.Origin, that1.Origin) {
+		return false
+	}
+	if !this.StateID.Equal(that1.StateID) {
+		return false
+	}
+	return true
+}
+func (this *Pass) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Pass)
+	if !ok {
+		that2, ok := that.(Pass)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !bytes.Equal(this.Origin, that1.Origin) {
+		return false
+	}
+	return true
+}
+func (this *SetCode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetCode)
+	if !ok {
+		that2, ok := that.(SetCode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !bytes.Equal(this.Record, that1.Record) {
+		return false
+	}
+	return true
+}
+func (this *Index) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Index)
+	if !ok {
+		that2, ok := that.(Index)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !bytes.Equal(this.Index, that1.Index) {
+		return false
+	}
+	return true
+}
+func (this *Code) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Code)
+	if !ok {
+		that2, ok := that.(Code)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !bytes.Equal(this.Record, that1.Record) {
+		return false
+	}
+	return true
+}
+func (this *State) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*State)
+	if !ok {
+		that2, ok := that.(State)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !bytes.Equal(this.Record, that1.Record) {
 		return false
 	}
 	if !bytes.Equal(this.Memory, that1.Memory) {
@@ -3311,6 +4066,36 @@ func (this *RequestInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GetResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetResult)
+	if !ok {
+		that2, ok := that.(GetResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.ObjectID.Equal(that1.ObjectID) {
+		return false
+	}
+	if !this.RequestID.Equal(that1.RequestID) {
+		return false
+	}
+	return true
+}
 func (this *GotHotConfirmation) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -3875,6 +4660,9 @@ func (this *Replication) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Drop, that1.Drop) {
 		return false
 	}
+	if this.Confirm != that1.Confirm {
+		return false
+	}
 	return true
 }
 func (this *GetJet) Equal(that interface{}) bool {
@@ -4072,1110 +4860,1215 @@ func (this *UpdateJet) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *Meta) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetPulseReplica) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 11)
-	s = append(s, "&payload.Meta{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
-	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
-	s = append(s, "Receiver: "+fmt.Sprintf("%#v", this.Receiver)+",\n")
-	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
-	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
-	s = append(s, "OriginHash: "+fmt.Sprintf("%#v", this.OriginHash)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Error) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*GetPulseReplica)
+	if !ok {
+		that2, ok := that.(GetPulseReplica)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&payload.Error{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
-	s = append(s, "Text: "+fmt.Sprintf("%#v", this.Text)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetObject) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&payload.GetObject{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
-	s = append(s, "ObjectRequestID: "+fmt.Sprintf("%#v", this.ObjectRequestID)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetCode) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Polymorph != that1.Polymorph {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&payload.GetCode{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "CodeID: "+fmt.Sprintf("%#v", this.CodeID)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PassState) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Pulse.Equal(that1.Pulse) {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&payload.PassState{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Origin: "+fmt.Sprintf("%#v", this.Origin)+",\n")
-	s = append(s, "StateID: "+fmt.Sprintf("%#v", this.StateID)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *Pass) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PulseReplica) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&payload.Pass{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Origin: "+fmt.Sprintf("%#v", this.Origin)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SetCode) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*PulseReplica)
+	if !ok {
+		that2, ok := that.(PulseReplica)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&payload.SetCode{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Index) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&payload.Index{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Code) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Polymorph != that1.Polymorph {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&payload.Code{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *State) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Pulse.Equal(that1.Pulse) {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&payload.State{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
-	s = append(s, "Memory: "+fmt.Sprintf("%#v", this.Memory)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ID) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Drops) != len(that1.Drops) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&payload.ID{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *IDs) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Drops {
+		if !bytes.Equal(this.Drops[i], that1.Drops[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&payload.IDs{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "IDs: "+fmt.Sprintf("%#v", this.IDs)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Jet) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Indexes) != len(that1.Indexes) {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&payload.Jet{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "JetID: "+fmt.Sprintf("%#v", this.JetID)+",\n")
-	s = append(s, "Actual: "+fmt.Sprintf("%#v", this.Actual)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SetIncomingRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Indexes {
+		if !this.Indexes[i].Equal(&that1.Indexes[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&payload.SetIncomingRequest{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Request: "+strings.Replace(this.Request.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SetOutgoingRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Records) != len(that1.Records) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&payload.SetOutgoingRequest{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Request: "+strings.Replace(this.Request.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *SagaCallAcceptNotification) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Records {
+		if !this.Records[i].Equal(&that1.Records[i]) {
+			return false
+		}
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&payload.SagaCallAcceptNotification{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
-	s = append(s, "DetachedRequestID: "+fmt.Sprintf("%#v", this.DetachedRequestID)+",\n")
-	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *SetResult) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *Validate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&payload.SetResult{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Activate) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*Validate)
+	if !ok {
+		that2, ok := that.(Validate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&payload.Activate{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
-	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Deactivate) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&payload.Deactivate{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
-	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Update) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Polymorph != that1.Polymorph {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&payload.Update{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
-	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetFilament) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Object.Equal(that1.Object) {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&payload.GetFilament{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
-	s = append(s, "StartFrom: "+fmt.Sprintf("%#v", this.StartFrom)+",\n")
-	s = append(s, "ReadUntil: "+fmt.Sprintf("%#v", this.ReadUntil)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *FilamentSegment) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Pulse.Equal(that1.Pulse) {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&payload.FilamentSegment{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
-	if this.Records != nil {
-		vs := make([]*record.CompositeFilamentRecord, len(this.Records))
-		for i := range vs {
-			vs[i] = &this.Records[i]
+	if len(this.Transcripts) != len(that1.Transcripts) {
+		return false
+	}
+	for i := range this.Transcripts {
+		if !this.Transcripts[i].Equal(&that1.Transcripts[i]) {
+			return false
 		}
-		s = append(s, "Records: "+fmt.Sprintf("%#v", vs)+",\n")
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RequestInfo) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ValidationTranscript) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&payload.RequestInfo{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
-	s = append(s, "RequestID: "+fmt.Sprintf("%#v", this.RequestID)+",\n")
-	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
-	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GotHotConfirmation) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ValidationTranscript)
+	if !ok {
+		that2, ok := that.(ValidationTranscript)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&payload.GotHotConfirmation{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "JetID: "+fmt.Sprintf("%#v", this.JetID)+",\n")
-	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
-	s = append(s, "Split: "+fmt.Sprintf("%#v", this.Split)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ResultInfo) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&payload.ResultInfo{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
-	s = append(s, "ResultID: "+fmt.Sprintf("%#v", this.ResultID)+",\n")
-	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *HotObjects) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Request.Equal(that1.Request) {
+		return false
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&payload.HotObjects{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "JetID: "+fmt.Sprintf("%#v", this.JetID)+",\n")
-	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
-	s = append(s, "Drop: "+fmt.Sprintf("%#v", this.Drop)+",\n")
-	if this.Indexes != nil {
-		vs := make([]*record.Index, len(this.Indexes))
-		for i := range vs {
-			vs[i] = &this.Indexes[i]
+	if len(this.Outgoings) != len(that1.Outgoings) {
+		return false
+	}
+	for i := range this.Outgoings {
+		if !this.Outgoings[i].Equal(&that1.Outgoings[i]) {
+			return false
 		}
-		s = append(s, "Indexes: "+fmt.Sprintf("%#v", vs)+",\n")
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *GetRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ValidationOutgoing) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&payload.GetRequest{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
-	s = append(s, "RequestID: "+fmt.Sprintf("%#v", this.RequestID)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *Request) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ValidationOutgoing)
+	if !ok {
+		that2, ok := that.(ValidationOutgoing)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&payload.Request{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "RequestID: "+fmt.Sprintf("%#v", this.RequestID)+",\n")
-	s = append(s, "Request: "+strings.Replace(this.Request.GoString(), `&`, ``, 1)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ServiceData) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&payload.ServiceData{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "LogTraceID: "+fmt.Sprintf("%#v", this.LogTraceID)+",\n")
-	s = append(s, "LogLevel: "+fmt.Sprintf("%#v", this.LogLevel)+",\n")
-	s = append(s, "TraceSpanData: "+fmt.Sprintf("%#v", this.TraceSpanData)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ExecutionQueueElement) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Request.Equal(&that1.Request) {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&payload.ExecutionQueueElement{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "RequestRef: "+fmt.Sprintf("%#v", this.RequestRef)+",\n")
-	if this.Incoming != nil {
-		s = append(s, "Incoming: "+fmt.Sprintf("%#v", this.Incoming)+",\n")
+	if !bytes.Equal(this.Response, that1.Response) {
+		return false
 	}
-	if this.ServiceData != nil {
-		s = append(s, "ServiceData: "+fmt.Sprintf("%#v", this.ServiceData)+",\n")
+	if that1.NewObject == nil {
+		if this.NewObject != nil {
+			return false
+		}
+	} else if !this.NewObject.Equal(*that1.NewObject) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ReturnResults) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Error != that1.Error {
+		return false
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&payload.ReturnResults{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Target: "+fmt.Sprintf("%#v", this.Target)+",\n")
-	s = append(s, "RequestRef: "+fmt.Sprintf("%#v", this.RequestRef)+",\n")
-	s = append(s, "Reply: "+fmt.Sprintf("%#v", this.Reply)+",\n")
-	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *CallMethod) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ValidationMismatch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&payload.CallMethod{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	if this.Request != nil {
-		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+
+	that1, ok := that.(*ValidationMismatch)
+	if !ok {
+		that2, ok := that.(ValidationMismatch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ExecutorResults) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&payload.ExecutorResults{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Caller: "+fmt.Sprintf("%#v", this.Caller)+",\n")
-	s = append(s, "RecordRef: "+fmt.Sprintf("%#v", this.RecordRef)+",\n")
-	if this.Queue != nil {
-		s = append(s, "Queue: "+fmt.Sprintf("%#v", this.Queue)+",\n")
+	if this.Polymorph != that1.Polymorph {
+		return false
 	}
-	s = append(s, "LedgerHasMoreRequests: "+fmt.Sprintf("%#v", this.LedgerHasMoreRequests)+",\n")
-	s = append(s, "Pending: "+fmt.Sprintf("%#v", this.Pending)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if !this.Object.Equal(that1.Object) {
+		return false
+	}
+	if !this.Request.Equal(that1.Request) {
+		return false
+	}
+	if !this.Pulse.Equal(that1.Pulse) {
+		return false
+	}
+	if !this.Executor.Equal(that1.Executor) {
+		return false
+	}
+	if !bytes.Equal(this.Expected, that1.Expected) {
+		return false
+	}
+	if !bytes.Equal(this.Actual, that1.Actual) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if !this.Validator.Equal(that1.Validator) {
+		return false
+	}
+	return true
 }
-func (this *PendingFinished) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *Chunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&payload.PendingFinished{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ObjectRef: "+fmt.Sprintf("%#v", this.ObjectRef)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+	that1, ok := that.(*Chunk)
+	if !ok {
+		that2, ok := that.(Chunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !bytes.Equal(this.StreamID, that1.StreamID) {
+		return false
+	}
+	if this.TotalSize != that1.TotalSize {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
 }
-func (this *AdditionalCallFromPreviousExecutor) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ChunkAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&payload.AdditionalCallFromPreviousExecutor{")
-	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ObjectReference: "+fmt.Sprintf("%#v", this.ObjectReference)+",\n")
-	s = append(s, "Pending: "+fmt.Sprintf("%#v", this.Pending)+",\n")
-	s = append(s, "RequestRef: "+fmt.Sprintf("%#v", this.RequestRef)+",\n")
-	if this.Request != nil {
-		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+
+	that1, ok := that.(*ChunkAck)
+	if !ok {
+		that2, ok := that.(ChunkAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.ServiceData != nil {
-		s = append(s, "ServiceData: "+fmt.Sprintf("%#v", this.ServiceData)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !bytes.Equal(this.StreamID, that1.StreamID) {
+		return false
+	}
+	if this.Offset != that1.Offset {
+		return false
+	}
+	return true
 }
-func (this *StillExecuting) GoString() string {
+func (this *SagaState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SagaState)
+	if !ok {
+		that2, ok := that.(SagaState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.Request.Equal(that1.Request) {
+		return false
+	}
+	if !this.Rollback.Equal(that1.Rollback) {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	if this.RetryIn != that1.RetryIn {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	return true
+}
+func (this *SagaStates) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SagaStates)
+	if !ok {
+		that2, ok := that.(SagaStates)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.Object.Equal(that1.Object) {
+		return false
+	}
+	if len(this.Sagas) != len(that1.Sagas) {
+		return false
+	}
+	for i := range this.Sagas {
+		if !this.Sagas[i].Equal(&that1.Sagas[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GetSagaStates) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetSagaStates)
+	if !ok {
+		that2, ok := that.(GetSagaStates)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Polymorph != that1.Polymorph {
+		return false
+	}
+	if !this.Object.Equal(that1.Object) {
+		return false
+	}
+	return true
+}
+func (this *Meta) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&payload.StillExecuting{")
+	s := make([]string, 0, 11)
+	s = append(s, "&payload.Meta{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ObjectRef: "+fmt.Sprintf("%#v", this.ObjectRef)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "Receiver: "+fmt.Sprintf("%#v", this.Receiver)+",\n")
+	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "OriginHash: "+fmt.Sprintf("%#v", this.OriginHash)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetPendings) GoString() string {
+func (this *Error) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&payload.GetPendings{")
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.Error{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "Text: "+fmt.Sprintf("%#v", this.Text)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *HasPendings) GoString() string {
+func (this *GetObject) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&payload.HasPendings{")
+	s := make([]string, 0, 8)
+	s = append(s, "&payload.GetObject{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "ObjectRequestID: "+fmt.Sprintf("%#v", this.ObjectRequestID)+",\n")
+	s = append(s, "StateID: "+fmt.Sprintf("%#v", this.StateID)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *PendingsInfo) GoString() string {
+func (this *GetCode) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
-	s = append(s, "&payload.PendingsInfo{")
+	s = append(s, "&payload.GetCode{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "HasPendings: "+fmt.Sprintf("%#v", this.HasPendings)+",\n")
+	s = append(s, "CodeID: "+fmt.Sprintf("%#v", this.CodeID)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *Replication) GoString() string {
+func (this *PassState) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&payload.Replication{")
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.PassState{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "JetID: "+fmt.Sprintf("%#v", this.JetID)+",\n")
-	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
-	if this.Indexes != nil {
-		vs := make([]*record.Index, len(this.Indexes))
-		for i := range vs {
-			vs[i] = &this.Indexes[i]
-		}
-		s = append(s, "Indexes: "+fmt.Sprintf("%#v", vs)+",\n")
-	}
-	if this.Records != nil {
-		vs := make([]*record.Material, len(this.Records))
-		for i := range vs {
-			vs[i] = &this.Records[i]
-		}
-		s = append(s, "Records: "+fmt.Sprintf("%#v", vs)+",\n")
-	}
-	s = append(s, "Drop: "+fmt.Sprintf("%#v", this.Drop)+",\n")
+	s = append(s, "Origin: "+fmt.Sprintf("%#v", this.Origin)+",\n")
+	s = append(s, "StateID: "+fmt.Sprintf("%#v", this.StateID)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetJet) GoString() string {
+func (this *Pass) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&payload.GetJet{")
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.Pass{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
-	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "Origin: "+fmt.Sprintf("%#v", this.Origin)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *AbandonedRequestsNotification) GoString() string {
+func (this *SetCode) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
-	s = append(s, "&payload.AbandonedRequestsNotification{")
+	s = append(s, "&payload.SetCode{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetLightInitialState) GoString() string {
+func (this *Index) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
-	s = append(s, "&payload.GetLightInitialState{")
+	s = append(s, "&payload.Index{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *LightInitialState) GoString() string {
+func (this *Code) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
-	s = append(s, "&payload.LightInitialState{")
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.Code{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "NetworkStart: "+fmt.Sprintf("%#v", this.NetworkStart)+",\n")
-	s = append(s, "JetIDs: "+fmt.Sprintf("%#v", this.JetIDs)+",\n")
-	s = append(s, "Drops: "+fmt.Sprintf("%#v", this.Drops)+",\n")
-	if this.Indices != nil {
-		vs := make([]*record.Index, len(this.Indices))
-		for i := range vs {
-			vs[i] = &this.Indices[i]
-		}
-		s = append(s, "Indices: "+fmt.Sprintf("%#v", vs)+",\n")
+	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *State) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	s = append(s, "Pulse: "+strings.Replace(this.Pulse.GoString(), `&`, ``, 1)+",\n")
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.State{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
+	s = append(s, "Memory: "+fmt.Sprintf("%#v", this.Memory)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *GetIndex) GoString() string {
+func (this *ID) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
-	s = append(s, "&payload.GetIndex{")
+	s = append(s, "&payload.ID{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *UpdateJet) GoString() string {
+func (this *IDs) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.IDs{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "IDs: "+fmt.Sprintf("%#v", this.IDs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Jet) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 7)
-	s = append(s, "&payload.UpdateJet{")
+	s = append(s, "&payload.Jet{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
-	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
 	s = append(s, "JetID: "+fmt.Sprintf("%#v", this.JetID)+",\n")
+	s = append(s, "Actual: "+fmt.Sprintf("%#v", this.Actual)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func valueToGoStringPayload(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *SetIncomingRequest) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.SetIncomingRequest{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Request: "+strings.Replace(this.Request.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (m *Meta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *SetOutgoingRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.SetOutgoingRequest{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Request: "+strings.Replace(this.Request.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Meta) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
-	}
-	if len(m.Payload) > 0 {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Payload)))
-		i += copy(dAtA[i:], m.Payload)
-	}
-	dAtA[i] = 0xaa
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.Sender.Size()))
-	n1, err := m.Sender.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+func (this *SagaCallAcceptNotification) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	i += n1
-	dAtA[i] = 0xb2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.Receiver.Size()))
-	n2, err := m.Receiver.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	s := make([]string, 0, 8)
+	s = append(s, "&payload.SagaCallAcceptNotification{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "DetachedRequestID: "+fmt.Sprintf("%#v", this.DetachedRequestID)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetResult) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	i += n2
-	dAtA[i] = 0xba
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
-	n3, err := m.Pulse.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.SetResult{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Activate) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	i += n3
-	if len(m.ID) > 0 {
-		dAtA[i] = 0xc2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.ID)))
-		i += copy(dAtA[i:], m.ID)
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.Activate{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Deactivate) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	dAtA[i] = 0xca
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.OriginHash.Size()))
-	n4, err := m.OriginHash.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.Deactivate{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Update) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	i += n4
-	return i, nil
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.Update{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Record: "+fmt.Sprintf("%#v", this.Record)+",\n")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Error) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *GetFilament) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 8)
+	s = append(s, "&payload.GetFilament{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "StartFrom: "+fmt.Sprintf("%#v", this.StartFrom)+",\n")
+	s = append(s, "ReadUntil: "+fmt.Sprintf("%#v", this.ReadUntil)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Error) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+func (this *FilamentSegment) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Code != 0 {
-		dAtA[i] = 0xa0
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Code))
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.FilamentSegment{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	if this.Records != nil {
+		vs := make([]*record.CompositeFilamentRecord, len(this.Records))
+		for i := range vs {
+			vs[i] = &this.Records[i]
+		}
+		s = append(s, "Records: "+fmt.Sprintf("%#v", vs)+",\n")
 	}
-	if len(m.Text) > 0 {
-		dAtA[i] = 0xaa
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Text)))
-		i += copy(dAtA[i:], m.Text)
-	}
-	return i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *RequestInfo) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 9)
+	s = append(s, "&payload.RequestInfo{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "RequestID: "+fmt.Sprintf("%#v", this.RequestID)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetObject) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
-	}
-	dAtA[i] = 0xa2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n5, err := m.ObjectID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+func (this *GetResult) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	i += n5
-	dAtA[i] = 0xaa
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectRequestID.Size()))
-	n6, err := m.ObjectRequestID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.GetResult{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "RequestID: "+fmt.Sprintf("%#v", this.RequestID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GotHotConfirmation) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	i += n6
-	return i, nil
+	s := make([]string, 0, 8)
+	s = append(s, "&payload.GotHotConfirmation{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "JetID: "+fmt.Sprintf("%#v", this.JetID)+",\n")
+	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
+	s = append(s, "Split: "+fmt.Sprintf("%#v", this.Split)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetCode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *ResultInfo) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 8)
+	s = append(s, "&payload.ResultInfo{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "ResultID: "+fmt.Sprintf("%#v", this.ResultID)+",\n")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetCode) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+func (this *HotObjects) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	dAtA[i] = 0xa2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.CodeID.Size()))
-	n7, err := m.CodeID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	s := make([]string, 0, 9)
+	s = append(s, "&payload.HotObjects{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "JetID: "+fmt.Sprintf("%#v", this.JetID)+",\n")
+	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
+	s = append(s, "Drop: "+fmt.Sprintf("%#v", this.Drop)+",\n")
+	if this.Indexes != nil {
+		vs := make([]*record.Index, len(this.Indexes))
+		for i := range vs {
+			vs[i] = &this.Indexes[i]
+		}
+		s = append(s, "Indexes: "+fmt.Sprintf("%#v", vs)+",\n")
 	}
-	i += n7
-	return i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PassState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *GetRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.GetRequest{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "RequestID: "+fmt.Sprintf("%#v", this.RequestID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PassState) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
-	}
-	if len(m.Origin) > 0 {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Origin)))
-		i += copy(dAtA[i:], m.Origin)
-	}
-	dAtA[i] = 0xaa
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.StateID.Size()))
-	n8, err := m.StateID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+func (this *Request) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	i += n8
-	return i, nil
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.Request{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "RequestID: "+fmt.Sprintf("%#v", this.RequestID)+",\n")
+	s = append(s, "Request: "+strings.Replace(this.Request.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Pass) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *ServiceData) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 8)
+	s = append(s, "&payload.ServiceData{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "LogTraceID: "+fmt.Sprintf("%#v", this.LogTraceID)+",\n")
+	s = append(s, "LogLevel: "+fmt.Sprintf("%#v", this.LogLevel)+",\n")
+	s = append(s, "TraceSpanData: "+fmt.Sprintf("%#v", this.TraceSpanData)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Pass) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+func (this *ExecutionQueueElement) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Origin) > 0 {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Origin)))
-		i += copy(dAtA[i:], m.Origin)
+	s := make([]string, 0, 8)
+	s = append(s, "&payload.ExecutionQueueElement{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "RequestRef: "+fmt.Sprintf("%#v", this.RequestRef)+",\n")
+	if this.Incoming != nil {
+		s = append(s, "Incoming: "+fmt.Sprintf("%#v", this.Incoming)+",\n")
 	}
-	return i, nil
-}
-
-func (m *SetCode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.ServiceData != nil {
+		s = append(s, "ServiceData: "+fmt.Sprintf("%#v", this.ServiceData)+",\n")
 	}
-	return dAtA[:n], nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *SetCode) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
-	}
-	if len(m.Record) > 0 {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Record)))
-		i += copy(dAtA[i:], m.Record)
-	}
-	return i, nil
-}
-
-func (m *Index) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *ReturnResults) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 9)
+	s = append(s, "&payload.ReturnResults{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Target: "+fmt.Sprintf("%#v", this.Target)+",\n")
+	s = append(s, "RequestRef: "+fmt.Sprintf("%#v", this.RequestRef)+",\n")
+	s = append(s, "Reply: "+fmt.Sprintf("%#v", this.Reply)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Index) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
-	}
-	if len(m.Index) > 0 {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Index)))
-		i += copy(dAtA[i:], m.Index)
+func (this *CallMethod) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return i, nil
-}
-
-func (m *Code) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.CallMethod{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	}
-	return dAtA[:n], nil
+	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Code) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+func (this *ExecutorResults) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Record) > 0 {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Record)))
-		i += copy(dAtA[i:], m.Record)
+	s := make([]string, 0, 10)
+	s = append(s, "&payload.ExecutorResults{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Caller: "+fmt.Sprintf("%#v", this.Caller)+",\n")
+	s = append(s, "RecordRef: "+fmt.Sprintf("%#v", this.RecordRef)+",\n")
+	if this.Queue != nil {
+		s = append(s, "Queue: "+fmt.Sprintf("%#v", this.Queue)+",\n")
 	}
-	return i, nil
+	s = append(s, "LedgerHasMoreRequests: "+fmt.Sprintf("%#v", this.LedgerHasMoreRequests)+",\n")
+	s = append(s, "Pending: "+fmt.Sprintf("%#v", this.Pending)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *State) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *PendingFinished) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.PendingFinished{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectRef: "+fmt.Sprintf("%#v", this.ObjectRef)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *State) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+func (this *AdditionalCallFromPreviousExecutor) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Record) > 0 {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Record)))
-		i += copy(dAtA[i:], m.Record)
+	s := make([]string, 0, 10)
+	s = append(s, "&payload.AdditionalCallFromPreviousExecutor{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectReference: "+fmt.Sprintf("%#v", this.ObjectReference)+",\n")
+	s = append(s, "Pending: "+fmt.Sprintf("%#v", this.Pending)+",\n")
+	s = append(s, "RequestRef: "+fmt.Sprintf("%#v", this.RequestRef)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	}
-	if len(m.Memory) > 0 {
-		dAtA[i] = 0xaa
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Memory)))
-		i += copy(dAtA[i:], m.Memory)
+	if this.ServiceData != nil {
+		s = append(s, "ServiceData: "+fmt.Sprintf("%#v", this.ServiceData)+",\n")
 	}
-	return i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *StillExecuting) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.StillExecuting{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectRef: "+fmt.Sprintf("%#v", this.ObjectRef)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ID) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+func (this *GetPendings) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	dAtA[i] = 0xa2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ID.Size()))
-	n9, err := m.ID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.GetPendings{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HasPendings) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	i += n9
-	return i, nil
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.HasPendings{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *IDs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *PendingsInfo) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.PendingsInfo{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "HasPendings: "+fmt.Sprintf("%#v", this.HasPendings)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *IDs) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		dAtA[i] = 0x80
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+func (this *Replication) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.IDs) > 0 {
-		for _, msg := range m.IDs {
-			dAtA[i] = 0xa2
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	s := make([]string, 0, 11)
+	s = append(s, "&payload.Replication{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "JetID: "+fmt.Sprintf("%#v", this.JetID)+",\n")
+	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
+	if this.Indexes != nil {
+		vs := make([]*record.Index, len(this.Indexes))
+		for i := range vs {
+			vs[i] = &this.Indexes[i]
+		}
+		s = append(s, "Indexes: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	if this.Records != nil {
+		vs := make([]*record.Material, len(this.Records))
+		for i := range vs {
+			vs[i] = &this.Records[i]
 		}
+		s = append(s, "Records: "+fmt.Sprintf("%#v", vs)+",\n")
 	}
-	return i, nil
+	s = append(s, "Drop: "+fmt.Sprintf("%#v", this.Drop)+",\n")
+	s = append(s, "Confirm: "+fmt.Sprintf("%#v", this.Confirm)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *Jet) Marshal() (dAtA []byte, err error) {
+func (this *GetJet) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.GetJet{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AbandonedRequestsNotification) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.AbandonedRequestsNotification{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetLightInitialState) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.GetLightInitialState{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LightInitialState) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&payload.LightInitialState{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "NetworkStart: "+fmt.Sprintf("%#v", this.NetworkStart)+",\n")
+	s = append(s, "JetIDs: "+fmt.Sprintf("%#v", this.JetIDs)+",\n")
+	s = append(s, "Drops: "+fmt.Sprintf("%#v", this.Drops)+",\n")
+	if this.Indices != nil {
+		vs := make([]*record.Index, len(this.Indices))
+		for i := range vs {
+			vs[i] = &this.Indices[i]
+		}
+		s = append(s, "Indices: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	s = append(s, "Pulse: "+strings.Replace(this.Pulse.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetIndex) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.GetIndex{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "ObjectID: "+fmt.Sprintf("%#v", this.ObjectID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateJet) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.UpdateJet{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
+	s = append(s, "JetID: "+fmt.Sprintf("%#v", this.JetID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetPulseReplica) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.GetPulseReplica{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PulseReplica) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&payload.PulseReplica{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
+	s = append(s, "Drops: "+fmt.Sprintf("%#v", this.Drops)+",\n")
+	if this.Indexes != nil {
+		vs := make([]*record.Index, len(this.Indexes))
+		for i := range vs {
+			vs[i] = &this.Indexes[i]
+		}
+		s = append(s, "Indexes: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	if this.Records != nil {
+		vs := make([]*record.Material, len(this.Records))
+		for i := range vs {
+			vs[i] = &this.Records[i]
+		}
+		s = append(s, "Records: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Validate) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&payload.Validate{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Object: "+fmt.Sprintf("%#v", this.Object)+",\n")
+	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
+	if this.Transcripts != nil {
+		vs := make([]*ValidationTranscript, len(this.Transcripts))
+		for i := range vs {
+			vs[i] = &this.Transcripts[i]
+		}
+		s = append(s, "Transcripts: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidationTranscript) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.ValidationTranscript{")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	if this.Outgoings != nil {
+		vs := make([]*ValidationOutgoing, len(this.Outgoings))
+		for i := range vs {
+			vs[i] = &this.Outgoings[i]
+		}
+		s = append(s, "Outgoings: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidationOutgoing) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&payload.ValidationOutgoing{")
+	s = append(s, "Request: "+strings.Replace(this.Request.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "Response: "+fmt.Sprintf("%#v", this.Response)+",\n")
+	s = append(s, "NewObject: "+fmt.Sprintf("%#v", this.NewObject)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidationMismatch) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&payload.ValidationMismatch{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Object: "+fmt.Sprintf("%#v", this.Object)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
+	s = append(s, "Executor: "+fmt.Sprintf("%#v", this.Executor)+",\n")
+	s = append(s, "Expected: "+fmt.Sprintf("%#v", this.Expected)+",\n")
+	s = append(s, "Actual: "+fmt.Sprintf("%#v", this.Actual)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "Validator: "+fmt.Sprintf("%#v", this.Validator)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Chunk) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&payload.Chunk{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "StreamID: "+fmt.Sprintf("%#v", this.StreamID)+",\n")
+	s = append(s, "TotalSize: "+fmt.Sprintf("%#v", this.TotalSize)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ChunkAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.ChunkAck{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "StreamID: "+fmt.Sprintf("%#v", this.StreamID)+",\n")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SagaState) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&payload.SagaState{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	s = append(s, "Rollback: "+fmt.Sprintf("%#v", this.Rollback)+",\n")
+	s = append(s, "Method: "+fmt.Sprintf("%#v", this.Method)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "Attempts: "+fmt.Sprintf("%#v", this.Attempts)+",\n")
+	s = append(s, "RetryIn: "+fmt.Sprintf("%#v", this.RetryIn)+",\n")
+	s = append(s, "LastError: "+fmt.Sprintf("%#v", this.LastError)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SagaStates) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&payload.SagaStates{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Object: "+fmt.Sprintf("%#v", this.Object)+",\n")
+	if this.Sagas != nil {
+		vs := make([]*SagaState, len(this.Sagas))
+		for i := range vs {
+			vs[i] = &this.Sagas[i]
+		}
+		s = append(s, "Sagas: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetSagaStates) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&payload.GetSagaStates{")
+	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
+	s = append(s, "Object: "+fmt.Sprintf("%#v", this.Object)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringPayload(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *Meta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5185,7 +6078,7 @@ func (m *Jet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Jet) MarshalTo(dAtA []byte) (int, error) {
+func (m *Meta) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5197,32 +6090,106 @@ func (m *Jet) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	dAtA[i] = 0xa2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.JetID.Size()))
-	n10, err := m.JetID.MarshalTo(dAtA[i:])
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Sender.Size()))
+	n1, err := m.Sender.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
-	if m.Actual {
-		dAtA[i] = 0xa8
+	i += n1
+	dAtA[i] = 0xb2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Receiver.Size()))
+	n2, err := m.Receiver.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	dAtA[i] = 0xba
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
+	n3, err := m.Pulse.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xc2
 		i++
 		dAtA[i] = 0x1
 		i++
-		if m.Actual {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	dAtA[i] = 0xca
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.OriginHash.Size()))
+	n4, err := m.OriginHash.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	return i, nil
+}
+
+func (m *Error) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Error) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+	}
+	if m.Code != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
 		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Text) > 0 {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Text)))
+		i += copy(dAtA[i:], m.Text)
 	}
 	return i, nil
 }
 
-func (m *SetIncomingRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5232,7 +6199,7 @@ func (m *SetIncomingRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetIncomingRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetObject) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5248,16 +6215,36 @@ func (m *SetIncomingRequest) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
-	n11, err := m.Request.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n5, err := m.ObjectID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n5
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectRequestID.Size()))
+	n6, err := m.ObjectRequestID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n6
+	dAtA[i] = 0xb2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.StateID.Size()))
+	n7, err := m.StateID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
 	return i, nil
 }
 
-func (m *SetOutgoingRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetCode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5267,7 +6254,7 @@ func (m *SetOutgoingRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetOutgoingRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetCode) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5283,16 +6270,16 @@ func (m *SetOutgoingRequest) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
-	n12, err := m.Request.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.CodeID.Size()))
+	n8, err := m.CodeID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n8
 	return i, nil
 }
 
-func (m *SagaCallAcceptNotification) Marshal() (dAtA []byte, err error) {
+func (m *PassState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5302,7 +6289,7 @@ func (m *SagaCallAcceptNotification) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SagaCallAcceptNotification) MarshalTo(dAtA []byte) (int, error) {
+func (m *PassState) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5314,38 +6301,28 @@ func (m *SagaCallAcceptNotification) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	dAtA[i] = 0xa2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n13, err := m.ObjectID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.Origin) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Origin)))
+		i += copy(dAtA[i:], m.Origin)
 	}
-	i += n13
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.DetachedRequestID.Size()))
-	n14, err := m.DetachedRequestID.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.StateID.Size()))
+	n9, err := m.StateID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
-	if len(m.Request) > 0 {
-		dAtA[i] = 0xb2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Request)))
-		i += copy(dAtA[i:], m.Request)
-	}
+	i += n9
 	return i, nil
 }
 
-func (m *SetResult) Marshal() (dAtA []byte, err error) {
+func (m *Pass) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5355,7 +6332,7 @@ func (m *SetResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *Pass) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5367,18 +6344,18 @@ func (m *SetResult) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	if len(m.Result) > 0 {
+	if len(m.Origin) > 0 {
 		dAtA[i] = 0xa2
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
-		i += copy(dAtA[i:], m.Result)
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Origin)))
+		i += copy(dAtA[i:], m.Origin)
 	}
 	return i, nil
 }
 
-func (m *Activate) Marshal() (dAtA []byte, err error) {
+func (m *SetCode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5388,7 +6365,7 @@ func (m *Activate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Activate) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetCode) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5408,18 +6385,43 @@ func (m *Activate) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPayload(dAtA, i, uint64(len(m.Record)))
 		i += copy(dAtA[i:], m.Record)
 	}
-	if len(m.Result) > 0 {
-		dAtA[i] = 0xaa
+	return i, nil
+}
+
+func (m *Index) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Index) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
-		i += copy(dAtA[i:], m.Result)
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+	}
+	if len(m.Index) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Index)))
+		i += copy(dAtA[i:], m.Index)
 	}
 	return i, nil
 }
 
-func (m *Deactivate) Marshal() (dAtA []byte, err error) {
+func (m *Code) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5429,7 +6431,7 @@ func (m *Deactivate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Deactivate) MarshalTo(dAtA []byte) (int, error) {
+func (m *Code) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5449,18 +6451,10 @@ func (m *Deactivate) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPayload(dAtA, i, uint64(len(m.Record)))
 		i += copy(dAtA[i:], m.Record)
 	}
-	if len(m.Result) > 0 {
-		dAtA[i] = 0xaa
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
-		i += copy(dAtA[i:], m.Result)
-	}
 	return i, nil
 }
 
-func (m *Update) Marshal() (dAtA []byte, err error) {
+func (m *State) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5470,7 +6464,7 @@ func (m *Update) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Update) MarshalTo(dAtA []byte) (int, error) {
+func (m *State) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5490,18 +6484,18 @@ func (m *Update) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPayload(dAtA, i, uint64(len(m.Record)))
 		i += copy(dAtA[i:], m.Record)
 	}
-	if len(m.Result) > 0 {
+	if len(m.Memory) > 0 {
 		dAtA[i] = 0xaa
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
-		i += copy(dAtA[i:], m.Result)
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Memory)))
+		i += copy(dAtA[i:], m.Memory)
 	}
 	return i, nil
 }
 
-func (m *GetFilament) Marshal() (dAtA []byte, err error) {
+func (m *ID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5511,7 +6505,7 @@ func (m *GetFilament) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetFilament) MarshalTo(dAtA []byte) (int, error) {
+func (m *ID) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5527,46 +6521,26 @@ func (m *GetFilament) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n15, err := m.ObjectID.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.ID.Size()))
+	n10, err := m.ID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
-	dAtA[i] = 0xaa
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.StartFrom.Size()))
-	n16, err := m.StartFrom.MarshalTo(dAtA[i:])
+	i += n10
+	return i, nil
+}
+
+func (m *IDs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
 	if err != nil {
-		return 0, err
-	}
-	i += n16
-	dAtA[i] = 0xb2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ReadUntil.Size()))
-	n17, err := m.ReadUntil.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n17
-	return i, nil
-}
-
-func (m *FilamentSegment) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *FilamentSegment) MarshalTo(dAtA []byte) (int, error) {
+func (m *IDs) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5578,19 +6552,9 @@ func (m *FilamentSegment) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	dAtA[i] = 0xa2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n18, err := m.ObjectID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n18
-	if len(m.Records) > 0 {
-		for _, msg := range m.Records {
-			dAtA[i] = 0xaa
+	if len(m.IDs) > 0 {
+		for _, msg := range m.IDs {
+			dAtA[i] = 0xa2
 			i++
 			dAtA[i] = 0x1
 			i++
@@ -5605,7 +6569,7 @@ func (m *FilamentSegment) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RequestInfo) Marshal() (dAtA []byte, err error) {
+func (m *Jet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5615,7 +6579,7 @@ func (m *RequestInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RequestInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *Jet) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5631,42 +6595,28 @@ func (m *RequestInfo) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n19, err := m.ObjectID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n19
-	dAtA[i] = 0xaa
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.RequestID.Size()))
-	n20, err := m.RequestID.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.JetID.Size()))
+	n11, err := m.JetID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
-	if len(m.Request) > 0 {
-		dAtA[i] = 0xb2
+	i += n11
+	if m.Actual {
+		dAtA[i] = 0xa8
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Request)))
-		i += copy(dAtA[i:], m.Request)
-	}
-	if len(m.Result) > 0 {
-		dAtA[i] = 0xba
-		i++
-		dAtA[i] = 0x1
+		if m.Actual {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
-		i += copy(dAtA[i:], m.Result)
 	}
 	return i, nil
 }
 
-func (m *GotHotConfirmation) Marshal() (dAtA []byte, err error) {
+func (m *SetIncomingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5676,7 +6626,7 @@ func (m *GotHotConfirmation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GotHotConfirmation) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetIncomingRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5692,38 +6642,16 @@ func (m *GotHotConfirmation) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.JetID.Size()))
-	n21, err := m.JetID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n21
-	dAtA[i] = 0xaa
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
-	n22, err := m.Pulse.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
+	n12, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
-	if m.Split {
-		dAtA[i] = 0xb0
-		i++
-		dAtA[i] = 0x1
-		i++
-		if m.Split {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
+	i += n12
 	return i, nil
 }
 
-func (m *ResultInfo) Marshal() (dAtA []byte, err error) {
+func (m *SetOutgoingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5733,7 +6661,7 @@ func (m *ResultInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResultInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetOutgoingRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5749,34 +6677,16 @@ func (m *ResultInfo) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n23, err := m.ObjectID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n23
-	dAtA[i] = 0xaa
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ResultID.Size()))
-	n24, err := m.ResultID.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
+	n13, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
-	if len(m.Result) > 0 {
-		dAtA[i] = 0xb2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
-		i += copy(dAtA[i:], m.Result)
-	}
+	i += n13
 	return i, nil
 }
 
-func (m *HotObjects) Marshal() (dAtA []byte, err error) {
+func (m *SagaCallAcceptNotification) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5786,7 +6696,7 @@ func (m *HotObjects) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HotObjects) MarshalTo(dAtA []byte) (int, error) {
+func (m *SagaCallAcceptNotification) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5802,48 +6712,34 @@ func (m *HotObjects) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.JetID.Size()))
-	n25, err := m.JetID.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n14, err := m.ObjectID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
-	if len(m.Drop) > 0 {
-		dAtA[i] = 0xaa
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Drop)))
-		i += copy(dAtA[i:], m.Drop)
-	}
-	dAtA[i] = 0xb2
+	i += n14
+	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
-	n26, err := m.Pulse.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.DetachedRequestID.Size()))
+	n15, err := m.DetachedRequestID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
-	if len(m.Indexes) > 0 {
-		for _, msg := range m.Indexes {
-			dAtA[i] = 0xba
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	i += n15
+	if len(m.Request) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Request)))
+		i += copy(dAtA[i:], m.Request)
 	}
 	return i, nil
 }
 
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
+func (m *SetResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5853,7 +6749,7 @@ func (m *GetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetResult) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5865,30 +6761,18 @@ func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	dAtA[i] = 0xa2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n27, err := m.ObjectID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n27
-	dAtA[i] = 0xaa
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.RequestID.Size()))
-	n28, err := m.RequestID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.Result) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
+		i += copy(dAtA[i:], m.Result)
 	}
-	i += n28
 	return i, nil
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
+func (m *Activate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5898,7 +6782,7 @@ func (m *Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *Activate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5910,30 +6794,26 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	dAtA[i] = 0xa2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.RequestID.Size()))
-	n29, err := m.RequestID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.Record) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Record)))
+		i += copy(dAtA[i:], m.Record)
 	}
-	i += n29
-	dAtA[i] = 0xaa
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
-	n30, err := m.Request.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.Result) > 0 {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
+		i += copy(dAtA[i:], m.Result)
 	}
-	i += n30
 	return i, nil
 }
 
-func (m *ServiceData) Marshal() (dAtA []byte, err error) {
+func (m *Deactivate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5943,7 +6823,7 @@ func (m *ServiceData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ServiceData) MarshalTo(dAtA []byte) (int, error) {
+func (m *Deactivate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5955,33 +6835,26 @@ func (m *ServiceData) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	if len(m.LogTraceID) > 0 {
+	if len(m.Record) > 0 {
 		dAtA[i] = 0xa2
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.LogTraceID)))
-		i += copy(dAtA[i:], m.LogTraceID)
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Record)))
+		i += copy(dAtA[i:], m.Record)
 	}
-	if m.LogLevel != 0 {
-		dAtA[i] = 0xa8
+	if len(m.Result) > 0 {
+		dAtA[i] = 0xaa
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.LogLevel))
-	}
-	if len(m.TraceSpanData) > 0 {
-		dAtA[i] = 0xb2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.TraceSpanData)))
-		i += copy(dAtA[i:], m.TraceSpanData)
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
+		i += copy(dAtA[i:], m.Result)
 	}
 	return i, nil
 }
 
-func (m *ExecutionQueueElement) Marshal() (dAtA []byte, err error) {
+func (m *Update) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5991,7 +6864,7 @@ func (m *ExecutionQueueElement) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExecutionQueueElement) MarshalTo(dAtA []byte) (int, error) {
+func (m *Update) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6003,44 +6876,26 @@ func (m *ExecutionQueueElement) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	dAtA[i] = 0xa2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.RequestRef.Size()))
-	n31, err := m.RequestRef.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n31
-	if m.Incoming != nil {
-		dAtA[i] = 0xaa
+	if len(m.Record) > 0 {
+		dAtA[i] = 0xa2
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Incoming.Size()))
-		n32, err := m.Incoming.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n32
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Record)))
+		i += copy(dAtA[i:], m.Record)
 	}
-	if m.ServiceData != nil {
-		dAtA[i] = 0xb2
+	if len(m.Result) > 0 {
+		dAtA[i] = 0xaa
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.ServiceData.Size()))
-		n33, err := m.ServiceData.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n33
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
+		i += copy(dAtA[i:], m.Result)
 	}
 	return i, nil
 }
 
-func (m *ReturnResults) Marshal() (dAtA []byte, err error) {
+func (m *GetFilament) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6050,7 +6905,7 @@ func (m *ReturnResults) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReturnResults) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetFilament) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6066,42 +6921,36 @@ func (m *ReturnResults) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.Target.Size()))
-	n34, err := m.Target.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n16, err := m.ObjectID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n34
+	i += n16
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.RequestRef.Size()))
-	n35, err := m.RequestRef.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.StartFrom.Size()))
+	n17, err := m.StartFrom.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n35
-	if len(m.Reply) > 0 {
-		dAtA[i] = 0xb2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Reply)))
-		i += copy(dAtA[i:], m.Reply)
-	}
-	if len(m.Error) > 0 {
-		dAtA[i] = 0xba
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Error)))
-		i += copy(dAtA[i:], m.Error)
+	i += n17
+	dAtA[i] = 0xb2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ReadUntil.Size()))
+	n18, err := m.ReadUntil.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n18
 	return i, nil
 }
 
-func (m *CallMethod) Marshal() (dAtA []byte, err error) {
+func (m *FilamentSegment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6111,7 +6960,7 @@ func (m *CallMethod) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CallMethod) MarshalTo(dAtA []byte) (int, error) {
+func (m *FilamentSegment) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6123,29 +6972,34 @@ func (m *CallMethod) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	if m.Request != nil {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
-		n36, err := m.Request.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n36
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n19, err := m.ObjectID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.PulseNumber != 0 {
-		dAtA[i] = 0xa8
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.PulseNumber))
+	i += n19
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
 
-func (m *ExecutorResults) Marshal() (dAtA []byte, err error) {
+func (m *RequestInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6155,7 +7009,7 @@ func (m *ExecutorResults) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExecutorResults) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6171,59 +7025,42 @@ func (m *ExecutorResults) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.Caller.Size()))
-	n37, err := m.Caller.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n20, err := m.ObjectID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n37
+	i += n20
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.RecordRef.Size()))
-	n38, err := m.RecordRef.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.RequestID.Size()))
+	n21, err := m.RequestID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n38
-	if len(m.Queue) > 0 {
-		for _, msg := range m.Queue {
-			dAtA[i] = 0xb2
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.LedgerHasMoreRequests {
-		dAtA[i] = 0xb8
+	i += n21
+	if len(m.Request) > 0 {
+		dAtA[i] = 0xb2
 		i++
 		dAtA[i] = 0x1
 		i++
-		if m.LedgerHasMoreRequests {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Request)))
+		i += copy(dAtA[i:], m.Request)
 	}
-	if m.Pending != 0 {
-		dAtA[i] = 0xc0
+	if len(m.Result) > 0 {
+		dAtA[i] = 0xba
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Pending))
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
+		i += copy(dAtA[i:], m.Result)
 	}
 	return i, nil
 }
 
-func (m *PendingFinished) Marshal() (dAtA []byte, err error) {
+func (m *GetResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6233,7 +7070,7 @@ func (m *PendingFinished) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PendingFinished) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetResult) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6249,16 +7086,26 @@ func (m *PendingFinished) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectRef.Size()))
-	n39, err := m.ObjectRef.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n22, err := m.ObjectID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n22
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.RequestID.Size()))
+	n23, err := m.RequestID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n39
+	i += n23
 	return i, nil
 }
 
-func (m *AdditionalCallFromPreviousExecutor) Marshal() (dAtA []byte, err error) {
+func (m *GotHotConfirmation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6268,7 +7115,7 @@ func (m *AdditionalCallFromPreviousExecutor) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *AdditionalCallFromPreviousExecutor) MarshalTo(dAtA []byte) (int, error) {
+func (m *GotHotConfirmation) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6284,57 +7131,38 @@ func (m *AdditionalCallFromPreviousExecutor) MarshalTo(dAtA []byte) (int, error)
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectReference.Size()))
-	n40, err := m.ObjectReference.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.JetID.Size()))
+	n24, err := m.JetID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
-	if m.Pending != 0 {
-		dAtA[i] = 0xa8
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Pending))
-	}
-	dAtA[i] = 0xb2
+	i += n24
+	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.RequestRef.Size()))
-	n41, err := m.RequestRef.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
+	n25, err := m.Pulse.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n41
-	if m.Request != nil {
-		dAtA[i] = 0xba
+	i += n25
+	if m.Split {
+		dAtA[i] = 0xb0
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
-		n42, err := m.Request.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		if m.Split {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n42
-	}
-	if m.ServiceData != nil {
-		dAtA[i] = 0xc2
 		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.ServiceData.Size()))
-		n43, err := m.ServiceData.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n43
 	}
 	return i, nil
 }
 
-func (m *StillExecuting) Marshal() (dAtA []byte, err error) {
+func (m *ResultInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6344,7 +7172,7 @@ func (m *StillExecuting) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StillExecuting) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResultInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6360,16 +7188,34 @@ func (m *StillExecuting) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectRef.Size()))
-	n44, err := m.ObjectRef.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n26, err := m.ObjectID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n44
+	i += n26
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ResultID.Size()))
+	n27, err := m.ResultID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n27
+	if len(m.Result) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Result)))
+		i += copy(dAtA[i:], m.Result)
+	}
 	return i, nil
 }
 
-func (m *GetPendings) Marshal() (dAtA []byte, err error) {
+func (m *HotObjects) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6379,7 +7225,7 @@ func (m *GetPendings) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetPendings) MarshalTo(dAtA []byte) (int, error) {
+func (m *HotObjects) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6395,51 +7241,48 @@ func (m *GetPendings) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n45, err := m.ObjectID.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.JetID.Size()))
+	n28, err := m.JetID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n45
-	return i, nil
-}
-
-func (m *HasPendings) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *HasPendings) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		dAtA[i] = 0x80
+	i += n28
+	if len(m.Drop) > 0 {
+		dAtA[i] = 0xaa
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Drop)))
+		i += copy(dAtA[i:], m.Drop)
 	}
-	dAtA[i] = 0xa2
+	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n46, err := m.ObjectID.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
+	n29, err := m.Pulse.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n46
+	i += n29
+	if len(m.Indexes) > 0 {
+		for _, msg := range m.Indexes {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func (m *PendingsInfo) Marshal() (dAtA []byte, err error) {
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6449,7 +7292,7 @@ func (m *PendingsInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PendingsInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6461,22 +7304,30 @@ func (m *PendingsInfo) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	if m.HasPendings {
-		dAtA[i] = 0xa0
-		i++
-		dAtA[i] = 0x1
-		i++
-		if m.HasPendings {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n30, err := m.ObjectID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n30
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.RequestID.Size()))
+	n31, err := m.RequestID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n31
 	return i, nil
 }
 
-func (m *Replication) Marshal() (dAtA []byte, err error) {
+func (m *Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6486,7 +7337,7 @@ func (m *Replication) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Replication) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6502,62 +7353,26 @@ func (m *Replication) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.JetID.Size()))
-	n47, err := m.JetID.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.RequestID.Size()))
+	n32, err := m.RequestID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n47
+	i += n32
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
-	n48, err := m.Pulse.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
+	n33, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n48
-	if len(m.Indexes) > 0 {
-		for _, msg := range m.Indexes {
-			dAtA[i] = 0xb2
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Records) > 0 {
-		for _, msg := range m.Records {
-			dAtA[i] = 0xba
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Drop) > 0 {
-		dAtA[i] = 0xc2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintPayload(dAtA, i, uint64(len(m.Drop)))
-		i += copy(dAtA[i:], m.Drop)
-	}
+	i += n33
 	return i, nil
 }
 
-func (m *GetJet) Marshal() (dAtA []byte, err error) {
+func (m *ServiceData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6567,7 +7382,7 @@ func (m *GetJet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetJet) MarshalTo(dAtA []byte) (int, error) {
+func (m *ServiceData) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6579,27 +7394,33 @@ func (m *GetJet) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	dAtA[i] = 0xa2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n49, err := m.ObjectID.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.LogTraceID) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.LogTraceID)))
+		i += copy(dAtA[i:], m.LogTraceID)
 	}
-	i += n49
-	if m.PulseNumber != 0 {
+	if m.LogLevel != 0 {
 		dAtA[i] = 0xa8
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintPayload(dAtA, i, uint64(m.PulseNumber))
+		i = encodeVarintPayload(dAtA, i, uint64(m.LogLevel))
+	}
+	if len(m.TraceSpanData) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.TraceSpanData)))
+		i += copy(dAtA[i:], m.TraceSpanData)
 	}
 	return i, nil
 }
 
-func (m *AbandonedRequestsNotification) Marshal() (dAtA []byte, err error) {
+func (m *ExecutionQueueElement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6609,7 +7430,7 @@ func (m *AbandonedRequestsNotification) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AbandonedRequestsNotification) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecutionQueueElement) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6625,16 +7446,40 @@ func (m *AbandonedRequestsNotification) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n50, err := m.ObjectID.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.RequestRef.Size()))
+	n34, err := m.RequestRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n50
+	i += n34
+	if m.Incoming != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Incoming.Size()))
+		n35, err := m.Incoming.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	if m.ServiceData != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.ServiceData.Size()))
+		n36, err := m.ServiceData.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
 	return i, nil
 }
 
-func (m *GetLightInitialState) Marshal() (dAtA []byte, err error) {
+func (m *ReturnResults) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6644,7 +7489,7 @@ func (m *GetLightInitialState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetLightInitialState) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReturnResults) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6656,20 +7501,46 @@ func (m *GetLightInitialState) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Target.Size()))
+	n37, err := m.Target.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n37
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
-	n51, err := m.Pulse.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.RequestRef.Size()))
+	n38, err := m.RequestRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n51
+	i += n38
+	if len(m.Reply) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Reply)))
+		i += copy(dAtA[i:], m.Reply)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
 	return i, nil
 }
 
-func (m *LightInitialState) Marshal() (dAtA []byte, err error) {
+func (m *CallMethod) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6679,7 +7550,7 @@ func (m *LightInitialState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LightInitialState) MarshalTo(dAtA []byte) (int, error) {
+func (m *CallMethod) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6691,45 +7562,73 @@ func (m *LightInitialState) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	if m.NetworkStart {
-		dAtA[i] = 0xa0
+	if m.Request != nil {
+		dAtA[i] = 0xa2
 		i++
 		dAtA[i] = 0x1
 		i++
-		if m.NetworkStart {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
+		n39, err := m.Request.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		i++
+		i += n39
 	}
-	if len(m.JetIDs) > 0 {
-		for _, msg := range m.JetIDs {
-			dAtA[i] = 0xaa
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.PulseNumber != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.PulseNumber))
 	}
-	if len(m.Drops) > 0 {
-		for _, b := range m.Drops {
-			dAtA[i] = 0xb2
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPayload(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	return i, nil
+}
+
+func (m *ExecutorResults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Indices) > 0 {
-		for _, msg := range m.Indices {
-			dAtA[i] = 0xba
+	return dAtA[:n], nil
+}
+
+func (m *ExecutorResults) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
+	}
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Caller.Size()))
+	n40, err := m.Caller.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n40
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.RecordRef.Size()))
+	n41, err := m.RecordRef.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n41
+	if len(m.Queue) > 0 {
+		for _, msg := range m.Queue {
+			dAtA[i] = 0xb2
 			i++
 			dAtA[i] = 0x1
 			i++
@@ -6741,20 +7640,29 @@ func (m *LightInitialState) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	dAtA[i] = 0xc2
-	i++
-	dAtA[i] = 0x1
-	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
-	n52, err := m.Pulse.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.LedgerHasMoreRequests {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.LedgerHasMoreRequests {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Pending != 0 {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Pending))
 	}
-	i += n52
 	return i, nil
 }
 
-func (m *GetIndex) Marshal() (dAtA []byte, err error) {
+func (m *PendingFinished) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6764,7 +7672,7 @@ func (m *GetIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingFinished) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6780,16 +7688,16 @@ func (m *GetIndex) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
-	n53, err := m.ObjectID.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectRef.Size()))
+	n42, err := m.ObjectRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n53
+	i += n42
 	return i, nil
 }
 
-func (m *UpdateJet) Marshal() (dAtA []byte, err error) {
+func (m *AdditionalCallFromPreviousExecutor) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6799,7 +7707,7 @@ func (m *UpdateJet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateJet) MarshalTo(dAtA []byte) (int, error) {
+func (m *AdditionalCallFromPreviousExecutor) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6815,662 +7723,1203 @@ func (m *UpdateJet) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
-	n54, err := m.Pulse.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectReference.Size()))
+	n43, err := m.ObjectReference.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n54
-	dAtA[i] = 0xaa
+	i += n43
+	if m.Pending != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Pending))
+	}
+	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
-	i = encodeVarintPayload(dAtA, i, uint64(m.JetID.Size()))
-	n55, err := m.JetID.MarshalTo(dAtA[i:])
+	i = encodeVarintPayload(dAtA, i, uint64(m.RequestRef.Size()))
+	n44, err := m.RequestRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n55
+	i += n44
+	if m.Request != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
+		n45, err := m.Request.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
+	}
+	if m.ServiceData != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.ServiceData.Size()))
+		n46, err := m.ServiceData.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
+	}
 	return i, nil
 }
 
-func encodeVarintPayload(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StillExecuting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Meta) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *StillExecuting) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
-	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = m.Sender.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.Receiver.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.Pulse.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = len(m.ID)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectRef.Size()))
+	n47, err := m.ObjectRef.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = m.OriginHash.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	return n
+	i += n47
+	return i, nil
 }
 
-func (m *Error) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetPendings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPendings) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
-	}
-	if m.Code != 0 {
-		n += 2 + sovPayload(uint64(m.Code))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = len(m.Text)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n48, err := m.ObjectID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
+	i += n48
+	return i, nil
 }
 
-func (m *GetObject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HasPendings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HasPendings) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = m.ObjectID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.ObjectRequestID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	return n
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n49, err := m.ObjectID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n49
+	return i, nil
 }
 
-func (m *GetCode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingsInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingsInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = m.CodeID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	return n
+	if m.HasPendings {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.HasPendings {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
 }
 
-func (m *PassState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Replication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Replication) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = len(m.Origin)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.JetID.Size()))
+	n50, err := m.JetID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = m.StateID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	return n
-}
-
-func (m *Pass) Size() (n int) {
-	if m == nil {
-		return 0
+	i += n50
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
+	n51, err := m.Pulse.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+	i += n51
+	if len(m.Indexes) > 0 {
+		for _, msg := range m.Indexes {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Origin)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return n
+	if len(m.Drop) > 0 {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Drop)))
+		i += copy(dAtA[i:], m.Drop)
+	}
+	if m.Confirm {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Confirm {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
 }
 
-func (m *SetCode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetJet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetJet) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = len(m.Record)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n52, err := m.ObjectID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
+	i += n52
+	if m.PulseNumber != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.PulseNumber))
+	}
+	return i, nil
 }
 
-func (m *Index) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AbandonedRequestsNotification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AbandonedRequestsNotification) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n53, err := m.ObjectID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
+	i += n53
+	return i, nil
 }
 
-func (m *Code) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetLightInitialState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLightInitialState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = len(m.Record)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
+	n54, err := m.Pulse.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
+	i += n54
+	return i, nil
 }
 
-func (m *State) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LightInitialState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LightInitialState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = len(m.Record)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	if m.NetworkStart {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.NetworkStart {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	l = len(m.Memory)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	if len(m.JetIDs) > 0 {
+		for _, msg := range m.JetIDs {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return n
-}
-
-func (m *ID) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Drops) > 0 {
+		for _, b := range m.Drops {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+	if len(m.Indices) > 0 {
+		for _, msg := range m.Indices {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = m.ID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	return n
+	dAtA[i] = 0xc2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
+	n55, err := m.Pulse.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n55
+	return i, nil
 }
 
-func (m *IDs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
-	}
-	if len(m.IDs) > 0 {
-		for _, e := range m.IDs {
-			l = e.Size()
-			n += 2 + l + sovPayload(uint64(l))
-		}
+func (m *GetIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Jet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetIndex) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = m.JetID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	if m.Actual {
-		n += 3
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.ObjectID.Size()))
+	n56, err := m.ObjectID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
+	i += n56
+	return i, nil
 }
 
-func (m *SetIncomingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+func (m *UpdateJet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Request.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SetOutgoingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UpdateJet) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
-	}
-	l = m.Request.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	return n
-}
-
-func (m *SagaCallAcceptNotification) Size() (n int) {
-	if m == nil {
-		return 0
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
+	n57, err := m.Pulse.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = m.ObjectID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.DetachedRequestID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = len(m.Request)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	i += n57
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.JetID.Size()))
+	n58, err := m.JetID.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
+	i += n58
+	return i, nil
 }
 
-func (m *SetResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
-	}
-	l = len(m.Result)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+func (m *GetPulseReplica) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Activate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetPulseReplica) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
-	}
-	l = len(m.Record)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = len(m.Result)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
+	n59, err := m.Pulse.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
+	i += n59
+	return i, nil
 }
 
-func (m *Deactivate) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PulseReplica) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PulseReplica) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = len(m.Record)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
+	n60, err := m.Pulse.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = len(m.Result)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	i += n60
+	if len(m.Drops) > 0 {
+		for _, b := range m.Drops {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
-	return n
+	if len(m.Indexes) > 0 {
+		for _, msg := range m.Indexes {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
 }
 
-func (m *Update) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Validate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Validate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = len(m.Record)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Object.Size()))
+	n61, err := m.Object.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = len(m.Result)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	i += n61
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
+	n62, err := m.Pulse.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
+	i += n62
+	if len(m.Transcripts) > 0 {
+		for _, msg := range m.Transcripts {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
 }
 
-func (m *GetFilament) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+func (m *ValidationTranscript) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = m.ObjectID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.StartFrom.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.ReadUntil.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FilamentSegment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidationTranscript) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
+	n63, err := m.Request.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = m.ObjectID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 2 + l + sovPayload(uint64(l))
+	i += n63
+	if len(m.Outgoings) > 0 {
+		for _, msg := range m.Outgoings {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *RequestInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
-	}
-	l = m.ObjectID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.RequestID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = len(m.Request)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
-	}
-	l = len(m.Result)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+func (m *ValidationOutgoing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GotHotConfirmation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidationOutgoing) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
-	}
-	l = m.JetID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.Pulse.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	if m.Split {
-		n += 3
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
+	n64, err := m.Request.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
-}
-
-func (m *ResultInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	i += n64
+	if len(m.Response) > 0 {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Response)))
+		i += copy(dAtA[i:], m.Response)
 	}
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+	if m.NewObject != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.NewObject.Size()))
+		n65, err := m.NewObject.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n65
 	}
-	l = m.ObjectID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.ResultID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = len(m.Result)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	if len(m.Error) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
 	}
-	return n
+	return i, nil
 }
 
-func (m *HotObjects) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidationMismatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidationMismatch) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = m.JetID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = len(m.Drop)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Object.Size()))
+	n66, err := m.Object.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = m.Pulse.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	if len(m.Indexes) > 0 {
-		for _, e := range m.Indexes {
-			l = e.Size()
-			n += 2 + l + sovPayload(uint64(l))
-		}
+	i += n66
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
+	n67, err := m.Request.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
-}
-
-func (m *GetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	i += n67
+	dAtA[i] = 0xb2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Pulse.Size()))
+	n68, err := m.Pulse.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+	i += n68
+	dAtA[i] = 0xba
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Executor.Size()))
+	n69, err := m.Executor.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = m.ObjectID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.RequestID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	return n
-}
-
-func (m *Request) Size() (n int) {
-	if m == nil {
-		return 0
+	i += n69
+	if len(m.Expected) > 0 {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Expected)))
+		i += copy(dAtA[i:], m.Expected)
 	}
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+	if len(m.Actual) > 0 {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Actual)))
+		i += copy(dAtA[i:], m.Actual)
 	}
-	l = m.RequestID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.Request.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	return n
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	dAtA[i] = 0xda
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Validator.Size()))
+	n70, err := m.Validator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n70
+	return i, nil
 }
 
-func (m *ServiceData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Chunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Chunk) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = len(m.LogTraceID)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	if len(m.StreamID) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.StreamID)))
+		i += copy(dAtA[i:], m.StreamID)
 	}
-	if m.LogLevel != 0 {
-		n += 2 + sovPayload(uint64(m.LogLevel))
+	if m.TotalSize != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.TotalSize))
 	}
-	l = len(m.TraceSpanData)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	if m.Offset != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Offset))
 	}
-	return n
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
 }
 
-func (m *ExecutionQueueElement) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChunkAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChunkAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = m.RequestRef.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	if m.Incoming != nil {
-		l = m.Incoming.Size()
-		n += 2 + l + sovPayload(uint64(l))
+	if len(m.StreamID) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.StreamID)))
+		i += copy(dAtA[i:], m.StreamID)
 	}
-	if m.ServiceData != nil {
-		l = m.ServiceData.Size()
-		n += 2 + l + sovPayload(uint64(l))
+	if m.Offset != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Offset))
 	}
-	return n
+	return i, nil
 }
 
-func (m *ReturnResults) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SagaState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SagaState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = m.Target.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.RequestRef.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = len(m.Reply)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Request.Size()))
+	n71, err := m.Request.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 2 + l + sovPayload(uint64(l))
+	i += n71
+	dAtA[i] = 0xaa
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Rollback.Size()))
+	n72, err := m.Rollback.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return n
-}
-
-func (m *CallMethod) Size() (n int) {
-	if m == nil {
-		return 0
+	i += n72
+	if len(m.Method) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.Method)))
+		i += copy(dAtA[i:], m.Method)
 	}
-	var l int
-	_ = l
-	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+	if m.Status != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Status))
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovPayload(uint64(l))
+	if m.Attempts != 0 {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Attempts))
 	}
-	if m.PulseNumber != 0 {
-		n += 2 + sovPayload(uint64(m.PulseNumber))
+	if m.RetryIn != 0 {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.RetryIn))
 	}
-	return n
+	if len(m.LastError) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(len(m.LastError)))
+		i += copy(dAtA[i:], m.LastError)
+	}
+	return i, nil
 }
 
-func (m *ExecutorResults) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SagaStates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SagaStates) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Polymorph != 0 {
-		n += 2 + sovPayload(uint64(m.Polymorph))
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	l = m.Caller.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.RecordRef.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	if len(m.Queue) > 0 {
-		for _, e := range m.Queue {
-			l = e.Size()
-			n += 2 + l + sovPayload(uint64(l))
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Object.Size()))
+	n73, err := m.Object.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n73
+	if len(m.Sagas) > 0 {
+		for _, msg := range m.Sagas {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.LedgerHasMoreRequests {
-		n += 3
+	return i, nil
+}
+
+func (m *GetSagaStates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pending != 0 {
-		n += 2 + sovPayload(uint64(m.Pending))
+	return dAtA[:n], nil
+}
+
+func (m *GetSagaStates) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Polymorph))
 	}
-	return n
+	dAtA[i] = 0xa2
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintPayload(dAtA, i, uint64(m.Object.Size()))
+	n74, err := m.Object.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n74
+	return i, nil
 }
 
-func (m *PendingFinished) Size() (n int) {
+func encodeVarintPayload(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Meta) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7479,12 +8928,26 @@ func (m *PendingFinished) Size() (n int) {
 	if m.Polymorph != 0 {
 		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	l = m.ObjectRef.Size()
+	l = len(m.Payload)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	l = m.Sender.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.Receiver.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.Pulse.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = len(m.ID)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	l = m.OriginHash.Size()
 	n += 2 + l + sovPayload(uint64(l))
 	return n
 }
 
-func (m *AdditionalCallFromPreviousExecutor) Size() (n int) {
+func (m *Error) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7493,25 +8956,17 @@ func (m *AdditionalCallFromPreviousExecutor) Size() (n int) {
 	if m.Polymorph != 0 {
 		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	l = m.ObjectReference.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	if m.Pending != 0 {
-		n += 2 + sovPayload(uint64(m.Pending))
-	}
-	l = m.RequestRef.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 2 + l + sovPayload(uint64(l))
+	if m.Code != 0 {
+		n += 2 + sovPayload(uint64(m.Code))
 	}
-	if m.ServiceData != nil {
-		l = m.ServiceData.Size()
+	l = len(m.Text)
+	if l > 0 {
 		n += 2 + l + sovPayload(uint64(l))
 	}
 	return n
 }
 
-func (m *StillExecuting) Size() (n int) {
+func (m *GetObject) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7520,12 +8975,16 @@ func (m *StillExecuting) Size() (n int) {
 	if m.Polymorph != 0 {
 		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	l = m.ObjectRef.Size()
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.ObjectRequestID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.StateID.Size()
 	n += 2 + l + sovPayload(uint64(l))
 	return n
 }
 
-func (m *GetPendings) Size() (n int) {
+func (m *GetCode) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7534,12 +8993,12 @@ func (m *GetPendings) Size() (n int) {
 	if m.Polymorph != 0 {
 		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	l = m.ObjectID.Size()
+	l = m.CodeID.Size()
 	n += 2 + l + sovPayload(uint64(l))
 	return n
 }
 
-func (m *HasPendings) Size() (n int) {
+func (m *PassState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7548,12 +9007,16 @@ func (m *HasPendings) Size() (n int) {
 	if m.Polymorph != 0 {
 		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	l = m.ObjectID.Size()
+	l = len(m.Origin)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	l = m.StateID.Size()
 	n += 2 + l + sovPayload(uint64(l))
 	return n
 }
 
-func (m *PendingsInfo) Size() (n int) {
+func (m *Pass) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7562,13 +9025,14 @@ func (m *PendingsInfo) Size() (n int) {
 	if m.Polymorph != 0 {
 		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	if m.HasPendings {
-		n += 3
+	l = len(m.Origin)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
 	return n
 }
 
-func (m *Replication) Size() (n int) {
+func (m *SetCode) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7577,30 +9041,30 @@ func (m *Replication) Size() (n int) {
 	if m.Polymorph != 0 {
 		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	l = m.JetID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.Pulse.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	if len(m.Indexes) > 0 {
-		for _, e := range m.Indexes {
-			l = e.Size()
-			n += 2 + l + sovPayload(uint64(l))
-		}
+	l = len(m.Record)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 2 + l + sovPayload(uint64(l))
-		}
+	return n
+}
+
+func (m *Index) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.Drop)
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = len(m.Index)
 	if l > 0 {
 		n += 2 + l + sovPayload(uint64(l))
 	}
 	return n
 }
 
-func (m *GetJet) Size() (n int) {
+func (m *Code) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7609,15 +9073,14 @@ func (m *GetJet) Size() (n int) {
 	if m.Polymorph != 0 {
 		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	l = m.ObjectID.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	if m.PulseNumber != 0 {
-		n += 2 + sovPayload(uint64(m.PulseNumber))
+	l = len(m.Record)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
 	return n
 }
 
-func (m *AbandonedRequestsNotification) Size() (n int) {
+func (m *State) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7626,12 +9089,18 @@ func (m *AbandonedRequestsNotification) Size() (n int) {
 	if m.Polymorph != 0 {
 		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	l = m.ObjectID.Size()
-	n += 2 + l + sovPayload(uint64(l))
+	l = len(m.Record)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	l = len(m.Memory)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
 	return n
 }
 
-func (m *GetLightInitialState) Size() (n int) {
+func (m *ID) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7640,12 +9109,12 @@ func (m *GetLightInitialState) Size() (n int) {
 	if m.Polymorph != 0 {
 		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	l = m.Pulse.Size()
+	l = m.ID.Size()
 	n += 2 + l + sovPayload(uint64(l))
 	return n
 }
 
-func (m *LightInitialState) Size() (n int) {
+func (m *IDs) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7654,33 +9123,33 @@ func (m *LightInitialState) Size() (n int) {
 	if m.Polymorph != 0 {
 		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	if m.NetworkStart {
-		n += 3
-	}
-	if len(m.JetIDs) > 0 {
-		for _, e := range m.JetIDs {
+	if len(m.IDs) > 0 {
+		for _, e := range m.IDs {
 			l = e.Size()
 			n += 2 + l + sovPayload(uint64(l))
 		}
 	}
-	if len(m.Drops) > 0 {
-		for _, b := range m.Drops {
-			l = len(b)
-			n += 2 + l + sovPayload(uint64(l))
-		}
+	return n
+}
+
+func (m *Jet) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Indices) > 0 {
-		for _, e := range m.Indices {
-			l = e.Size()
-			n += 2 + l + sovPayload(uint64(l))
-		}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	l = m.Pulse.Size()
+	l = m.JetID.Size()
 	n += 2 + l + sovPayload(uint64(l))
+	if m.Actual {
+		n += 3
+	}
 	return n
 }
 
-func (m *GetIndex) Size() (n int) {
+func (m *SetIncomingRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7689,12 +9158,12 @@ func (m *GetIndex) Size() (n int) {
 	if m.Polymorph != 0 {
 		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	l = m.ObjectID.Size()
+	l = m.Request.Size()
 	n += 2 + l + sovPayload(uint64(l))
 	return n
 }
 
-func (m *UpdateJet) Size() (n int) {
+func (m *SetOutgoingRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -7703,597 +9172,3225 @@ func (m *UpdateJet) Size() (n int) {
 	if m.Polymorph != 0 {
 		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	l = m.Pulse.Size()
-	n += 2 + l + sovPayload(uint64(l))
-	l = m.JetID.Size()
+	l = m.Request.Size()
 	n += 2 + l + sovPayload(uint64(l))
 	return n
 }
 
-func sovPayload(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *SagaCallAcceptNotification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.DetachedRequestID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = len(m.Request)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
 	return n
 }
-func sozPayload(x uint64) (n int) {
-	return sovPayload(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *Meta) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *SetResult) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Meta{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
-		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
-		`Receiver:` + fmt.Sprintf("%v", this.Receiver) + `,`,
-		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`OriginHash:` + fmt.Sprintf("%v", this.OriginHash) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Error) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	s := strings.Join([]string{`&Error{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
-		`Text:` + fmt.Sprintf("%v", this.Text) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetObject) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Result)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
-	s := strings.Join([]string{`&GetObject{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
-		`ObjectRequestID:` + fmt.Sprintf("%v", this.ObjectRequestID) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GetCode) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Activate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetCode{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`CodeID:` + fmt.Sprintf("%v", this.CodeID) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PassState) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	s := strings.Join([]string{`&PassState{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Origin:` + fmt.Sprintf("%v", this.Origin) + `,`,
-		`StateID:` + fmt.Sprintf("%v", this.StateID) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Pass) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Record)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
-	s := strings.Join([]string{`&Pass{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Origin:` + fmt.Sprintf("%v", this.Origin) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SetCode) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Result)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
-	s := strings.Join([]string{`&SetCode{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Index) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Deactivate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Index{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Code) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	s := strings.Join([]string{`&Code{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *State) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Record)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
-	s := strings.Join([]string{`&State{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
-		`Memory:` + fmt.Sprintf("%v", this.Memory) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ID) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Result)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
-	s := strings.Join([]string{`&ID{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *IDs) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Update) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&IDs{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`IDs:` + fmt.Sprintf("%v", this.IDs) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Jet) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	s := strings.Join([]string{`&Jet{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`JetID:` + fmt.Sprintf("%v", this.JetID) + `,`,
-		`Actual:` + fmt.Sprintf("%v", this.Actual) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SetIncomingRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Record)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
-	s := strings.Join([]string{`&SetIncomingRequest{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Request:` + strings.Replace(strings.Replace(this.Request.String(), "Virtual", "record.Virtual", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SetOutgoingRequest) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Result)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
-	s := strings.Join([]string{`&SetOutgoingRequest{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Request:` + strings.Replace(strings.Replace(this.Request.String(), "Virtual", "record.Virtual", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *SagaCallAcceptNotification) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetFilament) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&SagaCallAcceptNotification{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
-		`DetachedRequestID:` + fmt.Sprintf("%v", this.DetachedRequestID) + `,`,
-		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SetResult) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	s := strings.Join([]string{`&SetResult{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.StartFrom.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.ReadUntil.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
 }
-func (this *Activate) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *FilamentSegment) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Activate{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Deactivate) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	s := strings.Join([]string{`&Deactivate{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Update) String() string {
-	if this == nil {
-		return "nil"
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 2 + l + sovPayload(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&Update{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GetFilament) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RequestInfo) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetFilament{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
-		`StartFrom:` + fmt.Sprintf("%v", this.StartFrom) + `,`,
-		`ReadUntil:` + fmt.Sprintf("%v", this.ReadUntil) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *FilamentSegment) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	s := strings.Join([]string{`&FilamentSegment{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
-		`Records:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Records), "CompositeFilamentRecord", "record.CompositeFilamentRecord", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RequestInfo) String() string {
-	if this == nil {
-		return "nil"
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.RequestID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = len(m.Request)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
-	s := strings.Join([]string{`&RequestInfo{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
-		`RequestID:` + fmt.Sprintf("%v", this.RequestID) + `,`,
-		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GotHotConfirmation) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.Result)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
-	s := strings.Join([]string{`&GotHotConfirmation{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`JetID:` + fmt.Sprintf("%v", this.JetID) + `,`,
-		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
-		`Split:` + fmt.Sprintf("%v", this.Split) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ResultInfo) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetResult) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ResultInfo{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
-		`ResultID:` + fmt.Sprintf("%v", this.ResultID) + `,`,
-		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *HotObjects) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	s := strings.Join([]string{`&HotObjects{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`JetID:` + fmt.Sprintf("%v", this.JetID) + `,`,
-		`Drop:` + fmt.Sprintf("%v", this.Drop) + `,`,
-		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
-		`Indexes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Indexes), "Index", "record.Index", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.RequestID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
 }
-func (this *GetRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GotHotConfirmation) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetRequest{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
-		`RequestID:` + fmt.Sprintf("%v", this.RequestID) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Request) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	s := strings.Join([]string{`&Request{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`RequestID:` + fmt.Sprintf("%v", this.RequestID) + `,`,
-		`Request:` + strings.Replace(strings.Replace(this.Request.String(), "Virtual", "record.Virtual", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ServiceData) String() string {
-	if this == nil {
-		return "nil"
+	l = m.JetID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.Pulse.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if m.Split {
+		n += 3
 	}
-	s := strings.Join([]string{`&ServiceData{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`LogTraceID:` + fmt.Sprintf("%v", this.LogTraceID) + `,`,
-		`LogLevel:` + fmt.Sprintf("%v", this.LogLevel) + `,`,
-		`TraceSpanData:` + fmt.Sprintf("%v", this.TraceSpanData) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ExecutionQueueElement) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ResultInfo) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ExecutionQueueElement{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`RequestRef:` + fmt.Sprintf("%v", this.RequestRef) + `,`,
-		`Incoming:` + strings.Replace(fmt.Sprintf("%v", this.Incoming), "IncomingRequest", "record.IncomingRequest", 1) + `,`,
-		`ServiceData:` + strings.Replace(fmt.Sprintf("%v", this.ServiceData), "ServiceData", "ServiceData", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReturnResults) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	s := strings.Join([]string{`&ReturnResults{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
-		`RequestRef:` + fmt.Sprintf("%v", this.RequestRef) + `,`,
-		`Reply:` + fmt.Sprintf("%v", this.Reply) + `,`,
-		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CallMethod) String() string {
-	if this == nil {
-		return "nil"
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.ResultID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = len(m.Result)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
-	s := strings.Join([]string{`&CallMethod{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "IncomingRequest", "record.IncomingRequest", 1) + `,`,
-		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ExecutorResults) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *HotObjects) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ExecutorResults{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Caller:` + fmt.Sprintf("%v", this.Caller) + `,`,
-		`RecordRef:` + fmt.Sprintf("%v", this.RecordRef) + `,`,
-		`Queue:` + strings.Replace(fmt.Sprintf("%v", this.Queue), "ExecutionQueueElement", "ExecutionQueueElement", 1) + `,`,
-		`LedgerHasMoreRequests:` + fmt.Sprintf("%v", this.LedgerHasMoreRequests) + `,`,
-		`Pending:` + fmt.Sprintf("%v", this.Pending) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PendingFinished) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	s := strings.Join([]string{`&PendingFinished{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectRef:` + fmt.Sprintf("%v", this.ObjectRef) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AdditionalCallFromPreviousExecutor) String() string {
-	if this == nil {
-		return "nil"
+	l = m.JetID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = len(m.Drop)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
-	s := strings.Join([]string{`&AdditionalCallFromPreviousExecutor{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectReference:` + fmt.Sprintf("%v", this.ObjectReference) + `,`,
-		`Pending:` + fmt.Sprintf("%v", this.Pending) + `,`,
-		`RequestRef:` + fmt.Sprintf("%v", this.RequestRef) + `,`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "IncomingRequest", "record.IncomingRequest", 1) + `,`,
-		`ServiceData:` + strings.Replace(fmt.Sprintf("%v", this.ServiceData), "ServiceData", "ServiceData", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StillExecuting) String() string {
-	if this == nil {
-		return "nil"
+	l = m.Pulse.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if len(m.Indexes) > 0 {
+		for _, e := range m.Indexes {
+			l = e.Size()
+			n += 2 + l + sovPayload(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&StillExecuting{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectRef:` + fmt.Sprintf("%v", this.ObjectRef) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GetPendings) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GetRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetPendings{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *HasPendings) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	s := strings.Join([]string{`&HasPendings{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.RequestID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
 }
-func (this *PendingsInfo) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Request) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PendingsInfo{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`HasPendings:` + fmt.Sprintf("%v", this.HasPendings) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Replication) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	s := strings.Join([]string{`&Replication{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`JetID:` + fmt.Sprintf("%v", this.JetID) + `,`,
-		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
-		`Indexes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Indexes), "Index", "record.Index", 1), `&`, ``, 1) + `,`,
-		`Records:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Records), "Material", "record.Material", 1), `&`, ``, 1) + `,`,
-		`Drop:` + fmt.Sprintf("%v", this.Drop) + `,`,
-		`}`,
-	}, "")
-	return s
+	l = m.RequestID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.Request.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
 }
-func (this *GetJet) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ServiceData) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetJet{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
-		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AbandonedRequestsNotification) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
 	}
-	s := strings.Join([]string{`&AbandonedRequestsNotification{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetLightInitialState) String() string {
-	if this == nil {
-		return "nil"
+	l = len(m.LogTraceID)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
 	}
-	s := strings.Join([]string{`&GetLightInitialState{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.LogLevel != 0 {
+		n += 2 + sovPayload(uint64(m.LogLevel))
+	}
+	l = len(m.TraceSpanData)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	return n
 }
-func (this *LightInitialState) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ExecutionQueueElement) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&LightInitialState{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`NetworkStart:` + fmt.Sprintf("%v", this.NetworkStart) + `,`,
-		`JetIDs:` + fmt.Sprintf("%v", this.JetIDs) + `,`,
-		`Drops:` + fmt.Sprintf("%v", this.Drops) + `,`,
-		`Indices:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Indices), "Index", "record.Index", 1), `&`, ``, 1) + `,`,
-		`Pulse:` + strings.Replace(strings.Replace(this.Pulse.String(), "PulseProto", "pulse.PulseProto", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.RequestRef.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if m.Incoming != nil {
+		l = m.Incoming.Size()
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	if m.ServiceData != nil {
+		l = m.ServiceData.Size()
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	return n
 }
-func (this *GetIndex) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ReturnResults) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GetIndex{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.Target.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.RequestRef.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = len(m.Reply)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	return n
 }
-func (this *UpdateJet) String() string {
-	if this == nil {
+
+func (m *CallMethod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	if m.PulseNumber != 0 {
+		n += 2 + sovPayload(uint64(m.PulseNumber))
+	}
+	return n
+}
+
+func (m *ExecutorResults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.Caller.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.RecordRef.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if len(m.Queue) > 0 {
+		for _, e := range m.Queue {
+			l = e.Size()
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
+	if m.LedgerHasMoreRequests {
+		n += 3
+	}
+	if m.Pending != 0 {
+		n += 2 + sovPayload(uint64(m.Pending))
+	}
+	return n
+}
+
+func (m *PendingFinished) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.ObjectRef.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
+func (m *AdditionalCallFromPreviousExecutor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.ObjectReference.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if m.Pending != 0 {
+		n += 2 + sovPayload(uint64(m.Pending))
+	}
+	l = m.RequestRef.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	if m.ServiceData != nil {
+		l = m.ServiceData.Size()
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	return n
+}
+
+func (m *StillExecuting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.ObjectRef.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
+func (m *GetPendings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
+func (m *HasPendings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
+func (m *PendingsInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	if m.HasPendings {
+		n += 3
+	}
+	return n
+}
+
+func (m *Replication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.JetID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.Pulse.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if len(m.Indexes) > 0 {
+		for _, e := range m.Indexes {
+			l = e.Size()
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
+	l = len(m.Drop)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	if m.Confirm {
+		n += 3
+	}
+	return n
+}
+
+func (m *GetJet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if m.PulseNumber != 0 {
+		n += 2 + sovPayload(uint64(m.PulseNumber))
+	}
+	return n
+}
+
+func (m *AbandonedRequestsNotification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
+func (m *GetLightInitialState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.Pulse.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
+func (m *LightInitialState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	if m.NetworkStart {
+		n += 3
+	}
+	if len(m.JetIDs) > 0 {
+		for _, e := range m.JetIDs {
+			l = e.Size()
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
+	if len(m.Drops) > 0 {
+		for _, b := range m.Drops {
+			l = len(b)
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		for _, e := range m.Indices {
+			l = e.Size()
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
+	l = m.Pulse.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
+func (m *GetIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.ObjectID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
+func (m *UpdateJet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.Pulse.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.JetID.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
+func (m *GetPulseReplica) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.Pulse.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
+func (m *PulseReplica) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.Pulse.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if len(m.Drops) > 0 {
+		for _, b := range m.Drops {
+			l = len(b)
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
+	if len(m.Indexes) > 0 {
+		for _, e := range m.Indexes {
+			l = e.Size()
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Validate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.Object.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.Pulse.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if len(m.Transcripts) > 0 {
+		for _, e := range m.Transcripts {
+			l = e.Size()
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidationTranscript) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if len(m.Outgoings) > 0 {
+		for _, e := range m.Outgoings {
+			l = e.Size()
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidationOutgoing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Request.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = len(m.Response)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	if m.NewObject != nil {
+		l = m.NewObject.Size()
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidationMismatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.Object.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.Request.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.Pulse.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.Executor.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = len(m.Expected)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	l = len(m.Actual)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	l = m.Validator.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
+func (m *Chunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = len(m.StreamID)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 2 + sovPayload(uint64(m.TotalSize))
+	}
+	if m.Offset != 0 {
+		n += 2 + sovPayload(uint64(m.Offset))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	return n
+}
+
+func (m *ChunkAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = len(m.StreamID)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 2 + sovPayload(uint64(m.Offset))
+	}
+	return n
+}
+
+func (m *SagaState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.Request.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = m.Rollback.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	l = len(m.Method)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 2 + sovPayload(uint64(m.Status))
+	}
+	if m.Attempts != 0 {
+		n += 2 + sovPayload(uint64(m.Attempts))
+	}
+	if m.RetryIn != 0 {
+		n += 2 + sovPayload(uint64(m.RetryIn))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 2 + l + sovPayload(uint64(l))
+	}
+	return n
+}
+
+func (m *SagaStates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.Object.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	if len(m.Sagas) > 0 {
+		for _, e := range m.Sagas {
+			l = e.Size()
+			n += 2 + l + sovPayload(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetSagaStates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Polymorph != 0 {
+		n += 2 + sovPayload(uint64(m.Polymorph))
+	}
+	l = m.Object.Size()
+	n += 2 + l + sovPayload(uint64(l))
+	return n
+}
+
+func sovPayload(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPayload(x uint64) (n int) {
+	return sovPayload(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Meta) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Meta{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`Receiver:` + fmt.Sprintf("%v", this.Receiver) + `,`,
+		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`OriginHash:` + fmt.Sprintf("%v", this.OriginHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Error) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Error{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`Text:` + fmt.Sprintf("%v", this.Text) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetObject) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetObject{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`ObjectRequestID:` + fmt.Sprintf("%v", this.ObjectRequestID) + `,`,
+		`StateID:` + fmt.Sprintf("%v", this.StateID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetCode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetCode{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`CodeID:` + fmt.Sprintf("%v", this.CodeID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PassState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PassState{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Origin:` + fmt.Sprintf("%v", this.Origin) + `,`,
+		`StateID:` + fmt.Sprintf("%v", this.StateID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Pass) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Pass{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Origin:` + fmt.Sprintf("%v", this.Origin) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetCode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetCode{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Index) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Index{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Code) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Code{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *State) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&State{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
+		`Memory:` + fmt.Sprintf("%v", this.Memory) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ID) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ID{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IDs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IDs{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`IDs:` + fmt.Sprintf("%v", this.IDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Jet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Jet{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`JetID:` + fmt.Sprintf("%v", this.JetID) + `,`,
+		`Actual:` + fmt.Sprintf("%v", this.Actual) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetIncomingRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetIncomingRequest{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Request:` + strings.Replace(strings.Replace(this.Request.String(), "Virtual", "record.Virtual", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetOutgoingRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetOutgoingRequest{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Request:` + strings.Replace(strings.Replace(this.Request.String(), "Virtual", "record.Virtual", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SagaCallAcceptNotification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SagaCallAcceptNotification{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`DetachedRequestID:` + fmt.Sprintf("%v", this.DetachedRequestID) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetResult{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Activate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Activate{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Deactivate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Deactivate{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Update) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Update{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Record:` + fmt.Sprintf("%v", this.Record) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetFilament) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetFilament{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`StartFrom:` + fmt.Sprintf("%v", this.StartFrom) + `,`,
+		`ReadUntil:` + fmt.Sprintf("%v", this.ReadUntil) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FilamentSegment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FilamentSegment{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`Records:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Records), "CompositeFilamentRecord", "record.CompositeFilamentRecord", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RequestInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RequestInfo{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`RequestID:` + fmt.Sprintf("%v", this.RequestID) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetResult{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`RequestID:` + fmt.Sprintf("%v", this.RequestID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GotHotConfirmation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GotHotConfirmation{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`JetID:` + fmt.Sprintf("%v", this.JetID) + `,`,
+		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
+		`Split:` + fmt.Sprintf("%v", this.Split) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResultInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResultInfo{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`ResultID:` + fmt.Sprintf("%v", this.ResultID) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HotObjects) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HotObjects{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`JetID:` + fmt.Sprintf("%v", this.JetID) + `,`,
+		`Drop:` + fmt.Sprintf("%v", this.Drop) + `,`,
+		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
+		`Indexes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Indexes), "Index", "record.Index", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetRequest{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`RequestID:` + fmt.Sprintf("%v", this.RequestID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Request) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Request{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`RequestID:` + fmt.Sprintf("%v", this.RequestID) + `,`,
+		`Request:` + strings.Replace(strings.Replace(this.Request.String(), "Virtual", "record.Virtual", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServiceData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServiceData{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`LogTraceID:` + fmt.Sprintf("%v", this.LogTraceID) + `,`,
+		`LogLevel:` + fmt.Sprintf("%v", this.LogLevel) + `,`,
+		`TraceSpanData:` + fmt.Sprintf("%v", this.TraceSpanData) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExecutionQueueElement) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExecutionQueueElement{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`RequestRef:` + fmt.Sprintf("%v", this.RequestRef) + `,`,
+		`Incoming:` + strings.Replace(fmt.Sprintf("%v", this.Incoming), "IncomingRequest", "record.IncomingRequest", 1) + `,`,
+		`ServiceData:` + strings.Replace(fmt.Sprintf("%v", this.ServiceData), "ServiceData", "ServiceData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReturnResults) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReturnResults{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Target:` + fmt.Sprintf("%v", this.Target) + `,`,
+		`RequestRef:` + fmt.Sprintf("%v", this.RequestRef) + `,`,
+		`Reply:` + fmt.Sprintf("%v", this.Reply) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CallMethod) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CallMethod{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "IncomingRequest", "record.IncomingRequest", 1) + `,`,
+		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExecutorResults) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExecutorResults{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Caller:` + fmt.Sprintf("%v", this.Caller) + `,`,
+		`RecordRef:` + fmt.Sprintf("%v", this.RecordRef) + `,`,
+		`Queue:` + strings.Replace(fmt.Sprintf("%v", this.Queue), "ExecutionQueueElement", "ExecutionQueueElement", 1) + `,`,
+		`LedgerHasMoreRequests:` + fmt.Sprintf("%v", this.LedgerHasMoreRequests) + `,`,
+		`Pending:` + fmt.Sprintf("%v", this.Pending) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingFinished) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingFinished{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectRef:` + fmt.Sprintf("%v", this.ObjectRef) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AdditionalCallFromPreviousExecutor) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdditionalCallFromPreviousExecutor{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectReference:` + fmt.Sprintf("%v", this.ObjectReference) + `,`,
+		`Pending:` + fmt.Sprintf("%v", this.Pending) + `,`,
+		`RequestRef:` + fmt.Sprintf("%v", this.RequestRef) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "IncomingRequest", "record.IncomingRequest", 1) + `,`,
+		`ServiceData:` + strings.Replace(fmt.Sprintf("%v", this.ServiceData), "ServiceData", "ServiceData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StillExecuting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StillExecuting{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectRef:` + fmt.Sprintf("%v", this.ObjectRef) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetPendings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetPendings{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HasPendings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HasPendings{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingsInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PendingsInfo{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`HasPendings:` + fmt.Sprintf("%v", this.HasPendings) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Replication) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Replication{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`JetID:` + fmt.Sprintf("%v", this.JetID) + `,`,
+		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
+		`Indexes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Indexes), "Index", "record.Index", 1), `&`, ``, 1) + `,`,
+		`Records:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Records), "Material", "record.Material", 1), `&`, ``, 1) + `,`,
+		`Drop:` + fmt.Sprintf("%v", this.Drop) + `,`,
+		`Confirm:` + fmt.Sprintf("%v", this.Confirm) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetJet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetJet{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AbandonedRequestsNotification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AbandonedRequestsNotification{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetLightInitialState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetLightInitialState{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LightInitialState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LightInitialState{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`NetworkStart:` + fmt.Sprintf("%v", this.NetworkStart) + `,`,
+		`JetIDs:` + fmt.Sprintf("%v", this.JetIDs) + `,`,
+		`Drops:` + fmt.Sprintf("%v", this.Drops) + `,`,
+		`Indices:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Indices), "Index", "record.Index", 1), `&`, ``, 1) + `,`,
+		`Pulse:` + strings.Replace(strings.Replace(this.Pulse.String(), "PulseProto", "pulse.PulseProto", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetIndex) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetIndex{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`ObjectID:` + fmt.Sprintf("%v", this.ObjectID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateJet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateJet{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
+		`JetID:` + fmt.Sprintf("%v", this.JetID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetPulseReplica) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetPulseReplica{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PulseReplica) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PulseReplica{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
+		`Drops:` + fmt.Sprintf("%v", this.Drops) + `,`,
+		`Indexes:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Indexes), "Index", "record.Index", 1), `&`, ``, 1) + `,`,
+		`Records:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Records), "Material", "record.Material", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Validate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Validate{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Object:` + fmt.Sprintf("%v", this.Object) + `,`,
+		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
+		`Transcripts:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Transcripts), "ValidationTranscript", "ValidationTranscript", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidationTranscript) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidationTranscript{`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`Outgoings:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Outgoings), "ValidationOutgoing", "ValidationOutgoing", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidationOutgoing) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidationOutgoing{`,
+		`Request:` + strings.Replace(strings.Replace(this.Request.String(), "IncomingRequest", "record.IncomingRequest", 1), `&`, ``, 1) + `,`,
+		`Response:` + fmt.Sprintf("%v", this.Response) + `,`,
+		`NewObject:` + fmt.Sprintf("%v", this.NewObject) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidationMismatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidationMismatch{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Object:` + fmt.Sprintf("%v", this.Object) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
+		`Executor:` + fmt.Sprintf("%v", this.Executor) + `,`,
+		`Expected:` + fmt.Sprintf("%v", this.Expected) + `,`,
+		`Actual:` + fmt.Sprintf("%v", this.Actual) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Validator:` + fmt.Sprintf("%v", this.Validator) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Chunk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Chunk{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`StreamID:` + fmt.Sprintf("%v", this.StreamID) + `,`,
+		`TotalSize:` + fmt.Sprintf("%v", this.TotalSize) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChunkAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChunkAck{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`StreamID:` + fmt.Sprintf("%v", this.StreamID) + `,`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SagaState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SagaState{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
+		`Rollback:` + fmt.Sprintf("%v", this.Rollback) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`RetryIn:` + fmt.Sprintf("%v", this.RetryIn) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SagaStates) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SagaStates{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Object:` + fmt.Sprintf("%v", this.Object) + `,`,
+		`Sagas:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Sagas), "SagaState", "SagaState", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetSagaStates) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetSagaStates{`,
+		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
+		`Object:` + fmt.Sprintf("%v", this.Object) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringPayload(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
 		return "nil"
 	}
-	s := strings.Join([]string{`&UpdateJet{`,
-		`Polymorph:` + fmt.Sprintf("%v", this.Polymorph) + `,`,
-		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
-		`JetID:` + fmt.Sprintf("%v", this.JetID) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringPayload(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Meta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Meta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Meta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Receiver.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OriginHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Error) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectRequestID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectRequestID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StateID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PassState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PassState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PassState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origin = append(m.Origin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Origin == nil {
+				m.Origin = []byte{}
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StateID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Origin = append(m.Origin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Origin == nil {
+				m.Origin = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Record = append(m.Record[:0], dAtA[iNdEx:postIndex]...)
+			if m.Record == nil {
+				m.Record = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Index) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Index: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Index: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = append(m.Index[:0], dAtA[iNdEx:postIndex]...)
+			if m.Index == nil {
+				m.Index = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Code) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Code: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Code: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Record = append(m.Record[:0], dAtA[iNdEx:postIndex]...)
+			if m.Record == nil {
+				m.Record = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *State) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Record = append(m.Record[:0], dAtA[iNdEx:postIndex]...)
+			if m.Record == nil {
+				m.Record = []byte{}
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memory = append(m.Memory[:0], dAtA[iNdEx:postIndex]...)
+			if m.Memory == nil {
+				m.Memory = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IDs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IDs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IDs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.ID
+			m.IDs = append(m.IDs, v)
+			if err := m.IDs[len(m.IDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *Meta) Unmarshal(dAtA []byte) error {
+func (m *Jet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8316,10 +12413,10 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Meta: wiretype end group for non-group")
+			return fmt.Errorf("proto: Jet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Meta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Jet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -8343,7 +12440,7 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JetID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8370,16 +12467,15 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
+			if err := m.JetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actual", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -8389,30 +12485,70 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			m.Actual = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPayload
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetIncomingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
 			}
-			iNdEx = postIndex
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var byteLen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetIncomingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetIncomingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -8422,30 +12558,16 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Polymorph |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPayload
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Receiver.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 23:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -8455,64 +12577,102 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPayload
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPayload
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetOutgoingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetOutgoingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetOutgoingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 25:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -8522,22 +12682,22 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OriginHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8565,7 +12725,7 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Error) Unmarshal(dAtA []byte) error {
+func (m *SagaCallAcceptNotification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8588,10 +12748,10 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+			return fmt.Errorf("proto: SagaCallAcceptNotification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SagaCallAcceptNotification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -8614,10 +12774,10 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
 			}
-			m.Code = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -8627,16 +12787,30 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DetachedRequestID", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -8646,23 +12820,58 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Text = string(dAtA[iNdEx:postIndex])
+			if err := m.DetachedRequestID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
+			if m.Request == nil {
+				m.Request = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8688,7 +12897,7 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetObject) Unmarshal(dAtA []byte) error {
+func (m *SetResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8711,10 +12920,10 @@ func (m *GetObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -8738,40 +12947,7 @@ func (m *GetObject) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPayload
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPayload
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectRequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8798,8 +12974,9 @@ func (m *GetObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectRequestID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -8826,7 +13003,7 @@ func (m *GetObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCode) Unmarshal(dAtA []byte) error {
+func (m *Activate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8849,10 +13026,10 @@ func (m *GetCode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCode: wiretype end group for non-group")
+			return fmt.Errorf("proto: Activate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Activate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -8876,7 +13053,7 @@ func (m *GetCode) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8903,8 +13080,43 @@ func (m *GetCode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CodeID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Record = append(m.Record[:0], dAtA[iNdEx:postIndex]...)
+			if m.Record == nil {
+				m.Record = []byte{}
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -8931,7 +13143,7 @@ func (m *GetCode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PassState) Unmarshal(dAtA []byte) error {
+func (m *Deactivate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8954,10 +13166,10 @@ func (m *PassState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PassState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Deactivate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PassState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Deactivate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -8981,7 +13193,7 @@ func (m *PassState) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9008,14 +13220,14 @@ func (m *PassState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Origin = append(m.Origin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Origin == nil {
-				m.Origin = []byte{}
+			m.Record = append(m.Record[:0], dAtA[iNdEx:postIndex]...)
+			if m.Record == nil {
+				m.Record = []byte{}
 			}
 			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StateID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9042,8 +13254,9 @@ func (m *PassState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StateID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -9070,7 +13283,7 @@ func (m *PassState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pass) Unmarshal(dAtA []byte) error {
+func (m *Update) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9093,10 +13306,10 @@ func (m *Pass) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pass: wiretype end group for non-group")
+			return fmt.Errorf("proto: Update: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pass: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -9120,7 +13333,41 @@ func (m *Pass) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Record = append(m.Record[:0], dAtA[iNdEx:postIndex]...)
+			if m.Record == nil {
+				m.Record = []byte{}
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9147,9 +13394,9 @@ func (m *Pass) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Origin = append(m.Origin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Origin == nil {
-				m.Origin = []byte{}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -9176,7 +13423,7 @@ func (m *Pass) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetCode) Unmarshal(dAtA []byte) error {
+func (m *GetFilament) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9199,10 +13446,10 @@ func (m *SetCode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetCode: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFilament: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetCode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFilament: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -9226,7 +13473,7 @@ func (m *SetCode) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9253,69 +13500,15 @@ func (m *SetCode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Record = append(m.Record[:0], dAtA[iNdEx:postIndex]...)
-			if m.Record == nil {
-				m.Record = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPayload(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Index) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPayload
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Index: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Index: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartFrom", wireType)
 			}
-			m.Polymorph = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -9325,14 +13518,28 @@ func (m *Index) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Polymorph |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadUntil", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9359,9 +13566,8 @@ func (m *Index) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = append(m.Index[:0], dAtA[iNdEx:postIndex]...)
-			if m.Index == nil {
-				m.Index = []byte{}
+			if err := m.ReadUntil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -9388,7 +13594,7 @@ func (m *Index) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Code) Unmarshal(dAtA []byte) error {
+func (m *FilamentSegment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9411,10 +13617,10 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Code: wiretype end group for non-group")
+			return fmt.Errorf("proto: FilamentSegment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Code: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FilamentSegment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -9438,7 +13644,7 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9465,9 +13671,42 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Record = append(m.Record[:0], dAtA[iNdEx:postIndex]...)
-			if m.Record == nil {
-				m.Record = []byte{}
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, record.CompositeFilamentRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -9494,7 +13733,7 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *State) Unmarshal(dAtA []byte) error {
+func (m *RequestInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9517,10 +13756,10 @@ func (m *State) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: State: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -9544,7 +13783,7 @@ func (m *State) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9571,14 +13810,13 @@ func (m *State) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Record = append(m.Record[:0], dAtA[iNdEx:postIndex]...)
-			if m.Record == nil {
-				m.Record = []byte{}
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9605,69 +13843,15 @@ func (m *State) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memory = append(m.Memory[:0], dAtA[iNdEx:postIndex]...)
-			if m.Memory == nil {
-				m.Memory = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPayload(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.RequestID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ID) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPayload
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ID: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ID: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			m.Polymorph = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -9677,14 +13861,29 @@ func (m *ID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Polymorph |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
+			if m.Request == nil {
+				m.Request = []byte{}
+			}
+			iNdEx = postIndex
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9711,8 +13910,9 @@ func (m *ID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -9739,7 +13939,7 @@ func (m *ID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IDs) Unmarshal(dAtA []byte) error {
+func (m *GetResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9762,10 +13962,10 @@ func (m *IDs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IDs: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IDs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -9789,7 +13989,7 @@ func (m *IDs) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9816,9 +14016,40 @@ func (m *IDs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_insolar_insolar_insolar.ID
-			m.IDs = append(m.IDs, v)
-			if err := m.IDs[len(m.IDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9846,7 +14077,7 @@ func (m *IDs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Jet) Unmarshal(dAtA []byte) error {
+func (m *GotHotConfirmation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9869,10 +14100,10 @@ func (m *Jet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Jet: wiretype end group for non-group")
+			return fmt.Errorf("proto: GotHotConfirmation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Jet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GotHotConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -9928,8 +14159,41 @@ func (m *Jet) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actual", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9946,7 +14210,7 @@ func (m *Jet) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Actual = bool(v != 0)
+			m.Split = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -9971,7 +14235,7 @@ func (m *Jet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetIncomingRequest) Unmarshal(dAtA []byte) error {
+func (m *ResultInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9994,10 +14258,10 @@ func (m *SetIncomingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetIncomingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResultInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetIncomingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResultInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -10021,9 +14285,9 @@ func (m *SetIncomingRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -10033,83 +14297,30 @@ func (m *SetIncomingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPayload(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetOutgoingRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPayload
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetOutgoingRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetOutgoingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResultID", wireType)
 			}
-			m.Polymorph = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -10119,16 +14330,30 @@ func (m *SetOutgoingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Polymorph |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResultID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -10138,23 +14363,24 @@ func (m *SetOutgoingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -10181,7 +14407,7 @@ func (m *SetOutgoingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SagaCallAcceptNotification) Unmarshal(dAtA []byte) error {
+func (m *HotObjects) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10204,10 +14430,10 @@ func (m *SagaCallAcceptNotification) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SagaCallAcceptNotification: wiretype end group for non-group")
+			return fmt.Errorf("proto: HotObjects: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SagaCallAcceptNotification: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HotObjects: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -10231,7 +14457,7 @@ func (m *SagaCallAcceptNotification) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JetID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10258,13 +14484,13 @@ func (m *SagaCallAcceptNotification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DetachedRequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Drop", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10291,13 +14517,14 @@ func (m *SagaCallAcceptNotification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DetachedRequestID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Drop = append(m.Drop[:0], dAtA[iNdEx:postIndex]...)
+			if m.Drop == nil {
+				m.Drop = []byte{}
 			}
 			iNdEx = postIndex
 		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10324,9 +14551,42 @@ func (m *SagaCallAcceptNotification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
-			if m.Request == nil {
-				m.Request = []byte{}
+			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, record.Index{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -10353,7 +14613,7 @@ func (m *SagaCallAcceptNotification) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetResult) Unmarshal(dAtA []byte) error {
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10376,10 +14636,10 @@ func (m *SetResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -10403,7 +14663,7 @@ func (m *SetResult) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10430,9 +14690,41 @@ func (m *SetResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -10459,7 +14751,7 @@ func (m *SetResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Activate) Unmarshal(dAtA []byte) error {
+func (m *Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10482,10 +14774,10 @@ func (m *Activate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Activate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Activate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -10509,7 +14801,7 @@ func (m *Activate) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10536,16 +14828,15 @@ func (m *Activate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Record = append(m.Record[:0], dAtA[iNdEx:postIndex]...)
-			if m.Record == nil {
-				m.Record = []byte{}
+			if err := m.RequestID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -10555,24 +14846,23 @@ func (m *Activate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -10599,7 +14889,7 @@ func (m *Activate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deactivate) Unmarshal(dAtA []byte) error {
+func (m *ServiceData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10622,10 +14912,10 @@ func (m *Deactivate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deactivate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServiceData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deactivate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServiceData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -10649,9 +14939,9 @@ func (m *Deactivate) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogTraceID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -10661,29 +14951,46 @@ func (m *Deactivate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Record = append(m.Record[:0], dAtA[iNdEx:postIndex]...)
-			if m.Record == nil {
-				m.Record = []byte{}
-			}
+			m.LogTraceID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogLevel", wireType)
+			}
+			m.LogLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogLevel |= github_com_insolar_insolar_insolar.LogLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceSpanData", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10710,9 +15017,9 @@ func (m *Deactivate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
+			m.TraceSpanData = append(m.TraceSpanData[:0], dAtA[iNdEx:postIndex]...)
+			if m.TraceSpanData == nil {
+				m.TraceSpanData = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -10739,7 +15046,7 @@ func (m *Deactivate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Update) Unmarshal(dAtA []byte) error {
+func (m *ExecutionQueueElement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10762,10 +15069,10 @@ func (m *Update) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Update: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutionQueueElement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutionQueueElement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -10789,7 +15096,7 @@ func (m *Update) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestRef", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10816,16 +15123,15 @@ func (m *Update) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Record = append(m.Record[:0], dAtA[iNdEx:postIndex]...)
-			if m.Record == nil {
-				m.Record = []byte{}
+			if err := m.RequestRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Incoming", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -10835,24 +15141,62 @@ func (m *Update) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
+			if m.Incoming == nil {
+				m.Incoming = &record.IncomingRequest{}
+			}
+			if err := m.Incoming.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ServiceData == nil {
+				m.ServiceData = &ServiceData{}
+			}
+			if err := m.ServiceData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -10879,7 +15223,7 @@ func (m *Update) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetFilament) Unmarshal(dAtA []byte) error {
+func (m *ReturnResults) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10902,10 +15246,10 @@ func (m *GetFilament) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFilament: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReturnResults: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFilament: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReturnResults: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -10929,7 +15273,7 @@ func (m *GetFilament) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10956,13 +15300,13 @@ func (m *GetFilament) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestRef", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -10989,13 +15333,13 @@ func (m *GetFilament) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RequestRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reply", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -11022,9 +15366,42 @@ func (m *GetFilament) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReadUntil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Reply = append(m.Reply[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reply == nil {
+				m.Reply = []byte{}
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11050,7 +15427,7 @@ func (m *GetFilament) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FilamentSegment) Unmarshal(dAtA []byte) error {
+func (m *CallMethod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11073,10 +15450,10 @@ func (m *FilamentSegment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FilamentSegment: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallMethod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FilamentSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallMethod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -11100,9 +15477,9 @@ func (m *FilamentSegment) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -11112,30 +15489,33 @@ func (m *FilamentSegment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Request == nil {
+				m.Request = &record.IncomingRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
 			}
-			var msglen int
+			m.PulseNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -11145,26 +15525,11 @@ func (m *FilamentSegment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PulseNumber |= github_com_insolar_insolar_insolar.PulseNumber(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPayload
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Records = append(m.Records, record.CompositeFilamentRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -11189,7 +15554,7 @@ func (m *FilamentSegment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RequestInfo) Unmarshal(dAtA []byte) error {
+func (m *ExecutorResults) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11212,10 +15577,10 @@ func (m *RequestInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutorResults: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutorResults: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -11239,7 +15604,7 @@ func (m *RequestInfo) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -11266,13 +15631,13 @@ func (m *RequestInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Caller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordRef", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -11299,15 +15664,15 @@ func (m *RequestInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RequestID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -11317,29 +15682,140 @@ func (m *RequestInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Request = append(m.Request[:0], dAtA[iNdEx:postIndex]...)
-			if m.Request == nil {
-				m.Request = []byte{}
+			m.Queue = append(m.Queue, &ExecutionQueueElement{})
+			if err := m.Queue[len(m.Queue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerHasMoreRequests", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LedgerHasMoreRequests = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			m.Pending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pending |= github_com_insolar_insolar_insolar.PendingState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingFinished) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingFinished: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingFinished: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Polymorph |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectRef", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -11366,9 +15842,8 @@ func (m *RequestInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
+			if err := m.ObjectRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -11395,7 +15870,7 @@ func (m *RequestInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GotHotConfirmation) Unmarshal(dAtA []byte) error {
+func (m *AdditionalCallFromPreviousExecutor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11418,10 +15893,10 @@ func (m *GotHotConfirmation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GotHotConfirmation: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdditionalCallFromPreviousExecutor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GotHotConfirmation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdditionalCallFromPreviousExecutor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -11445,7 +15920,7 @@ func (m *GotHotConfirmation) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectReference", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -11472,13 +15947,32 @@ func (m *GotHotConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			m.Pending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pending |= github_com_insolar_insolar_insolar.PendingState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestRef", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -11505,15 +15999,51 @@ func (m *GotHotConfirmation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RequestRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &record.IncomingRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceData", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -11523,12 +16053,28 @@ func (m *GotHotConfirmation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Split = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ServiceData == nil {
+				m.ServiceData = &ServiceData{}
+			}
+			if err := m.ServiceData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -11553,7 +16099,7 @@ func (m *GotHotConfirmation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResultInfo) Unmarshal(dAtA []byte) error {
+func (m *StillExecuting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11576,10 +16122,10 @@ func (m *ResultInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResultInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: StillExecuting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResultInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StillExecuting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -11603,7 +16149,7 @@ func (m *ResultInfo) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectRef", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -11630,15 +16176,68 @@ func (m *ResultInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResultID", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var byteLen int
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPendings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPendings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPendings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -11648,28 +16247,14 @@ func (m *ResultInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Polymorph |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPayload
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ResultID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 22:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -11696,9 +16281,8 @@ func (m *ResultInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
-			if m.Result == nil {
-				m.Result = []byte{}
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -11725,7 +16309,7 @@ func (m *ResultInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HotObjects) Unmarshal(dAtA []byte) error {
+func (m *HasPendings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11748,10 +16332,10 @@ func (m *HotObjects) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HotObjects: wiretype end group for non-group")
+			return fmt.Errorf("proto: HasPendings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HotObjects: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HasPendings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -11775,7 +16359,7 @@ func (m *HotObjects) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -11802,49 +16386,68 @@ func (m *HotObjects) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Drop", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPayload
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPayload
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Drop = append(m.Drop[:0], dAtA[iNdEx:postIndex]...)
-			if m.Drop == nil {
-				m.Drop = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingsInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
 			}
-			iNdEx = postIndex
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var byteLen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingsInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			}
+			m.Polymorph = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -11854,30 +16457,16 @@ func (m *HotObjects) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Polymorph |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPayload
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPendings", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -11887,26 +16476,12 @@ func (m *HotObjects) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPayload
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Indexes = append(m.Indexes, record.Index{})
-			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.HasPendings = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -11931,7 +16506,7 @@ func (m *HotObjects) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+func (m *Replication) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11954,10 +16529,10 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Replication: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Replication: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -11981,7 +16556,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JetID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -12008,13 +16583,13 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -12041,68 +16616,15 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RequestID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPayload(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Request) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPayload
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
 			}
-			m.Polymorph = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -12112,16 +16634,31 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Polymorph |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, record.Index{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -12131,30 +16668,31 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RequestID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, record.Material{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Drop", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -12164,25 +16702,46 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Drop = append(m.Drop[:0], dAtA[iNdEx:postIndex]...)
+			if m.Drop == nil {
+				m.Drop = []byte{}
 			}
 			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirm", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Confirm = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -12207,7 +16766,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceData) Unmarshal(dAtA []byte) error {
+func (m *GetJet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12230,10 +16789,10 @@ func (m *ServiceData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceData: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetJet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetJet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -12257,9 +16816,9 @@ func (m *ServiceData) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogTraceID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -12269,48 +16828,30 @@ func (m *ServiceData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogTraceID = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogLevel", wireType)
-			}
-			m.LogLevel = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPayload
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LogLevel |= github_com_insolar_insolar_insolar.LogLevel(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceSpanData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
 			}
-			var byteLen int
+			m.PulseNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -12320,26 +16861,11 @@ func (m *ServiceData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.PulseNumber |= github_com_insolar_insolar_insolar.PulseNumber(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPayload
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TraceSpanData = append(m.TraceSpanData[:0], dAtA[iNdEx:postIndex]...)
-			if m.TraceSpanData == nil {
-				m.TraceSpanData = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -12364,7 +16890,7 @@ func (m *ServiceData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecutionQueueElement) Unmarshal(dAtA []byte) error {
+func (m *AbandonedRequestsNotification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12387,10 +16913,10 @@ func (m *ExecutionQueueElement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutionQueueElement: wiretype end group for non-group")
+			return fmt.Errorf("proto: AbandonedRequestsNotification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutionQueueElement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AbandonedRequestsNotification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -12414,7 +16940,7 @@ func (m *ExecutionQueueElement) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -12441,15 +16967,68 @@ func (m *ExecutionQueueElement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RequestRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incoming", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLightInitialState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLightInitialState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLightInitialState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
 			}
-			var msglen int
+			m.Polymorph = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -12459,33 +17038,16 @@ func (m *ExecutionQueueElement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Polymorph |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPayload
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Incoming == nil {
-				m.Incoming = &record.IncomingRequest{}
-			}
-			if err := m.Incoming.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 22:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -12495,25 +17057,22 @@ func (m *ExecutionQueueElement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServiceData == nil {
-				m.ServiceData = &ServiceData{}
-			}
-			if err := m.ServiceData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12541,7 +17100,7 @@ func (m *ExecutionQueueElement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReturnResults) Unmarshal(dAtA []byte) error {
+func (m *LightInitialState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12564,10 +17123,10 @@ func (m *ReturnResults) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReturnResults: wiretype end group for non-group")
+			return fmt.Errorf("proto: LightInitialState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReturnResults: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LightInitialState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -12590,8 +17149,28 @@ func (m *ReturnResults) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkStart", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NetworkStart = bool(v != 0)
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JetIDs", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -12618,13 +17197,15 @@ func (m *ReturnResults) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_insolar_insolar_insolar.JetID
+			m.JetIDs = append(m.JetIDs, v)
+			if err := m.JetIDs[len(m.JetIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Drops", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -12651,15 +17232,14 @@ func (m *ReturnResults) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RequestRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Drops = append(m.Drops, make([]byte, postIndex-iNdEx))
+			copy(m.Drops[len(m.Drops)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -12669,31 +17249,31 @@ func (m *ReturnResults) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reply = append(m.Reply[:0], dAtA[iNdEx:postIndex]...)
-			if m.Reply == nil {
-				m.Reply = []byte{}
+			m.Indices = append(m.Indices, record.Index{})
+			if err := m.Indices[len(m.Indices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 23:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -12703,23 +17283,24 @@ func (m *ReturnResults) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12745,7 +17326,7 @@ func (m *ReturnResults) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CallMethod) Unmarshal(dAtA []byte) error {
+func (m *GetIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12768,10 +17349,10 @@ func (m *CallMethod) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallMethod: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -12795,9 +17376,9 @@ func (m *CallMethod) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -12807,47 +17388,25 @@ func (m *CallMethod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &record.IncomingRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
-			}
-			m.PulseNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPayload
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PulseNumber |= github_com_insolar_insolar_insolar.PulseNumber(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -12872,7 +17431,7 @@ func (m *CallMethod) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecutorResults) Unmarshal(dAtA []byte) error {
+func (m *UpdateJet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12895,10 +17454,10 @@ func (m *ExecutorResults) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutorResults: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateJet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutorResults: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateJet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -12922,7 +17481,7 @@ func (m *ExecutorResults) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -12949,13 +17508,13 @@ func (m *ExecutorResults) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Caller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JetID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -12982,83 +17541,10 @@ func (m *ExecutorResults) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RecordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPayload
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPayload
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = append(m.Queue, &ExecutionQueueElement{})
-			if err := m.Queue[len(m.Queue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LedgerHasMoreRequests", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPayload
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.LedgerHasMoreRequests = bool(v != 0)
-		case 24:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
-			}
-			m.Pending = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPayload
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Pending |= github_com_insolar_insolar_insolar.PendingState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.JetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -13083,7 +17569,7 @@ func (m *ExecutorResults) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingFinished) Unmarshal(dAtA []byte) error {
+func (m *GetPulseReplica) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13106,10 +17592,10 @@ func (m *PendingFinished) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingFinished: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPulseReplica: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingFinished: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPulseReplica: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -13133,7 +17619,7 @@ func (m *PendingFinished) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -13160,7 +17646,7 @@ func (m *PendingFinished) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13188,7 +17674,7 @@ func (m *PendingFinished) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AdditionalCallFromPreviousExecutor) Unmarshal(dAtA []byte) error {
+func (m *PulseReplica) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13211,10 +17697,10 @@ func (m *AdditionalCallFromPreviousExecutor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AdditionalCallFromPreviousExecutor: wiretype end group for non-group")
+			return fmt.Errorf("proto: PulseReplica: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdditionalCallFromPreviousExecutor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PulseReplica: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -13238,7 +17724,7 @@ func (m *AdditionalCallFromPreviousExecutor) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectReference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -13265,32 +17751,13 @@ func (m *AdditionalCallFromPreviousExecutor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectReference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
-			}
-			m.Pending = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPayload
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Pending |= github_com_insolar_insolar_insolar.PendingState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Drops", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -13317,13 +17784,12 @@ func (m *AdditionalCallFromPreviousExecutor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RequestRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Drops = append(m.Drops, make([]byte, postIndex-iNdEx))
+			copy(m.Drops[len(m.Drops)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13350,16 +17816,14 @@ func (m *AdditionalCallFromPreviousExecutor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &record.IncomingRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Indexes = append(m.Indexes, record.Index{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13386,10 +17850,8 @@ func (m *AdditionalCallFromPreviousExecutor) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ServiceData == nil {
-				m.ServiceData = &ServiceData{}
-			}
-			if err := m.ServiceData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, record.Material{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13417,7 +17879,7 @@ func (m *AdditionalCallFromPreviousExecutor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StillExecuting) Unmarshal(dAtA []byte) error {
+func (m *Validate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13440,10 +17902,10 @@ func (m *StillExecuting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StillExecuting: wiretype end group for non-group")
+			return fmt.Errorf("proto: Validate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StillExecuting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Validate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -13467,7 +17929,7 @@ func (m *StillExecuting) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -13494,7 +17956,74 @@ func (m *StillExecuting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transcripts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transcripts = append(m.Transcripts, ValidationTranscript{})
+			if err := m.Transcripts[len(m.Transcripts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13522,7 +18051,7 @@ func (m *StillExecuting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPendings) Unmarshal(dAtA []byte) error {
+func (m *ValidationTranscript) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13545,17 +18074,17 @@ func (m *GetPendings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPendings: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidationTranscript: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPendings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidationTranscript: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			m.Polymorph = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -13565,16 +18094,30 @@ func (m *GetPendings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Polymorph |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outgoings", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -13584,22 +18127,23 @@ func (m *GetPendings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Outgoings = append(m.Outgoings, ValidationOutgoing{})
+			if err := m.Outgoings[len(m.Outgoings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13627,7 +18171,7 @@ func (m *GetPendings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HasPendings) Unmarshal(dAtA []byte) error {
+func (m *ValidationOutgoing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13650,17 +18194,17 @@ func (m *HasPendings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HasPendings: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidationOutgoing: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HasPendings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidationOutgoing: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			m.Polymorph = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -13670,16 +18214,99 @@ func (m *HasPendings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Polymorph |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Response = append(m.Response[:0], dAtA[iNdEx:postIndex]...)
+			if m.Response == nil {
+				m.Response = []byte{}
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewObject", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.Reference
+			m.NewObject = &v
+			if err := m.NewObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -13689,24 +18316,23 @@ func (m *HasPendings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13732,7 +18358,7 @@ func (m *HasPendings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingsInfo) Unmarshal(dAtA []byte) error {
+func (m *ValidationMismatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13755,10 +18381,10 @@ func (m *PendingsInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingsInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidationMismatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidationMismatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -13781,10 +18407,10 @@ func (m *PendingsInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasPendings", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -13794,70 +18420,63 @@ func (m *PendingsInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasPendings = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPayload(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Replication) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPayload
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Replication: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Replication: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
 			}
-			m.Polymorph = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -13867,14 +18486,28 @@ func (m *Replication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Polymorph |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -13901,13 +18534,13 @@ func (m *Replication) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Executor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -13934,15 +18567,16 @@ func (m *Replication) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Expected = append(m.Expected[:0], dAtA[iNdEx:postIndex]...)
+			if m.Expected == nil {
+				m.Expected = []byte{}
 			}
 			iNdEx = postIndex
-		case 22:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actual", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -13952,31 +18586,31 @@ func (m *Replication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Indexes = append(m.Indexes, record.Index{})
-			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Actual = append(m.Actual[:0], dAtA[iNdEx:postIndex]...)
+			if m.Actual == nil {
+				m.Actual = []byte{}
 			}
 			iNdEx = postIndex
-		case 23:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -13986,29 +18620,27 @@ func (m *Replication) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, record.Material{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 24:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Drop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -14035,9 +18667,8 @@ func (m *Replication) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Drop = append(m.Drop[:0], dAtA[iNdEx:postIndex]...)
-			if m.Drop == nil {
-				m.Drop = []byte{}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -14064,7 +18695,7 @@ func (m *Replication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetJet) Unmarshal(dAtA []byte) error {
+func (m *Chunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14087,10 +18718,10 @@ func (m *GetJet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetJet: wiretype end group for non-group")
+			return fmt.Errorf("proto: Chunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetJet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Chunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -14114,7 +18745,7 @@ func (m *GetJet) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -14123,105 +18754,34 @@ func (m *GetJet) Unmarshal(dAtA []byte) error {
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPayload
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
-			}
-			m.PulseNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPayload
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PulseNumber |= github_com_insolar_insolar_insolar.PulseNumber(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPayload(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AbandonedRequestsNotification) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPayload
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StreamID = append(m.StreamID[:0], dAtA[iNdEx:postIndex]...)
+			if m.StreamID == nil {
+				m.StreamID = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AbandonedRequestsNotification: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbandonedRequestsNotification: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 16:
+			iNdEx = postIndex
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Polymorph", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
 			}
-			m.Polymorph = 0
+			m.TotalSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -14231,14 +18791,33 @@ func (m *AbandonedRequestsNotification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Polymorph |= uint32(b&0x7F) << shift
+				m.TotalSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 20:
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -14265,8 +18844,9 @@ func (m *AbandonedRequestsNotification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -14293,7 +18873,7 @@ func (m *AbandonedRequestsNotification) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLightInitialState) Unmarshal(dAtA []byte) error {
+func (m *ChunkAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14316,10 +18896,10 @@ func (m *GetLightInitialState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLightInitialState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChunkAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLightInitialState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChunkAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -14341,9 +18921,9 @@ func (m *GetLightInitialState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 21:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -14370,10 +18950,30 @@ func (m *GetLightInitialState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StreamID = append(m.StreamID[:0], dAtA[iNdEx:postIndex]...)
+			if m.StreamID == nil {
+				m.StreamID = []byte{}
 			}
 			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -14398,7 +18998,7 @@ func (m *GetLightInitialState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LightInitialState) Unmarshal(dAtA []byte) error {
+func (m *SagaState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14421,10 +19021,10 @@ func (m *LightInitialState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LightInitialState: wiretype end group for non-group")
+			return fmt.Errorf("proto: SagaState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LightInitialState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SagaState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -14447,10 +19047,10 @@ func (m *LightInitialState) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkStart", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -14460,15 +19060,28 @@ func (m *LightInitialState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NetworkStart = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -14495,17 +19108,15 @@ func (m *LightInitialState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_insolar_insolar_insolar.JetID
-			m.JetIDs = append(m.JetIDs, v)
-			if err := m.JetIDs[len(m.JetIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Drops", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -14515,29 +19126,29 @@ func (m *LightInitialState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Drops = append(m.Drops, make([]byte, postIndex-iNdEx))
-			copy(m.Drops[len(m.Drops)-1], dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -14547,31 +19158,54 @@ func (m *LightInitialState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPayload
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryIn", wireType)
 			}
-			m.Indices = append(m.Indices, record.Index{})
-			if err := m.Indices[len(m.Indices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RetryIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryIn |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 24:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPayload
@@ -14581,24 +19215,23 @@ func (m *LightInitialState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPayload
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPayload
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14624,7 +19257,7 @@ func (m *LightInitialState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetIndex) Unmarshal(dAtA []byte) error {
+func (m *SagaStates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14647,10 +19280,10 @@ func (m *GetIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: SagaStates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SagaStates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -14674,7 +19307,7 @@ func (m *GetIndex) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -14701,7 +19334,41 @@ func (m *GetIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sagas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sagas = append(m.Sagas, SagaState{})
+			if err := m.Sagas[len(m.Sagas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14729,7 +19396,7 @@ func (m *GetIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateJet) Unmarshal(dAtA []byte) error {
+func (m *GetSagaStates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14752,10 +19419,10 @@ func (m *UpdateJet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateJet: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetSagaStates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateJet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetSagaStates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 16:
@@ -14779,40 +19446,7 @@ func (m *UpdateJet) Unmarshal(dAtA []byte) error {
 			}
 		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPayload
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPayload
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPayload
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JetID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -14839,7 +19473,7 @@ func (m *UpdateJet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JetID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -47,18 +47,27 @@ func _() {
 	_ = x[TypeLightInitialState-36]
 	_ = x[TypeGetIndex-37]
 	_ = x[TypeUpdateJet-38]
-	_ = x[TypeReturnResults-39]
-	_ = x[TypeCallMethod-40]
-	_ = x[TypeExecutorResults-41]
-	_ = x[TypePendingFinished-42]
-	_ = x[TypeAdditionalCallFromPreviousExecutor-43]
-	_ = x[TypeStillExecuting-44]
-	_ = x[_latestType-45]
+	_ = x[TypeGetPulseReplica-39]
+	_ = x[TypePulseReplica-40]
+	_ = x[TypeReturnResults-41]
+	_ = x[TypeCallMethod-42]
+	_ = x[TypeExecutorResults-43]
+	_ = x[TypePendingFinished-44]
+	_ = x[TypeAdditionalCallFromPreviousExecutor-45]
+	_ = x[TypeStillExecuting-46]
+	_ = x[TypeValidate-47]
+	_ = x[TypeValidationMismatch-48]
+	_ = x[TypeChunk-49]
+	_ = x[TypeChunkAck-50]
+	_ = x[TypeGetResult-51]
+	_ = x[TypeSagaStates-52]
+	_ = x[TypeGetSagaStates-53]
+	_ = x[_latestType-54]
 }
 
-const _Type_name = "TypeUnknownTypeMetaTypeErrorTypeIDTypeIDsTypeJetTypeStateTypeGetObjectTypePassStateTypeIndexTypePassTypeGetCodeTypeCodeTypeSetCodeTypeSetIncomingRequestTypeSetOutgoingRequestTypeSagaCallAcceptNotificationTypeGetFilamentTypeGetRequestTypeRequestTypeFilamentSegmentTypeSetResultTypeActivateTypeRequestInfoTypeGotHotConfirmationTypeDeactivateTypeUpdateTypeHotObjectsTypeResultInfoTypeGetPendingsTypeHasPendingsTypePendingsInfoTypeReplicationTypeGetJetTypeAbandonedRequestsNotificationTypeGetLightInitialStateTypeLightInitialStateTypeGetIndexTypeUpdateJetTypeReturnResultsTypeCallMethodTypeExecutorResultsTypePendingFinishedTypeAdditionalCallFromPreviousExecutorTypeStillExecuting_latestType"
+const _Type_name = "TypeUnknownTypeMetaTypeErrorTypeIDTypeIDsTypeJetTypeStateTypeGetObjectTypePassStateTypeIndexTypePassTypeGetCodeTypeCodeTypeSetCodeTypeSetIncomingRequestTypeSetOutgoingRequestTypeSagaCallAcceptNotificationTypeGetFilamentTypeGetRequestTypeRequestTypeFilamentSegmentTypeSetResultTypeActivateTypeRequestInfoTypeGotHotConfirmationTypeDeactivateTypeUpdateTypeHotObjectsTypeResultInfoTypeGetPendingsTypeHasPendingsTypePendingsInfoTypeReplicationTypeGetJetTypeAbandonedRequestsNotificationTypeGetLightInitialStateTypeLightInitialStateTypeGetIndexTypeUpdateJetTypeGetPulseReplicaTypePulseReplicaTypeReturnResultsTypeCallMethodTypeExecutorResultsTypePendingFinishedTypeAdditionalCallFromPreviousExecutorTypeStillExecutingTypeValidateTypeValidationMismatchTypeChunkTypeChunkAckTypeGetResultTypeSagaStatesTypeGetSagaStates_latestType"
 
-var _Type_index = [...]uint16{0, 11, 19, 28, 34, 41, 48, 57, 70, 83, 92, 100, 111, 119, 130, 152, 174, 204, 219, 233, 244, 263, 276, 288, 303, 325, 339, 349, 363, 377, 392, 407, 423, 438, 448, 481, 505, 526, 538, 551, 568, 582, 601, 620, 658, 676, 687}
+var _Type_index = [...]uint16{0, 11, 19, 28, 34, 41, 48, 57, 70, 83, 92, 100, 111, 119, 130, 152, 174, 204, 219, 233, 244, 263, 276, 288, 303, 325, 339, 349, 363, 377, 392, 407, 423, 438, 448, 481, 505, 526, 538, 551, 570, 586, 603, 617, 636, 655, 693, 711, 723, 745, 754, 766, 779, 793, 810, 821}
 
 func (i Type) String() string {
 	if i >= Type(len(_Type_index)-1) {
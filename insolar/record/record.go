@@ -230,8 +230,17 @@ func (r *OutgoingRequest) IsTemporaryUploadCode() bool {
 	return false
 }
 
+// IsReadyAt tells if detached request can be executed at provided pulse. Scheduled requests
+// are ready since their ExecuteAt pulse, other requests are always ready.
+func (r *OutgoingRequest) IsReadyAt(pulse insolar.PulseNumber) bool {
+	if r.ReturnMode != ReturnScheduled {
+		return true
+	}
+	return r.ExecuteAt <= pulse
+}
+
 func isDetached(rm ReturnMode) bool {
-	return rm == ReturnSaga
+	return rm == ReturnSaga || rm == ReturnScheduled
 }
 
 func CalculateRequestAffinityRef(
@@ -64,18 +64,22 @@ const (
 	ReturnNoWait ReturnMode = 1
 	// ReturnSaga - call saga method
 	ReturnSaga ReturnMode = 2
+	// ReturnScheduled - call method at pulse ExecuteAt
+	ReturnScheduled ReturnMode = 3
 )
 
 var ReturnMode_name = map[int32]string{
 	0: "ReturnResult",
 	1: "ReturnNoWait",
 	2: "ReturnSaga",
+	3: "ReturnScheduled",
 }
 
 var ReturnMode_value = map[string]int32{
-	"ReturnResult": 0,
-	"ReturnNoWait": 1,
-	"ReturnSaga":   2,
+	"ReturnResult":    0,
+	"ReturnNoWait":    1,
+	"ReturnSaga":      2,
+	"ReturnScheduled": 3,
 }
 
 func (ReturnMode) EnumDescriptor() ([]byte, []int) {
@@ -244,21 +248,22 @@ func (m *IncomingRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_IncomingRequest proto.InternalMessageInfo
 
 type OutgoingRequest struct {
-	Polymorph       int32                                         `protobuf:"varint,16,opt,name=polymorph,proto3" json:"polymorph,omitempty"`
-	CallType        CallType                                      `protobuf:"varint,20,opt,name=CallType,proto3,enum=record.CallType" json:"CallType,omitempty"`
-	Caller          github_com_insolar_insolar_insolar.Reference  `protobuf:"bytes,21,opt,name=Caller,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Caller"`
-	CallerPrototype github_com_insolar_insolar_insolar.Reference  `protobuf:"bytes,22,opt,name=CallerPrototype,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"CallerPrototype"`
-	Nonce           uint64                                        `protobuf:"varint,23,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
-	ReturnMode      ReturnMode                                    `protobuf:"varint,25,opt,name=ReturnMode,proto3,enum=record.ReturnMode" json:"ReturnMode,omitempty"`
-	Immutable       bool                                          `protobuf:"varint,26,opt,name=Immutable,proto3" json:"Immutable,omitempty"`
-	Base            *github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,27,opt,name=Base,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Base,omitempty"`
-	Object          *github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,28,opt,name=Object,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Object,omitempty"`
-	Prototype       *github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,29,opt,name=Prototype,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Prototype,omitempty"`
-	Method          string                                        `protobuf:"bytes,30,opt,name=Method,proto3" json:"Method,omitempty"`
-	Arguments       []byte                                        `protobuf:"bytes,31,opt,name=Arguments,proto3" json:"Arguments,omitempty"`
-	APIRequestID    string                                        `protobuf:"bytes,33,opt,name=APIRequestID,proto3" json:"APIRequestID,omitempty"`
-	Reason          github_com_insolar_insolar_insolar.Reference  `protobuf:"bytes,34,opt,name=Reason,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Reason"`
-	APINode         github_com_insolar_insolar_insolar.Reference  `protobuf:"bytes,35,opt,name=APINode,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"APINode"`
+	Polymorph       int32                                          `protobuf:"varint,16,opt,name=polymorph,proto3" json:"polymorph,omitempty"`
+	CallType        CallType                                       `protobuf:"varint,20,opt,name=CallType,proto3,enum=record.CallType" json:"CallType,omitempty"`
+	Caller          github_com_insolar_insolar_insolar.Reference   `protobuf:"bytes,21,opt,name=Caller,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Caller"`
+	CallerPrototype github_com_insolar_insolar_insolar.Reference   `protobuf:"bytes,22,opt,name=CallerPrototype,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"CallerPrototype"`
+	Nonce           uint64                                         `protobuf:"varint,23,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	ReturnMode      ReturnMode                                     `protobuf:"varint,25,opt,name=ReturnMode,proto3,enum=record.ReturnMode" json:"ReturnMode,omitempty"`
+	Immutable       bool                                           `protobuf:"varint,26,opt,name=Immutable,proto3" json:"Immutable,omitempty"`
+	Base            *github_com_insolar_insolar_insolar.Reference  `protobuf:"bytes,27,opt,name=Base,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Base,omitempty"`
+	Object          *github_com_insolar_insolar_insolar.Reference  `protobuf:"bytes,28,opt,name=Object,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Object,omitempty"`
+	Prototype       *github_com_insolar_insolar_insolar.Reference  `protobuf:"bytes,29,opt,name=Prototype,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Prototype,omitempty"`
+	Method          string                                         `protobuf:"bytes,30,opt,name=Method,proto3" json:"Method,omitempty"`
+	Arguments       []byte                                         `protobuf:"bytes,31,opt,name=Arguments,proto3" json:"Arguments,omitempty"`
+	APIRequestID    string                                         `protobuf:"bytes,33,opt,name=APIRequestID,proto3" json:"APIRequestID,omitempty"`
+	Reason          github_com_insolar_insolar_insolar.Reference   `protobuf:"bytes,34,opt,name=Reason,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Reason"`
+	APINode         github_com_insolar_insolar_insolar.Reference   `protobuf:"bytes,35,opt,name=APINode,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"APINode"`
+	ExecuteAt       github_com_insolar_insolar_insolar.PulseNumber `protobuf:"varint,36,opt,name=ExecuteAt,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"ExecuteAt"`
 }
 
 func (m *OutgoingRequest) Reset()      { *m = OutgoingRequest{} }
@@ -573,12 +578,14 @@ func (m *PendingFilament) XXX_DiscardUnknown() {
 var xxx_messageInfo_PendingFilament proto.InternalMessageInfo
 
 type Lifeline struct {
-	Polymorph           int32                                           `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
-	LatestState         *github_com_insolar_insolar_insolar.ID          `protobuf:"bytes,20,opt,name=LatestState,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"LatestState,omitempty"`
-	StateID             StateID                                         `protobuf:"varint,21,opt,name=StateID,proto3,customtype=StateID" json:"StateID"`
-	Parent              github_com_insolar_insolar_insolar.Reference    `protobuf:"bytes,22,opt,name=Parent,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Parent"`
-	LatestRequest       *github_com_insolar_insolar_insolar.ID          `protobuf:"bytes,23,opt,name=LatestRequest,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"LatestRequest,omitempty"`
-	EarliestOpenRequest *github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,24,opt,name=EarliestOpenRequest,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"EarliestOpenRequest,omitempty"`
+	Polymorph                int32                                           `protobuf:"varint,16,opt,name=Polymorph,proto3" json:"Polymorph,omitempty"`
+	LatestState              *github_com_insolar_insolar_insolar.ID          `protobuf:"bytes,20,opt,name=LatestState,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"LatestState,omitempty"`
+	StateID                  StateID                                         `protobuf:"varint,21,opt,name=StateID,proto3,customtype=StateID" json:"StateID"`
+	Parent                   github_com_insolar_insolar_insolar.Reference    `protobuf:"bytes,22,opt,name=Parent,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Parent"`
+	LatestRequest            *github_com_insolar_insolar_insolar.ID          `protobuf:"bytes,23,opt,name=LatestRequest,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"LatestRequest,omitempty"`
+	EarliestOpenRequest      *github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,24,opt,name=EarliestOpenRequest,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"EarliestOpenRequest,omitempty"`
+	EarliestScheduledRequest *github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,25,opt,name=EarliestScheduledRequest,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"EarliestScheduledRequest,omitempty"`
+	EarliestExecuteAt        *github_com_insolar_insolar_insolar.PulseNumber `protobuf:"bytes,26,opt,name=EarliestExecuteAt,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"EarliestExecuteAt,omitempty"`
 }
 
 func (m *Lifeline) Reset()      { *m = Lifeline{} }
@@ -1222,99 +1229,103 @@ func init() {
 func init() { proto.RegisterFile("insolar/record/record.proto", fileDescriptor_0c86cc3f6f53fe45) }
 
 var fileDescriptor_0c86cc3f6f53fe45 = []byte{
-	// 1471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4b, 0x6f, 0x5b, 0xc5,
-	0x17, 0xbf, 0xd7, 0xb1, 0xf3, 0x38, 0x79, 0xb9, 0xf3, 0x6f, 0x93, 0xf9, 0xf7, 0x71, 0x13, 0x8c,
-	0x2a, 0xb9, 0xa5, 0x4d, 0xaa, 0x52, 0x55, 0x08, 0x09, 0x89, 0xc4, 0x6e, 0xf1, 0x0d, 0x79, 0x98,
-	0x49, 0x0a, 0xac, 0x90, 0xc6, 0xf6, 0xc4, 0xbe, 0xe5, 0xfa, 0x5e, 0x73, 0x1f, 0x15, 0xd9, 0xf1,
-	0x11, 0x10, 0x12, 0x2c, 0x58, 0xb1, 0x41, 0xea, 0x96, 0x0d, 0x6b, 0x96, 0x11, 0xab, 0x76, 0x57,
-	0x90, 0xa8, 0x48, 0xba, 0x61, 0x59, 0xf1, 0x09, 0xd0, 0x3c, 0xee, 0xc3, 0x0e, 0xd4, 0x89, 0x5d,
-	0x21, 0x15, 0x65, 0xe5, 0x99, 0x33, 0xe7, 0xfc, 0xee, 0x9c, 0xd7, 0x9c, 0x39, 0x63, 0xb8, 0x60,
-	0x39, 0xbe, 0x6b, 0x53, 0x6f, 0xd9, 0x63, 0x75, 0xd7, 0x6b, 0xa8, 0x9f, 0xa5, 0x8e, 0xe7, 0x06,
-	0x2e, 0x1a, 0x95, 0xb3, 0xf3, 0xd7, 0x9b, 0x56, 0xd0, 0x0a, 0x6b, 0x4b, 0x75, 0xb7, 0xbd, 0xdc,
-	0x74, 0x9b, 0xee, 0xb2, 0x58, 0xae, 0x85, 0xbb, 0x62, 0x26, 0x26, 0x62, 0x24, 0xc5, 0x0a, 0x2b,
-	0x30, 0xf6, 0x1e, 0x73, 0x98, 0x6f, 0xf9, 0xe8, 0x22, 0x4c, 0x74, 0x5c, 0x7b, 0xaf, 0xed, 0x7a,
-	0x9d, 0x16, 0xce, 0x2f, 0xea, 0xc5, 0x1c, 0x49, 0x08, 0x08, 0x41, 0xb6, 0x42, 0xfd, 0x16, 0x3e,
-	0xbb, 0xa8, 0x17, 0xa7, 0x88, 0x18, 0xbf, 0x9d, 0x7d, 0xf8, 0xdd, 0x82, 0x5e, 0xf8, 0x49, 0x87,
-	0x5c, 0xa9, 0x65, 0xd9, 0x8d, 0x3e, 0x08, 0xef, 0xc3, 0x44, 0xd5, 0x63, 0x0f, 0x04, 0xab, 0x84,
-	0x59, 0xbd, 0xbe, 0xff, 0x74, 0x41, 0xfb, 0xf5, 0xe9, 0xc2, 0xe5, 0xd4, 0xa6, 0x23, 0x25, 0x7b,
-	0x7e, 0x97, 0xcc, 0x32, 0x49, 0xe4, 0xd1, 0x5d, 0x18, 0x21, 0x6c, 0x17, 0x9f, 0x13, 0x30, 0xb7,
-	0x14, 0xcc, 0xb5, 0x63, 0xc0, 0x10, 0xb6, 0xcb, 0x3c, 0xe6, 0xd4, 0x19, 0xe1, 0x00, 0x4a, 0x85,
-	0x2b, 0x30, 0xb2, 0xc6, 0x82, 0x17, 0xef, 0x5f, 0xb1, 0x3e, 0x1e, 0x85, 0x59, 0xd3, 0xa9, 0xbb,
-	0x6d, 0xcb, 0x69, 0x12, 0xf6, 0x59, 0xc8, 0xfc, 0x3e, 0x72, 0xe8, 0x1a, 0x8c, 0x97, 0xa8, 0x6d,
-	0xef, 0xec, 0x75, 0x98, 0x50, 0x7b, 0xe6, 0x66, 0x7e, 0x49, 0xb9, 0x2e, 0xa2, 0x93, 0x98, 0x03,
-	0xad, 0xc3, 0x28, 0x1f, 0x33, 0x6f, 0x28, 0xdd, 0x14, 0x06, 0xfa, 0x04, 0x66, 0xe5, 0xa8, 0xca,
-	0xbd, 0x1d, 0xf0, 0x2d, 0xcc, 0x0d, 0x01, 0xdb, 0x0b, 0x86, 0xce, 0x42, 0x6e, 0xd3, 0x75, 0xea,
-	0x0c, 0xcf, 0x2f, 0xea, 0xc5, 0x2c, 0x91, 0x13, 0x74, 0x13, 0x80, 0xb0, 0x20, 0xf4, 0x9c, 0x0d,
-	0xb7, 0xc1, 0xf0, 0xff, 0x85, 0xce, 0x28, 0xd2, 0x39, 0x59, 0x21, 0x29, 0x2e, 0x6e, 0x43, 0xb3,
-	0xdd, 0x0e, 0x03, 0x5a, 0xb3, 0x19, 0x3e, 0xbf, 0xa8, 0x17, 0xc7, 0x49, 0x42, 0x40, 0x65, 0xc8,
-	0xae, 0x52, 0x9f, 0xe1, 0x0b, 0x62, 0xf3, 0x37, 0x4e, 0xbc, 0x71, 0x21, 0x8d, 0x2a, 0x30, 0xba,
-	0x55, 0xbb, 0xcf, 0xea, 0x01, 0xbe, 0x38, 0x20, 0x8e, 0x92, 0x47, 0x9b, 0x3c, 0x96, 0x23, 0x8b,
-	0x5e, 0x1a, 0x10, 0x2c, 0x81, 0x40, 0x73, 0x30, 0xba, 0xc1, 0x82, 0x96, 0xdb, 0xc0, 0xc6, 0xa2,
-	0x5e, 0x9c, 0x20, 0x6a, 0xc6, 0xad, 0xb2, 0xe2, 0x35, 0xc3, 0x36, 0x73, 0x02, 0x1f, 0x2f, 0x88,
-	0xd4, 0x4b, 0x08, 0xa8, 0x00, 0x53, 0x2b, 0x55, 0x53, 0x45, 0xa1, 0x59, 0xc6, 0xaf, 0x09, 0xd9,
-	0x2e, 0x1a, 0x8f, 0x27, 0xc2, 0xa8, 0xef, 0x3a, 0xb8, 0x30, 0x4c, 0x3c, 0x49, 0x0c, 0xb4, 0x09,
-	0x63, 0x2b, 0x55, 0x73, 0x93, 0xbb, 0xf5, 0xf5, 0x21, 0xe0, 0x22, 0x90, 0x54, 0x4e, 0x6d, 0x85,
-	0x41, 0xd3, 0x3d, 0xcd, 0xa9, 0xd3, 0x9c, 0x3a, 0xcd, 0xa9, 0x97, 0x92, 0x53, 0xbf, 0xe9, 0x7c,
-	0x93, 0x7e, 0x68, 0xf7, 0x4b, 0xa5, 0x3b, 0xb1, 0x03, 0x07, 0xaa, 0xc9, 0x89, 0xf7, 0xc6, 0x94,
-	0x81, 0x86, 0x4a, 0xb2, 0x08, 0x04, 0x61, 0x18, 0xab, 0xd2, 0x3d, 0xdb, 0xa5, 0x0d, 0x99, 0x5d,
-	0x24, 0x9a, 0x2a, 0xfd, 0xfe, 0xd4, 0x21, 0x2b, 0x92, 0xfb, 0xc5, 0xda, 0xad, 0xc3, 0x68, 0xd9,
-	0x6d, 0x53, 0xcb, 0x51, 0xda, 0x0d, 0xe8, 0x2a, 0x89, 0xf1, 0xd2, 0x95, 0x2c, 0xc2, 0x2c, 0xd7,
-	0xa1, 0xcc, 0xea, 0x36, 0xf5, 0x68, 0x60, 0xb9, 0x8e, 0x52, 0xb6, 0x97, 0xac, 0x94, 0xfe, 0x31,
-	0x03, 0xd9, 0x92, 0xca, 0xec, 0x57, 0x56, 0x69, 0x24, 0x75, 0x50, 0x9a, 0x4a, 0x7d, 0x3e, 0x86,
-	0xc9, 0x0d, 0x5a, 0x6f, 0x59, 0x0e, 0x13, 0x47, 0x3a, 0x3f, 0xf9, 0xa6, 0x57, 0x6f, 0xab, 0xef,
-	0x2c, 0x1d, 0xe3, 0x3b, 0x29, 0x69, 0x92, 0x86, 0x52, 0x86, 0xfb, 0x76, 0x04, 0xc6, 0x57, 0xea,
-	0x81, 0xf5, 0x80, 0x06, 0xaf, 0xb6, 0xf1, 0xc4, 0xa1, 0xd6, 0x76, 0xbd, 0x3d, 0x65, 0x3e, 0x35,
-	0x43, 0x6b, 0x90, 0x33, 0xdb, 0xb4, 0x29, 0x4d, 0x37, 0xe8, 0x57, 0x24, 0x04, 0x5a, 0x84, 0x49,
-	0xd3, 0x4f, 0x8e, 0x62, 0x2c, 0x0a, 0x47, 0x9a, 0xc4, 0x6d, 0x54, 0xa5, 0x1e, 0x73, 0x02, 0x51,
-	0x88, 0x06, 0xb6, 0x91, 0xc4, 0x28, 0x7c, 0x33, 0x02, 0xb9, 0x95, 0x36, 0x73, 0x1a, 0xa7, 0x9e,
-	0x19, 0xda, 0x33, 0xaa, 0xc9, 0xda, 0x0e, 0x68, 0xc0, 0x94, 0x73, 0x06, 0x69, 0xb2, 0x84, 0x7c,
-	0xe1, 0xeb, 0x0c, 0x40, 0x99, 0xd1, 0xff, 0x42, 0xde, 0x74, 0xd9, 0x65, 0x6e, 0x48, 0xbb, 0x3c,
-	0xd6, 0x61, 0xb6, 0xca, 0x9c, 0x86, 0xe5, 0x34, 0xef, 0x5a, 0x36, 0xe5, 0x17, 0x87, 0x3e, 0xc6,
-	0x31, 0x61, 0x9c, 0x88, 0xab, 0x9a, 0x59, 0x1e, 0xac, 0xcc, 0xc6, 0xe2, 0xe8, 0x1e, 0xcc, 0xf0,
-	0x9d, 0x58, 0x6e, 0xe8, 0x4b, 0x9a, 0x32, 0x10, 0x07, 0xd4, 0x8f, 0x0f, 0xd8, 0x03, 0x52, 0xf8,
-	0x61, 0x04, 0xc6, 0xd7, 0xad, 0x5d, 0x66, 0x5b, 0x8e, 0xf0, 0x74, 0xb5, 0x57, 0x99, 0x98, 0x80,
-	0xb6, 0x60, 0x72, 0x9d, 0x06, 0xcc, 0x0f, 0xa4, 0x35, 0xcf, 0x0e, 0xf2, 0xf9, 0x34, 0x02, 0xba,
-	0x02, 0x63, 0x62, 0x60, 0x96, 0x85, 0x2e, 0xd3, 0xab, 0xb3, 0xca, 0x38, 0x11, 0x99, 0x44, 0x83,
-	0xd4, 0xc9, 0x33, 0x37, 0xfc, 0xc9, 0x83, 0xb6, 0x61, 0x5a, 0xee, 0x23, 0x8a, 0xb5, 0xf9, 0x41,
-	0x74, 0xe9, 0xc6, 0x40, 0x2d, 0xf8, 0xdf, 0x1d, 0xea, 0xd9, 0x16, 0xf3, 0x83, 0xad, 0x0e, 0x73,
-	0x22, 0x68, 0x2c, 0xa0, 0x6f, 0x2b, 0xe8, 0xe3, 0xd4, 0xb4, 0x6a, 0x68, 0xfb, 0x6c, 0x33, 0x6c,
-	0xd7, 0x98, 0x47, 0xfe, 0x0e, 0xb2, 0xf0, 0x4b, 0x06, 0x72, 0xa6, 0xd3, 0x60, 0x9f, 0xf7, 0x71,
-	0x58, 0x09, 0x72, 0x5b, 0xb5, 0xfb, 0x83, 0x86, 0x9e, 0x94, 0x45, 0x37, 0x93, 0xf8, 0x10, 0x5e,
-	0x9a, 0x4c, 0x5a, 0xae, 0x88, 0xbe, 0x9a, 0xe5, 0xc8, 0x24, 0x89, 0xa3, 0x1a, 0xe4, 0xa3, 0xf1,
-	0x3a, 0xf5, 0x83, 0x7b, 0x3e, 0x93, 0xb7, 0xb9, 0x93, 0xd5, 0xf6, 0xb4, 0x1d, 0x8e, 0xe0, 0x89,
-	0x7c, 0x90, 0xb9, 0x28, 0x23, 0xd9, 0xc7, 0xf3, 0x8b, 0x23, 0x27, 0xd7, 0xb2, 0x07, 0xa4, 0xf0,
-	0x55, 0x0e, 0xc6, 0x3e, 0xb4, 0xbc, 0x20, 0xa4, 0x76, 0x9f, 0xdc, 0x7e, 0x23, 0x7e, 0x42, 0xc3,
-	0x4c, 0xd8, 0x65, 0x36, 0xb2, 0x8b, 0x22, 0x57, 0x34, 0x12, 0x3f, 0xb2, 0x5d, 0x56, 0x6f, 0x65,
-	0x78, 0x57, 0xb0, 0x4e, 0xc7, 0x5d, 0x2b, 0x27, 0x56, 0x34, 0xa2, 0x5e, 0xd2, 0x16, 0xc4, 0x83,
-	0x14, 0x6e, 0x0a, 0xa6, 0xc9, 0x88, 0x69, 0x8d, 0x05, 0x15, 0x8d, 0x88, 0xa7, 0xaa, 0xd2, 0x91,
-	0x57, 0x28, 0xdc, 0x12, 0xcc, 0xf3, 0x11, 0x73, 0xcf, 0x72, 0x45, 0x23, 0x47, 0xde, 0xad, 0x4a,
-	0x47, 0xda, 0x6e, 0x6c, 0x75, 0x83, 0xf4, 0x2c, 0x73, 0x90, 0xde, 0x46, 0xbd, 0x18, 0xf5, 0x19,
-	0xf8, 0xbe, 0x90, 0x9d, 0x49, 0x9a, 0x52, 0x4e, 0xad, 0x68, 0x24, 0xea, 0x43, 0x0a, 0xf2, 0xc6,
-	0x8e, 0x3f, 0x15, 0x7c, 0x53, 0x11, 0x1f, 0xa7, 0x55, 0x34, 0x22, 0x6f, 0xf3, 0x05, 0x75, 0x39,
-	0xb4, 0xbb, 0x79, 0x38, 0x8d, 0xf3, 0x88, 0xcb, 0xe2, 0x52, 0x72, 0x97, 0xc3, 0xed, 0xee, 0x48,
-	0x8c, 0xe8, 0x15, 0x8d, 0x24, 0xf7, 0xbd, 0xcb, 0xea, 0x7a, 0x81, 0x9d, 0x6e, 0x9b, 0x0b, 0x22,
-	0xb7, 0xb9, 0xbc, 0x7c, 0xdc, 0x4a, 0x17, 0x3b, 0xec, 0x0a, 0xde, 0xb8, 0xc3, 0x4e, 0x56, 0x2a,
-	0x1a, 0x49, 0x17, 0xc5, 0xd2, 0x91, 0x52, 0x80, 0x3b, 0xdd, 0x36, 0xec, 0x59, 0xe6, 0x36, 0xec,
-	0x2d, 0x1e, 0x97, 0x60, 0x62, 0xdb, 0x6a, 0x3a, 0x34, 0x08, 0x3d, 0x86, 0xf7, 0x75, 0xd9, 0x93,
-	0xc6, 0x94, 0xd5, 0x31, 0xc8, 0x85, 0x8e, 0xe5, 0x3a, 0x85, 0x9f, 0x33, 0x30, 0xbe, 0x41, 0x03,
-	0xe6, 0x59, 0x7d, 0xa3, 0x72, 0x39, 0x0e, 0x5f, 0x91, 0xf5, 0xa9, 0xa8, 0x54, 0x64, 0x95, 0xac,
-	0x71, 0x90, 0xbf, 0x03, 0x19, 0x75, 0xfe, 0x9e, 0x38, 0x77, 0x32, 0x66, 0x99, 0x57, 0x38, 0xd9,
-	0x09, 0x9a, 0xe5, 0xc1, 0xea, 0x6b, 0x2c, 0x8e, 0xee, 0x42, 0x6e, 0x8d, 0x71, 0x1c, 0x79, 0x1a,
-	0xdf, 0x50, 0x38, 0xc5, 0x63, 0xe0, 0x08, 0x39, 0x22, 0xc5, 0xfb, 0x58, 0xb5, 0xf0, 0x7d, 0x06,
-	0xe6, 0x4b, 0x6e, 0xbb, 0xe3, 0xfa, 0x56, 0xc0, 0x22, 0x57, 0xc8, 0xf4, 0xff, 0xf7, 0xaa, 0xf9,
-	0x12, 0xcf, 0x9e, 0xb8, 0x8a, 0xa7, 0x22, 0x39, 0x72, 0xb3, 0x72, 0x93, 0xe2, 0xe2, 0xdd, 0xfa,
-	0x06, 0x0b, 0xe8, 0xa0, 0x46, 0x56, 0xc2, 0xe8, 0x2a, 0x64, 0xf9, 0x48, 0x58, 0xf8, 0x9f, 0x3f,
-	0x2a, 0x78, 0xae, 0x7e, 0x90, 0xbc, 0xb5, 0xa1, 0x29, 0x18, 0x2f, 0xed, 0xc8, 0x77, 0x94, 0xbc,
-	0x86, 0xce, 0xc0, 0x74, 0x69, 0x67, 0x9b, 0x3e, 0x60, 0x2b, 0xbe, 0x38, 0xb6, 0xf2, 0x3a, 0x9a,
-	0x86, 0x89, 0xd2, 0x8e, 0x3a, 0xec, 0xf2, 0x19, 0x74, 0x0e, 0xce, 0x94, 0x76, 0xca, 0xac, 0x63,
-	0xbb, 0x7b, 0xf1, 0x1d, 0x35, 0x3f, 0x72, 0xf5, 0xdd, 0xf4, 0x63, 0x16, 0xca, 0xc3, 0x94, 0x9c,
-	0xc9, 0x73, 0x22, 0xaf, 0x25, 0x94, 0x4d, 0xf7, 0x23, 0x6a, 0x05, 0x79, 0x1d, 0xcd, 0x44, 0x12,
-	0xdb, 0xb4, 0x49, 0xf3, 0x99, 0xd5, 0xb7, 0xf6, 0x0f, 0x0c, 0xed, 0xd1, 0x81, 0xa1, 0x3d, 0x39,
-	0x30, 0xb4, 0xe7, 0x07, 0x86, 0xfe, 0xc5, 0xa1, 0xa1, 0x3f, 0x3c, 0x34, 0xf4, 0xfd, 0x43, 0x43,
-	0x7f, 0x74, 0x68, 0xe8, 0xbf, 0x1f, 0x1a, 0xfa, 0x1f, 0x87, 0x86, 0xf6, 0xfc, 0xd0, 0xd0, 0xbf,
-	0x7c, 0x66, 0x68, 0x8f, 0x9e, 0x19, 0xda, 0x93, 0x67, 0x86, 0x56, 0x1b, 0x15, 0x7f, 0x7c, 0xbc,
-	0xf9, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x17, 0x22, 0x0a, 0x4e, 0x19, 0x00, 0x00,
+	// 1525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xdf, 0x75, 0xfc, 0x91, 0xbc, 0x7c, 0xd8, 0x9d, 0xa6, 0xc9, 0xa4, 0x1f, 0x1b, 0x63, 0xa8,
+	0xe4, 0x96, 0x36, 0xa9, 0x4a, 0x55, 0x21, 0x24, 0x0e, 0x8e, 0xdd, 0x62, 0x87, 0x7c, 0x98, 0x4d,
+	0x0a, 0x9c, 0x90, 0xd6, 0xf6, 0xc4, 0xde, 0xb2, 0xde, 0x35, 0xbb, 0xb3, 0x55, 0x73, 0xe3, 0xce,
+	0x05, 0x21, 0xc1, 0x81, 0x13, 0x17, 0x44, 0xff, 0x02, 0xce, 0x1c, 0x23, 0x4e, 0xed, 0xad, 0x20,
+	0x51, 0x11, 0xf7, 0xc2, 0xb1, 0xe2, 0x2f, 0x40, 0xf3, 0xb1, 0xbb, 0xb6, 0x03, 0x75, 0x62, 0x23,
+	0xa4, 0xa2, 0x9c, 0x3c, 0xf3, 0x9b, 0xf7, 0x7e, 0x3b, 0xef, 0xcd, 0x7b, 0x6f, 0x3e, 0x0c, 0x17,
+	0x4c, 0xdb, 0x73, 0x2c, 0xc3, 0x5d, 0x75, 0x49, 0xdd, 0x71, 0x1b, 0xf2, 0x67, 0xa5, 0xe3, 0x3a,
+	0xd4, 0x41, 0x49, 0xd1, 0x3b, 0x7f, 0xbd, 0x69, 0xd2, 0x96, 0x5f, 0x5b, 0xa9, 0x3b, 0xed, 0xd5,
+	0xa6, 0xd3, 0x74, 0x56, 0xf9, 0x70, 0xcd, 0xdf, 0xe3, 0x3d, 0xde, 0xe1, 0x2d, 0xa1, 0x96, 0x2b,
+	0x40, 0xea, 0x3d, 0x62, 0x13, 0xcf, 0xf4, 0xd0, 0x45, 0x98, 0xea, 0x38, 0xd6, 0x7e, 0xdb, 0x71,
+	0x3b, 0x2d, 0x9c, 0xc9, 0xaa, 0xf9, 0x84, 0x1e, 0x01, 0x08, 0x41, 0xbc, 0x6c, 0x78, 0x2d, 0x3c,
+	0x9f, 0x55, 0xf3, 0x33, 0x3a, 0x6f, 0xbf, 0x13, 0x7f, 0xf4, 0xdd, 0xb2, 0x9a, 0xfb, 0x49, 0x85,
+	0x44, 0xb1, 0x65, 0x5a, 0x8d, 0x21, 0x0c, 0xef, 0xc3, 0x54, 0xd5, 0x25, 0x0f, 0xb8, 0xa8, 0xa0,
+	0x59, 0xbb, 0x7e, 0xf0, 0x6c, 0x59, 0xf9, 0xf5, 0xd9, 0xf2, 0xe5, 0x9e, 0x49, 0x07, 0x46, 0x0e,
+	0xfc, 0xae, 0x54, 0x4a, 0x7a, 0xa4, 0x8f, 0xee, 0xc2, 0x84, 0x4e, 0xf6, 0xf0, 0x39, 0x4e, 0x73,
+	0x4b, 0xd2, 0x5c, 0x3b, 0x06, 0x8d, 0x4e, 0xf6, 0x88, 0x4b, 0xec, 0x3a, 0xd1, 0x19, 0x81, 0x34,
+	0xe1, 0x0a, 0x4c, 0xac, 0x13, 0xfa, 0xf2, 0xf9, 0x4b, 0xd1, 0x27, 0x49, 0x48, 0x57, 0xec, 0xba,
+	0xd3, 0x36, 0xed, 0xa6, 0x4e, 0x3e, 0xf3, 0x89, 0x37, 0x44, 0x0f, 0x5d, 0x83, 0xc9, 0xa2, 0x61,
+	0x59, 0xbb, 0xfb, 0x1d, 0xc2, 0xcd, 0x9e, 0xbb, 0x99, 0x59, 0x91, 0x4b, 0x17, 0xe0, 0x7a, 0x28,
+	0x81, 0x36, 0x20, 0xc9, 0xda, 0xc4, 0x1d, 0xcb, 0x36, 0xc9, 0x81, 0x3e, 0x81, 0xb4, 0x68, 0x55,
+	0xd9, 0x6a, 0x53, 0x36, 0x85, 0x85, 0x31, 0x68, 0x07, 0xc9, 0xd0, 0x3c, 0x24, 0xb6, 0x1c, 0xbb,
+	0x4e, 0xf0, 0x62, 0x56, 0xcd, 0xc7, 0x75, 0xd1, 0x41, 0x37, 0x01, 0x74, 0x42, 0x7d, 0xd7, 0xde,
+	0x74, 0x1a, 0x04, 0x2f, 0x71, 0x9b, 0x51, 0x60, 0x73, 0x34, 0xa2, 0xf7, 0x48, 0x31, 0x1f, 0x56,
+	0xda, 0x6d, 0x9f, 0x1a, 0x35, 0x8b, 0xe0, 0xf3, 0x59, 0x35, 0x3f, 0xa9, 0x47, 0x00, 0x2a, 0x41,
+	0x7c, 0xcd, 0xf0, 0x08, 0xbe, 0xc0, 0x27, 0x7f, 0xe3, 0xc4, 0x13, 0xe7, 0xda, 0xa8, 0x0c, 0xc9,
+	0xed, 0xda, 0x7d, 0x52, 0xa7, 0xf8, 0xe2, 0x88, 0x3c, 0x52, 0x1f, 0x6d, 0xc1, 0x54, 0xe8, 0x04,
+	0x7c, 0x69, 0x44, 0xb2, 0x88, 0x02, 0x2d, 0x40, 0x72, 0x93, 0xd0, 0x96, 0xd3, 0xc0, 0x5a, 0x56,
+	0xcd, 0x4f, 0xe9, 0xb2, 0xc7, 0xbc, 0x52, 0x70, 0x9b, 0x7e, 0x9b, 0xd8, 0xd4, 0xc3, 0xcb, 0x3c,
+	0xf5, 0x22, 0x00, 0xe5, 0x60, 0xa6, 0x50, 0xad, 0xc8, 0x28, 0xac, 0x94, 0xf0, 0x6b, 0x5c, 0xb7,
+	0x0f, 0x63, 0xf1, 0xa4, 0x13, 0xc3, 0x73, 0x6c, 0x9c, 0x1b, 0x27, 0x9e, 0x04, 0x07, 0xda, 0x82,
+	0x54, 0xa1, 0x5a, 0xd9, 0x62, 0xcb, 0xfa, 0xfa, 0x18, 0x74, 0x01, 0x89, 0xcc, 0xa9, 0x1f, 0x52,
+	0x90, 0xde, 0xf6, 0x69, 0xd3, 0x39, 0xcd, 0xa9, 0xd3, 0x9c, 0x3a, 0xcd, 0xa9, 0xf1, 0x72, 0x0a,
+	0xed, 0xc2, 0xd4, 0x9d, 0x87, 0xa4, 0xee, 0x53, 0x52, 0xa0, 0xf8, 0x8d, 0xac, 0x9a, 0x9f, 0x5d,
+	0xbb, 0x2d, 0x19, 0x57, 0x8e, 0xc1, 0x58, 0xf5, 0x2d, 0x8f, 0x6c, 0xf9, 0xed, 0x1a, 0x71, 0xf5,
+	0x88, 0x48, 0x66, 0xea, 0x6f, 0x2a, 0x33, 0xdd, 0xf3, 0xad, 0x61, 0x09, 0x7a, 0x27, 0x0c, 0x8b,
+	0x91, 0x76, 0xfa, 0x28, 0x26, 0x52, 0xd2, 0xed, 0x63, 0xa5, 0x6e, 0x40, 0x82, 0x30, 0xa4, 0xaa,
+	0xc6, 0xbe, 0xe5, 0x18, 0x0d, 0x91, 0xb3, 0x7a, 0xd0, 0x95, 0xf6, 0xfd, 0xa9, 0x42, 0x9c, 0x97,
+	0x8c, 0x97, 0x5b, 0xb7, 0x01, 0xc9, 0x92, 0xd3, 0x36, 0x4c, 0x1b, 0xcf, 0x8f, 0x31, 0x2b, 0xc9,
+	0xf1, 0xaf, 0x1b, 0x99, 0x87, 0x34, 0xb3, 0xa1, 0x44, 0xea, 0x96, 0xe1, 0x1a, 0xd4, 0x74, 0x6c,
+	0x69, 0xec, 0x20, 0x2c, 0x8d, 0xfe, 0x31, 0x06, 0xf1, 0xa2, 0xac, 0x17, 0xaf, 0xac, 0xd1, 0x48,
+	0xd8, 0x20, 0x2d, 0x15, 0xf6, 0x7c, 0x0c, 0xd3, 0x9b, 0x46, 0xbd, 0x65, 0xda, 0x84, 0x6f, 0x14,
+	0x8b, 0x27, 0xce, 0x85, 0x1e, 0x6d, 0xbd, 0x97, 0x4a, 0x3a, 0xee, 0xdb, 0x09, 0x98, 0x2c, 0xd4,
+	0xa9, 0xf9, 0xc0, 0xa0, 0xaf, 0xb6, 0xf3, 0x78, 0xa9, 0x6c, 0x3b, 0xee, 0xbe, 0x74, 0x9f, 0xec,
+	0xa1, 0x75, 0x48, 0x54, 0xda, 0x46, 0x53, 0xb8, 0x6e, 0xd4, 0xaf, 0x08, 0x0a, 0x94, 0x85, 0xe9,
+	0x8a, 0x17, 0x15, 0x78, 0xcc, 0xb7, 0xa3, 0x5e, 0x88, 0xf9, 0xa8, 0x6a, 0xb8, 0xc4, 0xa6, 0x78,
+	0x69, 0x8c, 0xcf, 0x49, 0x8e, 0xdc, 0x37, 0x13, 0x90, 0x28, 0xb4, 0x89, 0xdd, 0x38, 0x5d, 0x99,
+	0xb1, 0x57, 0x46, 0x5e, 0xdd, 0x76, 0xa8, 0x41, 0x09, 0x5e, 0x1a, 0xa5, 0xa0, 0x47, 0xfa, 0xb9,
+	0xaf, 0x63, 0x00, 0x25, 0x62, 0xfc, 0x1f, 0xf2, 0xa6, 0xcf, 0x2f, 0x0b, 0x63, 0xfa, 0xe5, 0x89,
+	0x0a, 0xe9, 0x2a, 0xb1, 0x1b, 0xa6, 0xdd, 0xbc, 0x6b, 0x5a, 0x06, 0x3b, 0x8e, 0x0c, 0x71, 0x4e,
+	0x05, 0x26, 0x75, 0x7e, 0x00, 0xac, 0x94, 0x46, 0xdb, 0x66, 0x43, 0x75, 0x74, 0x0f, 0xe6, 0xd8,
+	0x4c, 0x4c, 0xc7, 0xf7, 0x04, 0x86, 0xcf, 0x85, 0x84, 0xea, 0xf1, 0x09, 0x07, 0x48, 0x72, 0x5f,
+	0x24, 0x60, 0x72, 0xc3, 0xdc, 0x23, 0x96, 0x69, 0xf3, 0x95, 0xae, 0x0e, 0x1a, 0x13, 0x02, 0x68,
+	0x1b, 0xa6, 0x37, 0x0c, 0x4a, 0x3c, 0x2a, 0xbc, 0x39, 0x3f, 0xca, 0xe7, 0x7b, 0x19, 0xd0, 0x15,
+	0x48, 0xf1, 0x46, 0xa5, 0xc4, 0x6d, 0x99, 0x5d, 0x4b, 0x4b, 0xe7, 0x04, 0xb0, 0x1e, 0x34, 0x7a,
+	0x2a, 0xcf, 0xc2, 0xf8, 0x95, 0x07, 0xed, 0xc0, 0xac, 0x98, 0x47, 0x10, 0x6b, 0x8b, 0xa3, 0xd8,
+	0xd2, 0xcf, 0x81, 0x5a, 0x70, 0xf6, 0x8e, 0xe1, 0x5a, 0x26, 0xf1, 0xe8, 0x76, 0x87, 0xd8, 0x01,
+	0x35, 0xe6, 0xd4, 0xb7, 0x25, 0xf5, 0x49, 0xcf, 0x77, 0x7f, 0x47, 0x89, 0x5c, 0xc0, 0x01, 0xbc,
+	0x53, 0x6f, 0x91, 0x86, 0x6f, 0x91, 0x46, 0xf0, 0xb9, 0xa5, 0xb1, 0x3e, 0xf7, 0x8f, 0xbc, 0xa8,
+	0x01, 0x67, 0x82, 0xb1, 0xe8, 0xec, 0x7a, 0x7e, 0xac, 0x8f, 0x1d, 0x25, 0xcc, 0xfd, 0x12, 0x83,
+	0x44, 0xc5, 0x6e, 0x90, 0x87, 0x43, 0x42, 0xb1, 0x08, 0x89, 0xed, 0xda, 0xfd, 0x51, 0x93, 0x4a,
+	0xe8, 0xa2, 0x9b, 0x51, 0xe4, 0xf3, 0xf8, 0x9b, 0x8e, 0xae, 0xa8, 0x01, 0xbe, 0x16, 0x67, 0xcc,
+	0x7a, 0x94, 0x21, 0x35, 0xc8, 0x04, 0xed, 0x0d, 0xc3, 0xa3, 0xf7, 0x3c, 0x22, 0xce, 0xa9, 0xa3,
+	0x9f, 0xe0, 0x8f, 0xf0, 0xf1, 0x4c, 0x17, 0x55, 0x46, 0xe4, 0xa8, 0x87, 0x17, 0xb3, 0x13, 0x27,
+	0xb7, 0x72, 0x80, 0x24, 0xf7, 0x55, 0x02, 0x52, 0x1f, 0x9a, 0x2e, 0xf5, 0x0d, 0x6b, 0x48, 0xd5,
+	0x7a, 0x33, 0x7c, 0x72, 0xc4, 0x84, 0xfb, 0x25, 0x1d, 0xf8, 0x45, 0xc2, 0x65, 0x45, 0x0f, 0x24,
+	0xd0, 0x65, 0xf9, 0xb6, 0x88, 0xf7, 0xb8, 0xe8, 0x6c, 0x78, 0xcb, 0x67, 0x60, 0x59, 0xd1, 0xc5,
+	0x28, 0x5a, 0xe6, 0x0f, 0x78, 0xb8, 0xc9, 0x85, 0xa6, 0x03, 0xa1, 0x75, 0x42, 0xcb, 0x8a, 0xce,
+	0x46, 0x50, 0xf1, 0xc8, 0xab, 0x1d, 0x6e, 0x71, 0xe1, 0xc5, 0x40, 0x78, 0x60, 0xb8, 0xac, 0xe8,
+	0x83, 0x1a, 0xa8, 0x78, 0xe4, 0x99, 0x02, 0x9b, 0xfd, 0x24, 0x03, 0xc3, 0x8c, 0x64, 0x00, 0x42,
+	0xf9, 0xe0, 0x06, 0x85, 0xef, 0x73, 0xdd, 0xb9, 0xe8, 0x12, 0xcf, 0xd0, 0xb2, 0xa2, 0xcb, 0x71,
+	0x94, 0x13, 0x77, 0x11, 0xfc, 0x29, 0x97, 0x9b, 0x09, 0xe4, 0x18, 0x56, 0x56, 0x74, 0x3e, 0xc6,
+	0x64, 0xf8, 0xb1, 0xd7, 0xea, 0x97, 0x61, 0x18, 0x93, 0x61, 0xbf, 0x68, 0x25, 0x3a, 0xa5, 0xe2,
+	0x76, 0x7f, 0x24, 0x06, 0x78, 0x59, 0xd1, 0x43, 0x19, 0x74, 0x59, 0x1e, 0x9c, 0xb0, 0xdd, 0xef,
+	0x73, 0x0e, 0x32, 0x9f, 0xf3, 0x06, 0xba, 0xd5, 0xbb, 0x8d, 0x63, 0x87, 0xcb, 0x86, 0x2f, 0x12,
+	0xd1, 0x48, 0x59, 0xd1, 0x7b, 0xb7, 0xfb, 0xe2, 0x91, 0x4d, 0x0e, 0x77, 0xfa, 0x7d, 0x38, 0x30,
+	0xcc, 0x7c, 0x38, 0x00, 0xa1, 0x4b, 0x30, 0xb5, 0x63, 0x36, 0x6d, 0x83, 0xfa, 0x2e, 0xc1, 0x07,
+	0xaa, 0xb8, 0xc3, 0x87, 0xc8, 0x5a, 0x0a, 0x12, 0xbe, 0x6d, 0x3a, 0x76, 0xee, 0xe7, 0x18, 0x4c,
+	0x6e, 0x1a, 0x94, 0xb8, 0xe6, 0xd0, 0xa8, 0x5c, 0x0d, 0xc3, 0x17, 0xcf, 0xf7, 0x47, 0xa5, 0x84,
+	0x65, 0xb2, 0x86, 0x41, 0xfe, 0x2e, 0xc4, 0xe4, 0xce, 0x72, 0xe2, 0xdc, 0x89, 0x55, 0x4a, 0x6c,
+	0xef, 0x16, 0x77, 0xdc, 0x4a, 0x69, 0xb4, 0x93, 0x43, 0xa8, 0x8e, 0xee, 0x42, 0x62, 0x9d, 0x30,
+	0x1e, 0xb1, 0xcf, 0xdc, 0x90, 0x3c, 0xf9, 0x63, 0xf0, 0x70, 0x3d, 0x5d, 0xa8, 0x0f, 0xf1, 0x6a,
+	0xee, 0xfb, 0x18, 0x2c, 0x16, 0x9d, 0x76, 0xc7, 0xf1, 0x4c, 0x4a, 0x82, 0xa5, 0x10, 0xe9, 0xff,
+	0xdf, 0x9d, 0x53, 0x56, 0x20, 0x29, 0xda, 0x83, 0x35, 0x35, 0x58, 0x66, 0xb9, 0x4c, 0x52, 0x8a,
+	0xbd, 0x43, 0x6c, 0x12, 0x6a, 0x8c, 0xea, 0x64, 0xa9, 0x8c, 0xae, 0x42, 0x9c, 0xb5, 0xf0, 0xe2,
+	0x4b, 0x3f, 0xca, 0x65, 0xae, 0x7e, 0x10, 0xbd, 0x4d, 0xa2, 0x19, 0x98, 0x2c, 0xee, 0x8a, 0x77,
+	0xa7, 0x8c, 0x82, 0xce, 0xc0, 0x6c, 0x71, 0x77, 0xc7, 0x78, 0x40, 0x0a, 0x1e, 0x2f, 0x5b, 0x19,
+	0x15, 0xcd, 0xc2, 0x54, 0x71, 0x57, 0x16, 0xbb, 0x4c, 0x0c, 0x9d, 0x83, 0x33, 0xc5, 0xdd, 0x12,
+	0xe9, 0x58, 0xce, 0x7e, 0x78, 0xfa, 0xce, 0x4c, 0x5c, 0xbd, 0xd7, 0xfb, 0xf8, 0x87, 0x32, 0x30,
+	0x23, 0x7a, 0xa2, 0x4e, 0x64, 0x94, 0x08, 0xd9, 0x72, 0x3e, 0x32, 0x4c, 0x9a, 0x51, 0xd1, 0x5c,
+	0xa0, 0xb1, 0x63, 0x34, 0x8d, 0x4c, 0x0c, 0x9d, 0x85, 0xb4, 0xec, 0x07, 0x5b, 0x6f, 0x66, 0x62,
+	0xed, 0xed, 0x83, 0x43, 0x4d, 0x79, 0x7c, 0xa8, 0x29, 0x4f, 0x0f, 0x35, 0xe5, 0xc5, 0xa1, 0xa6,
+	0x7e, 0xde, 0xd5, 0xd4, 0x47, 0x5d, 0x4d, 0x3d, 0xe8, 0x6a, 0xea, 0xe3, 0xae, 0xa6, 0xfe, 0xde,
+	0xd5, 0xd4, 0x3f, 0xba, 0x9a, 0xf2, 0xa2, 0xab, 0xa9, 0x5f, 0x3e, 0xd7, 0x94, 0xc7, 0xcf, 0x35,
+	0xe5, 0xe9, 0x73, 0x4d, 0xa9, 0x25, 0xf9, 0xbf, 0x47, 0x6f, 0xfd, 0x35, 0x00, 0xbd, 0x77, 0x39,
+	0x61, 0x93, 0x1a, 0x00, 0x00,
 }
 
 func (x CallType) String() string {
@@ -1566,6 +1577,9 @@ func (this *OutgoingRequest) Equal(that interface{}) bool {
 	if !this.APINode.Equal(that1.APINode) {
 		return false
 	}
+	if !this.ExecuteAt.Equal(that1.ExecuteAt) {
+		return false
+	}
 	return true
 }
 func (this *Result) Equal(that interface{}) bool {
@@ -1870,6 +1884,20 @@ func (this *Lifeline) Equal(that interface{}) bool {
 	} else if !this.EarliestOpenRequest.Equal(*that1.EarliestOpenRequest) {
 		return false
 	}
+	if that1.EarliestScheduledRequest == nil {
+		if this.EarliestScheduledRequest != nil {
+			return false
+		}
+	} else if !this.EarliestScheduledRequest.Equal(*that1.EarliestScheduledRequest) {
+		return false
+	}
+	if that1.EarliestExecuteAt == nil {
+		if this.EarliestExecuteAt != nil {
+			return false
+		}
+	} else if !this.EarliestExecuteAt.Equal(*that1.EarliestExecuteAt) {
+		return false
+	}
 	return true
 }
 func (this *Index) Equal(that interface{}) bool {
@@ -2524,6 +2552,7 @@ type OutgoingRequestFace interface {
 	GetAPIRequestID() string
 	GetReason() github_com_insolar_insolar_insolar.Reference
 	GetAPINode() github_com_insolar_insolar_insolar.Reference
+	GetExecuteAt() github_com_insolar_insolar_insolar.PulseNumber
 }
 
 func (this *OutgoingRequest) Proto() github_com_gogo_protobuf_proto.Message {
@@ -2594,6 +2623,10 @@ func (this *OutgoingRequest) GetAPINode() github_com_insolar_insolar_insolar.Ref
 	return this.APINode
 }
 
+func (this *OutgoingRequest) GetExecuteAt() github_com_insolar_insolar_insolar.PulseNumber {
+	return this.ExecuteAt
+}
+
 func NewOutgoingRequestFromFace(that OutgoingRequestFace) *OutgoingRequest {
 	this := &OutgoingRequest{}
 	this.Polymorph = that.GetPolymorph()
@@ -2611,6 +2644,7 @@ func NewOutgoingRequestFromFace(that OutgoingRequestFace) *OutgoingRequest {
 	this.APIRequestID = that.GetAPIRequestID()
 	this.Reason = that.GetReason()
 	this.APINode = that.GetAPINode()
+	this.ExecuteAt = that.GetExecuteAt()
 	return this
 }
 
@@ -2804,7 +2838,7 @@ func (this *OutgoingRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 19)
+	s := make([]string, 0, 20)
 	s = append(s, "&record.OutgoingRequest{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "CallType: "+fmt.Sprintf("%#v", this.CallType)+",\n")
@@ -2821,6 +2855,7 @@ func (this *OutgoingRequest) GoString() string {
 	s = append(s, "APIRequestID: "+fmt.Sprintf("%#v", this.APIRequestID)+",\n")
 	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
 	s = append(s, "APINode: "+fmt.Sprintf("%#v", this.APINode)+",\n")
+	s = append(s, "ExecuteAt: "+fmt.Sprintf("%#v", this.ExecuteAt)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2925,7 +2960,7 @@ func (this *Lifeline) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 12)
 	s = append(s, "&record.Lifeline{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "LatestState: "+fmt.Sprintf("%#v", this.LatestState)+",\n")
@@ -2933,6 +2968,8 @@ func (this *Lifeline) GoString() string {
 	s = append(s, "Parent: "+fmt.Sprintf("%#v", this.Parent)+",\n")
 	s = append(s, "LatestRequest: "+fmt.Sprintf("%#v", this.LatestRequest)+",\n")
 	s = append(s, "EarliestOpenRequest: "+fmt.Sprintf("%#v", this.EarliestOpenRequest)+",\n")
+	s = append(s, "EarliestScheduledRequest: "+fmt.Sprintf("%#v", this.EarliestScheduledRequest)+",\n")
+	s = append(s, "EarliestExecuteAt: "+fmt.Sprintf("%#v", this.EarliestExecuteAt)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3513,6 +3550,13 @@ func (m *OutgoingRequest) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n16
+	if m.ExecuteAt != 0 {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.ExecuteAt))
+	}
 	return i, nil
 }
 
@@ -4029,6 +4073,30 @@ func (m *Lifeline) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n39
 	}
+	if m.EarliestScheduledRequest != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.EarliestScheduledRequest.Size()))
+		n40, err := m.EarliestScheduledRequest.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	if m.EarliestExecuteAt != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(m.EarliestExecuteAt.Size()))
+		n41, err := m.EarliestExecuteAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
 	return i, nil
 }
 
@@ -4059,21 +4127,21 @@ func (m *Index) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.ObjID.Size()))
-	n40, err := m.ObjID.MarshalTo(dAtA[i:])
+	n42, err := m.ObjID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
+	i += n42
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Lifeline.Size()))
-	n41, err := m.Lifeline.MarshalTo(dAtA[i:])
+	n43, err := m.Lifeline.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n41
+	i += n43
 	if m.LifelineLastUsed != 0 {
 		dAtA[i] = 0xb0
 		i++
@@ -4121,11 +4189,11 @@ func (m *Virtual) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRecord(dAtA, i, uint64(m.Polymorph))
 	}
 	if m.Union != nil {
-		nn42, err := m.Union.MarshalTo(dAtA[i:])
+		nn44, err := m.Union.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn42
+		i += nn44
 	}
 	if len(m.Signature) > 0 {
 		dAtA[i] = 0xc2
@@ -4146,11 +4214,11 @@ func (m *Virtual_Genesis) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Genesis.Size()))
-		n43, err := m.Genesis.MarshalTo(dAtA[i:])
+		n45, err := m.Genesis.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n45
 	}
 	return i, nil
 }
@@ -4162,11 +4230,11 @@ func (m *Virtual_Child) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Child.Size()))
-		n44, err := m.Child.MarshalTo(dAtA[i:])
+		n46, err := m.Child.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n46
 	}
 	return i, nil
 }
@@ -4178,11 +4246,11 @@ func (m *Virtual_Jet) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Jet.Size()))
-		n45, err := m.Jet.MarshalTo(dAtA[i:])
+		n47, err := m.Jet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n47
 	}
 	return i, nil
 }
@@ -4194,11 +4262,11 @@ func (m *Virtual_IncomingRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.IncomingRequest.Size()))
-		n46, err := m.IncomingRequest.MarshalTo(dAtA[i:])
+		n48, err := m.IncomingRequest.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n48
 	}
 	return i, nil
 }
@@ -4210,11 +4278,11 @@ func (m *Virtual_OutgoingRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.OutgoingRequest.Size()))
-		n47, err := m.OutgoingRequest.MarshalTo(dAtA[i:])
+		n49, err := m.OutgoingRequest.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n49
 	}
 	return i, nil
 }
@@ -4226,11 +4294,11 @@ func (m *Virtual_Result) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Result.Size()))
-		n48, err := m.Result.MarshalTo(dAtA[i:])
+		n50, err := m.Result.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n50
 	}
 	return i, nil
 }
@@ -4242,11 +4310,11 @@ func (m *Virtual_Type) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Type.Size()))
-		n49, err := m.Type.MarshalTo(dAtA[i:])
+		n51, err := m.Type.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n51
 	}
 	return i, nil
 }
@@ -4258,11 +4326,11 @@ func (m *Virtual_Code) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Code.Size()))
-		n50, err := m.Code.MarshalTo(dAtA[i:])
+		n52, err := m.Code.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n52
 	}
 	return i, nil
 }
@@ -4274,11 +4342,11 @@ func (m *Virtual_Activate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Activate.Size()))
-		n51, err := m.Activate.MarshalTo(dAtA[i:])
+		n53, err := m.Activate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n53
 	}
 	return i, nil
 }
@@ -4290,11 +4358,11 @@ func (m *Virtual_Amend) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Amend.Size()))
-		n52, err := m.Amend.MarshalTo(dAtA[i:])
+		n54, err := m.Amend.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n54
 	}
 	return i, nil
 }
@@ -4306,11 +4374,11 @@ func (m *Virtual_Deactivate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.Deactivate.Size()))
-		n53, err := m.Deactivate.MarshalTo(dAtA[i:])
+		n55, err := m.Deactivate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n55
 	}
 	return i, nil
 }
@@ -4322,11 +4390,11 @@ func (m *Virtual_PendingFilament) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x7
 		i++
 		i = encodeVarintRecord(dAtA, i, uint64(m.PendingFilament.Size()))
-		n54, err := m.PendingFilament.MarshalTo(dAtA[i:])
+		n56, err := m.PendingFilament.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n56
 	}
 	return i, nil
 }
@@ -4357,41 +4425,41 @@ func (m *Material) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Virtual.Size()))
-	n55, err := m.Virtual.MarshalTo(dAtA[i:])
+	n57, err := m.Virtual.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n55
+	i += n57
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.ID.Size()))
-	n56, err := m.ID.MarshalTo(dAtA[i:])
+	n58, err := m.ID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n56
+	i += n58
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.ObjectID.Size()))
-	n57, err := m.ObjectID.MarshalTo(dAtA[i:])
+	n59, err := m.ObjectID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n57
+	i += n59
 	dAtA[i] = 0xba
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.JetID.Size()))
-	n58, err := m.JetID.MarshalTo(dAtA[i:])
+	n60, err := m.JetID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n58
+	i += n60
 	if len(m.Signature) > 0 {
 		dAtA[i] = 0xc2
 		i++
@@ -4430,41 +4498,41 @@ func (m *CompositeFilamentRecord) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.RecordID.Size()))
-	n59, err := m.RecordID.MarshalTo(dAtA[i:])
+	n61, err := m.RecordID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n59
+	i += n61
 	dAtA[i] = 0xaa
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Record.Size()))
-	n60, err := m.Record.MarshalTo(dAtA[i:])
+	n62, err := m.Record.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n60
+	i += n62
 	dAtA[i] = 0xb2
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.MetaID.Size()))
-	n61, err := m.MetaID.MarshalTo(dAtA[i:])
+	n63, err := m.MetaID.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n61
+	i += n63
 	dAtA[i] = 0xba
 	i++
 	dAtA[i] = 0x1
 	i++
 	i = encodeVarintRecord(dAtA, i, uint64(m.Meta.Size()))
-	n62, err := m.Meta.MarshalTo(dAtA[i:])
+	n64, err := m.Meta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n62
+	i += n64
 	return i, nil
 }
 
@@ -4630,6 +4698,9 @@ func (m *OutgoingRequest) Size() (n int) {
 	n += 2 + l + sovRecord(uint64(l))
 	l = m.APINode.Size()
 	n += 2 + l + sovRecord(uint64(l))
+	if m.ExecuteAt != 0 {
+		n += 2 + sovRecord(uint64(m.ExecuteAt))
+	}
 	return n
 }
 
@@ -4812,6 +4883,14 @@ func (m *Lifeline) Size() (n int) {
 		l = m.EarliestOpenRequest.Size()
 		n += 2 + l + sovRecord(uint64(l))
 	}
+	if m.EarliestScheduledRequest != nil {
+		l = m.EarliestScheduledRequest.Size()
+		n += 2 + l + sovRecord(uint64(l))
+	}
+	if m.EarliestExecuteAt != nil {
+		l = m.EarliestExecuteAt.Size()
+		n += 2 + l + sovRecord(uint64(l))
+	}
 	return n
 }
 
@@ -5137,6 +5216,7 @@ func (this *OutgoingRequest) String() string {
 		`APIRequestID:` + fmt.Sprintf("%v", this.APIRequestID) + `,`,
 		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`APINode:` + fmt.Sprintf("%v", this.APINode) + `,`,
+		`ExecuteAt:` + fmt.Sprintf("%v", this.ExecuteAt) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5249,6 +5329,8 @@ func (this *Lifeline) String() string {
 		`Parent:` + fmt.Sprintf("%v", this.Parent) + `,`,
 		`LatestRequest:` + fmt.Sprintf("%v", this.LatestRequest) + `,`,
 		`EarliestOpenRequest:` + fmt.Sprintf("%v", this.EarliestOpenRequest) + `,`,
+		`EarliestScheduledRequest:` + fmt.Sprintf("%v", this.EarliestScheduledRequest) + `,`,
+		`EarliestExecuteAt:` + fmt.Sprintf("%v", this.EarliestExecuteAt) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6696,6 +6778,25 @@ func (m *OutgoingRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 36:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAt", wireType)
+			}
+			m.ExecuteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAt |= github_com_insolar_insolar_insolar.PulseNumber(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -8287,6 +8388,76 @@ func (m *Lifeline) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestScheduledRequest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.PulseNumber
+			m.EarliestScheduledRequest = &v
+			if err := m.EarliestScheduledRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarliestExecuteAt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_insolar_insolar_insolar.PulseNumber
+			m.EarliestExecuteAt = &v
+			if err := m.EarliestExecuteAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
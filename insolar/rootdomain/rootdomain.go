@@ -93,6 +93,12 @@ func (r Record) ID() insolar.ID {
 	return *insolar.NewID(genesisPulse, hash)
 }
 
+// GenesisPrototypeRef returns reference to prototype of genesis contract type.
+func GenesisPrototypeRef(contractType string) (insolar.Reference, bool) {
+	ref, ok := predefinedPrototypes[contractType+GenesisPrototypeSuffix]
+	return ref, ok
+}
+
 // GenesisRef returns reference to any genesis records based on the root domain.
 func GenesisRef(name string) insolar.Reference {
 	if ref, ok := predefinedPrototypes[name]; ok {
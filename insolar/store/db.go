@@ -82,4 +82,6 @@ const (
 	ScopeJetKeeper Scope = 8
 	// ScopeRecordPosition is the scope for records' positions.
 	ScopeRecordPosition Scope = 9
+	// ScopeValidationMismatch is the scope for validation mismatches reported by virtual validators.
+	ScopeValidationMismatch Scope = 10
 )
@@ -33,4 +33,43 @@ type TerminationHandler interface {
 	Abort(reason string)
 	// Terminating is an accessor
 	Terminating() bool
+	// Drain stops accepting new work, waits until pending work is finished or handed off and then leaves
+	Drain(context.Context, PulseNumber)
+	// DrainStatus returns progress of draining
+	DrainStatus() DrainStatus
+}
+
+// DrainStage is a stage of node draining before leaving the network.
+type DrainStage int
+
+//go:generate stringer -type=DrainStage
+const (
+	// DrainIdle node is not draining
+	DrainIdle DrainStage = iota
+	// DrainStarted zero power is requested, node waits until it is not chosen as executor anymore
+	DrainStarted
+	// DrainHandoff node waits until pending work is finished or handed off to the next executors
+	DrainHandoff
+	// DrainLeaving leave claim is sent, node waits for approval
+	DrainLeaving
+	// DrainDone node has left the network
+	DrainDone
+)
+
+// DrainStatus describes progress of node draining.
+type DrainStatus struct {
+	Stage DrainStage
+	// StartPulse is a pulse draining was started in.
+	StartPulse PulseNumber
+	// Pending holds amount of not finished work per component.
+	Pending map[string]int
+}
+
+// Drainer is a component that has to finish or hand off its work before the node leaves the network.
+type Drainer interface {
+	// StartDrain notifies component that the node is going to leave, component stops accepting work
+	// it can reject without losing it.
+	StartDrain(ctx context.Context)
+	// PendingWork returns amount of work items that are not finished or handed off yet.
+	PendingWork(ctx context.Context) int
 }
@@ -123,6 +123,25 @@ type ContractWrapper struct {
 	Constructors ContractConstructors
 }
 
+const (
+	// MigrateConstructor is the name of contract constructor that converts memory of the object created
+	// by one of previous prototypes into memory of the upgraded prototype.
+	MigrateConstructor = "Migrate"
+	// UpgradeMethod is the name of reserved method that switches object to the upgraded prototype
+	// without calling any contract method. Objects accept it only from the root domain.
+	UpgradeMethod = "$upgrade"
+	// SagaRollbackSuffix is appended to the name of saga accept method to get the name of wrapper method
+	// that calls rollback method of the saga.
+	SagaRollbackSuffix = "_INSROLLBACK"
+)
+
+// PrototypeUpgrade is stored as memory of the prototype that replaces previous prototypes of the domain.
+// Objects of previous prototypes are switched to the upgraded one by UpgradeMethod call.
+type PrototypeUpgrade struct {
+	// Previous is the list of prototypes which objects can be upgraded.
+	Previous []Reference
+}
+
 // PendingState is a state of execution for each object
 type PendingState int
 
@@ -68,6 +68,13 @@ func WithFields(ctx context.Context, fields map[string]interface{}) (context.Con
 	return SetLogger(ctx, l), l
 }
 
+// WithComponent returns context with logger bound to named component and logger itself.
+// Level of component logger can be overridden in configuration or changed at runtime.
+func WithComponent(ctx context.Context, component string) (context.Context, insolar.Logger) {
+	l := logger.ForComponent(getLogger(ctx), component)
+	return SetLogger(ctx, l), l
+}
+
 // WithTraceField returns context with logger initialized with provided traceid value and logger itself.
 func WithTraceField(ctx context.Context, traceid string) (context.Context, insolar.Logger) {
 	ctx, err := utils.SetInsTraceID(ctx, traceid)
@@ -0,0 +1,34 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package instracer
+
+import (
+	"go.opencensus.io/trace"
+)
+
+// SaveSamplingRules returns function which restores currently applied sampling rules.
+func SaveSamplingRules() (restore func()) {
+	r := currentRules()
+	return func() {
+		rules.Store(r)
+	}
+}
+
+// UnregisterExporter removes exporter registered by RegisterExporter.
+func UnregisterExporter(e trace.Exporter) {
+	trace.UnregisterExporter(errorFilter{Exporter: e})
+}
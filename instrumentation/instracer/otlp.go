@@ -0,0 +1,315 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package instracer
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// ErrOTLPConfigEmpty is returned if OTLP configuration has empty endpoint.
+var ErrOTLPConfigEmpty = errors.New("can't create OTLP exporter, config not provided")
+
+// OTLPExporter exports spans to OpenTelemetry collector with OTLP/HTTP JSON protocol.
+type OTLPExporter struct {
+	cfg      configuration.OTLPConfig
+	resource []otlpKeyValue
+	client   *http.Client
+
+	mu    sync.Mutex
+	spans []*trace.SpanData
+
+	flushCh chan chan struct{}
+	stop    chan struct{}
+}
+
+// NewOTLPExporter creates exporter and starts its background flush loop.
+func NewOTLPExporter(servicename string, nodeRef string, cfg configuration.OTLPConfig) (*OTLPExporter, error) {
+	if cfg.Endpoint == "" {
+		return nil, ErrOTLPConfigEmpty
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = configuration.NewTracer().OTLP.BatchSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = configuration.NewTracer().OTLP.FlushInterval
+	}
+
+	e := &OTLPExporter{
+		cfg: cfg,
+		resource: []otlpKeyValue{
+			stringKeyValue("service.name", servicename),
+			stringKeyValue("host.name", hostname()),
+			stringKeyValue("nodeRef", nodeRef),
+		},
+		client:  &http.Client{Timeout: 10 * time.Second},
+		flushCh: make(chan chan struct{}),
+		stop:    make(chan struct{}),
+	}
+	go e.loop()
+	return e, nil
+}
+
+// ExportSpan implements trace.Exporter.
+func (e *OTLPExporter) ExportSpan(sd *trace.SpanData) {
+	e.mu.Lock()
+	e.spans = append(e.spans, sd)
+	full := len(e.spans) >= e.cfg.BatchSize
+	e.mu.Unlock()
+
+	if full {
+		go e.Flush()
+	}
+}
+
+// Flush sends all buffered spans and waits for completion.
+func (e *OTLPExporter) Flush() {
+	done := make(chan struct{})
+	select {
+	case e.flushCh <- done:
+		<-done
+	case <-e.stop:
+	}
+}
+
+// Stop flushes buffered spans and stops flush loop.
+func (e *OTLPExporter) Stop() {
+	e.Flush()
+	close(e.stop)
+}
+
+func (e *OTLPExporter) loop() {
+	ticker := time.NewTicker(e.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			e.send()
+		case done := <-e.flushCh:
+			e.send()
+			close(done)
+		case <-e.stop:
+			return
+		}
+	}
+}
+
+func (e *OTLPExporter) send() {
+	e.mu.Lock()
+	spans := e.spans
+	e.spans = nil
+	e.mu.Unlock()
+
+	for len(spans) > 0 {
+		n := len(spans)
+		if n > e.cfg.BatchSize {
+			n = e.cfg.BatchSize
+		}
+		err := e.post(spans[:n])
+		if err != nil {
+			inslogger.FromContext(context.Background()).Warn("failed to export spans to OTLP collector: ", err)
+		}
+		spans = spans[n:]
+	}
+}
+
+func (e *OTLPExporter) post(spans []*trace.SpanData) error {
+	body, err := json.Marshal(e.request(spans))
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal spans")
+	}
+
+	resp, err := e.client.Post(e.cfg.Endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to send spans")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("collector responded with status %s", resp.Status)
+	}
+	return nil
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	Name         string         `json:"name"`
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Events            []otlpEvent    `json:"events,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpScopeSpans struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpResourceSpans struct {
+	Resource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	} `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+// OTLP status codes.
+const (
+	otlpStatusUnset = 0
+	otlpStatusError = 2
+)
+
+func (e *OTLPExporter) request(spans []*trace.SpanData) otlpRequest {
+	scope := otlpScopeSpans{Spans: make([]otlpSpan, 0, len(spans))}
+	scope.Scope.Name = "github.com/insolar/insolar/instrumentation/instracer"
+	for _, sd := range spans {
+		scope.Spans = append(scope.Spans, convertSpan(sd))
+	}
+
+	rs := otlpResourceSpans{ScopeSpans: []otlpScopeSpans{scope}}
+	rs.Resource.Attributes = e.resource
+	return otlpRequest{ResourceSpans: []otlpResourceSpans{rs}}
+}
+
+func convertSpan(sd *trace.SpanData) otlpSpan {
+	s := otlpSpan{
+		TraceID:           hex.EncodeToString(sd.TraceID[:]),
+		SpanID:            hex.EncodeToString(sd.SpanID[:]),
+		Name:              sd.Name,
+		Kind:              sd.SpanKind + 1,
+		StartTimeUnixNano: unixNano(sd.StartTime),
+		EndTimeUnixNano:   unixNano(sd.EndTime),
+		Attributes:        convertAttributes(sd.Attributes),
+		Status:            otlpStatus{Code: otlpStatusUnset},
+	}
+	if sd.ParentSpanID != (trace.SpanID{}) {
+		s.ParentSpanID = hex.EncodeToString(sd.ParentSpanID[:])
+	}
+	if isErr, _ := sd.Attributes["error"].(bool); isErr || sd.Status.Code != trace.StatusCodeOK {
+		s.Status = otlpStatus{Code: otlpStatusError, Message: sd.Status.Message}
+	}
+	for _, a := range sd.Annotations {
+		s.Events = append(s.Events, otlpEvent{
+			TimeUnixNano: unixNano(a.Time),
+			Name:         a.Message,
+			Attributes:   convertAttributes(a.Attributes),
+		})
+	}
+	return s
+}
+
+func convertAttributes(attrs map[string]interface{}) []otlpKeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	res := make([]otlpKeyValue, 0, len(attrs))
+	for k, v := range attrs {
+		switch val := v.(type) {
+		case string:
+			res = append(res, stringKeyValue(k, val))
+		case bool:
+			res = append(res, otlpKeyValue{Key: k, Value: otlpValue{BoolValue: &val}})
+		case int64:
+			i := strconv.FormatInt(val, 10)
+			res = append(res, otlpKeyValue{Key: k, Value: otlpValue{IntValue: &i}})
+		case float64:
+			res = append(res, otlpKeyValue{Key: k, Value: otlpValue{DoubleValue: &val}})
+		default:
+			res = append(res, stringKeyValue(k, fmt.Sprint(val)))
+		}
+	}
+	return res
+}
+
+func stringKeyValue(key string, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpValue{StringValue: &value}}
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// ShouldRegisterOTLP creates OTLP exporter, registers it in opencensus trace lib and returns flush function.
+func ShouldRegisterOTLP(
+	ctx context.Context,
+	servicename string,
+	nodeRef string,
+	cfg configuration.OTLPConfig,
+) (flusher func()) {
+	inslog := inslogger.FromContext(ctx)
+	exporter, err := NewOTLPExporter(servicename, nodeRef, cfg)
+	if err != nil {
+		if err == ErrOTLPConfigEmpty {
+			inslog.Info("registerOTLP skipped: config is not provided")
+		} else {
+			inslog.Warn("registerOTLP error:", err)
+		}
+		return func() {}
+	}
+
+	RegisterExporter(exporter)
+	return func() {
+		inslog.Debugf("Flush OTLP exporter for %v\n", servicename)
+		exporter.Stop()
+	}
+}
@@ -0,0 +1,201 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package instracer_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/trace"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/instrumentation/instracer"
+)
+
+type otlpCollector struct {
+	mu       sync.Mutex
+	requests []map[string]interface{}
+	status   int
+}
+
+func (c *otlpCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req map[string]interface{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err == nil {
+		c.requests = append(c.requests, req)
+	}
+	if c.status != 0 {
+		w.WriteHeader(c.status)
+	}
+}
+
+func (c *otlpCollector) received() []map[string]interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.requests
+}
+
+// path walks decoded JSON by object keys and array indexes.
+func path(t *testing.T, v interface{}, keys ...interface{}) interface{} {
+	for _, k := range keys {
+		switch key := k.(type) {
+		case string:
+			m, ok := v.(map[string]interface{})
+			require.True(t, ok, "expected object at %v", key)
+			v = m[key]
+		case int:
+			a, ok := v.([]interface{})
+			require.True(t, ok, "expected array at %v", key)
+			require.True(t, key < len(a), "index %v out of range", key)
+			v = a[key]
+		}
+	}
+	return v
+}
+
+func attributes(t *testing.T, v interface{}) map[string]interface{} {
+	res := map[string]interface{}{}
+	for _, a := range v.([]interface{}) {
+		res[path(t, a, "key").(string)] = path(t, a, "value")
+	}
+	return res
+}
+
+func testSpanData(name string) *trace.SpanData {
+	start := time.Unix(100, 5)
+	return &trace.SpanData{
+		SpanContext: trace.SpanContext{
+			TraceID: trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			SpanID:  trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		},
+		ParentSpanID: trace.SpanID{8, 7, 6, 5, 4, 3, 2, 1},
+		Name:         name,
+		SpanKind:     trace.SpanKindServer,
+		StartTime:    start,
+		EndTime:      start.Add(time.Second),
+		Attributes: map[string]interface{}{
+			"str":   "value",
+			"int":   int64(42),
+			"error": true,
+		},
+		Annotations: []trace.Annotation{{Time: start, Message: "failed"}},
+	}
+}
+
+func TestOTLPExporter_RoundTrip(t *testing.T) {
+	collector := &otlpCollector{}
+	srv := httptest.NewServer(collector)
+	defer srv.Close()
+
+	exporter, err := instracer.NewOTLPExporter("test-service", "test-ref", configuration.OTLPConfig{
+		Endpoint:      srv.URL,
+		BatchSize:     10,
+		FlushInterval: time.Hour,
+	})
+	require.NoError(t, err)
+
+	exporter.ExportSpan(testSpanData("test-span"))
+	exporter.Stop()
+
+	requests := collector.received()
+	require.Len(t, requests, 1)
+
+	rs := path(t, requests[0], "resourceSpans", 0)
+	resource := attributes(t, path(t, rs, "resource", "attributes"))
+	require.Equal(t, "test-service", path(t, resource["service.name"], "stringValue"))
+	require.Equal(t, "test-ref", path(t, resource["nodeRef"], "stringValue"))
+
+	span := path(t, rs, "scopeSpans", 0, "spans", 0)
+	require.Equal(t, "test-span", path(t, span, "name"))
+	require.Equal(t, "0102030405060708090a0b0c0d0e0f10", path(t, span, "traceId"))
+	require.Equal(t, "0102030405060708", path(t, span, "spanId"))
+	require.Equal(t, "0807060504030201", path(t, span, "parentSpanId"))
+	// opencensus server kind is 1, OTLP server kind is 2.
+	require.Equal(t, float64(2), path(t, span, "kind"))
+	require.Equal(t, "100000000005", path(t, span, "startTimeUnixNano"))
+	require.Equal(t, "101000000005", path(t, span, "endTimeUnixNano"))
+	require.Equal(t, float64(2), path(t, span, "status", "code"))
+	require.Equal(t, "failed", path(t, span, "events", 0, "name"))
+
+	attrs := attributes(t, path(t, span, "attributes"))
+	require.Equal(t, "value", path(t, attrs["str"], "stringValue"))
+	require.Equal(t, "42", path(t, attrs["int"], "intValue"))
+	require.Equal(t, true, path(t, attrs["error"], "boolValue"))
+}
+
+func TestOTLPExporter_Batches(t *testing.T) {
+	collector := &otlpCollector{}
+	srv := httptest.NewServer(collector)
+	defer srv.Close()
+
+	exporter, err := instracer.NewOTLPExporter("test-service", "test-ref", configuration.OTLPConfig{
+		Endpoint:      srv.URL,
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+	})
+	require.NoError(t, err)
+	defer exporter.Stop()
+
+	for _, name := range []string{"first", "second", "third"} {
+		exporter.ExportSpan(testSpanData(name))
+	}
+	exporter.Flush()
+
+	var names []string
+	for _, req := range collector.received() {
+		spans := path(t, req, "resourceSpans", 0, "scopeSpans", 0, "spans").([]interface{})
+		require.True(t, len(spans) <= 2)
+		for _, s := range spans {
+			names = append(names, path(t, s, "name").(string))
+		}
+	}
+	require.ElementsMatch(t, []string{"first", "second", "third"}, names)
+}
+
+func TestOTLPExporter_CollectorError(t *testing.T) {
+	collector := &otlpCollector{status: http.StatusInternalServerError}
+	srv := httptest.NewServer(collector)
+	defer srv.Close()
+
+	exporter, err := instracer.NewOTLPExporter("test-service", "test-ref", configuration.OTLPConfig{
+		Endpoint:      srv.URL,
+		FlushInterval: time.Hour,
+	})
+	require.NoError(t, err)
+	defer exporter.Stop()
+
+	exporter.ExportSpan(testSpanData("lost"))
+	exporter.Flush()
+	require.Len(t, collector.received(), 1)
+
+	// Failed spans are dropped, so the next flush sends nothing.
+	exporter.Flush()
+	require.Len(t, collector.received(), 1)
+}
+
+func TestOTLPExporter_EmptyEndpoint(t *testing.T) {
+	_, err := instracer.NewOTLPExporter("test-service", "test-ref", configuration.OTLPConfig{})
+	require.Equal(t, instracer.ErrOTLPConfigEmpty, err)
+}
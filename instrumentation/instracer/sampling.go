@@ -0,0 +1,169 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package instracer
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"go.opencensus.io/trace"
+
+	"github.com/insolar/insolar/configuration"
+)
+
+// deferredAttribute marks spans which are recorded only to be exported on error.
+const deferredAttribute = "insDeferred"
+
+type samplingRules struct {
+	configuration.SamplingRules
+	// defaultProbability is a probability to sample trace when no rule matched.
+	defaultProbability float64
+	// applied is false until rules are set, so opencensus default sampler is used.
+	applied bool
+}
+
+var (
+	rules atomic.Value
+
+	randMu sync.Mutex
+	random = rand.New(rand.NewSource(rand.Int63()))
+)
+
+func init() {
+	rules.Store(samplingRules{})
+}
+
+// ApplySamplingRules sets rules used by StartSpan to decide if trace should be sampled.
+//
+// probabilityRate has the same meaning as in RegisterJaeger: one of probabilityRate traces is sampled,
+// zero or negative value disables sampling by default.
+func ApplySamplingRules(cfg configuration.SamplingRules, probabilityRate float64) {
+	r := samplingRules{SamplingRules: cfg, applied: true}
+	if probabilityRate > 0 {
+		r.defaultProbability = 1 / probabilityRate
+	}
+	rules.Store(r)
+}
+
+func currentRules() samplingRules {
+	return rules.Load().(samplingRules)
+}
+
+type forceSampleKey struct{}
+type payloadTypeKey struct{}
+type sampledKey struct{}
+
+// ForceSample returns context in which new traces are always sampled.
+func ForceSample(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forceSampleKey{}, true)
+}
+
+// DebugRequested checks if request headers contain configured debug header.
+func DebugRequested(h http.Header) bool {
+	name := currentRules().DebugHeader
+	return name != "" && h.Get(name) != ""
+}
+
+// WithPayloadType returns context with payload type used by sampling rules.
+func WithPayloadType(ctx context.Context, payloadType string) context.Context {
+	return context.WithValue(ctx, payloadTypeKey{}, payloadType)
+}
+
+// IsSampled returns sampling decision made for trace in context.
+func IsSampled(ctx context.Context) bool {
+	if sampled, ok := ctx.Value(sampledKey{}).(bool); ok {
+		return sampled
+	}
+	span := trace.FromContext(ctx)
+	return span != nil && span.SpanContext().IsSampled()
+}
+
+// samplingDecision decides if span started in context belongs to sampled trace.
+// Decision is inherited from local or remote parent, otherwise it is made by sampling rules.
+func samplingDecision(ctx context.Context, r samplingRules) bool {
+	if sampled, ok := ctx.Value(sampledKey{}).(bool); ok {
+		return sampled
+	}
+	if parent, ok := ParentSpan(ctx); ok {
+		return parent.Sampled
+	}
+	if span := trace.FromContext(ctx); span != nil {
+		return span.SpanContext().IsSampled()
+	}
+	if force, ok := ctx.Value(forceSampleKey{}).(bool); ok && force {
+		return true
+	}
+	if payloadType, ok := ctx.Value(payloadTypeKey{}).(string); ok {
+		if probability, ok := r.PayloadTypes[payloadType]; ok {
+			return sample(probability)
+		}
+	}
+	return sample(r.defaultProbability)
+}
+
+func sample(probability float64) bool {
+	if probability >= 1 {
+		return true
+	}
+	if probability <= 0 {
+		return false
+	}
+	randMu.Lock()
+	defer randMu.Unlock()
+	return random.Float64() < probability
+}
+
+// samplerOptions returns span start options according to sampling rules.
+// If errors should be exported, not sampled spans are recorded and marked as deferred.
+func samplerOptions(ctx context.Context, r samplingRules) (opts []trace.StartOption, deferred bool) {
+	if !r.applied {
+		return nil, false
+	}
+
+	sampled := samplingDecision(ctx, r)
+	switch {
+	case sampled:
+		return []trace.StartOption{trace.WithSampler(trace.AlwaysSample())}, false
+	case r.Errors:
+		return []trace.StartOption{trace.WithSampler(trace.AlwaysSample())}, true
+	default:
+		return []trace.StartOption{trace.WithSampler(trace.NeverSample())}, false
+	}
+}
+
+// errorFilter is an exporter which drops deferred spans without errors.
+type errorFilter struct {
+	trace.Exporter
+}
+
+// ExportSpan implements trace.Exporter.
+func (f errorFilter) ExportSpan(sd *trace.SpanData) {
+	if deferred, _ := sd.Attributes[deferredAttribute].(bool); deferred {
+		if isErr, _ := sd.Attributes["error"].(bool); !isErr {
+			return
+		}
+	}
+	f.Exporter.ExportSpan(sd)
+}
+
+// RegisterExporter registers exporter in opencensus trace lib with respect to sampling rules.
+func RegisterExporter(e trace.Exporter) {
+	trace.RegisterExporter(errorFilter{Exporter: e})
+}
@@ -0,0 +1,143 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package instracer_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/trace"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/instrumentation/instracer"
+)
+
+func TestSamplingRules(t *testing.T) {
+	defer instracer.SaveSamplingRules()()
+	instracer.ApplySamplingRules(configuration.SamplingRules{
+		DebugHeader:  "X-Insolar-Debug",
+		PayloadTypes: map[string]float64{"TypeReplication": 1, "TypeGetObject": 0},
+	}, 0)
+
+	t.Run("default never", func(t *testing.T) {
+		ctx, span := instracer.StartSpan(context.Background(), "test")
+		defer span.End()
+		require.False(t, instracer.IsSampled(ctx))
+	})
+
+	t.Run("debug header", func(t *testing.T) {
+		h := http.Header{}
+		require.False(t, instracer.DebugRequested(h))
+		h.Set("X-Insolar-Debug", "1")
+		require.True(t, instracer.DebugRequested(h))
+
+		ctx, span := instracer.StartSpan(instracer.ForceSample(context.Background()), "test")
+		defer span.End()
+		require.True(t, instracer.IsSampled(ctx))
+
+		ctx, child := instracer.StartSpan(ctx, "child")
+		defer child.End()
+		require.True(t, instracer.IsSampled(ctx))
+	})
+
+	t.Run("payload type", func(t *testing.T) {
+		ctx := instracer.WithPayloadType(context.Background(), "TypeReplication")
+		ctx, span := instracer.StartSpan(ctx, "test")
+		defer span.End()
+		require.True(t, instracer.IsSampled(ctx))
+
+		ctx = instracer.WithPayloadType(context.Background(), "TypeGetObject")
+		ctx, span = instracer.StartSpan(ctx, "test")
+		defer span.End()
+		require.False(t, instracer.IsSampled(ctx))
+	})
+
+	t.Run("remote parent", func(t *testing.T) {
+		ctx, span := instracer.StartSpan(instracer.ForceSample(context.Background()), "test")
+		defer span.End()
+
+		remote := instracer.MustDeserialize(instracer.MustSerialize(ctx))
+		require.True(t, remote.Sampled)
+
+		ctx, child := instracer.StartSpan(instracer.WithParentSpan(context.Background(), remote), "remote")
+		defer child.End()
+		require.True(t, instracer.IsSampled(ctx))
+	})
+}
+
+type spanRecorder struct {
+	mu    sync.Mutex
+	spans []*trace.SpanData
+}
+
+func (r *spanRecorder) ExportSpan(sd *trace.SpanData) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spans = append(r.spans, sd)
+}
+
+func (r *spanRecorder) names() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var names []string
+	for _, sd := range r.spans {
+		names = append(names, sd.Name)
+	}
+	return names
+}
+
+func TestSamplingRules_Errors(t *testing.T) {
+	defer instracer.SaveSamplingRules()()
+	instracer.ApplySamplingRules(configuration.SamplingRules{Errors: true}, 0)
+
+	recorder := &spanRecorder{}
+	instracer.RegisterExporter(recorder)
+	defer instracer.UnregisterExporter(recorder)
+
+	ctx, span := instracer.StartSpan(context.Background(), "errors-rule-ok")
+	require.False(t, instracer.IsSampled(ctx))
+	span.End()
+
+	ctx, span = instracer.StartSpan(context.Background(), "errors-rule-failed")
+	require.False(t, instracer.IsSampled(ctx))
+	instracer.AddError(span, errors.New("test error"))
+	span.End()
+
+	_, span = instracer.StartSpan(instracer.ForceSample(context.Background()), "errors-rule-sampled")
+	span.End()
+
+	require.Equal(t, []string{"errors-rule-failed", "errors-rule-sampled"}, recorder.names())
+}
+
+func TestSamplingRules_ErrorsDisabled(t *testing.T) {
+	defer instracer.SaveSamplingRules()()
+	instracer.ApplySamplingRules(configuration.SamplingRules{}, 0)
+
+	recorder := &spanRecorder{}
+	instracer.RegisterExporter(recorder)
+	defer instracer.UnregisterExporter(recorder)
+
+	_, span := instracer.StartSpan(context.Background(), "errors-disabled-failed")
+	instracer.AddError(span, errors.New("test error"))
+	span.End()
+
+	require.Empty(t, recorder.names())
+}
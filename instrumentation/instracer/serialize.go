@@ -43,6 +43,7 @@ func Serialize(ctx context.Context) ([]byte, error) {
 		tracespan.TraceID = sc.TraceID[:]
 	}
 	tracespan.Entries = GetBaggage(ctx)
+	tracespan.Sampled = IsSampled(ctx)
 	return tracespan.Serialize()
 }
 
@@ -42,6 +42,9 @@ type TraceSpan struct {
 	TraceID []byte
 	SpanID  []byte
 	Entries []Entry
+	// Sampled is a sampling decision made for the trace by its first span.
+	// It is omitted when false to keep size of serialized unsampled spans unchanged.
+	Sampled bool `codec:",omitempty"`
 }
 
 func setSpanEntries(span *trace.Span, e ...Entry) {
@@ -56,6 +59,9 @@ func setSpanEntries(span *trace.Span, e ...Entry) {
 func (ts TraceSpan) spanContext() (sc trace.SpanContext) {
 	copy(sc.TraceID[:], ts.TraceID)
 	copy(sc.SpanID[:], ts.SpanID)
+	if ts.Sampled {
+		sc.TraceOptions = 1
+	}
 	return
 }
 
@@ -78,7 +84,11 @@ func GetBaggage(ctx context.Context) []Entry {
 }
 
 // StartSpan starts span with stored baggage and with parent span if find in context.
+// Trace sampling decision is inherited from parent span or made by sampling rules.
 func StartSpan(ctx context.Context, name string, o ...trace.StartOption) (context.Context, *trace.Span) {
+	opts, deferred := samplerOptions(ctx, currentRules())
+	o = append(opts, o...)
+
 	parentSpan, haveParent := ParentSpan(ctx)
 	var (
 		spanctx context.Context
@@ -100,7 +110,12 @@ func StartSpan(ctx context.Context, name string, o ...trace.StartOption) (contex
 	span.AddAttributes(
 		trace.StringAttribute("insTraceId", inslogger.TraceID(ctx)),
 	)
+	if deferred {
+		span.AddAttributes(trace.BoolAttribute(deferredAttribute, true))
+	}
 	setSpanEntries(span, GetBaggage(spanctx)...)
+	sampled := span.SpanContext().IsSampled() && !deferred
+	spanctx = context.WithValue(spanctx, sampledKey{}, sampled)
 	return spanctx, span
 }
 
@@ -149,7 +164,7 @@ func RegisterJaeger(
 	if err != nil {
 		return nil, err
 	}
-	trace.RegisterExporter(exporter)
+	RegisterExporter(exporter)
 	if probabilityRate > 0 {
 		trace.ApplyConfig(trace.Config{
 			DefaultSampler: trace.ProbabilitySampler(1 / probabilityRate),
@@ -27,6 +27,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/instrumentation/introspector/introproto"
+	"github.com/insolar/insolar/log"
 	"github.com/pkg/errors"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
@@ -94,6 +95,8 @@ func (s *Server) run(ctx context.Context, l net.Listener) error {
 	mux.HandleFunc("/swagger.json", func(w http.ResponseWriter, req *http.Request) {
 		_, _ = io.Copy(w, strings.NewReader(publisherSwagger))
 	})
+	mux.Handle("/debug/loglevel", log.NewLoglevelChangeHandler())
+	mux.Handle("/debug/loglevel/components", log.NewComponentLevelChangeHandler())
 
 	dOpts := []grpc.DialOption{grpc.WithInsecure()}
 	err := introproto.RegisterPublisherHandlerFromEndpoint(ctx, gwMux, l.Addr().String(), dOpts)
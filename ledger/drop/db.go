@@ -73,6 +73,30 @@ func (ds *DB) ForPulse(ctx context.Context, jetID insolar.JetID, pulse insolar.P
 	return *drop, nil
 }
 
+// AllForPulse returns all drops stored in a db for a provided pulse.
+func (ds *DB) AllForPulse(ctx context.Context, pulse insolar.PulseNumber) ([]Drop, error) {
+	it := ds.db.NewIterator(&dropDbKey{jetPrefix: []byte{}, pn: pulse}, false)
+	defer it.Close()
+
+	var drops []Drop
+	for it.Next() {
+		key := newDropDbKey(it.Key())
+		if key.pn != pulse {
+			break
+		}
+		buf, err := it.Value()
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't read drop: %+v", key)
+		}
+		drop, err := Decode(buf)
+		if err != nil {
+			return nil, errors.Wrapf(err, "can't decode drop: %+v", key)
+		}
+		drops = append(drops, *drop)
+	}
+	return drops, nil
+}
+
 // Set saves a provided Drop to a db.
 func (ds *DB) Set(ctx context.Context, drop Drop) error {
 	k := dropDbKey{drop.JetID.Prefix(), drop.Pulse}
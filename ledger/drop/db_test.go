@@ -145,6 +145,43 @@ func TestDropStorageDB_TruncateHead(t *testing.T) {
 	}
 }
 
+func TestDropStorageDB_AllForPulse(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	db, err := store.NewBadgerDB(tmpdir)
+	require.NoError(t, err)
+	defer db.Stop(ctx)
+
+	dropStore := NewDB(db)
+
+	pn := gen.PulseNumber()
+	expected := map[insolar.JetID]Drop{}
+	for i := 0; i < 5; i++ {
+		drop := Drop{Pulse: pn, JetID: *insolar.NewJetID(uint8(i+1), gen.ID().Bytes())}
+		expected[drop.JetID] = drop
+		require.NoError(t, dropStore.Set(ctx, drop))
+	}
+	// Drops of neighbour pulses should not be returned.
+	require.NoError(t, dropStore.Set(ctx, Drop{Pulse: pn - 1, JetID: gen.JetID()}))
+	require.NoError(t, dropStore.Set(ctx, Drop{Pulse: pn + 1, JetID: gen.JetID()}))
+
+	drops, err := dropStore.AllForPulse(ctx, pn)
+	require.NoError(t, err)
+	require.Len(t, drops, len(expected))
+	for _, drop := range drops {
+		require.Equal(t, expected[drop.JetID], drop)
+	}
+
+	drops, err = dropStore.AllForPulse(ctx, pn+2)
+	require.NoError(t, err)
+	require.Empty(t, drops)
+}
+
 func TestDropStorageDB_Set(t *testing.T) {
 
 	ctx := inslogger.TestContext(t)
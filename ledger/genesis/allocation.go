@@ -0,0 +1,87 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package genesis
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/bootstrap/contracts"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/rootdomain"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+)
+
+// allocation holds contract states created from genesis allocations and shards content for created members.
+type allocation struct {
+	states                 []insolar.GenesisContractState
+	publicKeyShards        [insolar.GenesisAmountPublicKeyShards]foundation.StableMap
+	migrationAddressShards [insolar.GenesisAmountMigrationAddressShards]foundation.StableMap
+}
+
+// newAllocation creates wallet, deposit and member states for every allocation and registers
+// created members in public key and migration address shards.
+func newAllocation(members []insolar.GenesisMemberAllocation) (*allocation, error) {
+	a := &allocation{}
+	for i := range a.publicKeyShards {
+		a.publicKeyShards[i] = foundation.StableMap{}
+	}
+	for i := range a.migrationAddressShards {
+		a.migrationAddressShards[i] = foundation.StableMap{}
+	}
+
+	for i, m := range members {
+		memberName := insolar.GenesisNameAllocationMember(i)
+		memberRef := rootdomain.GenesisRef(memberName)
+
+		key := foundation.TrimPublicKey(m.PublicKey)
+		if key == "" {
+			return nil, errors.Errorf("allocation %d: invalid public key", i)
+		}
+		shard := a.publicKeyShards[foundation.GetShardIndex(key, insolar.GenesisAmountPublicKeyShards)]
+		if _, ok := shard[key]; ok {
+			return nil, errors.Errorf("allocation %d: duplicated public key", i)
+		}
+		shard[key] = memberRef.String()
+
+		if m.MigrationAddress != "" {
+			address := foundation.TrimAddress(m.MigrationAddress)
+			shard := a.migrationAddressShards[foundation.GetShardIndex(address, insolar.GenesisAmountMigrationAddressShards)]
+			if _, ok := shard[address]; ok {
+				return nil, errors.Errorf("allocation %d: duplicated migration address %s", i, m.MigrationAddress)
+			}
+			shard[address] = memberRef.String()
+		}
+
+		walletName := insolar.GenesisNameAllocationWallet(i)
+		a.states = append(a.states, contracts.GetWalletGenesisContractState(m.Balance, walletName, memberName))
+
+		deposits := map[string]insolar.Reference{}
+		for j, d := range m.Deposits {
+			if _, ok := deposits[d.TxHash]; ok {
+				return nil, errors.Errorf("allocation %d: duplicated deposit tx hash %s", i, d.TxHash)
+			}
+			depositName := insolar.GenesisNameAllocationDeposit(i, j)
+			deposits[d.TxHash] = rootdomain.GenesisRef(depositName)
+			a.states = append(a.states, contracts.GetDepositGenesisContractState(d, depositName, memberName))
+		}
+
+		a.states = append(a.states, contracts.GetAllocationMemberGenesisContractState(
+			m, memberName, insolar.GenesisNameRootDomain, rootdomain.GenesisRef(walletName), deposits,
+		))
+	}
+	return a, nil
+}
@@ -0,0 +1,184 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package genesis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/rootdomain"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/artifact"
+	"github.com/insolar/insolar/logicrunner/builtin/contract/deposit"
+	"github.com/insolar/insolar/logicrunner/builtin/contract/member"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+	depositproxy "github.com/insolar/insolar/logicrunner/builtin/proxy/deposit"
+	memberproxy "github.com/insolar/insolar/logicrunner/builtin/proxy/member"
+	walletproxy "github.com/insolar/insolar/logicrunner/builtin/proxy/wallet"
+)
+
+const allocationPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEf+vsMVU75xH8uj5WRcOqYdHXtaHH
+N0na2RVQ1xbhsVybYPae3ujNHeQCPj+RaJyMVhb6Aj/AOsTTOPFswwIDAQ==
+-----END PUBLIC KEY-----
+`
+
+func TestNewAllocation(t *testing.T) {
+	members := []insolar.GenesisMemberAllocation{
+		{
+			PublicKey:        allocationPublicKey,
+			Balance:          "1000",
+			MigrationAddress: "0xAbC",
+			Deposits: []insolar.GenesisDepositAllocation{
+				{TxHash: "0x01", Amount: "100"},
+				{TxHash: "0x02", Amount: "200", HoldReleasePulse: insolar.FirstPulseNumber + 10},
+			},
+		},
+	}
+
+	a, err := newAllocation(members)
+	require.NoError(t, err)
+
+	memberRef := rootdomain.GenesisRef(insolar.GenesisNameAllocationMember(0))
+	key := foundation.TrimPublicKey(allocationPublicKey)
+	pkShard := a.publicKeyShards[foundation.GetShardIndex(key, insolar.GenesisAmountPublicKeyShards)]
+	require.Equal(t, memberRef.String(), pkShard[key])
+	maShard := a.migrationAddressShards[foundation.GetShardIndex("0xabc", insolar.GenesisAmountMigrationAddressShards)]
+	require.Equal(t, memberRef.String(), maShard["0xabc"])
+
+	require.Len(t, a.states, 4)
+	require.Equal(t, insolar.GenesisNameAllocationWallet(0), a.states[0].Name)
+	require.Equal(t, insolar.GenesisNameAllocationDeposit(0, 0), a.states[1].Name)
+	require.Equal(t, insolar.GenesisNameAllocationDeposit(0, 1), a.states[2].Name)
+	require.Equal(t, insolar.GenesisNameAllocationMember(0), a.states[3].Name)
+	require.Equal(t, insolar.GenesisNameWallet, a.states[0].Prototype)
+	require.Equal(t, insolar.GenesisNameDeposit, a.states[1].Prototype)
+	require.Equal(t, insolar.GenesisNameDeposit, a.states[2].Prototype)
+	require.Equal(t, insolar.GenesisNameMember, a.states[3].Prototype)
+
+	var d deposit.Deposit
+	err = insolar.Deserialize(a.states[2].Memory, &d)
+	require.NoError(t, err)
+	require.Equal(t, "200", d.Amount)
+	require.Equal(t, insolar.PulseNumber(insolar.FirstPulseNumber+10), d.PulseDepositUnHold)
+	for _, c := range d.MigrationDaemonConfirms {
+		require.NotEmpty(t, c)
+	}
+
+	var m member.Member
+	err = insolar.Deserialize(a.states[3].Memory, &m)
+	require.NoError(t, err)
+	require.Equal(t, rootdomain.GenesisRef(insolar.GenesisNameAllocationWallet(0)), m.Wallet)
+	require.Equal(t, "0xAbC", m.MigrationAddress)
+	require.Equal(t, map[string]insolar.Reference{
+		"0x01": rootdomain.GenesisRef(insolar.GenesisNameAllocationDeposit(0, 0)),
+		"0x02": rootdomain.GenesisRef(insolar.GenesisNameAllocationDeposit(0, 1)),
+	}, m.Deposits)
+}
+
+func TestNewAllocation_Duplicates(t *testing.T) {
+	t.Run("public key", func(t *testing.T) {
+		_, err := newAllocation([]insolar.GenesisMemberAllocation{
+			{PublicKey: allocationPublicKey, Balance: "1"},
+			{PublicKey: allocationPublicKey, Balance: "2"},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("deposit tx hash", func(t *testing.T) {
+		_, err := newAllocation([]insolar.GenesisMemberAllocation{
+			{
+				PublicKey: allocationPublicKey,
+				Deposits: []insolar.GenesisDepositAllocation{
+					{TxHash: "0x01", Amount: "1"},
+					{TxHash: "0x01", Amount: "2"},
+				},
+			},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestGenesis_StoreContracts_AllocationPrototypes(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	prototypes := map[insolar.Reference]insolar.Reference{}
+	am := artifact.NewManagerMock(mc).
+		RegisterRequestMock.Set(func(context.Context, record.IncomingRequest) (*insolar.ID, error) {
+		id := gen.ID()
+		return &id, nil
+	}).
+		ActivateObjectMock.Set(func(_ context.Context, _, obj, _, prototype insolar.Reference, _ []byte) error {
+		prototypes[obj] = prototype
+		return nil
+	}).
+		RegisterResultMock.Return(nil, nil)
+
+	g := &Genesis{
+		ArtifactManager: am,
+		ContractsConfig: insolar.GenesisContractsConfig{
+			RootBalance: "0",
+			MDBalance:   "0",
+			Allocations: []insolar.GenesisMemberAllocation{
+				{
+					PublicKey: allocationPublicKey,
+					Balance:   "1000",
+					Deposits: []insolar.GenesisDepositAllocation{
+						{TxHash: "0x01", Amount: "100"},
+					},
+				},
+			},
+		},
+	}
+	err := g.storeContracts(ctx)
+	require.NoError(t, err)
+
+	for _, proto := range prototypes {
+		require.NotEqual(t, insolar.Reference{}, proto)
+	}
+	require.Equal(t,
+		*memberproxy.PrototypeReference,
+		prototypes[rootdomain.GenesisRef(insolar.GenesisNameAllocationMember(0))],
+	)
+	require.Equal(t,
+		*walletproxy.PrototypeReference,
+		prototypes[rootdomain.GenesisRef(insolar.GenesisNameAllocationWallet(0))],
+	)
+	require.Equal(t,
+		*depositproxy.PrototypeReference,
+		prototypes[rootdomain.GenesisRef(insolar.GenesisNameAllocationDeposit(0, 0))],
+	)
+}
+
+func TestGenesis_ActivateContract_UnknownPrototype(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	g := &Genesis{ArtifactManager: artifact.NewManagerMock(mc)}
+
+	_, err := g.activateContract(ctx, insolar.GenesisContractState{Name: "unknown", Prototype: "unknown"})
+	require.Error(t, err)
+}
@@ -238,15 +238,21 @@ func (g *Genesis) storeContracts(ctx context.Context) error {
 		contracts.GetCostCenterGenesisContractState(),
 	}
 
+	alloc, err := newAllocation(g.ContractsConfig.Allocations)
+	if err != nil {
+		return errors.Wrap(err, "failed to prepare genesis allocations")
+	}
+
 	for i, key := range g.ContractsConfig.MigrationDaemonPublicKeys {
 		states = append(states, contracts.GetMemberGenesisContractState(key, insolar.GenesisNameMigrationDaemonMembers[i], insolar.GenesisNameRootDomain, insolar.Reference{}))
 	}
-	for _, name := range insolar.GenesisNamePublicKeyShards {
-		states = append(states, contracts.GetPKShardGenesisContractState(name))
+	for i, name := range insolar.GenesisNamePublicKeyShards {
+		states = append(states, contracts.GetPKShardGenesisContractState(name, alloc.publicKeyShards[i]))
 	}
-	for _, name := range insolar.GenesisNameMigrationAddressShards {
-		states = append(states, contracts.GetMigrationShardGenesisContractState(name))
+	for i, name := range insolar.GenesisNameMigrationAddressShards {
+		states = append(states, contracts.GetMigrationShardGenesisContractState(name, alloc.migrationAddressShards[i]))
 	}
+	states = append(states, alloc.states...)
 	for _, conf := range states {
 		_, err := g.activateContract(ctx, conf)
 		if err != nil {
@@ -261,8 +267,10 @@ func (g *Genesis) activateContract(ctx context.Context, state insolar.GenesisCon
 	name := state.Name
 	objRef := rootdomain.GenesisRef(name)
 
-	protoName := name + rootdomain.GenesisPrototypeSuffix
-	protoRef := rootdomain.GenesisRef(protoName)
+	protoRef, ok := rootdomain.GenesisPrototypeRef(state.Prototype)
+	if !ok {
+		return nil, errors.Errorf("unknown prototype '%v' of '%v' contract", state.Prototype, name)
+	}
 
 	reqID, err := g.ArtifactManager.RegisterRequest(
 		ctx,
@@ -28,6 +28,12 @@ type JetKeeperMock struct {
 	beforeAddHotConfirmationCounter uint64
 	AddHotConfirmationMock          mJetKeeperMockAddHotConfirmation
 
+	funcSkipPulse          func(ctx context.Context, pn insolar.PulseNumber) (err error)
+	inspectFuncSkipPulse   func(ctx context.Context, pn insolar.PulseNumber)
+	afterSkipPulseCounter  uint64
+	beforeSkipPulseCounter uint64
+	SkipPulseMock          mJetKeeperMockSkipPulse
+
 	funcTopSyncPulse          func() (p1 insolar.PulseNumber)
 	inspectFuncTopSyncPulse   func()
 	afterTopSyncPulseCounter  uint64
@@ -48,6 +54,9 @@ func NewJetKeeperMock(t minimock.Tester) *JetKeeperMock {
 	m.AddHotConfirmationMock = mJetKeeperMockAddHotConfirmation{mock: m}
 	m.AddHotConfirmationMock.callArgs = []*JetKeeperMockAddHotConfirmationParams{}
 
+	m.SkipPulseMock = mJetKeeperMockSkipPulse{mock: m}
+	m.SkipPulseMock.callArgs = []*JetKeeperMockSkipPulseParams{}
+
 	m.TopSyncPulseMock = mJetKeeperMockTopSyncPulse{mock: m}
 
 	return m
@@ -187,17 +196,17 @@ func (mmAddDropConfirmation *JetKeeperMock) AddDropConfirmation(ctx context.Cont
 
 	if mmAddDropConfirmation.AddDropConfirmationMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmAddDropConfirmation.AddDropConfirmationMock.defaultExpectation.Counter, 1)
-		want := mmAddDropConfirmation.AddDropConfirmationMock.defaultExpectation.params
-		got := JetKeeperMockAddDropConfirmationParams{ctx, pn, jet, split}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmAddDropConfirmation.t.Errorf("JetKeeperMock.AddDropConfirmation got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmAddDropConfirmation.AddDropConfirmationMock.defaultExpectation.params
+		mm_got := JetKeeperMockAddDropConfirmationParams{ctx, pn, jet, split}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddDropConfirmation.t.Errorf("JetKeeperMock.AddDropConfirmation got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmAddDropConfirmation.AddDropConfirmationMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmAddDropConfirmation.AddDropConfirmationMock.defaultExpectation.results
+		if mm_results == nil {
 			mmAddDropConfirmation.t.Fatal("No results are set for the JetKeeperMock.AddDropConfirmation")
 		}
-		return (*results).err
+		return (*mm_results).err
 	}
 	if mmAddDropConfirmation.funcAddDropConfirmation != nil {
 		return mmAddDropConfirmation.funcAddDropConfirmation(ctx, pn, jet, split)
@@ -405,17 +414,17 @@ func (mmAddHotConfirmation *JetKeeperMock) AddHotConfirmation(ctx context.Contex
 
 	if mmAddHotConfirmation.AddHotConfirmationMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmAddHotConfirmation.AddHotConfirmationMock.defaultExpectation.Counter, 1)
-		want := mmAddHotConfirmation.AddHotConfirmationMock.defaultExpectation.params
-		got := JetKeeperMockAddHotConfirmationParams{ctx, pn, jet, split}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmAddHotConfirmation.t.Errorf("JetKeeperMock.AddHotConfirmation got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmAddHotConfirmation.AddHotConfirmationMock.defaultExpectation.params
+		mm_got := JetKeeperMockAddHotConfirmationParams{ctx, pn, jet, split}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddHotConfirmation.t.Errorf("JetKeeperMock.AddHotConfirmation got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmAddHotConfirmation.AddHotConfirmationMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmAddHotConfirmation.AddHotConfirmationMock.defaultExpectation.results
+		if mm_results == nil {
 			mmAddHotConfirmation.t.Fatal("No results are set for the JetKeeperMock.AddHotConfirmation")
 		}
-		return (*results).err
+		return (*mm_results).err
 	}
 	if mmAddHotConfirmation.funcAddHotConfirmation != nil {
 		return mmAddHotConfirmation.funcAddHotConfirmation(ctx, pn, jet, split)
@@ -489,6 +498,222 @@ func (m *JetKeeperMock) MinimockAddHotConfirmationInspect() {
 	}
 }
 
+type mJetKeeperMockSkipPulse struct {
+	mock               *JetKeeperMock
+	defaultExpectation *JetKeeperMockSkipPulseExpectation
+	expectations       []*JetKeeperMockSkipPulseExpectation
+
+	callArgs []*JetKeeperMockSkipPulseParams
+	mutex    sync.RWMutex
+}
+
+// JetKeeperMockSkipPulseExpectation specifies expectation struct of the JetKeeper.SkipPulse
+type JetKeeperMockSkipPulseExpectation struct {
+	mock    *JetKeeperMock
+	params  *JetKeeperMockSkipPulseParams
+	results *JetKeeperMockSkipPulseResults
+	Counter uint64
+}
+
+// JetKeeperMockSkipPulseParams contains parameters of the JetKeeper.SkipPulse
+type JetKeeperMockSkipPulseParams struct {
+	ctx context.Context
+	pn  insolar.PulseNumber
+}
+
+// JetKeeperMockSkipPulseResults contains results of the JetKeeper.SkipPulse
+type JetKeeperMockSkipPulseResults struct {
+	err error
+}
+
+// Expect sets up expected params for JetKeeper.SkipPulse
+func (mmSkipPulse *mJetKeeperMockSkipPulse) Expect(ctx context.Context, pn insolar.PulseNumber) *mJetKeeperMockSkipPulse {
+	if mmSkipPulse.mock.funcSkipPulse != nil {
+		mmSkipPulse.mock.t.Fatalf("JetKeeperMock.SkipPulse mock is already set by Set")
+	}
+
+	if mmSkipPulse.defaultExpectation == nil {
+		mmSkipPulse.defaultExpectation = &JetKeeperMockSkipPulseExpectation{}
+	}
+
+	mmSkipPulse.defaultExpectation.params = &JetKeeperMockSkipPulseParams{ctx, pn}
+	for _, e := range mmSkipPulse.expectations {
+		if minimock.Equal(e.params, mmSkipPulse.defaultExpectation.params) {
+			mmSkipPulse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSkipPulse.defaultExpectation.params)
+		}
+	}
+
+	return mmSkipPulse
+}
+
+// Inspect accepts an inspector function that has same arguments as the JetKeeper.SkipPulse
+func (mmSkipPulse *mJetKeeperMockSkipPulse) Inspect(f func(ctx context.Context, pn insolar.PulseNumber)) *mJetKeeperMockSkipPulse {
+	if mmSkipPulse.mock.inspectFuncSkipPulse != nil {
+		mmSkipPulse.mock.t.Fatalf("Inspect function is already set for JetKeeperMock.SkipPulse")
+	}
+
+	mmSkipPulse.mock.inspectFuncSkipPulse = f
+
+	return mmSkipPulse
+}
+
+// Return sets up results that will be returned by JetKeeper.SkipPulse
+func (mmSkipPulse *mJetKeeperMockSkipPulse) Return(err error) *JetKeeperMock {
+	if mmSkipPulse.mock.funcSkipPulse != nil {
+		mmSkipPulse.mock.t.Fatalf("JetKeeperMock.SkipPulse mock is already set by Set")
+	}
+
+	if mmSkipPulse.defaultExpectation == nil {
+		mmSkipPulse.defaultExpectation = &JetKeeperMockSkipPulseExpectation{mock: mmSkipPulse.mock}
+	}
+	mmSkipPulse.defaultExpectation.results = &JetKeeperMockSkipPulseResults{err}
+	return mmSkipPulse.mock
+}
+
+//Set uses given function f to mock the JetKeeper.SkipPulse method
+func (mmSkipPulse *mJetKeeperMockSkipPulse) Set(f func(ctx context.Context, pn insolar.PulseNumber) (err error)) *JetKeeperMock {
+	if mmSkipPulse.defaultExpectation != nil {
+		mmSkipPulse.mock.t.Fatalf("Default expectation is already set for the JetKeeper.SkipPulse method")
+	}
+
+	if len(mmSkipPulse.expectations) > 0 {
+		mmSkipPulse.mock.t.Fatalf("Some expectations are already set for the JetKeeper.SkipPulse method")
+	}
+
+	mmSkipPulse.mock.funcSkipPulse = f
+	return mmSkipPulse.mock
+}
+
+// When sets expectation for the JetKeeper.SkipPulse which will trigger the result defined by the following
+// Then helper
+func (mmSkipPulse *mJetKeeperMockSkipPulse) When(ctx context.Context, pn insolar.PulseNumber) *JetKeeperMockSkipPulseExpectation {
+	if mmSkipPulse.mock.funcSkipPulse != nil {
+		mmSkipPulse.mock.t.Fatalf("JetKeeperMock.SkipPulse mock is already set by Set")
+	}
+
+	expectation := &JetKeeperMockSkipPulseExpectation{
+		mock:   mmSkipPulse.mock,
+		params: &JetKeeperMockSkipPulseParams{ctx, pn},
+	}
+	mmSkipPulse.expectations = append(mmSkipPulse.expectations, expectation)
+	return expectation
+}
+
+// Then sets up JetKeeper.SkipPulse return parameters for the expectation previously defined by the When method
+func (e *JetKeeperMockSkipPulseExpectation) Then(err error) *JetKeeperMock {
+	e.results = &JetKeeperMockSkipPulseResults{err}
+	return e.mock
+}
+
+// SkipPulse implements JetKeeper
+func (mmSkipPulse *JetKeeperMock) SkipPulse(ctx context.Context, pn insolar.PulseNumber) (err error) {
+	mm_atomic.AddUint64(&mmSkipPulse.beforeSkipPulseCounter, 1)
+	defer mm_atomic.AddUint64(&mmSkipPulse.afterSkipPulseCounter, 1)
+
+	if mmSkipPulse.inspectFuncSkipPulse != nil {
+		mmSkipPulse.inspectFuncSkipPulse(ctx, pn)
+	}
+
+	params := &JetKeeperMockSkipPulseParams{ctx, pn}
+
+	// Record call args
+	mmSkipPulse.SkipPulseMock.mutex.Lock()
+	mmSkipPulse.SkipPulseMock.callArgs = append(mmSkipPulse.SkipPulseMock.callArgs, params)
+	mmSkipPulse.SkipPulseMock.mutex.Unlock()
+
+	for _, e := range mmSkipPulse.SkipPulseMock.expectations {
+		if minimock.Equal(e.params, params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSkipPulse.SkipPulseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSkipPulse.SkipPulseMock.defaultExpectation.Counter, 1)
+		mm_want := mmSkipPulse.SkipPulseMock.defaultExpectation.params
+		mm_got := JetKeeperMockSkipPulseParams{ctx, pn}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSkipPulse.t.Errorf("JetKeeperMock.SkipPulse got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSkipPulse.SkipPulseMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSkipPulse.t.Fatal("No results are set for the JetKeeperMock.SkipPulse")
+		}
+		return (*mm_results).err
+	}
+	if mmSkipPulse.funcSkipPulse != nil {
+		return mmSkipPulse.funcSkipPulse(ctx, pn)
+	}
+	mmSkipPulse.t.Fatalf("Unexpected call to JetKeeperMock.SkipPulse. %v %v", ctx, pn)
+	return
+}
+
+// SkipPulseAfterCounter returns a count of finished JetKeeperMock.SkipPulse invocations
+func (mmSkipPulse *JetKeeperMock) SkipPulseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSkipPulse.afterSkipPulseCounter)
+}
+
+// SkipPulseBeforeCounter returns a count of JetKeeperMock.SkipPulse invocations
+func (mmSkipPulse *JetKeeperMock) SkipPulseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSkipPulse.beforeSkipPulseCounter)
+}
+
+// Calls returns a list of arguments used in each call to JetKeeperMock.SkipPulse.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSkipPulse *mJetKeeperMockSkipPulse) Calls() []*JetKeeperMockSkipPulseParams {
+	mmSkipPulse.mutex.RLock()
+
+	argCopy := make([]*JetKeeperMockSkipPulseParams, len(mmSkipPulse.callArgs))
+	copy(argCopy, mmSkipPulse.callArgs)
+
+	mmSkipPulse.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSkipPulseDone returns true if the count of the SkipPulse invocations corresponds
+// the number of defined expectations
+func (m *JetKeeperMock) MinimockSkipPulseDone() bool {
+	for _, e := range m.SkipPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SkipPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSkipPulseCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSkipPulse != nil && mm_atomic.LoadUint64(&m.afterSkipPulseCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockSkipPulseInspect logs each unmet expectation
+func (m *JetKeeperMock) MinimockSkipPulseInspect() {
+	for _, e := range m.SkipPulseMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to JetKeeperMock.SkipPulse with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SkipPulseMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterSkipPulseCounter) < 1 {
+		if m.SkipPulseMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to JetKeeperMock.SkipPulse")
+		} else {
+			m.t.Errorf("Expected call to JetKeeperMock.SkipPulse with params: %#v", *m.SkipPulseMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSkipPulse != nil && mm_atomic.LoadUint64(&m.afterSkipPulseCounter) < 1 {
+		m.t.Error("Expected call to JetKeeperMock.SkipPulse")
+	}
+}
+
 type mJetKeeperMockTopSyncPulse struct {
 	mock               *JetKeeperMock
 	defaultExpectation *JetKeeperMockTopSyncPulseExpectation
@@ -571,11 +796,11 @@ func (mmTopSyncPulse *JetKeeperMock) TopSyncPulse() (p1 insolar.PulseNumber) {
 	if mmTopSyncPulse.TopSyncPulseMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmTopSyncPulse.TopSyncPulseMock.defaultExpectation.Counter, 1)
 
-		results := mmTopSyncPulse.TopSyncPulseMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmTopSyncPulse.TopSyncPulseMock.defaultExpectation.results
+		if mm_results == nil {
 			mmTopSyncPulse.t.Fatal("No results are set for the JetKeeperMock.TopSyncPulse")
 		}
-		return (*results).p1
+		return (*mm_results).p1
 	}
 	if mmTopSyncPulse.funcTopSyncPulse != nil {
 		return mmTopSyncPulse.funcTopSyncPulse()
@@ -639,6 +864,8 @@ func (m *JetKeeperMock) MinimockFinish() {
 
 		m.MinimockAddHotConfirmationInspect()
 
+		m.MinimockSkipPulseInspect()
+
 		m.MinimockTopSyncPulseInspect()
 		m.t.FailNow()
 	}
@@ -665,5 +892,6 @@ func (m *JetKeeperMock) minimockDone() bool {
 	return done &&
 		m.MinimockAddDropConfirmationDone() &&
 		m.MinimockAddHotConfirmationDone() &&
+		m.MinimockSkipPulseDone() &&
 		m.MinimockTopSyncPulseDone()
 }
@@ -37,6 +37,9 @@ type JetKeeper interface {
 	AddDropConfirmation(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool) error
 	// AddHotConfirmation performs adding hot confirmation to storage and checks pulse completion.
 	AddHotConfirmation(ctx context.Context, pn insolar.PulseNumber, jet insolar.JetID, split bool) error
+	// SkipPulse marks pulse which isn't replicated to the node (other heavy replicas store it) as synced
+	// and checks completion of next pulses.
+	SkipPulse(ctx context.Context, pn insolar.PulseNumber) error
 	// TopSyncPulse provides access to highest synced (replicated) pulse.
 	TopSyncPulse() insolar.PulseNumber
 }
@@ -172,6 +175,42 @@ func (jk *dbJetKeeper) updateTopSyncPulse(ctx context.Context, pn insolar.PulseN
 		return nil
 	}
 
+	return jk.propagateTopSyncPulse(ctx, pn)
+}
+
+func (jk *dbJetKeeper) SkipPulse(ctx context.Context, pn insolar.PulseNumber) error {
+	jk.Lock()
+	defer jk.Unlock()
+
+	inslogger.FromContext(ctx).Debug("SkipPulse. pulse: ", pn)
+
+	prev, err := jk.pulses.Backwards(ctx, pn, 1)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get previous pulse for %d", pn)
+	}
+	if top := jk.topSyncPulse(); prev.PulseNumber != top {
+		return errors.Errorf("can't skip pulse %d, it's not next to top synced pulse %d", pn, top)
+	}
+
+	err = jk.updateSyncPulse(pn)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update consistent pulse")
+	}
+
+	next, err := jk.pulses.Forwards(ctx, pn, 1)
+	if err == pulse.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to get next pulse for %d", pn)
+	}
+	return jk.propagateTopSyncPulse(ctx, next.PulseNumber)
+}
+
+// propagateTopSyncPulse moves top synced pulse forward starting from provided pulse while pulses are complete.
+func (jk *dbJetKeeper) propagateTopSyncPulse(ctx context.Context, pn insolar.PulseNumber) error {
+	logger := inslogger.FromContext(ctx)
+
 	for jk.checkPulseConsistency(ctx, pn) {
 		err := jk.updateSyncPulse(pn)
 		if err != nil {
@@ -442,3 +442,35 @@ func TestDbJetKeeper_TopSyncPulse_FinalizeMultiple(t *testing.T) {
 	require.Equal(t, futurePulse, jetKeeper.TopSyncPulse())
 
 }
+
+func TestDbJetKeeper_SkipPulse(t *testing.T) {
+	t.Parallel()
+	ctx := inslogger.TestContext(t)
+	skippedPulse := insolar.GenesisPulse.PulseNumber + 10
+	nextPulse := insolar.GenesisPulse.PulseNumber + 20
+	jetKeeper, tmpDir, db, jets := initDB(t, skippedPulse)
+	defer os.RemoveAll(tmpDir)
+	defer db.Stop(ctx)
+
+	err := pulse.NewDB(db).Append(ctx, insolar.Pulse{PulseNumber: nextPulse})
+	require.NoError(t, err)
+
+	// Can't skip pulse which is not next to top synced one.
+	err = jetKeeper.SkipPulse(ctx, nextPulse)
+	require.Error(t, err)
+	require.Equal(t, insolar.GenesisPulse.PulseNumber, jetKeeper.TopSyncPulse())
+
+	// Next pulse is replicated before skipped one.
+	testJet := gen.JetID()
+	err = jets.Update(ctx, nextPulse, true, testJet)
+	require.NoError(t, err)
+	err = jetKeeper.AddHotConfirmation(ctx, nextPulse, testJet, false)
+	require.NoError(t, err)
+	err = jetKeeper.AddDropConfirmation(ctx, nextPulse, testJet, false)
+	require.NoError(t, err)
+	require.Equal(t, insolar.GenesisPulse.PulseNumber, jetKeeper.TopSyncPulse())
+
+	err = jetKeeper.SkipPulse(ctx, skippedPulse)
+	require.NoError(t, err)
+	require.Equal(t, nextPulse, jetKeeper.TopSyncPulse())
+}
@@ -0,0 +1,55 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+var (
+	statReconciledPulses = stats.Int64(
+		"heavy/reconcile/pulses",
+		"How many pulses were fetched from other heavy replicas",
+		stats.UnitDimensionless,
+	)
+	statReconcileErrors = stats.Int64(
+		"heavy/reconcile/errors",
+		"How many times fetching a pulse from other heavy replicas failed",
+		stats.UnitDimensionless,
+	)
+)
+
+func init() {
+	err := view.Register(
+		&view.View{
+			Name:        statReconciledPulses.Name(),
+			Description: statReconciledPulses.Description(),
+			Measure:     statReconciledPulses,
+			Aggregation: view.Count(),
+		},
+		&view.View{
+			Name:        statReconcileErrors.Name(),
+			Description: statReconcileErrors.Description(),
+			Measure:     statReconcileErrors,
+			Aggregation: view.Count(),
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,282 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+)
+
+const (
+	// reconcileDelay is amount of pulses heavy waits for replication from light nodes
+	// before fetching a pulse from other heavy replicas.
+	reconcileDelay = 2
+	// reconcileBatch is maximum amount of pulses fetched from other replicas on one pulse change.
+	reconcileBatch = 10
+)
+
+// Reconciler fetches pulses missing on the node from other heavy replicas of these pulses.
+type Reconciler interface {
+	// OnPulse fetches pulses after top synced one which were not replicated from light nodes in time.
+	OnPulse(ctx context.Context, current insolar.PulseNumber)
+}
+
+// ReconcilerDefault requests missing pulses from other heavies and stores them like replication from light.
+type ReconcilerDefault struct {
+	running uint32
+
+	jetKeeper   JetKeeper
+	pulses      pulse.Calculator
+	coordinator jet.Coordinator
+	sender      bus.Sender
+
+	records   object.RecordModifier
+	positions object.RecordPositionModifier
+	indexes   object.IndexModifier
+	drops     drop.Modifier
+	jets      jet.Modifier
+}
+
+// NewReconciler creates new instance of ReconcilerDefault.
+func NewReconciler(
+	jetKeeper JetKeeper,
+	pulses pulse.Calculator,
+	coordinator jet.Coordinator,
+	sender bus.Sender,
+	records object.RecordModifier,
+	positions object.RecordPositionModifier,
+	indexes object.IndexModifier,
+	drops drop.Modifier,
+	jets jet.Modifier,
+) *ReconcilerDefault {
+	return &ReconcilerDefault{
+		jetKeeper:   jetKeeper,
+		pulses:      pulses,
+		coordinator: coordinator,
+		sender:      sender,
+		records:     records,
+		positions:   positions,
+		indexes:     indexes,
+		drops:       drops,
+		jets:        jets,
+	}
+}
+
+// OnPulse starts fetching of pulses which were not replicated from light nodes in time in background,
+// so pulse change isn't blocked by other heavies. If previous reconciliation is still in progress,
+// nothing is started: missing pulses are fetched on one of the next pulses.
+func (r *ReconcilerDefault) OnPulse(ctx context.Context, current insolar.PulseNumber) {
+	if !atomic.CompareAndSwapUint32(&r.running, 0, 1) {
+		inslogger.FromContext(ctx).Debug("reconciler: previous reconciliation is in progress, skipping pulse ", current)
+		return
+	}
+
+	ctx = inslogger.SetLogger(context.Background(), inslogger.FromContext(ctx))
+	go func() {
+		defer atomic.StoreUint32(&r.running, 0)
+		r.reconcileMissing(ctx, current)
+	}()
+}
+
+// reconcileMissing fetches pulses after top synced one which were not replicated from light nodes in time.
+func (r *ReconcilerDefault) reconcileMissing(ctx context.Context, current insolar.PulseNumber) {
+	logger := inslogger.FromContext(ctx)
+
+	limit, err := r.pulses.Backwards(ctx, current, reconcileDelay)
+	if err != nil {
+		if err != pulse.ErrNotFound {
+			logger.Error(errors.Wrap(err, "reconciler: failed to calculate limit pulse"))
+		}
+		return
+	}
+
+	for i := 0; i < reconcileBatch; i++ {
+		top := r.jetKeeper.TopSyncPulse()
+		next, err := r.pulses.Forwards(ctx, top, 1)
+		if err != nil {
+			if err != pulse.ErrNotFound {
+				logger.Error(errors.Wrapf(err, "reconciler: failed to get pulse after %v", top))
+			}
+			return
+		}
+		if next.PulseNumber > limit.PulseNumber {
+			return
+		}
+
+		heavies, err := r.coordinator.Heavies(ctx, next.PulseNumber)
+		if err != nil {
+			logger.Error(errors.Wrapf(err, "reconciler: failed to calculate heavies for pulse %v", next.PulseNumber))
+			return
+		}
+		if !contains(heavies, r.coordinator.Me()) {
+			logger.Debugf("reconciler: pulse %v is stored by other heavies, skipping", next.PulseNumber)
+			err = r.jetKeeper.SkipPulse(ctx, next.PulseNumber)
+			if err != nil {
+				logger.Error(errors.Wrapf(err, "reconciler: failed to skip pulse %v", next.PulseNumber))
+				return
+			}
+			continue
+		}
+
+		logger.Infof("reconciler: pulse %v is not replicated in time, fetching from other heavies", next.PulseNumber)
+		err = r.reconcile(ctx, next.PulseNumber, heavies)
+		if err != nil {
+			stats.Record(ctx, statReconcileErrors.M(1))
+			logger.Error(errors.Wrapf(err, "reconciler: failed to fetch pulse %v", next.PulseNumber))
+			return
+		}
+		stats.Record(ctx, statReconciledPulses.M(1))
+
+		if r.jetKeeper.TopSyncPulse() == top {
+			logger.Warnf("reconciler: pulse %v is fetched but still not finalized", next.PulseNumber)
+			return
+		}
+	}
+}
+
+// reconcile fetches pulse from the first of other heavy replicas which has it.
+func (r *ReconcilerDefault) reconcile(ctx context.Context, pn insolar.PulseNumber, heavies []insolar.Reference) error {
+	me := r.coordinator.Me()
+	lastErr := errors.New("no other heavy replicas")
+	for _, heavy := range heavies {
+		if heavy == me {
+			continue
+		}
+		replica, err := r.fetch(ctx, pn, heavy)
+		if err != nil {
+			lastErr = errors.Wrapf(err, "failed to fetch from %v", heavy.String())
+			continue
+		}
+		return r.store(ctx, replica)
+	}
+	return lastErr
+}
+
+func contains(refs []insolar.Reference, ref insolar.Reference) bool {
+	for _, r := range refs {
+		if r == ref {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *ReconcilerDefault) fetch(
+	ctx context.Context, pn insolar.PulseNumber, heavy insolar.Reference,
+) (*payload.PulseReplica, error) {
+	msg, err := payload.NewMessage(&payload.GetPulseReplica{
+		Pulse: pn,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create message")
+	}
+
+	reps, done := r.sender.SendTarget(ctx, msg, heavy)
+	defer done()
+	res, ok := <-reps
+	if !ok {
+		return nil, errors.New("no reply")
+	}
+
+	pl, err := payload.UnmarshalFromMeta(res.Payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal reply")
+	}
+	switch rep := pl.(type) {
+	case *payload.PulseReplica:
+		return rep, nil
+	case *payload.Error:
+		return nil, errors.New(rep.Text)
+	default:
+		return nil, fmt.Errorf("unexpected reply %T", pl)
+	}
+}
+
+func (r *ReconcilerDefault) store(ctx context.Context, replica *payload.PulseReplica) error {
+	logger := inslogger.FromContext(ctx)
+
+	for _, rec := range replica.Records {
+		err := r.records.Set(ctx, rec)
+		if err == object.ErrOverride {
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to store record %v", rec.ID.DebugString())
+		}
+		err = r.positions.IncrementPosition(rec.ID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to store record position %v", rec.ID.DebugString())
+		}
+	}
+
+	for _, idx := range replica.Indexes {
+		err := r.indexes.SetIndex(ctx, replica.Pulse, idx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to store index %v", idx.ObjID.DebugString())
+		}
+	}
+
+	for _, raw := range replica.Drops {
+		d, err := drop.Decode(raw)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode drop")
+		}
+
+		err = r.drops.Set(ctx, *d)
+		switch err {
+		case nil:
+			err = r.jetKeeper.AddDropConfirmation(ctx, d.Pulse, d.JetID, d.Split)
+			if err != nil {
+				return errors.Wrapf(err, "failed to add drop confirmation jet=%v", d.JetID.DebugString())
+			}
+		case drop.ErrOverride:
+			// Drop was replicated from light, so it's already confirmed.
+		default:
+			return errors.Wrapf(err, "failed to store drop jet=%v", d.JetID.DebugString())
+		}
+
+		hot := []insolar.JetID{d.JetID}
+		if d.Split {
+			left, right := jet.Siblings(d.JetID)
+			hot = []insolar.JetID{left, right}
+		}
+		err = r.jets.Update(ctx, d.Pulse, true, hot...)
+		if err != nil {
+			return errors.Wrapf(err, "failed to update jets for drop jet=%v", d.JetID.DebugString())
+		}
+		for _, id := range hot {
+			err = r.jetKeeper.AddHotConfirmation(ctx, d.Pulse, id, d.Split)
+			if err != nil {
+				// Confirmation could be received from light before.
+				logger.Debug(errors.Wrapf(err, "reconciler: failed to add hot confirmation jet=%v", id.DebugString()))
+			}
+		}
+	}
+	return nil
+}
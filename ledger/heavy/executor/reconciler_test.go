@@ -0,0 +1,224 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+func TestReconciler_OnPulse_NothingToFetch(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	current := gen.PulseNumber()
+	top := current - 2
+
+	keeper := NewJetKeeperMock(mc)
+	keeper.TopSyncPulseMock.Return(top)
+
+	pulses := pulse.NewCalculatorMock(mc)
+	pulses.BackwardsMock.Expect(ctx, current, reconcileDelay).Return(insolar.Pulse{PulseNumber: top}, nil)
+	pulses.ForwardsMock.Expect(ctx, top, 1).Return(insolar.Pulse{PulseNumber: current - 1}, nil)
+
+	r := NewReconciler(keeper, pulses, nil, nil, nil, nil, nil, nil, nil)
+	r.reconcileMissing(ctx, current)
+}
+
+func TestReconciler_OnPulse_FetchesMissingPulse(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	current := gen.PulseNumber()
+	top := current - 10
+	missing := top + 1
+	limit := current - reconcileDelay
+
+	me := gen.Reference()
+	other := gen.Reference()
+
+	rec := record.Material{ID: *insolar.NewID(missing, []byte{1})}
+	idx := record.Index{ObjID: gen.ID()}
+	dr := drop.Drop{Pulse: missing, JetID: insolar.ZeroJetID}
+
+	synced := top
+	keeper := NewJetKeeperMock(mc)
+	keeper.TopSyncPulseMock.Set(func() insolar.PulseNumber {
+		return synced
+	})
+	keeper.AddDropConfirmationMock.Expect(ctx, missing, insolar.ZeroJetID, false).Return(nil)
+	keeper.AddHotConfirmationMock.Set(func(_ context.Context, pn insolar.PulseNumber, id insolar.JetID, split bool) error {
+		require.Equal(t, missing, pn)
+		require.Equal(t, insolar.ZeroJetID, id)
+		require.False(t, split)
+		synced = missing
+		return nil
+	})
+
+	pulses := pulse.NewCalculatorMock(mc)
+	pulses.BackwardsMock.Expect(ctx, current, reconcileDelay).Return(insolar.Pulse{PulseNumber: limit}, nil)
+	pulses.ForwardsMock.Set(func(_ context.Context, pn insolar.PulseNumber, steps int) (insolar.Pulse, error) {
+		require.Equal(t, 1, steps)
+		if pn == top {
+			return insolar.Pulse{PulseNumber: missing}, nil
+		}
+		return insolar.Pulse{}, pulse.ErrNotFound
+	})
+
+	coordinator := jet.NewCoordinatorMock(mc)
+	coordinator.MeMock.Return(me)
+	coordinator.HeaviesMock.Expect(ctx, missing).Return([]insolar.Reference{other, me}, nil)
+
+	sender := bus.NewSenderMock(mc)
+	sender.SendTargetMock.Set(func(_ context.Context, msg *message.Message, target insolar.Reference) (<-chan *message.Message, func()) {
+		require.Equal(t, other, target)
+		pl, err := payload.Unmarshal(msg.Payload)
+		require.NoError(t, err)
+		require.Equal(t, missing, pl.(*payload.GetPulseReplica).Pulse)
+
+		rep, err := payload.NewMessage(&payload.PulseReplica{
+			Pulse:   missing,
+			Drops:   [][]byte{drop.MustEncode(&dr)},
+			Indexes: []record.Index{idx},
+			Records: []record.Material{rec},
+		})
+		require.NoError(t, err)
+		meta := payload.Meta{Payload: rep.Payload}
+		buf, err := meta.Marshal()
+		require.NoError(t, err)
+		rep.Payload = buf
+		ch := make(chan *message.Message, 1)
+		ch <- rep
+		return ch, func() {}
+	})
+
+	records := object.NewRecordModifierMock(mc)
+	records.SetMock.Set(func(_ context.Context, r record.Material) error {
+		require.Equal(t, rec.ID, r.ID)
+		return nil
+	})
+	positions := object.NewRecordPositionModifierMock(mc)
+	positions.IncrementPositionMock.Expect(rec.ID).Return(nil)
+	indexes := object.NewIndexModifierMock(mc)
+	indexes.SetIndexMock.Set(func(_ context.Context, pn insolar.PulseNumber, i record.Index) error {
+		require.Equal(t, missing, pn)
+		require.Equal(t, idx.ObjID, i.ObjID)
+		return nil
+	})
+	drops := drop.NewModifierMock(mc)
+	drops.SetMock.Set(func(_ context.Context, d drop.Drop) error {
+		require.Equal(t, dr.Pulse, d.Pulse)
+		require.Equal(t, dr.JetID, d.JetID)
+		return nil
+	})
+	jets := jet.NewModifierMock(mc)
+	jets.UpdateMock.Expect(ctx, missing, true, insolar.ZeroJetID).Return(nil)
+
+	r := NewReconciler(keeper, pulses, coordinator, sender, records, positions, indexes, drops, jets)
+	r.reconcileMissing(ctx, current)
+
+	require.Equal(t, missing, synced)
+}
+
+func TestReconciler_OnPulse_SkipsPulseOfOtherReplicas(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	current := gen.PulseNumber()
+	top := current - 10
+	skipped := top + 1
+	limit := current - reconcileDelay
+
+	synced := top
+	keeper := NewJetKeeperMock(mc)
+	keeper.TopSyncPulseMock.Set(func() insolar.PulseNumber {
+		return synced
+	})
+	keeper.SkipPulseMock.Set(func(_ context.Context, pn insolar.PulseNumber) error {
+		require.Equal(t, skipped, pn)
+		synced = skipped
+		return nil
+	})
+
+	pulses := pulse.NewCalculatorMock(mc)
+	pulses.BackwardsMock.Expect(ctx, current, reconcileDelay).Return(insolar.Pulse{PulseNumber: limit}, nil)
+	pulses.ForwardsMock.Set(func(_ context.Context, pn insolar.PulseNumber, steps int) (insolar.Pulse, error) {
+		require.Equal(t, 1, steps)
+		if pn == top {
+			return insolar.Pulse{PulseNumber: skipped}, nil
+		}
+		return insolar.Pulse{}, pulse.ErrNotFound
+	})
+
+	coordinator := jet.NewCoordinatorMock(mc)
+	coordinator.MeMock.Return(gen.Reference())
+	coordinator.HeaviesMock.Expect(ctx, skipped).Return([]insolar.Reference{gen.Reference(), gen.Reference()}, nil)
+
+	// Pulse is not fetched from its replicas, so sender is not used.
+	r := NewReconciler(keeper, pulses, coordinator, nil, nil, nil, nil, nil, nil)
+	r.reconcileMissing(ctx, current)
+
+	require.Equal(t, skipped, synced)
+}
+
+func TestReconciler_OnPulse_InBackground(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	current := gen.PulseNumber()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	finished := make(chan struct{})
+
+	pulses := pulse.NewCalculatorMock(mc)
+	pulses.BackwardsMock.Set(func(_ context.Context, pn insolar.PulseNumber, steps int) (insolar.Pulse, error) {
+		require.Equal(t, current, pn)
+		close(started)
+		<-release
+		defer close(finished)
+		return insolar.Pulse{}, pulse.ErrNotFound
+	})
+
+	r := NewReconciler(nil, pulses, nil, nil, nil, nil, nil, nil, nil)
+	r.OnPulse(ctx, current)
+	<-started
+
+	// Reconciliation is in progress, the next pulse doesn't start another one.
+	r.OnPulse(ctx, current+1)
+
+	close(release)
+	<-finished
+}
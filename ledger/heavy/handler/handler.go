@@ -42,12 +42,13 @@ import (
 type Handler struct {
 	cfg configuration.Ledger
 
-	Bus             insolar.MessageBus
-	JetCoordinator  jet.Coordinator
-	PCS             insolar.PlatformCryptographyScheme
-	RecordAccessor  object.RecordAccessor
-	RecordModifier  object.RecordModifier
-	RecordPositions object.RecordPositionModifier
+	Bus                    insolar.MessageBus
+	JetCoordinator         jet.Coordinator
+	PCS                    insolar.PlatformCryptographyScheme
+	RecordAccessor         object.RecordAccessor
+	RecordModifier         object.RecordModifier
+	RecordPositions        object.RecordPositionModifier
+	RecordPositionAccessor object.RecordPositionAccessor
 
 	IndexAccessor object.IndexAccessor
 	IndexModifier object.IndexModifier
@@ -57,6 +58,7 @@ type Handler struct {
 	JetModifier   jet.Modifier
 	JetAccessor   jet.Accessor
 	JetKeeper     executor.JetKeeper
+	Mismatches    object.MismatchModifier
 
 	Sender          bus.Sender
 	StartPulse      pulse.StartPulse
@@ -100,6 +102,7 @@ func New(cfg configuration.Ledger) *Handler {
 				h.DropModifier,
 				h.JetModifier,
 				h.JetKeeper,
+				h.Sender,
 			)
 		},
 		SendJet: func(p *proc.SendJet) {
@@ -124,6 +127,19 @@ func New(cfg configuration.Ledger) *Handler {
 				h.Sender,
 			)
 		},
+		SendPulseReplica: func(p *proc.SendPulseReplica) {
+			p.Dep(
+				h.JetKeeper,
+				h.DropDB,
+				h.RecordPositionAccessor,
+				h.RecordAccessor,
+				h.IndexAccessor,
+				h.Sender,
+			)
+		},
+		StoreMismatch: func(p *proc.StoreMismatch) {
+			p.Dep(h.Mismatches)
+		},
 	}
 	h.dep = &dep
 	return h
@@ -216,6 +232,14 @@ func (h *Handler) handle(ctx context.Context, msg *watermillMsg.Message) error {
 		p := proc.NewSendInitialState(meta)
 		h.dep.SendInitialState(p)
 		err = p.Proceed(ctx)
+	case payload.TypeGetPulseReplica:
+		p := proc.NewSendPulseReplica(meta)
+		h.dep.SendPulseReplica(p)
+		err = p.Proceed(ctx)
+	case payload.TypeValidationMismatch:
+		p := proc.NewStoreMismatch(meta)
+		h.dep.StoreMismatch(p)
+		err = p.Proceed(ctx)
 	default:
 		err = fmt.Errorf("no handler for message type %s", payloadType.String())
 	}
@@ -27,6 +27,11 @@ var (
 		"How many heavy-payload messages were received from a light-node",
 		stats.UnitDimensionless,
 	)
+	statValidationMismatches = stats.Int64(
+		"heavy/validation/mismatches",
+		"Amount of validation mismatches reported by virtual validators",
+		stats.UnitDimensionless,
+	)
 )
 
 func init() {
@@ -37,6 +42,12 @@ func init() {
 			Measure:     statReceivedHeavyPayloadCount,
 			Aggregation: view.Count(),
 		},
+		&view.View{
+			Name:        statValidationMismatches.Name(),
+			Description: statValidationMismatches.Description(),
+			Measure:     statValidationMismatches,
+			Aggregation: view.Count(),
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -53,10 +53,14 @@ func (p *PassState) Proceed(ctx context.Context) error {
 		return errors.Wrap(err, "failed to decode PassState payload")
 	}
 
-	origin := payload.Meta{}
-	err = origin.Unmarshal(pass.Origin)
-	if err != nil {
-		return errors.Wrap(err, "failed to decode origin message")
+	// Without origin the state is sent to the sender, so light can try other heavy replicas.
+	origin := p.message
+	if len(pass.Origin) > 0 {
+		origin = payload.Meta{}
+		err = origin.Unmarshal(pass.Origin)
+		if err != nil {
+			return errors.Wrap(err, "failed to decode origin message")
+		}
 	}
 
 	rec, err := p.Dep.Records.ForID(ctx, pass.StateID)
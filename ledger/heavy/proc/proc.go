@@ -25,4 +25,6 @@ type Dependencies struct {
 	SendJet          func(*SendJet)
 	SendIndex        func(*SendIndex)
 	SendInitialState func(*SendInitialState)
+	SendPulseReplica func(*SendPulseReplica)
+	StoreMismatch    func(*StoreMismatch)
 }
@@ -22,6 +22,7 @@ import (
 
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
 	"github.com/insolar/insolar/insolar/jet"
 	"github.com/insolar/insolar/insolar/payload"
 	"github.com/insolar/insolar/insolar/pulse"
@@ -47,6 +48,7 @@ type Replication struct {
 		drops            drop.Modifier
 		jets             jet.Modifier
 		keeper           executor.JetKeeper
+		sender           bus.Sender
 	}
 }
 
@@ -66,6 +68,7 @@ func (p *Replication) Dep(
 	drops drop.Modifier,
 	jets jet.Modifier,
 	keeper executor.JetKeeper,
+	sender bus.Sender,
 ) {
 	p.dep.records = records
 	p.dep.indexes = indexes
@@ -75,6 +78,7 @@ func (p *Replication) Dep(
 	p.dep.drops = drops
 	p.dep.jets = jets
 	p.dep.keeper = keeper
+	p.dep.sender = sender
 }
 
 func (p *Replication) Proceed(ctx context.Context) error {
@@ -105,6 +109,18 @@ func (p *Replication) Proceed(ctx context.Context) error {
 		statReceivedHeavyPayloadCount.M(1),
 	)
 
+	if msg.Confirm {
+		confirmation, err := payload.NewMessage(&payload.GotHotConfirmation{
+			JetID: dr.JetID,
+			Pulse: dr.Pulse,
+			Split: dr.Split,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create confirmation")
+		}
+		p.dep.sender.Reply(ctx, p.message, confirmation)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,151 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package proc
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/store"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/executor"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/pkg/errors"
+)
+
+// SendPulseReplica sends all finalized data of a pulse to another heavy replica.
+type SendPulseReplica struct {
+	meta payload.Meta
+
+	dep struct {
+		keeper    executor.JetKeeper
+		drops     *drop.DB
+		positions object.RecordPositionAccessor
+		records   object.RecordAccessor
+		indexes   object.IndexAccessor
+		sender    bus.Sender
+	}
+}
+
+func NewSendPulseReplica(meta payload.Meta) *SendPulseReplica {
+	return &SendPulseReplica{
+		meta: meta,
+	}
+}
+
+func (p *SendPulseReplica) Dep(
+	keeper executor.JetKeeper,
+	drops *drop.DB,
+	positions object.RecordPositionAccessor,
+	records object.RecordAccessor,
+	indexes object.IndexAccessor,
+	sender bus.Sender,
+) {
+	p.dep.keeper = keeper
+	p.dep.drops = drops
+	p.dep.positions = positions
+	p.dep.records = records
+	p.dep.indexes = indexes
+	p.dep.sender = sender
+}
+
+func (p *SendPulseReplica) Proceed(ctx context.Context) error {
+	get := payload.GetPulseReplica{}
+	err := get.Unmarshal(p.meta.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal GetPulseReplica message")
+	}
+
+	// Only fully replicated pulses are shared, so the requester doesn't get partial data.
+	if top := p.dep.keeper.TopSyncPulse(); get.Pulse > top {
+		msg, err := payload.NewMessage(&payload.Error{
+			Text: errors.Errorf("pulse %v is not finalized yet, top synced pulse %v", get.Pulse, top).Error(),
+			Code: payload.CodeNotFound,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create reply")
+		}
+		p.dep.sender.Reply(ctx, p.meta, msg)
+		return nil
+	}
+
+	drops, err := p.dep.drops.AllForPulse(ctx, get.Pulse)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch drops")
+	}
+	// Pulse is synced but stored by other heavy replicas.
+	if len(drops) == 0 {
+		msg, err := payload.NewMessage(&payload.Error{
+			Text: errors.Errorf("pulse %v is not stored on the node", get.Pulse).Error(),
+			Code: payload.CodeNotFound,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create reply")
+		}
+		p.dep.sender.Reply(ctx, p.meta, msg)
+		return nil
+	}
+	rawDrops := make([][]byte, 0, len(drops))
+	for i := range drops {
+		rawDrops = append(rawDrops, drop.MustEncode(&drops[i]))
+	}
+
+	records, err := p.pulseRecords(ctx, get)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch records")
+	}
+
+	msg, err := payload.NewMessage(&payload.PulseReplica{
+		Pulse:   get.Pulse,
+		Drops:   rawDrops,
+		Indexes: p.dep.indexes.ForPulse(ctx, get.Pulse),
+		Records: records,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create reply")
+	}
+
+	p.dep.sender.Reply(ctx, p.meta, msg)
+	return nil
+}
+
+// pulseRecords returns records of the pulse in the order they were stored.
+func (p *SendPulseReplica) pulseRecords(ctx context.Context, get payload.GetPulseReplica) ([]record.Material, error) {
+	last, err := p.dep.positions.LastKnownPosition(get.Pulse)
+	if err == store.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch last known position")
+	}
+
+	records := make([]record.Material, 0, last)
+	for position := uint32(1); position <= last; position++ {
+		id, err := p.dep.positions.AtPosition(get.Pulse, position)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch record id at position %v", position)
+		}
+		rec, err := p.dep.records.ForID(ctx, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch record %v", id.DebugString())
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
@@ -0,0 +1,69 @@
+/*
+ *    Copyright 2019 Insolar Technologies
+ *
+ *    Licensed under the Apache License, Version 2.0 (the "License");
+ *    you may not use this file except in compliance with the License.
+ *    You may obtain a copy of the License at
+ *
+ *        http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *    Unless required by applicable law or agreed to in writing, software
+ *    distributed under the License is distributed on an "AS IS" BASIS,
+ *    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *    See the License for the specific language governing permissions and
+ *    limitations under the License.
+ */
+
+package proc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+// StoreMismatch persists validation mismatch reported by virtual validator.
+type StoreMismatch struct {
+	meta payload.Meta
+
+	dep struct {
+		mismatches object.MismatchModifier
+	}
+}
+
+func NewStoreMismatch(meta payload.Meta) *StoreMismatch {
+	return &StoreMismatch{
+		meta: meta,
+	}
+}
+
+func (p *StoreMismatch) Dep(mismatches object.MismatchModifier) {
+	p.dep.mismatches = mismatches
+}
+
+func (p *StoreMismatch) Proceed(ctx context.Context) error {
+	mismatch := payload.ValidationMismatch{}
+	err := mismatch.Unmarshal(p.meta.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode ValidationMismatch payload")
+	}
+	// Validator can't report on behalf of another node.
+	mismatch.Validator = p.meta.Sender
+
+	stats.Record(ctx, statValidationMismatches.M(1))
+	inslogger.FromContext(ctx).WithFields(map[string]interface{}{
+		"object":    mismatch.Object.String(),
+		"request":   mismatch.Request.String(),
+		"pulse":     mismatch.Pulse.String(),
+		"executor":  mismatch.Executor.String(),
+		"validator": mismatch.Validator.String(),
+	}).Errorf("validator reported faulty execution: %s", mismatch.Reason)
+
+	err = p.dep.mismatches.Set(ctx, mismatch)
+	return errors.Wrap(err, "failed to store validation mismatch")
+}
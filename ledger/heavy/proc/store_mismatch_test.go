@@ -0,0 +1,62 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package proc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/heavy/proc"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreMismatch_Proceed(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+	ctx := inslogger.TestContext(t)
+
+	sender := gen.Reference()
+	mismatch := payload.ValidationMismatch{
+		Object:    gen.Reference(),
+		Request:   gen.Reference(),
+		Pulse:     gen.PulseNumber(),
+		Executor:  gen.Reference(),
+		Validator: gen.Reference(),
+		Reason:    "result hash mismatch",
+	}
+	buf, err := mismatch.Marshal()
+	require.NoError(t, err)
+
+	mismatches := object.NewMismatchModifierMock(mc).SetMock.Set(func(_ context.Context, m payload.ValidationMismatch) error {
+		require.Equal(t, mismatch.Object, m.Object)
+		require.Equal(t, mismatch.Request, m.Request)
+		require.Equal(t, mismatch.Reason, m.Reason)
+		// Validator is the sender of the report.
+		require.Equal(t, sender, m.Validator)
+		return nil
+	})
+
+	p := proc.NewStoreMismatch(payload.Meta{Payload: buf, Sender: sender})
+	p.Dep(mismatches)
+	err = p.Proceed(ctx)
+	require.NoError(t, err)
+}
@@ -47,6 +47,7 @@ type PulseManager struct {
 	PulseAppender      pulse.Appender              `inject:""`
 	PulseAccessor      pulse.Accessor              `inject:""`
 	FinalizationKeeper executor.FinalizationKeeper `inject:""`
+	Reconciler         executor.Reconciler         `inject:""`
 	JetModifier        jet.Modifier                `inject:""`
 
 	currentPulse insolar.Pulse
@@ -91,6 +92,9 @@ func (m *PulseManager) Set(ctx context.Context, newPulse insolar.Pulse) error {
 	)
 	defer span.End()
 
+	logger.Debug("before calling to Reconciler.OnPulse")
+	m.Reconciler.OnPulse(ctx, newPulse.PulseNumber)
+
 	logger.Debug("before calling to FinalizationKeeper.OnPulse")
 	err := m.FinalizationKeeper.OnPulse(ctx, newPulse.PulseNumber)
 	if err != nil {
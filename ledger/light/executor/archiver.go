@@ -0,0 +1,172 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/pkg/errors"
+)
+
+// Archiver exports data of pulses evicted from light node's storage.
+type Archiver interface {
+	// Archive exports all data of provided pulse. It's called before data is removed.
+	Archive(ctx context.Context, pn insolar.PulseNumber) error
+}
+
+// FileArchiver implements Archiver. It writes every pulse to a separate gzip-compressed file
+// as a sequence of length-prefixed payload.Replication messages, one per jet.
+type FileArchiver struct {
+	dir string
+
+	jetAccessor  jet.Accessor
+	dropAccessor drop.Accessor
+	recsAccessor object.RecordCollectionAccessor
+	idxAccessor  object.IndexAccessor
+}
+
+// NewFileArchiver creates new instance of FileArchiver.
+func NewFileArchiver(
+	dir string,
+	jetAccessor jet.Accessor,
+	dropAccessor drop.Accessor,
+	recsAccessor object.RecordCollectionAccessor,
+	idxAccessor object.IndexAccessor,
+) *FileArchiver {
+	return &FileArchiver{
+		dir:          dir,
+		jetAccessor:  jetAccessor,
+		dropAccessor: dropAccessor,
+		recsAccessor: recsAccessor,
+		idxAccessor:  idxAccessor,
+	}
+}
+
+// ArchivePath returns path of archive file for provided pulse.
+func (a *FileArchiver) ArchivePath(pn insolar.PulseNumber) string {
+	return filepath.Join(a.dir, fmt.Sprintf("%d.replication.gz", pn))
+}
+
+// Archive exports all data of provided pulse to archive file.
+func (a *FileArchiver) Archive(ctx context.Context, pn insolar.PulseNumber) error {
+	err := os.MkdirAll(a.dir, 0755)
+	if err != nil {
+		return errors.Wrap(err, "failed to create archive directory")
+	}
+
+	indexes := map[insolar.JetID][]record.Index{}
+	for _, idx := range a.idxAccessor.ForPulse(ctx, pn) {
+		jetID, _ := a.jetAccessor.ForID(ctx, pn, idx.ObjID)
+		indexes[jetID] = append(indexes[jetID], idx)
+	}
+
+	path := a.ArchivePath(pn)
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to create archive file")
+	}
+
+	err = a.write(ctx, f, pn, indexes)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return errors.Wrapf(err, "failed to archive pulse %v", pn)
+	}
+	return os.Rename(tmpPath, path)
+}
+
+func (a *FileArchiver) write(
+	ctx context.Context, w io.Writer, pn insolar.PulseNumber, indexes map[insolar.JetID][]record.Index,
+) error {
+	gz := gzip.NewWriter(w)
+	for _, jetID := range a.jetAccessor.All(ctx, pn) {
+		pl, err := replicationPayload(ctx, a.dropAccessor, a.recsAccessor, pn, jetID, indexes[jetID])
+		if errors.Cause(err) == drop.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		buf, err := pl.Marshal()
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal payload")
+		}
+
+		var size [4]byte
+		binary.BigEndian.PutUint32(size[:], uint32(len(buf)))
+		if _, err := gz.Write(size[:]); err != nil {
+			return err
+		}
+		if _, err := gz.Write(buf); err != nil {
+			return err
+		}
+	}
+	return gz.Close()
+}
+
+// ReadArchive reads data of archived pulse from archive file.
+func ReadArchive(path string) ([]payload.Replication, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open archive")
+	}
+	defer gz.Close()
+
+	var res []payload.Replication
+	for {
+		var size [4]byte
+		_, err := io.ReadFull(gz, size[:])
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read archive")
+		}
+
+		buf := make([]byte, binary.BigEndian.Uint32(size[:]))
+		if _, err := io.ReadFull(gz, buf); err != nil {
+			return nil, errors.Wrap(err, "failed to read archive")
+		}
+		var pl payload.Replication
+		if err := pl.Unmarshal(buf); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal payload")
+		}
+		res = append(res, pl)
+	}
+}
@@ -0,0 +1,67 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/light/executor"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+func TestFileArchiver_Archive(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	dir, err := ioutil.TempDir("", "light-archive")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pn := gen.PulseNumber()
+	jets := jet.NewStore()
+	drops := drop.NewStorageMemory()
+	records := object.NewRecordMemory()
+	indexes := object.NewIndexStorageMemory()
+
+	err = jets.Update(ctx, pn, true, insolar.ZeroJetID)
+	require.NoError(t, err)
+	err = drops.Set(ctx, drop.Drop{Pulse: pn, JetID: insolar.ZeroJetID})
+	require.NoError(t, err)
+	objID := gen.IDWithPulse(pn)
+	indexes.Set(ctx, pn, record.Index{ObjID: objID, LifelineLastUsed: pn})
+
+	archiver := executor.NewFileArchiver(dir, jets, drops, records, indexes)
+	err = archiver.Archive(ctx, pn)
+	require.NoError(t, err)
+
+	archived, err := executor.ReadArchive(archiver.ArchivePath(pn))
+	require.NoError(t, err)
+	require.Len(t, archived, 1)
+	require.Equal(t, pn, archived[0].Pulse)
+	require.Equal(t, insolar.ZeroJetID, archived[0].JetID)
+	require.Len(t, archived[0].Indexes, 1)
+	require.Equal(t, objID, archived[0].Indexes[0].ObjID)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/ledger/drop"
 	"github.com/insolar/insolar/ledger/object"
+	"go.opencensus.io/stats"
 )
 
 //go:generate minimock -i github.com/insolar/insolar/ledger/light/executor.Cleaner -o ./ -s _mock.go -g
@@ -59,9 +60,24 @@ type LightCleaner struct {
 	pulseCalculator pulse.Calculator
 
 	filamentCleaner FilamentCleaner
+	archiver        Archiver
 
 	lightChainLimit int
 	cleanerDelay    int
+	keepPulses      int
+
+	retentionLock sync.RWMutex
+	retention     RetentionWindow
+}
+
+// RetentionWindow describes pulses which data is kept locally.
+type RetentionWindow struct {
+	// Oldest is the oldest pulse which data is kept, zero if nothing was cleaned yet.
+	Oldest insolar.PulseNumber
+	// Latest is the latest pulse cleaner was notified about.
+	Latest insolar.PulseNumber
+	// Depth is amount of pulses kept before the latest one, zero if nothing was cleaned yet.
+	Depth int
 }
 
 // NewCleaner creates a new instance of LightCleaner
@@ -77,6 +93,8 @@ func NewCleaner(
 	filamentCleaner FilamentCleaner,
 	lightChainLimit int,
 	cleanerDelay int,
+	keepPulses int,
+	archiver Archiver,
 ) *LightCleaner {
 	return &LightCleaner{
 		jetCleaner:      jetCleaner,
@@ -88,6 +106,8 @@ func NewCleaner(
 		pulseCalculator: pulseCalculator,
 		lightChainLimit: lightChainLimit,
 		cleanerDelay:    cleanerDelay,
+		keepPulses:      keepPulses,
+		archiver:        archiver,
 		filamentCleaner: filamentCleaner,
 		indexAccessor:   indexAccessor,
 		pulseForClean:   make(chan insolar.PulseNumber),
@@ -100,7 +120,7 @@ func NewCleaner(
 // all the data for it will be cleaned
 func (c *LightCleaner) NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber) {
 	c.once.Do(func() {
-		go c.clean(context.Background())
+		go c.clean(WithComponent(context.Background()))
 	})
 	inslogger.FromContext(ctx).Debugf("[Cleaner][NotifyAboutPulse] received pulse - %v", pn)
 	c.pulseForClean <- pn
@@ -120,17 +140,27 @@ func (c *LightCleaner) clean(ctx context.Context) {
 		// and then access nodes. Between message receive and data access cleaner can remove data for the
 		// pulse on lightChainLimit. This will lead to data fetch failure. We need to give handlers time to
 		// finish before removing data.
-		cleanFrom := c.lightChainLimit + c.cleanerDelay
+		// Data is additionally kept for keepPulses pulses if retention is configured.
+		cleanFrom := c.lightChainLimit + c.cleanerDelay + c.keepPulses
 		expiredPn, err := c.pulseCalculator.Backwards(ctx, pn, cleanFrom)
 		if err == pulse.ErrNotFound {
 			logger.Warnf("[Cleaner][NotifyAboutPulse] expiredPn for pn - %v doesn't exist. limit - %v",
 				pn, c.lightChainLimit)
+			c.setRetention(RetentionWindow{Latest: pn})
 			return
 		}
 		if err != nil {
 			panic(err)
 		}
+		c.archivePulse(ctx, expiredPn.PulseNumber)
 		c.cleanPulse(ctx, expiredPn.PulseNumber)
+
+		oldest, err := c.pulseCalculator.Backwards(ctx, pn, cleanFrom-1)
+		if err != nil {
+			panic(err)
+		}
+		c.setRetention(RetentionWindow{Oldest: oldest.PulseNumber, Latest: pn, Depth: cleanFrom - 1})
+		stats.Record(ctx, statRetentionOldestPulse.M(int64(oldest.PulseNumber)))
 	}
 
 	for {
@@ -147,6 +177,38 @@ func (c *LightCleaner) clean(ctx context.Context) {
 	}
 }
 
+// Retention returns pulses which data is currently kept locally.
+func (c *LightCleaner) Retention() RetentionWindow {
+	c.retentionLock.RLock()
+	defer c.retentionLock.RUnlock()
+
+	return c.retention
+}
+
+func (c *LightCleaner) setRetention(w RetentionWindow) {
+	c.retentionLock.Lock()
+	defer c.retentionLock.Unlock()
+
+	c.retention = w
+}
+
+// archivePulse exports pulse data before cleaning if archiver is set. Cleaning is not blocked by archive errors.
+func (c *LightCleaner) archivePulse(ctx context.Context, pn insolar.PulseNumber) {
+	if c.archiver == nil {
+		return
+	}
+
+	logger := inslogger.FromContext(ctx)
+	err := c.archiver.Archive(ctx, pn)
+	if err != nil {
+		stats.Record(ctx, statArchiveErrors.M(1))
+		logger.Errorf("[Cleaner][archivePulse] failed to archive pulse %v: %s", pn, err)
+		return
+	}
+	stats.Record(ctx, statArchivedPulses.M(1))
+	logger.Debugf("[Cleaner][archivePulse] pulse archived. pn - %v", pn)
+}
+
 func (c *LightCleaner) cleanPulse(ctx context.Context, pn insolar.PulseNumber) {
 	inslogger.FromContext(ctx).Debugf("[Cleaner][cleanPulse] start cleaning. pn - %v", pn)
 	c.nodeModifier.DeleteForPN(pn)
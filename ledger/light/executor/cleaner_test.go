@@ -68,7 +68,7 @@ func TestCleaner_cleanPulse(t *testing.T) {
 	fc := NewFilamentCleanerMock(ctrl)
 	fc.ClearMock.Expect(objID)
 
-	cleaner := NewCleaner(jm, nm, dc, rc, ic, ps, nil, ia, fc, 0, 0)
+	cleaner := NewCleaner(jm, nm, dc, rc, ic, ps, nil, ia, fc, 0, 0, 0, nil)
 
 	cleaner.cleanPulse(ctx, inputPulse.PulseNumber)
 
@@ -86,6 +86,7 @@ func TestCleaner_clean(t *testing.T) {
 
 	inputPulse := insolar.Pulse{PulseNumber: insolar.PulseNumber(111)}
 	calculatedPulse := insolar.Pulse{PulseNumber: insolar.PulseNumber(98765)}
+	retainedPulse := insolar.Pulse{PulseNumber: insolar.PulseNumber(98775)}
 	limit := 123
 
 	ctrl := minimock.NewController(t)
@@ -114,6 +115,9 @@ func TestCleaner_clean(t *testing.T) {
 	pc := pulse.NewCalculatorMock(ctrl)
 	pc.BackwardsMock.Set(func(p context.Context, pn insolar.PulseNumber, l int) (r insolar.Pulse, r1 error) {
 		require.Equal(t, inputPulse.PulseNumber, pn)
+		if l == limit {
+			return retainedPulse, nil
+		}
 		require.Equal(t, limit+1, l)
 		return calculatedPulse, nil
 	})
@@ -130,13 +134,19 @@ func TestCleaner_clean(t *testing.T) {
 	fc := NewFilamentCleanerMock(ctrl)
 	fc.ClearMock.Expect(objID)
 
-	cleaner := NewCleaner(jm, nm, dc, rc, ic, ps, pc, ia, fc, limit, 1)
+	cleaner := NewCleaner(jm, nm, dc, rc, ic, ps, pc, ia, fc, limit, 1, 0, nil)
 	defer close(cleaner.pulseForClean)
 
 	go cleaner.clean(ctx)
 	cleaner.pulseForClean <- inputPulse.PulseNumber
 
 	ctrl.Wait(time.Minute)
+
+	expected := RetentionWindow{Oldest: retainedPulse.PulseNumber, Latest: inputPulse.PulseNumber, Depth: limit}
+	for i := 0; i < 100 && cleaner.Retention() != expected; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, expected, cleaner.Retention())
 }
 
 func TestLightCleaner_NotifyAboutPulse(t *testing.T) {
@@ -144,6 +154,7 @@ func TestLightCleaner_NotifyAboutPulse(t *testing.T) {
 
 	inputPulse := insolar.Pulse{PulseNumber: insolar.PulseNumber(111)}
 	calculatedPulse := insolar.Pulse{PulseNumber: insolar.PulseNumber(98765)}
+	retainedPulse := insolar.Pulse{PulseNumber: insolar.PulseNumber(98775)}
 	limit := 123
 
 	ctrl := minimock.NewController(t)
@@ -172,6 +183,9 @@ func TestLightCleaner_NotifyAboutPulse(t *testing.T) {
 	pc := pulse.NewCalculatorMock(ctrl)
 	pc.BackwardsMock.Set(func(p context.Context, pn insolar.PulseNumber, l int) (r insolar.Pulse, r1 error) {
 		require.Equal(t, inputPulse.PulseNumber, pn)
+		if l == limit {
+			return retainedPulse, nil
+		}
 		require.Equal(t, limit+1, l)
 		return calculatedPulse, nil
 	})
@@ -188,7 +202,7 @@ func TestLightCleaner_NotifyAboutPulse(t *testing.T) {
 	fc := NewFilamentCleanerMock(ctrl)
 	fc.ClearMock.Expect(objID)
 
-	cleaner := NewCleaner(jm, nm, dc, rc, ic, ps, pc, ia, fc, limit, 1)
+	cleaner := NewCleaner(jm, nm, dc, rc, ic, ps, pc, ia, fc, limit, 1, 0, nil)
 	defer close(cleaner.pulseForClean)
 
 	go cleaner.NotifyAboutPulse(ctx, inputPulse.PulseNumber)
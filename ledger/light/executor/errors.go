@@ -23,4 +23,6 @@ import (
 var (
 	ErrWaiterNotLocked = errors.New("unlocked waiter unlock attempt")
 	ErrWriteClosed     = errors.New("requested pulse is closed for writing")
+
+	ErrHotDataBackpressure = errors.New("too many requests are waiting for hot data")
 )
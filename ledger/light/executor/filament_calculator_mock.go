@@ -35,6 +35,12 @@ type FilamentCalculatorMock struct {
 	beforeRequestsCounter uint64
 	RequestsMock          mFilamentCalculatorMockRequests
 
+	funcResult          func(ctx context.Context, pulse insolar.PulseNumber, objectID insolar.ID, requestID insolar.ID) (cp1 *record.CompositeFilamentRecord, err error)
+	inspectFuncResult   func(ctx context.Context, pulse insolar.PulseNumber, objectID insolar.ID, requestID insolar.ID)
+	afterResultCounter  uint64
+	beforeResultCounter uint64
+	ResultMock          mFilamentCalculatorMockResult
+
 	funcResultDuplicate          func(ctx context.Context, objectID insolar.ID, resultID insolar.ID, result record.Result) (foundResult *record.CompositeFilamentRecord, err error)
 	inspectFuncResultDuplicate   func(ctx context.Context, objectID insolar.ID, resultID insolar.ID, result record.Result)
 	afterResultDuplicateCounter  uint64
@@ -58,6 +64,9 @@ func NewFilamentCalculatorMock(t minimock.Tester) *FilamentCalculatorMock {
 	m.RequestsMock = mFilamentCalculatorMockRequests{mock: m}
 	m.RequestsMock.callArgs = []*FilamentCalculatorMockRequestsParams{}
 
+	m.ResultMock = mFilamentCalculatorMockResult{mock: m}
+	m.ResultMock.callArgs = []*FilamentCalculatorMockResultParams{}
+
 	m.ResultDuplicateMock = mFilamentCalculatorMockResultDuplicate{mock: m}
 	m.ResultDuplicateMock.callArgs = []*FilamentCalculatorMockResultDuplicateParams{}
 
@@ -139,7 +148,7 @@ func (mmOpenedRequests *mFilamentCalculatorMockOpenedRequests) Return(ca1 []reco
 	return mmOpenedRequests.mock
 }
 
-//Set uses given function f to mock the FilamentCalculator.OpenedRequests method
+// Set uses given function f to mock the FilamentCalculator.OpenedRequests method
 func (mmOpenedRequests *mFilamentCalculatorMockOpenedRequests) Set(f func(ctx context.Context, pulse insolar.PulseNumber, objectID insolar.ID, pendingOnly bool) (ca1 []record.CompositeFilamentRecord, err error)) *FilamentCalculatorMock {
 	if mmOpenedRequests.defaultExpectation != nil {
 		mmOpenedRequests.mock.t.Fatalf("Default expectation is already set for the FilamentCalculator.OpenedRequests method")
@@ -183,15 +192,15 @@ func (mmOpenedRequests *FilamentCalculatorMock) OpenedRequests(ctx context.Conte
 		mmOpenedRequests.inspectFuncOpenedRequests(ctx, pulse, objectID, pendingOnly)
 	}
 
-	params := &FilamentCalculatorMockOpenedRequestsParams{ctx, pulse, objectID, pendingOnly}
+	mm_params := &FilamentCalculatorMockOpenedRequestsParams{ctx, pulse, objectID, pendingOnly}
 
 	// Record call args
 	mmOpenedRequests.OpenedRequestsMock.mutex.Lock()
-	mmOpenedRequests.OpenedRequestsMock.callArgs = append(mmOpenedRequests.OpenedRequestsMock.callArgs, params)
+	mmOpenedRequests.OpenedRequestsMock.callArgs = append(mmOpenedRequests.OpenedRequestsMock.callArgs, mm_params)
 	mmOpenedRequests.OpenedRequestsMock.mutex.Unlock()
 
 	for _, e := range mmOpenedRequests.OpenedRequestsMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.ca1, e.results.err
 		}
@@ -199,17 +208,17 @@ func (mmOpenedRequests *FilamentCalculatorMock) OpenedRequests(ctx context.Conte
 
 	if mmOpenedRequests.OpenedRequestsMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmOpenedRequests.OpenedRequestsMock.defaultExpectation.Counter, 1)
-		want := mmOpenedRequests.OpenedRequestsMock.defaultExpectation.params
-		got := FilamentCalculatorMockOpenedRequestsParams{ctx, pulse, objectID, pendingOnly}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmOpenedRequests.t.Errorf("FilamentCalculatorMock.OpenedRequests got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmOpenedRequests.OpenedRequestsMock.defaultExpectation.params
+		mm_got := FilamentCalculatorMockOpenedRequestsParams{ctx, pulse, objectID, pendingOnly}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOpenedRequests.t.Errorf("FilamentCalculatorMock.OpenedRequests got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmOpenedRequests.OpenedRequestsMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmOpenedRequests.OpenedRequestsMock.defaultExpectation.results
+		if mm_results == nil {
 			mmOpenedRequests.t.Fatal("No results are set for the FilamentCalculatorMock.OpenedRequests")
 		}
-		return (*results).ca1, (*results).err
+		return (*mm_results).ca1, (*mm_results).err
 	}
 	if mmOpenedRequests.funcOpenedRequests != nil {
 		return mmOpenedRequests.funcOpenedRequests(ctx, pulse, objectID, pendingOnly)
@@ -359,7 +368,7 @@ func (mmRequestDuplicate *mFilamentCalculatorMockRequestDuplicate) Return(foundR
 	return mmRequestDuplicate.mock
 }
 
-//Set uses given function f to mock the FilamentCalculator.RequestDuplicate method
+// Set uses given function f to mock the FilamentCalculator.RequestDuplicate method
 func (mmRequestDuplicate *mFilamentCalculatorMockRequestDuplicate) Set(f func(ctx context.Context, objectID insolar.ID, requestID insolar.ID, request record.Request) (foundRequest *record.CompositeFilamentRecord, foundResult *record.CompositeFilamentRecord, err error)) *FilamentCalculatorMock {
 	if mmRequestDuplicate.defaultExpectation != nil {
 		mmRequestDuplicate.mock.t.Fatalf("Default expectation is already set for the FilamentCalculator.RequestDuplicate method")
@@ -403,15 +412,15 @@ func (mmRequestDuplicate *FilamentCalculatorMock) RequestDuplicate(ctx context.C
 		mmRequestDuplicate.inspectFuncRequestDuplicate(ctx, objectID, requestID, request)
 	}
 
-	params := &FilamentCalculatorMockRequestDuplicateParams{ctx, objectID, requestID, request}
+	mm_params := &FilamentCalculatorMockRequestDuplicateParams{ctx, objectID, requestID, request}
 
 	// Record call args
 	mmRequestDuplicate.RequestDuplicateMock.mutex.Lock()
-	mmRequestDuplicate.RequestDuplicateMock.callArgs = append(mmRequestDuplicate.RequestDuplicateMock.callArgs, params)
+	mmRequestDuplicate.RequestDuplicateMock.callArgs = append(mmRequestDuplicate.RequestDuplicateMock.callArgs, mm_params)
 	mmRequestDuplicate.RequestDuplicateMock.mutex.Unlock()
 
 	for _, e := range mmRequestDuplicate.RequestDuplicateMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.foundRequest, e.results.foundResult, e.results.err
 		}
@@ -419,17 +428,17 @@ func (mmRequestDuplicate *FilamentCalculatorMock) RequestDuplicate(ctx context.C
 
 	if mmRequestDuplicate.RequestDuplicateMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmRequestDuplicate.RequestDuplicateMock.defaultExpectation.Counter, 1)
-		want := mmRequestDuplicate.RequestDuplicateMock.defaultExpectation.params
-		got := FilamentCalculatorMockRequestDuplicateParams{ctx, objectID, requestID, request}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmRequestDuplicate.t.Errorf("FilamentCalculatorMock.RequestDuplicate got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmRequestDuplicate.RequestDuplicateMock.defaultExpectation.params
+		mm_got := FilamentCalculatorMockRequestDuplicateParams{ctx, objectID, requestID, request}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequestDuplicate.t.Errorf("FilamentCalculatorMock.RequestDuplicate got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmRequestDuplicate.RequestDuplicateMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmRequestDuplicate.RequestDuplicateMock.defaultExpectation.results
+		if mm_results == nil {
 			mmRequestDuplicate.t.Fatal("No results are set for the FilamentCalculatorMock.RequestDuplicate")
 		}
-		return (*results).foundRequest, (*results).foundResult, (*results).err
+		return (*mm_results).foundRequest, (*mm_results).foundResult, (*mm_results).err
 	}
 	if mmRequestDuplicate.funcRequestDuplicate != nil {
 		return mmRequestDuplicate.funcRequestDuplicate(ctx, objectID, requestID, request)
@@ -578,7 +587,7 @@ func (mmRequests *mFilamentCalculatorMockRequests) Return(ca1 []record.Composite
 	return mmRequests.mock
 }
 
-//Set uses given function f to mock the FilamentCalculator.Requests method
+// Set uses given function f to mock the FilamentCalculator.Requests method
 func (mmRequests *mFilamentCalculatorMockRequests) Set(f func(ctx context.Context, objectID insolar.ID, from insolar.ID, readUntil insolar.PulseNumber) (ca1 []record.CompositeFilamentRecord, err error)) *FilamentCalculatorMock {
 	if mmRequests.defaultExpectation != nil {
 		mmRequests.mock.t.Fatalf("Default expectation is already set for the FilamentCalculator.Requests method")
@@ -622,15 +631,15 @@ func (mmRequests *FilamentCalculatorMock) Requests(ctx context.Context, objectID
 		mmRequests.inspectFuncRequests(ctx, objectID, from, readUntil)
 	}
 
-	params := &FilamentCalculatorMockRequestsParams{ctx, objectID, from, readUntil}
+	mm_params := &FilamentCalculatorMockRequestsParams{ctx, objectID, from, readUntil}
 
 	// Record call args
 	mmRequests.RequestsMock.mutex.Lock()
-	mmRequests.RequestsMock.callArgs = append(mmRequests.RequestsMock.callArgs, params)
+	mmRequests.RequestsMock.callArgs = append(mmRequests.RequestsMock.callArgs, mm_params)
 	mmRequests.RequestsMock.mutex.Unlock()
 
 	for _, e := range mmRequests.RequestsMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.ca1, e.results.err
 		}
@@ -638,17 +647,17 @@ func (mmRequests *FilamentCalculatorMock) Requests(ctx context.Context, objectID
 
 	if mmRequests.RequestsMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmRequests.RequestsMock.defaultExpectation.Counter, 1)
-		want := mmRequests.RequestsMock.defaultExpectation.params
-		got := FilamentCalculatorMockRequestsParams{ctx, objectID, from, readUntil}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmRequests.t.Errorf("FilamentCalculatorMock.Requests got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmRequests.RequestsMock.defaultExpectation.params
+		mm_got := FilamentCalculatorMockRequestsParams{ctx, objectID, from, readUntil}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequests.t.Errorf("FilamentCalculatorMock.Requests got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmRequests.RequestsMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmRequests.RequestsMock.defaultExpectation.results
+		if mm_results == nil {
 			mmRequests.t.Fatal("No results are set for the FilamentCalculatorMock.Requests")
 		}
-		return (*results).ca1, (*results).err
+		return (*mm_results).ca1, (*mm_results).err
 	}
 	if mmRequests.funcRequests != nil {
 		return mmRequests.funcRequests(ctx, objectID, from, readUntil)
@@ -722,6 +731,225 @@ func (m *FilamentCalculatorMock) MinimockRequestsInspect() {
 	}
 }
 
+type mFilamentCalculatorMockResult struct {
+	mock               *FilamentCalculatorMock
+	defaultExpectation *FilamentCalculatorMockResultExpectation
+	expectations       []*FilamentCalculatorMockResultExpectation
+
+	callArgs []*FilamentCalculatorMockResultParams
+	mutex    sync.RWMutex
+}
+
+// FilamentCalculatorMockResultExpectation specifies expectation struct of the FilamentCalculator.Result
+type FilamentCalculatorMockResultExpectation struct {
+	mock    *FilamentCalculatorMock
+	params  *FilamentCalculatorMockResultParams
+	results *FilamentCalculatorMockResultResults
+	Counter uint64
+}
+
+// FilamentCalculatorMockResultParams contains parameters of the FilamentCalculator.Result
+type FilamentCalculatorMockResultParams struct {
+	ctx       context.Context
+	pulse     insolar.PulseNumber
+	objectID  insolar.ID
+	requestID insolar.ID
+}
+
+// FilamentCalculatorMockResultResults contains results of the FilamentCalculator.Result
+type FilamentCalculatorMockResultResults struct {
+	cp1 *record.CompositeFilamentRecord
+	err error
+}
+
+// Expect sets up expected params for FilamentCalculator.Result
+func (mmResult *mFilamentCalculatorMockResult) Expect(ctx context.Context, pulse insolar.PulseNumber, objectID insolar.ID, requestID insolar.ID) *mFilamentCalculatorMockResult {
+	if mmResult.mock.funcResult != nil {
+		mmResult.mock.t.Fatalf("FilamentCalculatorMock.Result mock is already set by Set")
+	}
+
+	if mmResult.defaultExpectation == nil {
+		mmResult.defaultExpectation = &FilamentCalculatorMockResultExpectation{}
+	}
+
+	mmResult.defaultExpectation.params = &FilamentCalculatorMockResultParams{ctx, pulse, objectID, requestID}
+	for _, e := range mmResult.expectations {
+		if minimock.Equal(e.params, mmResult.defaultExpectation.params) {
+			mmResult.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResult.defaultExpectation.params)
+		}
+	}
+
+	return mmResult
+}
+
+// Inspect accepts an inspector function that has same arguments as the FilamentCalculator.Result
+func (mmResult *mFilamentCalculatorMockResult) Inspect(f func(ctx context.Context, pulse insolar.PulseNumber, objectID insolar.ID, requestID insolar.ID)) *mFilamentCalculatorMockResult {
+	if mmResult.mock.inspectFuncResult != nil {
+		mmResult.mock.t.Fatalf("Inspect function is already set for FilamentCalculatorMock.Result")
+	}
+
+	mmResult.mock.inspectFuncResult = f
+
+	return mmResult
+}
+
+// Return sets up results that will be returned by FilamentCalculator.Result
+func (mmResult *mFilamentCalculatorMockResult) Return(cp1 *record.CompositeFilamentRecord, err error) *FilamentCalculatorMock {
+	if mmResult.mock.funcResult != nil {
+		mmResult.mock.t.Fatalf("FilamentCalculatorMock.Result mock is already set by Set")
+	}
+
+	if mmResult.defaultExpectation == nil {
+		mmResult.defaultExpectation = &FilamentCalculatorMockResultExpectation{mock: mmResult.mock}
+	}
+	mmResult.defaultExpectation.results = &FilamentCalculatorMockResultResults{cp1, err}
+	return mmResult.mock
+}
+
+// Set uses given function f to mock the FilamentCalculator.Result method
+func (mmResult *mFilamentCalculatorMockResult) Set(f func(ctx context.Context, pulse insolar.PulseNumber, objectID insolar.ID, requestID insolar.ID) (cp1 *record.CompositeFilamentRecord, err error)) *FilamentCalculatorMock {
+	if mmResult.defaultExpectation != nil {
+		mmResult.mock.t.Fatalf("Default expectation is already set for the FilamentCalculator.Result method")
+	}
+
+	if len(mmResult.expectations) > 0 {
+		mmResult.mock.t.Fatalf("Some expectations are already set for the FilamentCalculator.Result method")
+	}
+
+	mmResult.mock.funcResult = f
+	return mmResult.mock
+}
+
+// When sets expectation for the FilamentCalculator.Result which will trigger the result defined by the following
+// Then helper
+func (mmResult *mFilamentCalculatorMockResult) When(ctx context.Context, pulse insolar.PulseNumber, objectID insolar.ID, requestID insolar.ID) *FilamentCalculatorMockResultExpectation {
+	if mmResult.mock.funcResult != nil {
+		mmResult.mock.t.Fatalf("FilamentCalculatorMock.Result mock is already set by Set")
+	}
+
+	expectation := &FilamentCalculatorMockResultExpectation{
+		mock:   mmResult.mock,
+		params: &FilamentCalculatorMockResultParams{ctx, pulse, objectID, requestID},
+	}
+	mmResult.expectations = append(mmResult.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FilamentCalculator.Result return parameters for the expectation previously defined by the When method
+func (e *FilamentCalculatorMockResultExpectation) Then(cp1 *record.CompositeFilamentRecord, err error) *FilamentCalculatorMock {
+	e.results = &FilamentCalculatorMockResultResults{cp1, err}
+	return e.mock
+}
+
+// Result implements FilamentCalculator
+func (mmResult *FilamentCalculatorMock) Result(ctx context.Context, pulse insolar.PulseNumber, objectID insolar.ID, requestID insolar.ID) (cp1 *record.CompositeFilamentRecord, err error) {
+	mm_atomic.AddUint64(&mmResult.beforeResultCounter, 1)
+	defer mm_atomic.AddUint64(&mmResult.afterResultCounter, 1)
+
+	if mmResult.inspectFuncResult != nil {
+		mmResult.inspectFuncResult(ctx, pulse, objectID, requestID)
+	}
+
+	mm_params := &FilamentCalculatorMockResultParams{ctx, pulse, objectID, requestID}
+
+	// Record call args
+	mmResult.ResultMock.mutex.Lock()
+	mmResult.ResultMock.callArgs = append(mmResult.ResultMock.callArgs, mm_params)
+	mmResult.ResultMock.mutex.Unlock()
+
+	for _, e := range mmResult.ResultMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmResult.ResultMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResult.ResultMock.defaultExpectation.Counter, 1)
+		mm_want := mmResult.ResultMock.defaultExpectation.params
+		mm_got := FilamentCalculatorMockResultParams{ctx, pulse, objectID, requestID}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResult.t.Errorf("FilamentCalculatorMock.Result got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResult.ResultMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResult.t.Fatal("No results are set for the FilamentCalculatorMock.Result")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmResult.funcResult != nil {
+		return mmResult.funcResult(ctx, pulse, objectID, requestID)
+	}
+	mmResult.t.Fatalf("Unexpected call to FilamentCalculatorMock.Result. %v %v %v %v", ctx, pulse, objectID, requestID)
+	return
+}
+
+// ResultAfterCounter returns a count of finished FilamentCalculatorMock.Result invocations
+func (mmResult *FilamentCalculatorMock) ResultAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResult.afterResultCounter)
+}
+
+// ResultBeforeCounter returns a count of FilamentCalculatorMock.Result invocations
+func (mmResult *FilamentCalculatorMock) ResultBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResult.beforeResultCounter)
+}
+
+// Calls returns a list of arguments used in each call to FilamentCalculatorMock.Result.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResult *mFilamentCalculatorMockResult) Calls() []*FilamentCalculatorMockResultParams {
+	mmResult.mutex.RLock()
+
+	argCopy := make([]*FilamentCalculatorMockResultParams, len(mmResult.callArgs))
+	copy(argCopy, mmResult.callArgs)
+
+	mmResult.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResultDone returns true if the count of the Result invocations corresponds
+// the number of defined expectations
+func (m *FilamentCalculatorMock) MinimockResultDone() bool {
+	for _, e := range m.ResultMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResultMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterResultCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResult != nil && mm_atomic.LoadUint64(&m.afterResultCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockResultInspect logs each unmet expectation
+func (m *FilamentCalculatorMock) MinimockResultInspect() {
+	for _, e := range m.ResultMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FilamentCalculatorMock.Result with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResultMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterResultCounter) < 1 {
+		if m.ResultMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to FilamentCalculatorMock.Result")
+		} else {
+			m.t.Errorf("Expected call to FilamentCalculatorMock.Result with params: %#v", *m.ResultMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResult != nil && mm_atomic.LoadUint64(&m.afterResultCounter) < 1 {
+		m.t.Error("Expected call to FilamentCalculatorMock.Result")
+	}
+}
+
 type mFilamentCalculatorMockResultDuplicate struct {
 	mock               *FilamentCalculatorMock
 	defaultExpectation *FilamentCalculatorMockResultDuplicateExpectation
@@ -797,7 +1025,7 @@ func (mmResultDuplicate *mFilamentCalculatorMockResultDuplicate) Return(foundRes
 	return mmResultDuplicate.mock
 }
 
-//Set uses given function f to mock the FilamentCalculator.ResultDuplicate method
+// Set uses given function f to mock the FilamentCalculator.ResultDuplicate method
 func (mmResultDuplicate *mFilamentCalculatorMockResultDuplicate) Set(f func(ctx context.Context, objectID insolar.ID, resultID insolar.ID, result record.Result) (foundResult *record.CompositeFilamentRecord, err error)) *FilamentCalculatorMock {
 	if mmResultDuplicate.defaultExpectation != nil {
 		mmResultDuplicate.mock.t.Fatalf("Default expectation is already set for the FilamentCalculator.ResultDuplicate method")
@@ -841,15 +1069,15 @@ func (mmResultDuplicate *FilamentCalculatorMock) ResultDuplicate(ctx context.Con
 		mmResultDuplicate.inspectFuncResultDuplicate(ctx, objectID, resultID, result)
 	}
 
-	params := &FilamentCalculatorMockResultDuplicateParams{ctx, objectID, resultID, result}
+	mm_params := &FilamentCalculatorMockResultDuplicateParams{ctx, objectID, resultID, result}
 
 	// Record call args
 	mmResultDuplicate.ResultDuplicateMock.mutex.Lock()
-	mmResultDuplicate.ResultDuplicateMock.callArgs = append(mmResultDuplicate.ResultDuplicateMock.callArgs, params)
+	mmResultDuplicate.ResultDuplicateMock.callArgs = append(mmResultDuplicate.ResultDuplicateMock.callArgs, mm_params)
 	mmResultDuplicate.ResultDuplicateMock.mutex.Unlock()
 
 	for _, e := range mmResultDuplicate.ResultDuplicateMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.foundResult, e.results.err
 		}
@@ -857,17 +1085,17 @@ func (mmResultDuplicate *FilamentCalculatorMock) ResultDuplicate(ctx context.Con
 
 	if mmResultDuplicate.ResultDuplicateMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmResultDuplicate.ResultDuplicateMock.defaultExpectation.Counter, 1)
-		want := mmResultDuplicate.ResultDuplicateMock.defaultExpectation.params
-		got := FilamentCalculatorMockResultDuplicateParams{ctx, objectID, resultID, result}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmResultDuplicate.t.Errorf("FilamentCalculatorMock.ResultDuplicate got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmResultDuplicate.ResultDuplicateMock.defaultExpectation.params
+		mm_got := FilamentCalculatorMockResultDuplicateParams{ctx, objectID, resultID, result}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResultDuplicate.t.Errorf("FilamentCalculatorMock.ResultDuplicate got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmResultDuplicate.ResultDuplicateMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmResultDuplicate.ResultDuplicateMock.defaultExpectation.results
+		if mm_results == nil {
 			mmResultDuplicate.t.Fatal("No results are set for the FilamentCalculatorMock.ResultDuplicate")
 		}
-		return (*results).foundResult, (*results).err
+		return (*mm_results).foundResult, (*mm_results).err
 	}
 	if mmResultDuplicate.funcResultDuplicate != nil {
 		return mmResultDuplicate.funcResultDuplicate(ctx, objectID, resultID, result)
@@ -950,6 +1178,8 @@ func (m *FilamentCalculatorMock) MinimockFinish() {
 
 		m.MinimockRequestsInspect()
 
+		m.MinimockResultInspect()
+
 		m.MinimockResultDuplicateInspect()
 		m.t.FailNow()
 	}
@@ -977,5 +1207,6 @@ func (m *FilamentCalculatorMock) minimockDone() bool {
 		m.MinimockOpenedRequestsDone() &&
 		m.MinimockRequestDuplicateDone() &&
 		m.MinimockRequestsDone() &&
+		m.MinimockResultDone() &&
 		m.MinimockResultDuplicateDone()
 }
@@ -77,6 +77,15 @@ type FilamentCalculator interface {
 		foundResult *record.CompositeFilamentRecord,
 		err error,
 	)
+
+	// Result searches objectID's chain for the Result record Request field of which equals requestID param.
+	// Chain is scanned from the latest request of the object in provided pulse back to the pulse of the request.
+	// Nil is returned if there is no result for the request yet.
+	Result(
+		ctx context.Context,
+		pulse insolar.PulseNumber,
+		objectID, requestID insolar.ID,
+	) (*record.CompositeFilamentRecord, error)
 }
 
 //go:generate minimock -i github.com/insolar/insolar/ledger/light/executor.FilamentCleaner -o ./ -s _mock.go -g
@@ -167,7 +176,8 @@ func (c *FilamentCalculatorDefault) OpenedRequests(ctx context.Context, pulse in
 	if idx.Lifeline.LatestRequest == nil {
 		return []record.CompositeFilamentRecord{}, nil
 	}
-	if idx.Lifeline.EarliestOpenRequest == nil {
+	readUntil := earliestRequest(idx.Lifeline)
+	if readUntil == nil {
 		return []record.CompositeFilamentRecord{}, nil
 	}
 
@@ -176,7 +186,7 @@ func (c *FilamentCalculatorDefault) OpenedRequests(ctx context.Context, pulse in
 		cache,
 		objectID,
 		*idx.Lifeline.LatestRequest,
-		*idx.Lifeline.EarliestOpenRequest,
+		*readUntil,
 		c.jetFetcher,
 		c.coordinator,
 		c.sender,
@@ -206,7 +216,7 @@ func (c *FilamentCalculatorDefault) OpenedRequests(ctx context.Context, pulse in
 
 		case *record.OutgoingRequest:
 			_, reasonClosed := hasResult[*r.Reason.Record()]
-			isReadyDetached := r.IsDetached() && reasonClosed
+			isReadyDetached := r.IsDetached() && reasonClosed && r.IsReadyAt(pulse)
 			if pendingOnly && !isReadyDetached {
 				break
 			}
@@ -225,6 +235,16 @@ func (c *FilamentCalculatorDefault) OpenedRequests(ctx context.Context, pulse in
 	return ordered, nil
 }
 
+// earliestRequest returns pulse of the earliest opened request including scheduled requests that are not ready yet.
+func earliestRequest(lifeline record.Lifeline) *insolar.PulseNumber {
+	earliest := lifeline.EarliestOpenRequest
+	scheduled := lifeline.EarliestScheduledRequest
+	if earliest == nil || (scheduled != nil && *scheduled < *earliest) {
+		return scheduled
+	}
+	return earliest
+}
+
 func (c *FilamentCalculatorDefault) ResultDuplicate(
 	ctx context.Context, objectID, resultID insolar.ID, result record.Result,
 ) (*record.CompositeFilamentRecord, error) {
@@ -365,6 +385,52 @@ func (c *FilamentCalculatorDefault) RequestDuplicate(
 	return foundRequest, foundResult, nil
 }
 
+func (c *FilamentCalculatorDefault) Result(
+	ctx context.Context, pulse insolar.PulseNumber, objectID, requestID insolar.ID,
+) (*record.CompositeFilamentRecord, error) {
+	idx, err := c.indexes.ForID(ctx, pulse, objectID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch index")
+	}
+	if idx.Lifeline.LatestRequest == nil {
+		return nil, nil
+	}
+
+	cache := c.cache.Get(objectID)
+	cache.Lock()
+	defer cache.Unlock()
+
+	iter := newFetchingIterator(
+		ctx,
+		cache,
+		objectID,
+		*idx.Lifeline.LatestRequest,
+		requestID.Pulse(),
+		c.jetFetcher,
+		c.coordinator,
+		c.sender,
+	)
+
+	for iter.HasPrev() {
+		rec, err := iter.Prev(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to fetch filament")
+		}
+
+		// Request found, results go after it on the chain.
+		if bytes.Equal(rec.RecordID.Hash(), requestID.Hash()) {
+			return nil, nil
+		}
+
+		virtual := record.Unwrap(&rec.Record.Virtual)
+		if r, ok := virtual.(*record.Result); ok && bytes.Equal(r.Request.Record().Hash(), requestID.Hash()) {
+			return &rec, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func (c *FilamentCalculatorDefault) Clear(objID insolar.ID) {
 	c.cache.Delete(objID)
 }
@@ -603,12 +669,24 @@ func (i *fetchingIterator) fetchFromNetwork(
 		instracer.AddError(span, err)
 		return nil, errors.Wrap(err, "failed to calculate limit")
 	}
-	var node *insolar.Reference
+	span.AddAttributes(
+		trace.StringAttribute("objID", i.objectID.DebugString()),
+		trace.StringAttribute("startFrom", forID.DebugString()),
+		trace.StringAttribute("readUntil", i.readUntil.String()),
+	)
+
+	getFilament := &payload.GetFilament{
+		ObjectID:  i.objectID,
+		StartFrom: forID,
+		ReadUntil: i.readUntil,
+	}
+
+	var pl payload.Payload
 	if isBeyond {
-		node, err = i.coordinator.Heavy(ctx)
+		pl, err = FetchFromHeavy(ctx, i.coordinator, i.sender, forID.Pulse(), getFilament)
 		if err != nil {
 			instracer.AddError(span, err)
-			return nil, errors.Wrap(err, "failed to calculate node")
+			return nil, errors.Wrap(err, "failed to fetch filament from heavy")
 		}
 	} else {
 		jetID, err := i.jetFetcher.Fetch(ctx, i.objectID, forID.Pulse())
@@ -616,45 +694,23 @@ func (i *fetchingIterator) fetchFromNetwork(
 			instracer.AddError(span, err)
 			return nil, errors.Wrap(err, "failed to fetch jet")
 		}
-		node, err = i.coordinator.NodeForJet(ctx, *jetID, forID.Pulse())
+		node, err := i.coordinator.NodeForJet(ctx, *jetID, forID.Pulse())
 		if err != nil {
 			instracer.AddError(span, err)
 			return nil, errors.Wrap(err, "failed to calculate node")
 		}
-	}
-	if *node == i.coordinator.Me() {
-		instracer.AddError(span, errors.New("tried to send message to self"))
-		return nil, errors.New("tried to send message to self")
-	}
-
-	span.AddAttributes(
-		trace.StringAttribute("objID", i.objectID.DebugString()),
-		trace.StringAttribute("startFrom", forID.DebugString()),
-		trace.StringAttribute("readUntil", i.readUntil.String()),
-	)
+		if *node == i.coordinator.Me() {
+			instracer.AddError(span, errors.New("tried to send message to self"))
+			return nil, errors.New("tried to send message to self")
+		}
 
-	msg, err := payload.NewMessage(&payload.GetFilament{
-		ObjectID:  i.objectID,
-		StartFrom: forID,
-		ReadUntil: i.readUntil,
-	})
-	if err != nil {
-		instracer.AddError(span, err)
-		return nil, errors.Wrap(err, "failed to create fetching message")
-	}
-	reps, done := i.sender.SendTarget(ctx, msg, *node)
-	defer done()
-	res, ok := <-reps
-	if !ok {
-		instracer.AddError(span, errors.New("no reply for filament fetch"))
-		return nil, errors.New("no reply for filament fetch")
+		pl, err = fetchFromNode(ctx, i.sender, getFilament, *node)
+		if err != nil {
+			instracer.AddError(span, err)
+			return nil, errors.Wrap(err, "failed to fetch filament")
+		}
 	}
 
-	pl, err := payload.UnmarshalFromMeta(res.Payload)
-	if err != nil {
-		instracer.AddError(span, err)
-		return nil, errors.Wrap(err, "failed to unmarshal reply")
-	}
 	switch p := pl.(type) {
 	case *payload.FilamentSegment:
 		return p.Records, nil
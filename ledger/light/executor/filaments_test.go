@@ -302,8 +302,8 @@ func TestFilamentCalculatorDefault_PendingRequests(t *testing.T) {
 		})
 
 		node := gen.Reference()
-		coordinator.HeavyMock.Set(func(_ context.Context) (*insolar.Reference, error) {
-			return &node, nil
+		coordinator.HeaviesMock.Set(func(_ context.Context, _ insolar.PulseNumber) ([]insolar.Reference, error) {
+			return []insolar.Reference{node}, nil
 		})
 		coordinator.MeMock.Return(node)
 
@@ -434,6 +434,43 @@ func TestFilamentCalculatorDefault_PendingRequests(t *testing.T) {
 
 		mc.Finish()
 	})
+
+	resetComponents()
+	t.Run("return scheduled outgoing since its pulse", func(t *testing.T) {
+		b := newFilamentBuilder(ctx, pcs, records)
+		reason := b.Append(insolar.FirstPulseNumber+1, &record.IncomingRequest{Nonce: rand.Uint64()})
+		outgoing := b.Append(insolar.FirstPulseNumber+1, &record.OutgoingRequest{
+			Nonce:      rand.Uint64(),
+			Reason:     *insolar.NewReference(reason.RecordID),
+			CallType:   record.CTMethod,
+			ReturnMode: record.ReturnScheduled,
+			ExecuteAt:  insolar.FirstPulseNumber + 10,
+		})
+		reasonRes := b.Append(insolar.FirstPulseNumber+1, &record.Result{Request: *insolar.NewReference(reason.RecordID)})
+
+		objectID := gen.ID()
+		earliestPending := reasonRes.MetaID.Pulse()
+		index := record.Index{
+			ObjID: objectID,
+			Lifeline: record.Lifeline{
+				LatestRequest:       &reasonRes.MetaID,
+				EarliestOpenRequest: &earliestPending,
+			},
+		}
+		indexes.Set(ctx, reasonRes.MetaID.Pulse(), index)
+		indexes.Set(ctx, insolar.FirstPulseNumber+10, index)
+
+		recs, err := calculator.OpenedRequests(ctx, reasonRes.MetaID.Pulse(), objectID, true)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(recs))
+
+		recs, err = calculator.OpenedRequests(ctx, insolar.FirstPulseNumber+10, objectID, true)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(recs))
+		require.Equal(t, outgoing, recs[0])
+
+		mc.Finish()
+	})
 }
 
 func TestFilamentCalculatorDefault_ResultDuplicate(t *testing.T) {
@@ -0,0 +1,135 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// JetHandoff describes sending of hot data for one jet to the next executor.
+type JetHandoff struct {
+	JetID    insolar.JetID
+	Indexes  int
+	Started  time.Time
+	Finished time.Time
+	Error    string
+}
+
+// Done returns true if hot data was sent (successfully or not).
+func (h JetHandoff) Done() bool {
+	return !h.Finished.IsZero()
+}
+
+// HandoffProgress describes hot data handoff for current pulse.
+type HandoffProgress struct {
+	// Pulse is a pulse hot data was sent for.
+	Pulse insolar.PulseNumber
+	// Outgoing holds hot data sending state for every jet of the pulse.
+	Outgoing []JetHandoff
+	// Waiting holds amount of requests waiting for incoming hot data per jet.
+	Waiting map[insolar.JetID]int
+}
+
+// Pending returns amount of jets hot data is still being sent for.
+func (p HandoffProgress) Pending() int {
+	pending := 0
+	for _, h := range p.Outgoing {
+		if !h.Done() {
+			pending++
+		}
+	}
+	return pending
+}
+
+// handoffTracker collects per-jet progress of hot data sending.
+type handoffTracker struct {
+	lock  sync.Mutex
+	pulse insolar.PulseNumber
+	jets  map[insolar.JetID]*JetHandoff
+}
+
+func newHandoffTracker() *handoffTracker {
+	return &handoffTracker{
+		jets: map[insolar.JetID]*JetHandoff{},
+	}
+}
+
+// begin resets tracker for new pulse.
+func (t *handoffTracker) begin(pulse insolar.PulseNumber) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.pulse = pulse
+	t.jets = map[insolar.JetID]*JetHandoff{}
+}
+
+// started marks sending of hot data for jet as started and returns number of jets in progress.
+func (t *handoffTracker) started(jetID insolar.JetID, indexes int) int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.jets[jetID] = &JetHandoff{
+		JetID:   jetID,
+		Indexes: indexes,
+		Started: time.Now(),
+	}
+	return t.pending()
+}
+
+// finished marks sending of hot data for jet as finished and returns sending duration and number of jets in progress.
+func (t *handoffTracker) finished(pulse insolar.PulseNumber, jetID insolar.JetID, err error) (time.Duration, int) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	h, ok := t.jets[jetID]
+	if !ok || t.pulse != pulse {
+		return 0, t.pending()
+	}
+	h.Finished = time.Now()
+	if err != nil {
+		h.Error = err.Error()
+	}
+	return h.Finished.Sub(h.Started), t.pending()
+}
+
+func (t *handoffTracker) pending() int {
+	pending := 0
+	for _, h := range t.jets {
+		if !h.Done() {
+			pending++
+		}
+	}
+	return pending
+}
+
+func (t *handoffTracker) progress() (insolar.PulseNumber, []JetHandoff) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	res := make([]JetHandoff, 0, len(t.jets))
+	for _, h := range t.jets {
+		res = append(res, *h)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].JetID.DebugString() < res[j].JetID.DebugString()
+	})
+	return t.pulse, res
+}
@@ -0,0 +1,188 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+)
+
+// SendToHeavies sends payload to every heavy replica of provided pulse without waiting for replies.
+// It returns amount of heavies the payload was sent to.
+func SendToHeavies(
+	ctx context.Context,
+	coordinator jet.Coordinator,
+	sender bus.Sender,
+	pn insolar.PulseNumber,
+	pl payload.Payload,
+) (int, error) {
+	heavies, err := coordinator.Heavies(ctx, pn)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to calculate heavies")
+	}
+
+	for _, heavy := range heavies {
+		msg, err := payload.NewMessage(pl)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to create message")
+		}
+		_, done := sender.SendTarget(ctx, msg, heavy)
+		done()
+	}
+	return len(heavies), nil
+}
+
+// ConfirmByHeavies sends payload to every heavy replica of provided pulse and waits for their replies.
+// It returns amount of heavies that confirmed the payload.
+func ConfirmByHeavies(
+	ctx context.Context,
+	coordinator jet.Coordinator,
+	sender bus.Sender,
+	pn insolar.PulseNumber,
+	pl payload.Payload,
+) (int, error) {
+	heavies, err := coordinator.Heavies(ctx, pn)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to calculate heavies")
+	}
+
+	logger := inslogger.FromContext(ctx)
+	confirmed := 0
+	for _, heavy := range heavies {
+		rep, err := fetchFromNode(ctx, sender, pl, heavy)
+		if err != nil {
+			logger.Warn(errors.Wrapf(err, "heavy %s didn't confirm payload", heavy.String()))
+			continue
+		}
+		if _, ok := rep.(*payload.GotHotConfirmation); !ok {
+			logger.Warnf("heavy %s replied with unexpected payload %T", heavy.String(), rep)
+			continue
+		}
+		confirmed++
+	}
+	return confirmed, nil
+}
+
+// FetchFromHeavy requests payload from heavy replicas of provided pulse one by one until one of them replies
+// without error. If every replica replied with error, the last error reply is returned.
+func FetchFromHeavy(
+	ctx context.Context,
+	coordinator jet.Coordinator,
+	sender bus.Sender,
+	pn insolar.PulseNumber,
+	pl payload.Payload,
+) (payload.Payload, error) {
+	heavies, err := coordinator.Heavies(ctx, pn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate heavies")
+	}
+
+	logger := inslogger.FromContext(ctx)
+	me := coordinator.Me()
+	var (
+		lastReply payload.Payload
+		lastErr   error
+		attempts  int
+	)
+	for _, heavy := range heavies {
+		if heavy == me {
+			continue
+		}
+		if attempts > 0 {
+			stats.Record(ctx, statHeavyFailover.M(1))
+			logger.Debug("fetching from next heavy replica ", heavy.String())
+		}
+		attempts++
+
+		rep, err := fetchFromNode(ctx, sender, pl, heavy)
+		if err != nil {
+			logger.Warn(errors.Wrapf(err, "failed to fetch from heavy %s", heavy.String()))
+			lastErr = err
+			continue
+		}
+		if e, ok := rep.(*payload.Error); ok {
+			logger.Debug("heavy ", heavy.String(), " replied with error: ", e.Text)
+			lastReply = rep
+			continue
+		}
+		return rep, nil
+	}
+
+	if lastReply != nil {
+		return lastReply, nil
+	}
+	if lastErr != nil {
+		return nil, lastErr
+	}
+	return nil, errors.New("tried to send message to self")
+}
+
+// PassToHeavy requests payload from heavy replicas of provided pulse one by one (see FetchFromHeavy) and
+// replies to origin message with the received reply. If no heavy replied, origin gets an error reply.
+func PassToHeavy(
+	ctx context.Context,
+	coordinator jet.Coordinator,
+	sender bus.Sender,
+	origin payload.Meta,
+	pn insolar.PulseNumber,
+	pl payload.Payload,
+) {
+	rep, err := FetchFromHeavy(ctx, coordinator, sender, pn, pl)
+	if err != nil {
+		err = errors.Wrap(err, "failed to fetch from heavy")
+		inslogger.FromContext(ctx).Error(err)
+		rep = &payload.Error{Text: err.Error()}
+	}
+	msg, err := payload.NewMessage(rep)
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to create reply"))
+		return
+	}
+	sender.Reply(ctx, origin, msg)
+}
+
+func fetchFromNode(
+	ctx context.Context,
+	sender bus.Sender,
+	pl payload.Payload,
+	node insolar.Reference,
+) (payload.Payload, error) {
+	msg, err := payload.NewMessage(pl)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create message")
+	}
+
+	reps, done := sender.SendTarget(ctx, msg, node)
+	defer done()
+	res, ok := <-reps
+	if !ok {
+		return nil, errors.New("no reply")
+	}
+
+	rep, err := payload.UnmarshalFromMeta(res.Payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal reply")
+	}
+	return rep, nil
+}
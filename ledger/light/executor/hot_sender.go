@@ -36,6 +36,8 @@ import (
 // HotSender provides sending hot records send for provided pulse.
 type HotSender interface {
 	SendHot(ctx context.Context, old, new insolar.PulseNumber, jets []insolar.JetID) error
+	// Handoff returns per-jet progress of the last hot data sending.
+	Handoff() (insolar.PulseNumber, []JetHandoff)
 }
 
 // HotSenderDefault implements HotSender.
@@ -45,6 +47,7 @@ type HotSenderDefault struct {
 	pulseCalculator pulse.Calculator
 	jetAccessor     jet.Accessor
 	sender          bus.Sender
+	handoff         *handoffTracker
 
 	// lightChainLimit is the LM-node cache limit configuration (how long index could be unused)
 	lightChainLimit int
@@ -65,6 +68,7 @@ func NewHotSender(
 		pulseCalculator: pulseCalculator,
 		jetAccessor:     jetAccessor,
 		sender:          sender,
+		handoff:         newHandoffTracker(),
 
 		lightChainLimit: lightChainLimit,
 	}
@@ -85,7 +89,7 @@ func (m *HotSenderDefault) filterAndGroupIndexes(
 	// filtering in-place (optimization to avoid double allocation)
 	filtered := indexes[:0]
 	for _, idx := range indexes {
-		if idx.LifelineLastUsed < limitPN.PulseNumber && idx.Lifeline.EarliestOpenRequest == nil {
+		if idx.LifelineLastUsed < limitPN.PulseNumber && earliestRequest(idx.Lifeline) == nil {
 			continue
 		}
 		filtered = append(filtered, record.Index{
@@ -118,6 +122,7 @@ func (m *HotSenderDefault) SendHot(
 		return err
 	}
 
+	m.handoff.begin(newPulse)
 	for _, id := range jets {
 		jetID := id
 		logger := logger.WithSkipFrameCount(1).WithField("jetID", jetID.DebugString())
@@ -130,12 +135,18 @@ func (m *HotSenderDefault) SendHot(
 		}
 		logger.Infof("save drop for pulse %v", currentPulse)
 
+		pending := m.handoff.started(jetID, len(idxByJet[jetID]))
+		stats.Record(ctx, statHandoffPending.M(int64(pending)))
+
 		// send data for every jet asynchronously
 		go func() {
 			err := m.sendForJet(ctx, jetID, newPulse, idxByJet[jetID], block)
+			latency, pending := m.handoff.finished(newPulse, jetID, err)
+			stats.Record(ctx, statHandoffPending.M(int64(pending)))
 			if err != nil {
 				logger.WithField("error", err.Error()).Error("hot sender: sendForJet failed")
 			} else {
+				stats.Record(ctx, statHandoffLatency.M(float64(latency.Nanoseconds())/1e6))
 				logger.Info("hot sender: sendForJet OK")
 			}
 		}()
@@ -143,6 +154,11 @@ func (m *HotSenderDefault) SendHot(
 	return nil
 }
 
+// Handoff returns per-jet progress of the last hot data sending.
+func (m *HotSenderDefault) Handoff() (insolar.PulseNumber, []JetHandoff) {
+	return m.handoff.progress()
+}
+
 func (m *HotSenderDefault) sendForJet(
 	ctx context.Context,
 	jetID insolar.JetID,
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/bus"
@@ -41,10 +43,17 @@ import (
 type LightReplicator interface {
 	// NotifyAboutPulse is method for notifying a sync component about new pulse
 	NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber)
+	// RequireConfirmation makes replicator wait until heavies confirm replicated data.
+	RequireConfirmation()
+	// Pending returns amount of pulses which data is not replicated yet.
+	Pending() int
 
 	Stop()
 }
 
+// confirmRetryInterval is a delay between attempts to replicate data that wasn't confirmed by heavies.
+var confirmRetryInterval = time.Second
+
 // LightReplicatorDefault is a base impl of LightReplicator
 type LightReplicatorDefault struct {
 	once sync.Once
@@ -53,6 +62,7 @@ type LightReplicatorDefault struct {
 	jetCalculator   JetCalculator
 	cleaner         Cleaner
 	sender          bus.Sender
+	coordinator     jet.Coordinator
 	pulseCalculator pulse.Calculator
 
 	dropAccessor drop.Accessor
@@ -61,6 +71,8 @@ type LightReplicatorDefault struct {
 	jetAccessor  jet.Accessor
 
 	syncWaitingPulses chan insolar.PulseNumber
+	pending           int64
+	confirm           uint32
 }
 
 // NewReplicatorDefault creates new instance of LightReplicator
@@ -68,6 +80,7 @@ func NewReplicatorDefault(
 	jetCalculator JetCalculator,
 	cleaner Cleaner,
 	sender bus.Sender,
+	coordinator jet.Coordinator,
 	calculator pulse.Calculator,
 	dropAccessor drop.Accessor,
 	recsAccessor object.RecordCollectionAccessor,
@@ -78,6 +91,7 @@ func NewReplicatorDefault(
 		jetCalculator:   jetCalculator,
 		cleaner:         cleaner,
 		sender:          sender,
+		coordinator:     coordinator,
 		pulseCalculator: calculator,
 
 		dropAccessor: dropAccessor,
@@ -94,11 +108,11 @@ func NewReplicatorDefault(
 // When it's called, a provided pulse is added to a channel.
 // There is a special gorutine that is reading that channel. When a new pulse is being received,
 // the routine starts to gather data (with using of LightDataGatherer). After gathering all the data,
-// it attempts to send it to the heavy. After sending a heavy payload to a heavy, data is deleted
+// it attempts to send it to every heavy replica. After sending a heavy payload to heavies, data is deleted
 // with help of Cleaner
 func (lr *LightReplicatorDefault) NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber) {
 	lr.once.Do(func() {
-		go lr.sync(context.Background())
+		go lr.sync(WithComponent(context.Background()))
 	})
 
 	logger := inslogger.FromContext(ctx)
@@ -111,9 +125,21 @@ func (lr *LightReplicatorDefault) NotifyAboutPulse(ctx context.Context, pn insol
 	}
 
 	logger.Debugf("[Replicator][NotifyAboutPulse] start replication, pulse - %v", prevPN.PulseNumber)
+	atomic.AddInt64(&lr.pending, 1)
 	lr.syncWaitingPulses <- prevPN.PulseNumber
 }
 
+// RequireConfirmation makes replicator wait until heavies confirm replicated data. Data that wasn't confirmed by
+// any heavy is sent again.
+func (lr *LightReplicatorDefault) RequireConfirmation() {
+	atomic.StoreUint32(&lr.confirm, 1)
+}
+
+// Pending returns amount of pulses which data is not replicated yet.
+func (lr *LightReplicatorDefault) Pending() int {
+	return int(atomic.LoadInt64(&lr.pending))
+}
+
 func (lr *LightReplicatorDefault) Stop() {
 	close(lr.done)
 }
@@ -159,6 +185,7 @@ func (lr *LightReplicatorDefault) sync(ctx context.Context) {
 		}
 
 		lr.cleaner.NotifyAboutPulse(ctx, pn)
+		atomic.AddInt64(&lr.pending, -1)
 		span.End()
 	}
 
@@ -177,7 +204,13 @@ func (lr *LightReplicatorDefault) sync(ctx context.Context) {
 }
 
 func (lr *LightReplicatorDefault) sendToHeavy(ctx context.Context, pl payload.Replication) error {
-	msg, err := payload.NewMessage(&pl)
+	inslogger.FromContext(ctx).Debug("send drop to heavy. pulse: ", pl.Pulse, ". jet: ", pl.JetID.DebugString())
+
+	if atomic.LoadUint32(&lr.confirm) == 1 {
+		return lr.sendToHeavyConfirmed(ctx, pl)
+	}
+
+	sent, err := SendToHeavies(ctx, lr.coordinator, lr.sender, pl.Pulse, &pl)
 	if err != nil {
 		stats.Record(ctx,
 			statErrHeavyPayloadCount.M(1),
@@ -185,15 +218,36 @@ func (lr *LightReplicatorDefault) sendToHeavy(ctx context.Context, pl payload.Re
 		return err
 	}
 
-	inslogger.FromContext(ctx).Debug("send drop to heavy. pulse: ", pl.Pulse, ". jet: ", pl.JetID.DebugString())
+	for i := 0; i < sent; i++ {
+		stats.Record(ctx,
+			statHeavyPayloadCount.M(1),
+		)
+	}
+	return nil
+}
 
-	_, done := lr.sender.SendRole(ctx, msg, insolar.DynamicRoleHeavyExecutor, *insolar.NewReference(insolar.ID(pl.JetID)))
-	done()
+// sendToHeavyConfirmed sends payload to heavies until at least one of them confirms it or replicator is stopped.
+func (lr *LightReplicatorDefault) sendToHeavyConfirmed(ctx context.Context, pl payload.Replication) error {
+	pl.Confirm = true
+	for {
+		confirmed, err := ConfirmByHeavies(ctx, lr.coordinator, lr.sender, pl.Pulse, &pl)
+		if err != nil {
+			stats.Record(ctx, statErrHeavyPayloadCount.M(1))
+			return err
+		}
+		if confirmed > 0 {
+			stats.Record(ctx, statHeavyPayloadCount.M(int64(confirmed)))
+			return nil
+		}
 
-	stats.Record(ctx,
-		statHeavyPayloadCount.M(1),
-	)
-	return nil
+		stats.Record(ctx, statErrHeavyPayloadCount.M(1))
+		inslogger.FromContext(ctx).Warn("replicated data wasn't confirmed by heavies, retrying. jet: ", pl.JetID.DebugString())
+		select {
+		case <-time.After(confirmRetryInterval):
+		case <-lr.done:
+			return errors.New("replicator stopped before heavies confirmed data")
+		}
+	}
 }
 
 func (lr *LightReplicatorDefault) filterAndGroupIndexes(
@@ -215,12 +269,24 @@ func (lr *LightReplicatorDefault) heavyPayload(
 	jetID insolar.JetID,
 	indexes []record.Index,
 ) (payload.Replication, error) {
-	dr, err := lr.dropAccessor.ForPulse(ctx, jetID, pn)
+	return replicationPayload(ctx, lr.dropAccessor, lr.recsAccessor, pn, jetID, indexes)
+}
+
+// replicationPayload gathers data of provided pulse and jet.
+func replicationPayload(
+	ctx context.Context,
+	dropAccessor drop.Accessor,
+	recsAccessor object.RecordCollectionAccessor,
+	pn insolar.PulseNumber,
+	jetID insolar.JetID,
+	indexes []record.Index,
+) (payload.Replication, error) {
+	dr, err := dropAccessor.ForPulse(ctx, jetID, pn)
 	if err != nil {
 		return payload.Replication{}, errors.Wrap(err, "failed to fetch drop")
 	}
 
-	records := lr.recsAccessor.ForPulse(ctx, jetID, pn)
+	records := recsAccessor.ForPulse(ctx, jetID, pn)
 
 	return payload.Replication{
 		JetID:   jetID,
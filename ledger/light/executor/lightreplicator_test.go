@@ -65,11 +65,17 @@ func Test_NotifyAboutPulse(t *testing.T) {
 		Records:   expectRecords,
 	}
 
+	heavies := []insolar.Reference{gen.Reference(), gen.Reference()}
+	coordinator := jet.NewCoordinatorMock(mc)
+	coordinator.HeaviesMock.Return(heavies, nil)
+
+	var sentTo []insolar.Reference
 	sender := bus.NewSenderMock(mc)
-	sender.SendRoleMock.Set(func(_ context.Context, msg *message2.Message, role insolar.DynamicRole, _ insolar.Reference) (r <-chan *message2.Message, r1 func()) {
+	sender.SendTargetMock.Set(func(_ context.Context, msg *message2.Message, target insolar.Reference) (r <-chan *message2.Message, r1 func()) {
 		pl, err := payload.Unmarshal(msg.Payload)
 		require.NoError(t, err)
 		require.Equal(t, &expectPL, pl, "heavy message payload")
+		sentTo = append(sentTo, target)
 		return nil, func() {}
 	})
 
@@ -109,6 +115,7 @@ func Test_NotifyAboutPulse(t *testing.T) {
 		jetCalc,
 		cleaner,
 		sender,
+		coordinator,
 		pulseCalc,
 		dropAccessor,
 		recordAccessor,
@@ -120,4 +127,6 @@ func Test_NotifyAboutPulse(t *testing.T) {
 	r.NotifyAboutPulse(ctx, expectPN+1)
 	mc.Wait(time.Minute)
 	mc.Finish()
+
+	require.Equal(t, heavies, sentTo, "payload is sent to every heavy replica")
 }
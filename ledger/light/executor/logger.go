@@ -0,0 +1,32 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package executor
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// LogComponent is a name of light executor logger, its level can be set in Log.Components configuration.
+const LogComponent = "light.executor"
+
+// WithComponent returns context with logger bound to light executor component.
+func WithComponent(ctx context.Context) context.Context {
+	ctx, _ = inslogger.WithComponent(ctx, LogComponent)
+	return ctx
+}
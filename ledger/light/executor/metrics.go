@@ -34,6 +34,52 @@ var (
 		"How many heavy-payload messages were failed",
 		stats.UnitDimensionless,
 	)
+
+	statHandoffPending = stats.Int64(
+		"hotdata/handoff/pending",
+		"Amount of jets hot data is being sent for to next executors",
+		stats.UnitDimensionless,
+	)
+	statHandoffLatency = stats.Float64(
+		"hotdata/handoff/latency",
+		"Time spent on sending hot data for a jet to next executor",
+		stats.UnitMilliseconds,
+	)
+	statHotWaitersPending = stats.Int64(
+		"hotdata/waiters/pending",
+		"Amount of requests waiting for hot data",
+		stats.UnitDimensionless,
+	)
+	statHotWaitLatency = stats.Float64(
+		"hotdata/waiters/latency",
+		"Time requests spent waiting for hot data",
+		stats.UnitMilliseconds,
+	)
+	statRetentionOldestPulse = stats.Int64(
+		"light/retention/oldest",
+		"The oldest pulse which data is kept on light node",
+		stats.UnitDimensionless,
+	)
+	statArchivedPulses = stats.Int64(
+		"light/archive/pulses",
+		"Amount of pulses exported to archive before cleaning",
+		stats.UnitDimensionless,
+	)
+	statArchiveErrors = stats.Int64(
+		"light/archive/errors",
+		"Amount of pulses failed to be exported to archive",
+		stats.UnitDimensionless,
+	)
+	statHeavyFailover = stats.Int64(
+		"lightsyncer/heavy/failover",
+		"How many times reading from a heavy replica failed and next replica was requested",
+		stats.UnitDimensionless,
+	)
+	statHotWaitersRejected = stats.Int64(
+		"hotdata/waiters/rejected",
+		"Amount of requests rejected because too many requests are waiting for hot data",
+		stats.UnitDimensionless,
+	)
 )
 
 func init() {
@@ -64,6 +110,61 @@ func init() {
 			Measure:     statErrHeavyPayloadCount,
 			Aggregation: view.Count(),
 		},
+
+		&view.View{
+			Name:        statHandoffPending.Name(),
+			Description: statHandoffPending.Description(),
+			Measure:     statHandoffPending,
+			Aggregation: view.LastValue(),
+		},
+		&view.View{
+			Name:        statHandoffLatency.Name(),
+			Description: statHandoffLatency.Description(),
+			Measure:     statHandoffLatency,
+			Aggregation: view.Distribution(10, 50, 100, 250, 500, 1000, 2500, 5000, 10000),
+		},
+		&view.View{
+			Name:        statHotWaitersPending.Name(),
+			Description: statHotWaitersPending.Description(),
+			Measure:     statHotWaitersPending,
+			Aggregation: view.LastValue(),
+		},
+		&view.View{
+			Name:        statHotWaitLatency.Name(),
+			Description: statHotWaitLatency.Description(),
+			Measure:     statHotWaitLatency,
+			Aggregation: view.Distribution(10, 50, 100, 250, 500, 1000, 2500, 5000, 10000),
+		},
+		&view.View{
+			Name:        statHotWaitersRejected.Name(),
+			Description: statHotWaitersRejected.Description(),
+			Measure:     statHotWaitersRejected,
+			Aggregation: view.Count(),
+		},
+		&view.View{
+			Name:        statHeavyFailover.Name(),
+			Description: statHeavyFailover.Description(),
+			Measure:     statHeavyFailover,
+			Aggregation: view.Count(),
+		},
+		&view.View{
+			Name:        statRetentionOldestPulse.Name(),
+			Description: statRetentionOldestPulse.Description(),
+			Measure:     statRetentionOldestPulse,
+			Aggregation: view.LastValue(),
+		},
+		&view.View{
+			Name:        statArchivedPulses.Name(),
+			Description: statArchivedPulses.Description(),
+			Measure:     statArchivedPulses,
+			Aggregation: view.Count(),
+		},
+		&view.View{
+			Name:        statArchiveErrors.Name(),
+			Description: statArchiveErrors.Description(),
+			Measure:     statArchiveErrors,
+			Aggregation: view.Count(),
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -46,6 +46,9 @@ type PulseManager struct {
 	hotSender       HotSender
 	writeManager    WriteManager
 	stateIniter     StateIniter
+
+	// draining is set when the node is going to leave, pulses are not opened for writing anymore.
+	draining bool
 }
 
 // NewPulseManager creates PulseManager instance.
@@ -80,6 +83,7 @@ func NewPulseManager(
 
 // Set set's new pulse and closes current jet drop.
 func (m *PulseManager) Set(ctx context.Context, newPulse insolar.Pulse) error {
+	ctx = WithComponent(ctx)
 	logger := inslogger.FromContext(ctx)
 
 	m.setLock.Lock()
@@ -135,14 +139,16 @@ func (m *PulseManager) Set(ctx context.Context, newPulse insolar.Pulse) error {
 
 		m.jetReleaser.CloseAllUntil(ctx, endedPulse.PulseNumber)
 
-		err = m.writeManager.CloseAndWait(ctx, endedPulse.PulseNumber)
-		if err != nil {
-			panic(errors.Wrap(err, "can't close pulse for writing"))
-		}
+		if !m.draining {
+			err = m.writeManager.CloseAndWait(ctx, endedPulse.PulseNumber)
+			if err != nil {
+				panic(errors.Wrap(err, "can't close pulse for writing"))
+			}
 
-		err = m.writeManager.Open(ctx, newPulse.PulseNumber)
-		if err != nil {
-			panic(errors.Wrap(err, "failed to open pulse for writing"))
+			err = m.writeManager.Open(ctx, newPulse.PulseNumber)
+			if err != nil {
+				panic(errors.Wrap(err, "failed to open pulse for writing"))
+			}
 		}
 
 		if err := m.pulseAppender.Append(ctx, newPulse); err != nil {
@@ -164,3 +170,52 @@ func (m *PulseManager) Set(ctx context.Context, newPulse insolar.Pulse) error {
 
 	return nil
 }
+
+// HandoffProgress returns progress of hot data handoff to the next executors and
+// amount of requests waiting for hot data from the previous ones.
+func (m *PulseManager) HandoffProgress() HandoffProgress {
+	pn, outgoing := m.hotSender.Handoff()
+	return HandoffProgress{
+		Pulse:    pn,
+		Outgoing: outgoing,
+		Waiting:  m.jetReleaser.Waiting(),
+	}
+}
+
+// StartDrain closes current pulse for writing and doesn't open next pulses, so writes are rejected with
+// flow cancel and virtuals resend them after pulse change. Hot data is still handed off to the next executors
+// and light replicator waits for heavies to confirm replicated data before the node leaves.
+func (m *PulseManager) StartDrain(ctx context.Context) {
+	logger := inslogger.FromContext(ctx)
+
+	m.setLock.Lock()
+	defer m.setLock.Unlock()
+
+	if m.draining {
+		return
+	}
+	m.draining = true
+	m.lightReplicator.RequireConfirmation()
+
+	latest, err := m.pulseAccessor.Latest(ctx)
+	if err != nil {
+		logger.Error(errors.Wrap(err, "failed to fetch current pulse, writes are closed since next pulse"))
+		return
+	}
+	err = m.writeManager.CloseAndWait(ctx, latest.PulseNumber)
+	if err != nil {
+		logger.Error(errors.Wrap(err, "failed to close current pulse for writing"))
+	}
+	logger.Info("light starts draining, writes are closed, replication to heavy requires confirmation")
+}
+
+// PendingWork returns amount of jets hot data is still being sent for, requests waiting for hot data
+// and pulses not replicated to heavy yet.
+func (m *PulseManager) PendingWork(ctx context.Context) int {
+	progress := m.HandoffProgress()
+	pending := progress.Pending() + m.lightReplicator.Pending()
+	for _, n := range progress.Waiting {
+		pending += n
+	}
+	return pending
+}
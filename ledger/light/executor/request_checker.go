@@ -77,6 +77,9 @@ func (c *RequestCheckerDefault) CheckRequest(ctx context.Context, requestID inso
 		if request.IsCreationRequest() {
 			return errors.New("outgoing cannot be creating request")
 		}
+		if r.ReturnMode == record.ReturnScheduled && r.ExecuteAt <= requestID.Pulse() {
+			return errors.Errorf("scheduled request should be executed after pulse %v (got %v)", requestID.Pulse(), r.ExecuteAt)
+		}
 
 		// FIXME: replace with "FindRequest" calculator method.
 		requests, err := c.filaments.OpenedRequests(
@@ -105,41 +108,31 @@ func (c *RequestCheckerDefault) checkReasonExists(
 	if err != nil {
 		return errors.Wrap(err, "failed to calculate limit")
 	}
-	var node *insolar.Reference
+	inslogger.FromContext(ctx).Debug("check reason. request: ", reasonID.DebugString())
+	getRequest := &payload.GetRequest{
+		ObjectID:  objectID,
+		RequestID: reasonID,
+	}
+
+	var pl payload.Payload
 	if isBeyond {
-		node, err = c.coordinator.Heavy(ctx)
+		pl, err = FetchFromHeavy(ctx, c.coordinator, c.sender, reasonID.Pulse(), getRequest)
 		if err != nil {
-			return errors.Wrap(err, "failed to calculate node")
+			return errors.Wrap(err, "failed to fetch reason from heavy")
 		}
 	} else {
 		jetID, err := c.fetcher.Fetch(ctx, objectID, reasonID.Pulse())
 		if err != nil {
 			return errors.Wrap(err, "failed to fetch jet")
 		}
-		node, err = c.coordinator.NodeForJet(ctx, *jetID, reasonID.Pulse())
+		node, err := c.coordinator.NodeForJet(ctx, *jetID, reasonID.Pulse())
 		if err != nil {
 			return errors.Wrap(err, "failed to calculate node")
 		}
-	}
-	inslogger.FromContext(ctx).Debug("check reason. request: ", reasonID.DebugString())
-	msg, err := payload.NewMessage(&payload.GetRequest{
-		ObjectID:  objectID,
-		RequestID: reasonID,
-	})
-	if err != nil {
-		return errors.Wrap(err, "failed to check an object existence")
-	}
-
-	reps, done := c.sender.SendTarget(ctx, msg, *node)
-	defer done()
-	res, ok := <-reps
-	if !ok {
-		return errors.New("no reply for reason check")
-	}
-
-	pl, err := payload.UnmarshalFromMeta(res.Payload)
-	if err != nil {
-		return errors.Wrap(err, "failed to unmarshal reply")
+		pl, err = fetchFromNode(ctx, c.sender, getRequest, *node)
+		if err != nil {
+			return errors.Wrap(err, "failed to check reason")
+		}
 	}
 
 	switch concrete := pl.(type) {
@@ -19,9 +19,11 @@ package executor
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"go.opencensus.io/stats"
 )
 
 // JetWaiter provides method for locking on jet id.
@@ -29,10 +31,12 @@ type JetWaiter interface {
 	Wait(ctx context.Context, jetID insolar.JetID, pulse insolar.PulseNumber) error
 }
 
-// JetReleaser provides methods for releasing jet waiters.
+// JetReleaser provides methods for releasing and inspecting jet waiters.
 type JetReleaser interface {
 	Unlock(ctx context.Context, pulse insolar.PulseNumber, jetID insolar.JetID) error
 	CloseAllUntil(ctx context.Context, pulse insolar.PulseNumber)
+	// Waiting returns amount of requests waiting for hot data per jet.
+	Waiting() map[insolar.JetID]int
 }
 
 // ChannelWaiter implements methods for locking and unlocking a certain jet id.
@@ -40,11 +44,16 @@ type ChannelWaiter struct {
 	lock        sync.Mutex
 	closedUntil insolar.PulseNumber
 	waiters     map[insolar.PulseNumber]*pulseWaiter
+
+	// limit is a maximum amount of requests waiting for one jet, zero means no limit.
+	limit   int
+	pending int
 }
 
 type pulseWaiter struct {
 	pulse   insolar.PulseNumber
 	waiters map[insolar.JetID]waiter
+	pending map[insolar.JetID]int
 	timeout chan struct{}
 }
 
@@ -66,16 +75,19 @@ func (w waiter) isClosed() bool {
 	return false
 }
 
-// NewChannelWaiter creates new waiter instance.
-func NewChannelWaiter() *ChannelWaiter {
+// NewChannelWaiter creates new waiter instance. Limit is a maximum amount of requests
+// waiting for hot data of one jet, requests over the limit are rejected with ErrHotDataBackpressure.
+func NewChannelWaiter(limit int) *ChannelWaiter {
 	return &ChannelWaiter{
 		waiters: map[insolar.PulseNumber]*pulseWaiter{},
+		limit:   limit,
 	}
 }
 
 // Wait waits for the raising one of two channels.
 // If hotDataChannel or timeoutChannel was raised, the method returns error
-// Either nil or ErrHotDataTimeout
+// Either nil or ErrHotDataTimeout. If too many requests are waiting for the jet, ErrHotDataBackpressure
+// is returned immediately.
 func (w *ChannelWaiter) Wait(ctx context.Context, jetID insolar.JetID, pulse insolar.PulseNumber) error {
 	logger := inslogger.FromContext(ctx).WithFields(map[string]interface{}{
 		"pulse": pulse.String(),
@@ -91,8 +103,32 @@ func (w *ChannelWaiter) Wait(ctx context.Context, jetID insolar.JetID, pulse ins
 	pWaiter := w.getOrCreate(pulse)
 	timeout := pWaiter.timeout
 	waitCh := pWaiter.getOrCreate(jetID)
+	if waitCh.isClosed() {
+		w.lock.Unlock()
+		return nil
+	}
+	if w.limit > 0 && pWaiter.pending[jetID] >= w.limit {
+		w.lock.Unlock()
+		stats.Record(ctx, statHotWaitersRejected.M(1))
+		logger.Warn("too many requests are waiting for hot objects")
+		return ErrHotDataBackpressure
+	}
+	pWaiter.pending[jetID]++
+	w.pending++
+	stats.Record(ctx, statHotWaitersPending.M(int64(w.pending)))
 	w.lock.Unlock()
 
+	started := time.Now()
+	defer func() {
+		w.lock.Lock()
+		pWaiter.pending[jetID]--
+		w.pending--
+		stats.Record(ctx, statHotWaitersPending.M(int64(w.pending)))
+		w.lock.Unlock()
+
+		stats.Record(ctx, statHotWaitLatency.M(float64(time.Since(started).Nanoseconds())/1e6))
+	}()
+
 	select {
 	case <-waitCh:
 		return nil
@@ -102,6 +138,22 @@ func (w *ChannelWaiter) Wait(ctx context.Context, jetID insolar.JetID, pulse ins
 	}
 }
 
+// Waiting returns amount of requests waiting for hot data per jet.
+func (w *ChannelWaiter) Waiting() map[insolar.JetID]int {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	res := map[insolar.JetID]int{}
+	for _, pWaiter := range w.waiters {
+		for jetID, count := range pWaiter.pending {
+			if count > 0 {
+				res[jetID] += count
+			}
+		}
+	}
+	return res
+}
+
 // Unlock raises hotDataChannel
 func (w *ChannelWaiter) Unlock(ctx context.Context, pulse insolar.PulseNumber, jetID insolar.JetID) error {
 	logger := inslogger.FromContext(ctx).WithFields(map[string]interface{}{
@@ -147,6 +199,7 @@ func (w *ChannelWaiter) getOrCreate(pn insolar.PulseNumber) *pulseWaiter {
 	pWaiter = &pulseWaiter{
 		pulse:   pn,
 		waiters: map[insolar.JetID]waiter{},
+		pending: map[insolar.JetID]int{},
 		timeout: make(chan struct{}),
 	}
 	w.waiters[pn] = pWaiter
@@ -17,6 +17,7 @@
 package executor_test
 
 import (
+	"runtime"
 	"sync"
 	"testing"
 	"time"
@@ -35,7 +36,7 @@ func Test_HotDataWaiterConcrete_WaitUnlock(t *testing.T) {
 	waitingStarted := make(chan struct{}, 1)
 	waitingFinished := make(chan struct{})
 
-	hdw := executor.NewChannelWaiter()
+	hdw := executor.NewChannelWaiter(0)
 	hdwLock := sync.Mutex{}
 	hdwGetter := func() *executor.ChannelWaiter {
 		hdwLock.Lock()
@@ -74,7 +75,7 @@ func Test_HotDataWaiterConcrete_Close(t *testing.T) {
 	waitingStarted := make(chan struct{}, 1)
 	waitingFinished := make(chan struct{})
 
-	hdw := executor.NewChannelWaiter()
+	hdw := executor.NewChannelWaiter(0)
 	hdwLock := sync.Mutex{}
 	hdwGetter := func() *executor.ChannelWaiter {
 		hdwLock.Lock()
@@ -112,7 +113,7 @@ func Test_HotDataWaiterConcrete_WaitClose_MultipleMembers(t *testing.T) {
 	waitingStarted := make(chan struct{}, 2)
 	waitingFinished := make(chan struct{})
 
-	hdw := executor.NewChannelWaiter()
+	hdw := executor.NewChannelWaiter(0)
 	hdwLock := sync.Mutex{}
 	hdwGetter := func() *executor.ChannelWaiter {
 		hdwLock.Lock()
@@ -150,3 +151,36 @@ func Test_HotDataWaiterConcrete_WaitClose_MultipleMembers(t *testing.T) {
 	<-waitingFinished
 	<-waitingFinished
 }
+
+func Test_HotDataWaiterConcrete_Backpressure(t *testing.T) {
+	t.Parallel()
+	ctx := inslogger.TestContext(t)
+	hdw := executor.NewChannelWaiter(1)
+
+	jetID := gen.JetID()
+	pulse := gen.PulseNumber()
+
+	waitingFinished := make(chan error)
+	go func() {
+		waitingFinished <- hdw.Wait(ctx, jetID, pulse)
+	}()
+
+	// Waiting counter is updated before Wait blocks, so it signals that the first request is registered.
+	for hdw.Waiting()[jetID] == 0 {
+		select {
+		case err := <-waitingFinished:
+			t.Fatalf("wait finished before unlock: %v", err)
+		default:
+			runtime.Gosched()
+		}
+	}
+	require.Equal(t, 1, hdw.Waiting()[jetID])
+
+	err := hdw.Wait(ctx, jetID, pulse)
+	require.Equal(t, executor.ErrHotDataBackpressure, err)
+
+	err = hdw.Unlock(ctx, pulse, jetID)
+	require.NoError(t, err)
+	require.NoError(t, <-waitingFinished)
+	require.Empty(t, hdw.Waiting())
+}
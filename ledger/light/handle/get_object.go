@@ -74,7 +74,7 @@ func (s *GetObject) Present(ctx context.Context, f flow.Flow) error {
 		return err
 	}
 
-	send := proc.NewSendObject(s.meta, msg.ObjectID)
+	send := proc.NewSendObject(s.meta, msg.ObjectID, msg.StateID)
 	s.dep.SendObject(send)
 	return f.Procedure(ctx, send, false)
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/insolar/insolar/insolar/flow"
 	"github.com/insolar/insolar/insolar/payload"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/light/executor"
 	"github.com/insolar/insolar/ledger/light/proc"
 	"github.com/pkg/errors"
 )
@@ -49,6 +50,7 @@ func (s *Init) Future(ctx context.Context, f flow.Flow) error {
 }
 
 func (s *Init) Present(ctx context.Context, f flow.Flow) error {
+	ctx = executor.WithComponent(ctx)
 	logger := inslogger.FromContext(ctx)
 	err := s.handle(ctx, f)
 	if err != nil {
@@ -84,6 +86,9 @@ func (s *Init) handle(ctx context.Context, f flow.Flow) error {
 	case payload.TypeGetFilament:
 		h := NewGetRequests(s.dep, meta)
 		return f.Handle(ctx, h.Present)
+	case payload.TypeGetResult:
+		h := NewGetResult(s.dep, meta, false)
+		err = f.Handle(ctx, h.Present)
 	case payload.TypePassState:
 		h := NewPassState(s.dep, meta)
 		err = f.Handle(ctx, h.Present)
@@ -204,6 +209,9 @@ func (s *Init) handlePass(ctx context.Context, f flow.Flow, meta payload.Meta) e
 	case payload.TypeGetRequest:
 		h := NewGetRequest(s.dep, originMeta, true)
 		err = f.Handle(ctx, h.Present)
+	case payload.TypeGetResult:
+		h := NewGetResult(s.dep, originMeta, true)
+		err = f.Handle(ctx, h.Present)
 	default:
 		err = fmt.Errorf("no handler for message type %s", payloadType.String())
 	}
@@ -264,7 +272,8 @@ func (s *Init) Past(ctx context.Context, f flow.Flow) error {
 		payload.TypeGetJet,
 		payload.TypeGetRequest,
 		payload.TypePassState,
-		payload.TypeGetFilament:
+		payload.TypeGetFilament,
+		payload.TypeGetResult:
 		return s.Present(ctx, f)
 	}
 
@@ -274,8 +283,11 @@ func (s *Init) Past(ctx context.Context, f flow.Flow) error {
 
 func (s *Init) replyError(ctx context.Context, replyTo payload.Meta, err error) {
 	errCode := payload.CodeUnknown
-	if err == flow.ErrCancelled {
+	switch errors.Cause(err) {
+	case flow.ErrCancelled:
 		errCode = payload.CodeFlowCanceled
+	case executor.ErrHotDataBackpressure:
+		errCode = payload.CodeBackpressure
 	}
 	errMsg, newErr := payload.NewMessage(&payload.Error{Text: err.Error(), Code: uint32(errCode)})
 	if newErr != nil {
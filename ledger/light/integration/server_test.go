@@ -174,7 +174,7 @@ func NewServer(
 		Pulses = pulse.NewStorageMem()
 		Jets = jet.NewStore()
 
-		c := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit)
+		c := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit, cfg.Ledger.Retention.KeepPulses, cfg.Ledger.HeavyReplicas)
 		c.PulseCalculator = Pulses
 		c.PulseAccessor = Pulses
 		c.JetAccessor = Jets
@@ -197,7 +197,7 @@ func NewServer(
 		ClientPubSub = gochannel.NewGoChannel(gochannel.Config{}, logger)
 		ServerBus = bus.NewBus(cfg.Bus, ServerPubSub, Pulses, Coordinator, CryptoScheme)
 
-		c := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit)
+		c := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit, cfg.Ledger.Retention.KeepPulses, cfg.Ledger.HeavyReplicas)
 		c.PulseCalculator = Pulses
 		c.PulseAccessor = Pulses
 		c.JetAccessor = Jets
@@ -221,7 +221,7 @@ func NewServer(
 		records := object.NewRecordMemory()
 		indexes := object.NewIndexStorageMemory()
 		writeController := executor.NewWriteController()
-		hotWaitReleaser := executor.NewChannelWaiter()
+		hotWaitReleaser := executor.NewChannelWaiter(conf.HotWaitersLimit)
 
 		jetFetcher := executor.NewFetcher(Nodes, Jets, ServerBus, Coordinator)
 		filamentCalculator := executor.NewFilamentCalculator(
@@ -247,6 +247,8 @@ func NewServer(
 			filamentCalculator,
 			conf.LightChainLimit,
 			conf.CleanerDelay,
+			conf.Retention.KeepPulses,
+			nil,
 		)
 		Cleaner = lightCleaner
 
@@ -254,6 +256,7 @@ func NewServer(
 			jetCalculator,
 			lightCleaner,
 			ServerBus,
+			Coordinator,
 			Pulses,
 			drops,
 			records,
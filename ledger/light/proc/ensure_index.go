@@ -28,6 +28,7 @@ import (
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/instrumentation/instracer"
+	"github.com/insolar/insolar/ledger/light/executor"
 	"github.com/insolar/insolar/ledger/object"
 	"github.com/pkg/errors"
 	"go.opencensus.io/trace"
@@ -102,29 +103,13 @@ func (p *EnsureIndex) process(ctx context.Context) error {
 	}
 
 	logger.Debug("EnsureIndex: failed to fetch index (fetching from heavy)")
-	heavy, err := p.dep.coordinator.Heavy(ctx)
-	if err != nil {
-		return errors.Wrap(err, "EnsureIndex: failed to calculate heavy")
-	}
-
-	ensureIndex, err := payload.NewMessage(&payload.GetIndex{
+	// Index could be updated in any pulse. Heavies reconcile pulses they are not replicas for,
+	// so replicas of the current pulse are asked.
+	pl, err := executor.FetchFromHeavy(ctx, p.dep.coordinator, p.dep.sender, flow.Pulse(ctx), &payload.GetIndex{
 		ObjectID: p.object,
 	})
 	if err != nil {
-		return errors.Wrap(err, "EnsureIndex: failed to create EnsureIndex message")
-	}
-
-	reps, done := p.dep.sender.SendTarget(ctx, ensureIndex, *heavy)
-	defer done()
-
-	res, ok := <-reps
-	if !ok {
-		return errors.New("EnsureIndex: no reply")
-	}
-
-	pl, err := payload.UnmarshalFromMeta(res.Payload)
-	if err != nil {
-		return errors.Wrap(err, "EnsureIndex: failed to unmarshal reply")
+		return errors.Wrap(err, "EnsureIndex: failed to fetch index from heavy")
 	}
 
 	switch rep := pl.(type) {
@@ -84,6 +84,20 @@ func (p *GetCode) Proceed(ctx context.Context) error {
 	}
 
 	sendPassCode := func() error {
+		onHeavy, err := p.dep.coordinator.IsBeyondLimit(ctx, p.codeID.Pulse())
+		if err != nil {
+			return errors.Wrap(err, "failed to calculate pulse")
+		}
+		if onHeavy {
+			go func() {
+				executor.PassToHeavy(ctx, p.dep.coordinator, p.dep.sender, p.message, p.codeID.Pulse(), &payload.GetCode{
+					CodeID: p.codeID,
+				})
+				logger.Debug("passed GetCode to heavy")
+			}()
+			return nil
+		}
+
 		originMeta, err := p.message.Marshal()
 		if err != nil {
 			return errors.Wrap(err, "failed to marshal origin meta message")
@@ -95,32 +109,18 @@ func (p *GetCode) Proceed(ctx context.Context) error {
 			return errors.Wrap(err, "failed to create reply")
 		}
 
-		onHeavy, err := p.dep.coordinator.IsBeyondLimit(ctx, p.codeID.Pulse())
+		jetID, err := p.dep.jetFetcher.Fetch(ctx, p.codeID, p.codeID.Pulse())
 		if err != nil {
-			return errors.Wrap(err, "failed to calculate pulse")
+			return errors.Wrap(err, "failed to fetch jet")
 		}
-		var node insolar.Reference
-		if onHeavy {
-			h, err := p.dep.coordinator.Heavy(ctx)
-			if err != nil {
-				return errors.Wrap(err, "failed to calculate heavy")
-			}
-			node = *h
-		} else {
-			jetID, err := p.dep.jetFetcher.Fetch(ctx, p.codeID, p.codeID.Pulse())
-			if err != nil {
-				return errors.Wrap(err, "failed to fetch jet")
-			}
-			logger.Debug("calculated jet for pass: %s", jetID.DebugString())
-			l, err := p.dep.coordinator.LightExecutorForJet(ctx, *jetID, p.codeID.Pulse())
-			if err != nil {
-				return errors.Wrap(err, "failed to calculate role")
-			}
-			node = *l
+		logger.Debug("calculated jet for pass: %s", jetID.DebugString())
+		node, err := p.dep.coordinator.LightExecutorForJet(ctx, *jetID, p.codeID.Pulse())
+		if err != nil {
+			return errors.Wrap(err, "failed to calculate role")
 		}
 
 		go func() {
-			_, done := p.dep.sender.SendTarget(ctx, msg, node)
+			_, done := p.dep.sender.SendTarget(ctx, msg, *node)
 			done()
 			logger.Debug("passed GetCode")
 		}()
@@ -86,6 +86,18 @@ func (p *GetRequest) Proceed(ctx context.Context) error {
 	}
 
 	sendPassRequest := func() error {
+		onHeavy, err := p.dep.coordinator.IsBeyondLimit(ctx, p.requestID.Pulse())
+		if err != nil {
+			return errors.Wrap(err, "failed to calculate pulse")
+		}
+		if onHeavy {
+			go executor.PassToHeavy(ctx, p.dep.coordinator, p.dep.sender, p.message, p.requestID.Pulse(), &payload.GetRequest{
+				ObjectID:  p.objectID,
+				RequestID: p.requestID,
+			})
+			return nil
+		}
+
 		buf, err := p.message.Marshal()
 		if err != nil {
 			return errors.Wrap(err, "failed to marshal origin meta message")
@@ -97,30 +109,16 @@ func (p *GetRequest) Proceed(ctx context.Context) error {
 			return errors.Wrap(err, "failed to create reply")
 		}
 
-		onHeavy, err := p.dep.coordinator.IsBeyondLimit(ctx, p.requestID.Pulse())
+		jetID, err := p.dep.fetcher.Fetch(ctx, p.objectID, p.requestID.Pulse())
 		if err != nil {
-			return errors.Wrap(err, "failed to calculate pulse")
+			return errors.Wrap(err, "failed to fetch jet")
 		}
-		var node insolar.Reference
-		if onHeavy {
-			h, err := p.dep.coordinator.Heavy(ctx)
-			if err != nil {
-				return errors.Wrap(err, "failed to calculate heavy")
-			}
-			node = *h
-		} else {
-			jetID, err := p.dep.fetcher.Fetch(ctx, p.objectID, p.requestID.Pulse())
-			if err != nil {
-				return errors.Wrap(err, "failed to fetch jet")
-			}
-			l, err := p.dep.coordinator.LightExecutorForJet(ctx, *jetID, p.requestID.Pulse())
-			if err != nil {
-				return errors.Wrap(err, "failed to calculate role")
-			}
-			node = *l
+		node, err := p.dep.coordinator.LightExecutorForJet(ctx, *jetID, p.requestID.Pulse())
+		if err != nil {
+			return errors.Wrap(err, "failed to calculate role")
 		}
 
-		_, done := p.dep.sender.SendTarget(ctx, msg, node)
+		_, done := p.dep.sender.SendTarget(ctx, msg, *node)
 		done()
 		return nil
 	}
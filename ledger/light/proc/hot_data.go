@@ -133,19 +133,26 @@ func (p *HotObjects) Proceed(ctx context.Context) error {
 			continue
 		}
 
+		lifeline := idx.Lifeline
+		released := releaseScheduled(&lifeline, p.pulse)
 		p.dep.indices.Set(
 			ctx,
 			p.pulse,
 			record.Index{
 				ObjID:            idx.ObjID,
-				Lifeline:         idx.Lifeline,
+				Lifeline:         lifeline,
 				LifelineLastUsed: idx.LifelineLastUsed,
 				PendingRecords:   []insolar.ID{},
 			},
 		)
 		logger.Debugf("[handleHotRecords] lifeline with id - %v saved", idx.ObjID.DebugString())
 
-		go p.notifyPending(ctx, idx.ObjID, idx.Lifeline, pendingNotifyPulse.PulseNumber)
+		if released {
+			// Executor is notified about scheduled requests right when their pulse comes.
+			go p.sendPendingNotification(ctx, idx.ObjID)
+			continue
+		}
+		go p.notifyPending(ctx, idx.ObjID, lifeline, pendingNotifyPulse.PulseNumber)
 	}
 
 	p.dep.jetFetcher.Release(ctx, p.jetID, p.pulse)
@@ -159,21 +166,17 @@ func (p *HotObjects) Proceed(ctx context.Context) error {
 }
 
 func (p *HotObjects) sendConfirmationToHeavy(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber, split bool) {
-	msg, err := payload.NewMessage(&payload.GotHotConfirmation{
+	inslogger.FromContext(ctx).Debug("Send hot confirmation to heavy. pulse: ", pn, " jet: ", p.drop.JetID.DebugString())
+
+	// Every heavy replica keeps its own JetKeeper, so each of them needs the confirmation.
+	_, err := executor.SendToHeavies(ctx, p.dep.coordinator, p.dep.sender, pn, &payload.GotHotConfirmation{
 		JetID: jetID,
 		Pulse: pn,
 		Split: split,
 	})
-
 	if err != nil {
-		inslogger.FromContext(ctx).Error("Can't create GotHotConfirmation message: ", err)
-		return
+		inslogger.FromContext(ctx).Error("Can't send GotHotConfirmation message: ", err)
 	}
-
-	inslogger.FromContext(ctx).Debug("Send hot confirmation to heavy. pulse: ", pn, " jet: ", p.drop.JetID.DebugString())
-
-	_, done := p.dep.sender.SendRole(ctx, msg, insolar.DynamicRoleHeavyExecutor, *insolar.NewReference(insolar.ID(jetID)))
-	done()
 }
 
 func (p *HotObjects) notifyPending(
@@ -192,6 +195,10 @@ func (p *HotObjects) notifyPending(
 		return
 	}
 
+	p.sendPendingNotification(ctx, objectID)
+}
+
+func (p *HotObjects) sendPendingNotification(ctx context.Context, objectID insolar.ID) {
 	msg, err := payload.NewMessage(&payload.AbandonedRequestsNotification{
 		ObjectID: objectID,
 	})
@@ -205,3 +212,19 @@ func (p *HotObjects) notifyPending(
 	_, done := p.dep.sender.SendRole(ctx, msg, insolar.DynamicRoleVirtualExecutor, *insolar.NewReference(objectID))
 	done()
 }
+
+// releaseScheduled makes scheduled requests pending if the earliest of them is ready at provided pulse.
+// Requests scheduled to later pulses stay pending until the result of the ready one is saved.
+func releaseScheduled(lifeline *record.Lifeline, pn insolar.PulseNumber) bool {
+	if lifeline.EarliestExecuteAt == nil || lifeline.EarliestScheduledRequest == nil || *lifeline.EarliestExecuteAt > pn {
+		return false
+	}
+
+	scheduled := *lifeline.EarliestScheduledRequest
+	if lifeline.EarliestOpenRequest == nil || scheduled < *lifeline.EarliestOpenRequest {
+		lifeline.EarliestOpenRequest = &scheduled
+	}
+	lifeline.EarliestScheduledRequest = nil
+	lifeline.EarliestExecuteAt = nil
+	return true
+}
@@ -42,6 +42,7 @@ type Dependencies struct {
 	CalculateID  func(*CalculateID)
 	SetCode      func(*SetCode)
 	SendRequests func(*SendRequests)
+	SendResult   func(*SendResult)
 	HasPendings  func(*HasPendings)
 }
 
@@ -176,6 +177,12 @@ func NewDependencies(
 				filaments,
 			)
 		},
+		SendResult: func(p *SendResult) {
+			p.Dep(
+				sender,
+				filaments,
+			)
+		},
 		PassState: func(p *PassState) {
 			p.Dep(
 				recordStorage,
@@ -216,6 +223,7 @@ func NewDependenciesMock() *Dependencies {
 		CalculateID:  func(*CalculateID) {},
 		SetCode:      func(*SetCode) {},
 		SendRequests: func(*SendRequests) {},
+		SendResult:   func(*SendResult) {},
 		HasPendings:  func(*HasPendings) {},
 	}
 }
@@ -37,6 +37,7 @@ import (
 type SendObject struct {
 	message  payload.Meta
 	objectID insolar.ID
+	stateID  insolar.ID
 
 	dep struct {
 		coordinator jet.Coordinator
@@ -48,13 +49,16 @@ type SendObject struct {
 	}
 }
 
+// NewSendObject creates procedure which sends object index and state. The latest state is sent if stateID is empty.
 func NewSendObject(
 	msg payload.Meta,
 	id insolar.ID,
+	stateID insolar.ID,
 ) *SendObject {
 	return &SendObject{
 		message:  msg,
 		objectID: id,
+		stateID:  stateID,
 	}
 }
 
@@ -112,6 +116,20 @@ func (p *SendObject) Proceed(ctx context.Context) error {
 		ctx, span := instracer.StartSpan(ctx, "SendObject.sendPassState")
 		defer span.End()
 
+		onHeavy, err := p.dep.coordinator.IsBeyondLimit(ctx, stateID.Pulse())
+		if err != nil {
+			return errors.Wrap(err, "failed to calculate pulse")
+		}
+		if onHeavy {
+			inslogger.FromContext(ctx).Warnf("State not found on light. Go to heavy. StateID:%v, CurrentPN:%v", stateID.DebugString(), flow.Pulse(ctx))
+			span.Annotate(nil, fmt.Sprintf("Send StateID:%v to heavy", stateID.DebugString()))
+			// Heavy replies to the sender of PassState without origin, so replicas can be tried one by one.
+			go executor.PassToHeavy(ctx, p.dep.coordinator, p.dep.sender, p.message, stateID.Pulse(), &payload.PassState{
+				StateID: stateID,
+			})
+			return nil
+		}
+
 		buf, err := p.message.Marshal()
 		if err != nil {
 			return errors.Wrap(err, "failed to marshal origin meta message")
@@ -124,35 +142,19 @@ func (p *SendObject) Proceed(ctx context.Context) error {
 			return errors.Wrap(err, "failed to create reply")
 		}
 
-		onHeavy, err := p.dep.coordinator.IsBeyondLimit(ctx, stateID.Pulse())
+		inslogger.FromContext(ctx).Warnf("State not found on light. Go to light. StateID:%v, CurrentPN:%v", stateID.DebugString(), flow.Pulse(ctx))
+		jetID, err := p.dep.jetFetcher.Fetch(ctx, p.objectID, stateID.Pulse())
 		if err != nil {
-			return errors.Wrap(err, "failed to calculate pulse")
+			return errors.Wrap(err, "failed to fetch jet")
 		}
-		var node insolar.Reference
-		if onHeavy {
-			inslogger.FromContext(ctx).Warnf("State not found on light. Go to heavy. StateID:%v, CurrentPN:%v", stateID.DebugString(), flow.Pulse(ctx))
-			h, err := p.dep.coordinator.Heavy(ctx)
-			if err != nil {
-				return errors.Wrap(err, "failed to calculate heavy")
-			}
-			node = *h
-			span.Annotate(nil, fmt.Sprintf("Send StateID:%v to heavy", stateID.DebugString()))
-		} else {
-			inslogger.FromContext(ctx).Warnf("State not found on light. Go to light. StateID:%v, CurrentPN:%v", stateID.DebugString(), flow.Pulse(ctx))
-			jetID, err := p.dep.jetFetcher.Fetch(ctx, p.objectID, stateID.Pulse())
-			if err != nil {
-				return errors.Wrap(err, "failed to fetch jet")
-			}
-			l, err := p.dep.coordinator.LightExecutorForJet(ctx, *jetID, stateID.Pulse())
-			if err != nil {
-				return errors.Wrap(err, "failed to calculate role")
-			}
-			node = *l
-			span.Annotate(nil, fmt.Sprintf("Send StateID:%v to light", stateID.DebugString()))
+		node, err := p.dep.coordinator.LightExecutorForJet(ctx, *jetID, stateID.Pulse())
+		if err != nil {
+			return errors.Wrap(err, "failed to calculate role")
 		}
+		span.Annotate(nil, fmt.Sprintf("Send StateID:%v to light", stateID.DebugString()))
 
 		go func() {
-			_, done := p.dep.sender.SendTarget(ctx, msg, node)
+			_, done := p.dep.sender.SendTarget(ctx, msg, *node)
 			done()
 		}()
 		return nil
@@ -165,14 +167,17 @@ func (p *SendObject) Proceed(ctx context.Context) error {
 
 	lifeline := idx.Lifeline
 
-	if lifeline.StateID == record.StateDeactivation {
-		return errors.New("object is deactivated")
-	}
-
 	if lifeline.LatestState == nil {
 		return ErrNotActivated
 	}
 
+	stateID := *lifeline.LatestState
+	if !p.stateID.IsEmpty() {
+		stateID = p.stateID
+	} else if lifeline.StateID == record.StateDeactivation {
+		return errors.New("object is deactivated")
+	}
+
 	{
 		buf, err := lifeline.Marshal()
 		if err != nil {
@@ -188,12 +193,15 @@ func (p *SendObject) Proceed(ctx context.Context) error {
 		p.dep.sender.Reply(ctx, p.message, msg)
 	}
 
-	rec, err := p.dep.records.ForID(ctx, *lifeline.LatestState)
+	rec, err := p.dep.records.ForID(ctx, stateID)
 	switch err {
 	case nil:
+		if !p.stateID.IsEmpty() && !rec.ObjectID.IsEmpty() && rec.ObjectID != p.objectID {
+			return errors.New("requested state doesn't belong to the object")
+		}
 		return sendState(rec)
 	case object.ErrNotFound:
-		return sendPassState(*lifeline.LatestState)
+		return sendPassState(stateID)
 	default:
 		return errors.Wrap(err, "failed to fetch record")
 	}
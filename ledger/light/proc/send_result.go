@@ -0,0 +1,85 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package proc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/flow"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/ledger/light/executor"
+)
+
+type SendResult struct {
+	message             payload.Meta
+	objectID, requestID insolar.ID
+
+	dep struct {
+		sender    bus.Sender
+		filaments executor.FilamentCalculator
+	}
+}
+
+func NewSendResult(msg payload.Meta, objectID, requestID insolar.ID) *SendResult {
+	return &SendResult{
+		message:   msg,
+		objectID:  objectID,
+		requestID: requestID,
+	}
+}
+
+func (p *SendResult) Dep(sender bus.Sender, filaments executor.FilamentCalculator) {
+	p.dep.sender = sender
+	p.dep.filaments = filaments
+}
+
+func (p *SendResult) Proceed(ctx context.Context) error {
+	res, err := p.dep.filaments.Result(ctx, flow.Pulse(ctx), p.objectID, p.requestID)
+	if err != nil {
+		return errors.Wrap(err, "failed to find result")
+	}
+	if res == nil {
+		msg, err := payload.NewMessage(&payload.Error{
+			Text: "result not found",
+			Code: payload.CodeNotFound,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create reply")
+		}
+		p.dep.sender.Reply(ctx, p.message, msg)
+		return nil
+	}
+
+	buf, err := res.Record.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal result")
+	}
+	msg, err := payload.NewMessage(&payload.ResultInfo{
+		ObjectID: p.objectID,
+		ResultID: res.RecordID,
+		Result:   buf,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create reply")
+	}
+	p.dep.sender.Reply(ctx, p.message, msg)
+	return nil
+}
@@ -253,8 +253,17 @@ func (p *SetRequest) Proceed(ctx context.Context) error {
 	// Save updated index.
 	index.LifelineLastUsed = p.requestID.Pulse()
 	index.Lifeline.LatestRequest = &Filament.ID
-	if index.Lifeline.EarliestOpenRequest == nil {
-		pn := p.requestID.Pulse()
+	pn := p.requestID.Pulse()
+	if outgoing, ok := p.request.(*record.OutgoingRequest); ok && !outgoing.IsReadyAt(pn) {
+		// Scheduled request isn't pending until its pulse comes, hot data releases it then.
+		if index.Lifeline.EarliestScheduledRequest == nil {
+			index.Lifeline.EarliestScheduledRequest = &pn
+		}
+		executeAt := outgoing.ExecuteAt
+		if index.Lifeline.EarliestExecuteAt == nil || executeAt < *index.Lifeline.EarliestExecuteAt {
+			index.Lifeline.EarliestExecuteAt = &executeAt
+		}
+	} else if index.Lifeline.EarliestOpenRequest == nil {
 		index.Lifeline.EarliestOpenRequest = &pn
 	}
 	p.dep.indexes.Set(ctx, p.requestID.Pulse(), index)
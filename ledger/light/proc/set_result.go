@@ -148,7 +148,7 @@ func (p *SetResult) Proceed(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to find request being closed")
 	}
-	earliestPending, err := calcPending(opened, closedRequest.RecordID)
+	pending, err := calcPending(opened, closedRequest.RecordID, flow.Pulse(ctx))
 	if err != nil {
 		return errors.Wrap(err, "failed to calculate earliest pending")
 	}
@@ -222,7 +222,9 @@ func (p *SetResult) Proceed(ctx context.Context) error {
 		// Save updated index.
 		index.LifelineLastUsed = flow.Pulse(ctx)
 		index.Lifeline.LatestRequest = &Filament.ID
-		index.Lifeline.EarliestOpenRequest = earliestPending
+		index.Lifeline.EarliestOpenRequest = pending.earliestOpen
+		index.Lifeline.EarliestScheduledRequest = pending.earliestScheduled
+		index.Lifeline.EarliestExecuteAt = pending.earliestExecuteAt
 		p.dep.indexes.Set(ctx, resultID.Pulse(), index)
 		return nil
 	}()
@@ -232,7 +234,7 @@ func (p *SetResult) Proceed(ctx context.Context) error {
 
 	// Only incoming request cannot be a reason. We are only interested in potential reason requests.
 	if _, ok := record.Unwrap(&closedRequest.Record.Virtual).(*record.IncomingRequest); ok {
-		notifyDetached(ctx, p.dep.sender, opened, objectID, closedRequest.RecordID)
+		notifyDetached(ctx, p.dep.sender, opened, objectID, closedRequest.RecordID, flow.Pulse(ctx))
 	}
 
 	msg, err := payload.NewMessage(&payload.ResultInfo{
@@ -247,34 +249,55 @@ func (p *SetResult) Proceed(ctx context.Context) error {
 	return nil
 }
 
-// EarliestPending checks if received result closes earliest request. If so, it should return new earliest request or
-// nil if the last request was closed.
-func calcPending(opened []record.CompositeFilamentRecord, closedRequestID insolar.ID) (*insolar.PulseNumber, error) {
+// pendingPulses are pulses of requests left opened after the result is saved.
+type pendingPulses struct {
+	earliestOpen      *insolar.PulseNumber
+	earliestScheduled *insolar.PulseNumber
+	earliestExecuteAt *insolar.PulseNumber
+}
+
+// CalcPending calculates pulses of earliest requests left opened after received result closes provided request.
+// Scheduled requests that are not ready at provided pulse are not pending, they are tracked separately
+// until their pulse comes.
+func calcPending(
+	opened []record.CompositeFilamentRecord, closedRequestID insolar.ID, pulse insolar.PulseNumber,
+) (pendingPulses, error) {
 	// If we don't have pending requests BEFORE we try to save result, something went wrong.
 	if len(opened) == 0 {
-		return nil, errors.New("no requests in pending before result")
+		return pendingPulses{}, errors.New("no requests in pending before result")
 	}
 
-	currentEarliest := opened[0]
-	// Received result doesn't close earliest known request. It means the earliest Request is still the earliest.
-	if currentEarliest.RecordID != closedRequestID {
-		// If earliest request is not closed by received result and its the only request, something went wrong.
-		if len(opened) < 2 {
-			return nil, errors.New("result doesn't match with any pending requests")
+	var (
+		res    pendingPulses
+		closed bool
+	)
+	// Opened requests are ordered, so the first found request is the earliest one.
+	for _, req := range opened {
+		if req.RecordID == closedRequestID {
+			closed = true
+			continue
 		}
-		p := currentEarliest.RecordID.Pulse()
-		return &p, nil
-	}
 
-	// If earliest request is closed by received result and its the only request, no open requests left.
-	if len(opened) < 2 {
-		return nil, nil
+		pn := req.RecordID.Pulse()
+		outgoing, ok := record.Unwrap(&req.Record.Virtual).(*record.OutgoingRequest)
+		if ok && !outgoing.IsReadyAt(pulse) {
+			if res.earliestScheduled == nil {
+				res.earliestScheduled = &pn
+			}
+			executeAt := outgoing.ExecuteAt
+			if res.earliestExecuteAt == nil || executeAt < *res.earliestExecuteAt {
+				res.earliestExecuteAt = &executeAt
+			}
+			continue
+		}
+		if res.earliestOpen == nil {
+			res.earliestOpen = &pn
+		}
 	}
-
-	// Returning next earliest request.
-	newEarliest := opened[1]
-	p := newEarliest.RecordID.Pulse()
-	return &p, nil
+	if !closed {
+		return pendingPulses{}, errors.New("result doesn't match with any pending requests")
+	}
+	return res, nil
 }
 
 // FindClosed looks for request that was closed by provided result. Returns error if not found.
@@ -301,6 +324,7 @@ func notifyDetached(
 	sender bus.Sender,
 	opened []record.CompositeFilamentRecord,
 	objectID, closedRequestID insolar.ID,
+	pulse insolar.PulseNumber,
 ) {
 	for _, req := range opened {
 		outgoing, ok := record.Unwrap(&req.Record.Virtual).(*record.OutgoingRequest)
@@ -313,6 +337,10 @@ func notifyDetached(
 		if reasonRef := outgoing.ReasonRef(); *reasonRef.Record() != closedRequestID {
 			continue
 		}
+		// Scheduled requests are picked up by executor from pendings when their pulse comes.
+		if !outgoing.IsReadyAt(pulse) {
+			continue
+		}
 
 		buf, err := req.Record.Virtual.Marshal()
 		if err != nil {
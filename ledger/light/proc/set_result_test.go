@@ -152,6 +152,139 @@ func TestSetResult_Proceed(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSetResult_Proceed_ScheduledRequestNotPending(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	flowPulse := insolar.GenesisPulse.PulseNumber + 2
+	ctx := flow.TestContextWithPulse(
+		inslogger.TestContext(t),
+		flowPulse,
+	)
+
+	writeAccessor := executor.NewWriteAccessorMock(mc)
+	writeAccessor.BeginMock.Return(func() {}, nil)
+	pcs := testutils.NewPlatformCryptographyScheme()
+
+	sender := bus.NewSenderMock(t)
+	sender.ReplyMock.Return()
+
+	jetID := gen.JetID()
+	objectID := gen.ID()
+	requestID := gen.ID()
+	scheduledID := gen.IDWithPulse(flowPulse - 1)
+	executeAt := flowPulse + 10
+
+	resultRecord := &record.Result{
+		Request: *insolar.NewReference(requestID),
+		Object:  objectID,
+	}
+	virtual := record.Virtual{
+		Union: &record.Virtual_Result{
+			Result: resultRecord,
+		},
+	}
+	hash := record.HashVirtual(pcs.ReferenceHasher(), virtual)
+	resultID := *insolar.NewID(flow.Pulse(ctx), hash)
+	virtualBuf, err := virtual.Marshal()
+	require.NoError(t, err)
+
+	result := payload.SetResult{
+		Result: virtualBuf,
+	}
+	resultBuf, err := result.Marshal()
+	require.NoError(t, err)
+
+	msg := payload.Meta{
+		Payload: resultBuf,
+	}
+	LatestRequest := gen.IDWithPulse(flowPulse)
+	expectedFilament := record.PendingFilament{
+		RecordID:       resultID,
+		PreviousRecord: &LatestRequest,
+	}
+	hash = record.HashVirtual(pcs.ReferenceHasher(), record.Wrap(&expectedFilament))
+	expectedFilamentID := *insolar.NewID(resultID.Pulse(), hash)
+
+	indexes := object.NewMemoryIndexStorageMock(mc)
+	indexes.ForIDMock.Set(func(_ context.Context, pn insolar.PulseNumber, id insolar.ID) (record.Index, error) {
+		require.Equal(t, flow.Pulse(ctx), pn)
+		require.Equal(t, objectID, id)
+		earliestPN := requestID.Pulse()
+		scheduledPN := scheduledID.Pulse()
+		return record.Index{
+			Lifeline: record.Lifeline{
+				LatestRequest:            &LatestRequest,
+				EarliestOpenRequest:      &earliestPN,
+				EarliestScheduledRequest: &scheduledPN,
+				EarliestExecuteAt:        &executeAt,
+			},
+		}, nil
+	})
+	indexes.SetMock.Set(func(_ context.Context, pn insolar.PulseNumber, idx record.Index) {
+		require.Equal(t, resultID.Pulse(), pn)
+		// Scheduled request isn't pending until its pulse comes.
+		scheduledPN := scheduledID.Pulse()
+		expectedIndex := record.Index{
+			LifelineLastUsed: resultID.Pulse(),
+			Lifeline: record.Lifeline{
+				LatestRequest:            &expectedFilamentID,
+				EarliestOpenRequest:      nil,
+				EarliestScheduledRequest: &scheduledPN,
+				EarliestExecuteAt:        &executeAt,
+			},
+		}
+		require.Equal(t, expectedIndex, idx)
+	})
+	records := object.NewAtomicRecordModifierMock(mc)
+	records.SetAtomicMock.Set(func(_ context.Context, recs ...record.Material) (r error) {
+		require.Equal(t, 2, len(recs))
+
+		result := recs[0]
+		filament := recs[1]
+		require.Equal(t, resultID, result.ID)
+		require.Equal(t, resultRecord, record.Unwrap(&result.Virtual))
+
+		require.Equal(t, expectedFilamentID, filament.ID)
+		require.Equal(t, &expectedFilament, record.Unwrap(&filament.Virtual))
+		return nil
+	})
+
+	filaments := executor.NewFilamentCalculatorMock(mc)
+	filaments.ResultDuplicateMock.Set(func(_ context.Context, objID insolar.ID, resID insolar.ID, r record.Result) (*record.CompositeFilamentRecord, error) {
+		require.Equal(t, objectID, objID)
+		require.Equal(t, *resultRecord, r)
+		return nil, nil
+	})
+	filaments.OpenedRequestsMock.Set(func(_ context.Context, pn insolar.PulseNumber, objID insolar.ID, pendingOnly bool) ([]record.CompositeFilamentRecord, error) {
+		require.Equal(t, objectID, objID)
+		require.Equal(t, flow.Pulse(ctx), pn)
+		require.False(t, pendingOnly)
+
+		scheduled := record.Wrap(&record.OutgoingRequest{
+			ReturnMode: record.ReturnScheduled,
+			ExecuteAt:  executeAt,
+		})
+		v := record.Wrap(&record.IncomingRequest{})
+		return []record.CompositeFilamentRecord{
+			{
+				RecordID: scheduledID,
+				Record:   record.Material{Virtual: scheduled},
+			},
+			{
+				RecordID: requestID,
+				Record:   record.Material{Virtual: v},
+			},
+		}, nil
+	})
+
+	setResultProc := proc.NewSetResult(msg, jetID, *resultRecord, nil)
+	setResultProc.Dep(writeAccessor, sender, object.NewIndexLocker(), filaments, records, indexes, pcs)
+
+	err = setResultProc.Proceed(ctx)
+	require.NoError(t, err)
+}
+
 func TestSetResult_Proceed_ResultDuplicated(t *testing.T) {
 	t.Parallel()
 
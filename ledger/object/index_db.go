@@ -142,8 +142,33 @@ func (i *IndexDB) ForID(ctx context.Context, pn insolar.PulseNumber, objID insol
 	return *buck, nil
 }
 
+// ForPulse returns all lifeline buckets stored for provided PN.
 func (i *IndexDB) ForPulse(ctx context.Context, pn insolar.PulseNumber) []record.Index {
-	panic("implement me")
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	it := i.db.NewIterator(&indexKey{objID: insolar.ID{}, pn: pn}, false)
+	defer it.Close()
+
+	var res []record.Index
+	for it.Next() {
+		key := newIndexKey(it.Key())
+		if key.pn != pn {
+			break
+		}
+		buff, err := it.Value()
+		if err != nil {
+			inslogger.FromContext(ctx).Errorf("can't read index bucket: %+v", key)
+			continue
+		}
+		bucket := record.Index{}
+		if err := bucket.Unmarshal(buff); err != nil {
+			inslogger.FromContext(ctx).Errorf("can't unmarshal index bucket: %+v", key)
+			continue
+		}
+		res = append(res, bucket)
+	}
+	return res
 }
 
 func (i *IndexDB) setBucket(pn insolar.PulseNumber, objID insolar.ID, bucket *record.Index) error {
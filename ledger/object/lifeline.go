@@ -64,5 +64,15 @@ func CloneLifeline(idx record.Lifeline) record.Lifeline {
 		idx.EarliestOpenRequest = &tmp
 	}
 
+	if idx.EarliestScheduledRequest != nil {
+		tmp := *idx.EarliestScheduledRequest
+		idx.EarliestScheduledRequest = &tmp
+	}
+
+	if idx.EarliestExecuteAt != nil {
+		tmp := *idx.EarliestExecuteAt
+		idx.EarliestExecuteAt = &tmp
+	}
+
 	return idx
 }
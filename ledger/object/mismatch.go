@@ -0,0 +1,120 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package object
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/store"
+)
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/object.MismatchAccessor -o ./ -s _mock.go -g
+
+// MismatchAccessor provides access to validation mismatches reported by virtual validators.
+type MismatchAccessor interface {
+	// ForObject returns mismatches reported for provided object ordered by validated pulse.
+	ForObject(ctx context.Context, object insolar.Reference) ([]payload.ValidationMismatch, error)
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/object.MismatchModifier -o ./ -s _mock.go -g
+
+// MismatchModifier provides methods for saving validation mismatches.
+type MismatchModifier interface {
+	// Set saves mismatch. Repeated report of the same validator for the same request overrides previous one.
+	Set(ctx context.Context, mismatch payload.ValidationMismatch) error
+}
+
+// MismatchDB is a DB storage for validation mismatches.
+type MismatchDB struct {
+	db store.DB
+}
+
+// NewMismatchDB creates new instance of MismatchDB.
+func NewMismatchDB(db store.DB) *MismatchDB {
+	return &MismatchDB{db: db}
+}
+
+type mismatchKey struct {
+	object    insolar.Reference
+	pulse     insolar.PulseNumber
+	request   insolar.Reference
+	validator insolar.Reference
+}
+
+func (k mismatchKey) Scope() store.Scope {
+	return store.ScopeValidationMismatch
+}
+
+func (k mismatchKey) ID() []byte {
+	// Object goes first so mismatches of the object are stored sequentially ordered by pulse.
+	return bytes.Join([][]byte{k.object.Bytes(), k.pulse.Bytes(), k.request.Bytes(), k.validator.Bytes()}, nil)
+}
+
+type mismatchPrefix insolar.Reference
+
+func (k mismatchPrefix) Scope() store.Scope {
+	return store.ScopeValidationMismatch
+}
+
+func (k mismatchPrefix) ID() []byte {
+	return insolar.Reference(k).Bytes()
+}
+
+// Set saves mismatch to the db.
+func (m *MismatchDB) Set(ctx context.Context, mismatch payload.ValidationMismatch) error {
+	buf, err := mismatch.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal mismatch")
+	}
+	key := mismatchKey{
+		object:    mismatch.Object,
+		pulse:     mismatch.Pulse,
+		request:   mismatch.Request,
+		validator: mismatch.Validator,
+	}
+	return m.db.Set(key, buf)
+}
+
+// ForObject returns mismatches reported for provided object ordered by validated pulse.
+func (m *MismatchDB) ForObject(ctx context.Context, object insolar.Reference) ([]payload.ValidationMismatch, error) {
+	prefix := object.Bytes()
+	it := m.db.NewIterator(mismatchPrefix(object), false)
+	defer it.Close()
+
+	var res []payload.ValidationMismatch
+	for it.Next() {
+		if !bytes.HasPrefix(it.Key(), prefix) {
+			break
+		}
+		buf, err := it.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read mismatch")
+		}
+		mismatch := payload.ValidationMismatch{}
+		err = mismatch.Unmarshal(buf)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal mismatch")
+		}
+		res = append(res, mismatch)
+	}
+	return res, nil
+}
@@ -0,0 +1,83 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package object
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/store"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+func TestMismatchDB_ForObject(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	db, err := store.NewBadgerDB(tmpdir)
+	require.NoError(t, err)
+	defer db.Stop(ctx)
+
+	mismatches := NewMismatchDB(db)
+
+	object := gen.Reference()
+	pn := gen.PulseNumber()
+	later := payload.ValidationMismatch{
+		Object:    object,
+		Request:   gen.Reference(),
+		Pulse:     pn + 10,
+		Executor:  gen.Reference(),
+		Validator: gen.Reference(),
+		Reason:    "result hash mismatch",
+	}
+	earlier := payload.ValidationMismatch{
+		Object:    object,
+		Request:   gen.Reference(),
+		Pulse:     pn,
+		Executor:  gen.Reference(),
+		Validator: gen.Reference(),
+		Reason:    "failed to execute",
+	}
+	other := payload.ValidationMismatch{
+		Object:    gen.Reference(),
+		Request:   gen.Reference(),
+		Pulse:     pn,
+		Validator: gen.Reference(),
+	}
+	require.NoError(t, mismatches.Set(ctx, later))
+	require.NoError(t, mismatches.Set(ctx, earlier))
+	require.NoError(t, mismatches.Set(ctx, other))
+	// Repeated report overrides previous one.
+	require.NoError(t, mismatches.Set(ctx, earlier))
+
+	res, err := mismatches.ForObject(ctx, object)
+	require.NoError(t, err)
+	require.Equal(t, []payload.ValidationMismatch{earlier, later}, res)
+
+	res, err = mismatches.ForObject(ctx, gen.Reference())
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
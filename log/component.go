@@ -0,0 +1,100 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package log
+
+import (
+	"sync"
+
+	"github.com/rs/zerolog"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// ComponentFieldName is a log field name for component loggers.
+const ComponentFieldName = "component"
+
+// componentLevels holds process-wide log level overrides for named loggers.
+var componentLevels = struct {
+	sync.RWMutex
+	levels map[string]insolar.LogLevel
+}{levels: map[string]insolar.LogLevel{}}
+
+// SetComponentLevel overrides log level for loggers created by ForComponent with provided name.
+// Empty level removes override, so component logs with level of its parent logger.
+func SetComponentLevel(component string, level string) error {
+	levelNumber, err := insolar.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	componentLevels.Lock()
+	defer componentLevels.Unlock()
+
+	if levelNumber == insolar.NoLevel {
+		delete(componentLevels.levels, component)
+		return nil
+	}
+	componentLevels.levels[component] = levelNumber
+	return nil
+}
+
+// ComponentLevels returns copy of all component log level overrides.
+func ComponentLevels() map[string]string {
+	componentLevels.RLock()
+	defer componentLevels.RUnlock()
+
+	res := make(map[string]string, len(componentLevels.levels))
+	for name, level := range componentLevels.levels {
+		res[name] = level.String()
+	}
+	return res
+}
+
+func componentLevel(component string) (zerolog.Level, bool) {
+	componentLevels.RLock()
+	level, ok := componentLevels.levels[component]
+	componentLevels.RUnlock()
+	if !ok {
+		return zerolog.NoLevel, false
+	}
+
+	zlevel, err := InternalLevelToZerologLevel(level)
+	if err != nil {
+		return zerolog.NoLevel, false
+	}
+	return zlevel, true
+}
+
+// ForComponent returns copy of logger bound to named component, component of already bound logger is replaced.
+// Level of returned logger can be changed at runtime with SetComponentLevel.
+func ForComponent(logger insolar.Logger, component string) insolar.Logger {
+	if z, ok := logger.(*zerologAdapter); ok {
+		if z.component == component {
+			return z
+		}
+		// component field is added on write, so the logger never has more than one
+		zCopy := *z
+		zCopy.component = component
+		return &zCopy
+	}
+	return logger.WithField(ComponentFieldName, component)
+}
+
+// Component returns global logger bound to named component.
+func Component(component string) insolar.Logger {
+	return ForComponent(GlobalLogger, component)
+}
@@ -0,0 +1,89 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+)
+
+func TestComponentLevel_Override(t *testing.T) {
+	logger, err := NewLog(configuration.Log{Level: "info", Adapter: "zerolog", Formatter: "json"})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	logger = ForComponent(logger.WithOutput(&buf), "test-component")
+
+	logger.Debug("hidden")
+	require.NotContains(t, buf.String(), "hidden")
+	require.False(t, logger.Is(insolar.DebugLevel))
+
+	require.NoError(t, SetComponentLevel("test-component", "debug"))
+	defer func() { require.NoError(t, SetComponentLevel("test-component", "")) }()
+
+	logger.Debug("visible")
+	require.Contains(t, buf.String(), "visible")
+	require.Contains(t, buf.String(), `"component":"test-component"`)
+	require.True(t, logger.Is(insolar.DebugLevel))
+	require.Equal(t, "debug", ComponentLevels()["test-component"])
+}
+
+func TestComponentLevel_FromConfig(t *testing.T) {
+	_, err := NewLog(configuration.Log{
+		Level:      "info",
+		Adapter:    "zerolog",
+		Formatter:  "json",
+		Components: map[string]string{"cfg-component": "error"},
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, SetComponentLevel("cfg-component", "")) }()
+
+	require.Equal(t, "error", ComponentLevels()["cfg-component"])
+
+	_, err = NewLog(configuration.Log{
+		Level:      "info",
+		Adapter:    "zerolog",
+		Formatter:  "json",
+		Components: map[string]string{"cfg-component": "unknown"},
+	})
+	require.Error(t, err)
+}
+
+func TestForComponent_Rebind(t *testing.T) {
+	logger, err := NewLog(configuration.Log{Level: "info", Adapter: "zerolog", Formatter: "json"})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	logger = ForComponent(logger.WithOutput(&buf), "test-component")
+	logger = ForComponent(logger.WithField("key", "value"), "test-component")
+
+	logger.Info("message")
+	require.Equal(t, 1, bytes.Count(buf.Bytes(), []byte(`"component"`)))
+
+	// binding to another component replaces the field
+	buf.Reset()
+	logger = ForComponent(logger, "other-component")
+	logger.Info("message")
+	require.Equal(t, 1, bytes.Count(buf.Bytes(), []byte(`"component"`)))
+	require.Contains(t, buf.String(), `"component":"other-component"`)
+	require.NotContains(t, buf.String(), "test-component")
+}
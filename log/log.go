@@ -74,6 +74,16 @@ func NewLog(cfg configuration.Log) (insolar.Logger, error) {
 		logger, err = logger.WithLevel(cfg.Level)
 	}
 
+	if err == nil {
+		for component, level := range cfg.Components {
+			err = SetComponentLevel(component, level)
+			if err != nil {
+				err = errors.Wrapf(err, "invalid level for component %s", component)
+				break
+			}
+		}
+	}
+
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid logger config")
 	}
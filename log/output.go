@@ -0,0 +1,63 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package log
+
+import (
+	"io"
+	"log/syslog"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/insolar/insolar/configuration"
+)
+
+const (
+	stderrOutput = "stderr"
+	fileOutput   = "file"
+)
+
+// newOutput creates primary log destination from configuration.
+func newOutput(cfg configuration.Log) (io.Writer, error) {
+	switch strings.ToLower(cfg.OutputType) {
+	case "", stderrOutput:
+		return os.Stderr, nil
+	case fileOutput:
+		rf, err := newRotatingFile(cfg.File)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create file output")
+		}
+		return rf, nil
+	}
+	return nil, errors.New("unknown output type " + cfg.OutputType)
+}
+
+// newSink creates optional secondary log destination from configuration.
+// Messages are written to syslog with priority corresponding to log level.
+func newSink(cfg configuration.LogSyslog) (io.Writer, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	w, err := syslog.Dial(cfg.Network, cfg.Address, syslog.LOG_INFO|syslog.LOG_DAEMON, cfg.Tag)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to syslog")
+	}
+	return zerolog.SyslogLevelWriter(w), nil
+}
@@ -0,0 +1,266 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package log
+
+import (
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+)
+
+const (
+	megabyte              = 1024 * 1024
+	backupTimestampFormat = "2006-01-02T15-04-05.000"
+	compressSuffix        = ".gz"
+)
+
+// rotatingFile is an io.WriteCloser which writes to file and rotates it by size and time.
+// Rotated files are renamed with timestamp suffix, optionally compressed and removed by age and count.
+type rotatingFile struct {
+	cfg configuration.LogFile
+
+	mu       sync.Mutex
+	file     *os.File
+	size     int64
+	openedAt time.Time
+
+	millMu sync.Mutex
+	now    func() time.Time
+}
+
+func newRotatingFile(cfg configuration.LogFile) (*rotatingFile, error) {
+	if cfg.Path == "" {
+		return nil, errors.New("log file path is not set")
+	}
+	rf := &rotatingFile{
+		cfg: cfg,
+		now: time.Now,
+	}
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+	return rf, nil
+}
+
+// Write implements io.Writer.
+func (rf *rotatingFile) Write(p []byte) (int, error) {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	if rf.file == nil {
+		if err := rf.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	if rf.shouldRotate(int64(len(p))) {
+		if err := rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := rf.file.Write(p)
+	rf.size += int64(n)
+	return n, err
+}
+
+// Close implements io.Closer.
+func (rf *rotatingFile) Close() error {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	if rf.file == nil {
+		return nil
+	}
+	err := rf.file.Close()
+	rf.file = nil
+	return err
+}
+
+func (rf *rotatingFile) shouldRotate(writeLen int64) bool {
+	if rf.size == 0 {
+		return false
+	}
+	if rf.cfg.MaxSize > 0 && rf.size+writeLen > int64(rf.cfg.MaxSize)*megabyte {
+		return true
+	}
+	if rf.cfg.RotationInterval > 0 && rf.now().Sub(rf.openedAt) >= rf.cfg.RotationInterval {
+		return true
+	}
+	return false
+}
+
+func (rf *rotatingFile) open() error {
+	err := os.MkdirAll(filepath.Dir(rf.cfg.Path), 0755)
+	if err != nil {
+		return errors.Wrap(err, "failed to create log directory")
+	}
+
+	f, err := os.OpenFile(rf.cfg.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to open log file")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "failed to stat log file")
+	}
+
+	rf.file = f
+	rf.size = info.Size()
+	rf.openedAt = rf.now()
+	return nil
+}
+
+func (rf *rotatingFile) rotate() error {
+	if err := rf.file.Close(); err != nil {
+		return errors.Wrap(err, "failed to close log file")
+	}
+	rf.file = nil
+
+	err := os.Rename(rf.cfg.Path, rf.backupName(rf.now()))
+	if err != nil {
+		return errors.Wrap(err, "failed to rename log file")
+	}
+	if err := rf.open(); err != nil {
+		return err
+	}
+
+	go rf.mill()
+	return nil
+}
+
+// backupName returns free name of rotated file with UTC timestamp t. Timestamps of files rotated within the same
+// millisecond are moved forward, so the names don't collide.
+func (rf *rotatingFile) backupName(t time.Time) string {
+	dir, prefix, ext := rf.nameParts()
+	t = t.UTC()
+	for {
+		name := filepath.Join(dir, prefix+t.Format(backupTimestampFormat)+ext)
+		if !fileExists(name) && !fileExists(name+compressSuffix) {
+			return name
+		}
+		t = t.Add(time.Millisecond)
+	}
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return !os.IsNotExist(err)
+}
+
+func (rf *rotatingFile) nameParts() (dir string, prefix string, ext string) {
+	dir = filepath.Dir(rf.cfg.Path)
+	name := filepath.Base(rf.cfg.Path)
+	ext = filepath.Ext(name)
+	prefix = strings.TrimSuffix(name, ext) + "-"
+	return dir, prefix, ext
+}
+
+type backupFile struct {
+	path      string
+	timestamp time.Time
+}
+
+// backups returns rotated files sorted from newest to oldest.
+func (rf *rotatingFile) backups() ([]backupFile, error) {
+	dir, prefix, ext := rf.nameParts()
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []backupFile
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		ts := strings.TrimPrefix(strings.TrimSuffix(strings.TrimSuffix(name, compressSuffix), ext), prefix)
+		// timestamps are formatted in UTC
+		t, err := time.Parse(backupTimestampFormat, ts)
+		if err != nil {
+			continue
+		}
+		res = append(res, backupFile{path: filepath.Join(dir, name), timestamp: t})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].timestamp.After(res[j].timestamp)
+	})
+	return res, nil
+}
+
+// mill compresses and removes outdated rotated files.
+func (rf *rotatingFile) mill() {
+	rf.millMu.Lock()
+	defer rf.millMu.Unlock()
+
+	files, err := rf.backups()
+	if err != nil {
+		return
+	}
+
+	cutoff := rf.now().Add(-rf.cfg.MaxAge)
+	for i, f := range files {
+		outdated := rf.cfg.MaxAge > 0 && f.timestamp.Before(cutoff)
+		excess := rf.cfg.MaxBackups > 0 && i >= rf.cfg.MaxBackups
+		if outdated || excess {
+			_ = os.Remove(f.path)
+			continue
+		}
+		if rf.cfg.Compress && !strings.HasSuffix(f.path, compressSuffix) {
+			_ = compressFile(f.path)
+		}
+	}
+}
+
+func compressFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(path+compressSuffix, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(dst)
+	_, err = io.Copy(gz, src)
+	if err == nil {
+		err = gz.Close()
+	}
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(path + compressSuffix)
+		return err
+	}
+	return os.Remove(path)
+}
@@ -0,0 +1,169 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package log
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+)
+
+func TestRotatingFile_RotateBySize(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	rf, err := newRotatingFile(configuration.LogFile{
+		Path:    filepath.Join(dir, "insolar.log"),
+		MaxSize: 1,
+	})
+	require.NoError(t, err)
+	defer rf.Close()
+
+	now := time.Now()
+	rf.now = func() time.Time { return now }
+
+	line := []byte(strings.Repeat("x", megabyte/2-1) + "\n")
+	for i := 0; i < 3; i++ {
+		_, err = rf.Write(line)
+		require.NoError(t, err)
+		now = now.Add(time.Second)
+	}
+
+	backups, err := rf.backups()
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+}
+
+func TestRotatingFile_MillRemovesOutdated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	rf, err := newRotatingFile(configuration.LogFile{
+		Path:       filepath.Join(dir, "insolar.log"),
+		MaxAge:     time.Hour,
+		MaxBackups: 2,
+		Compress:   true,
+	})
+	require.NoError(t, err)
+	defer rf.Close()
+
+	now := time.Now()
+	rf.now = func() time.Time { return now }
+	for _, age := range []time.Duration{2 * time.Hour, 3 * time.Minute, 2 * time.Minute, time.Minute} {
+		name := rf.backupName(now.Add(-age))
+		require.NoError(t, ioutil.WriteFile(name, []byte("data"), 0644))
+	}
+
+	rf.mill()
+
+	backups, err := rf.backups()
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+	for _, b := range backups {
+		require.True(t, strings.HasSuffix(b.path, compressSuffix))
+	}
+}
+
+func TestRotatingFile_SameMillisecond(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	rf, err := newRotatingFile(configuration.LogFile{
+		Path:    filepath.Join(dir, "insolar.log"),
+		MaxSize: 1,
+	})
+	require.NoError(t, err)
+	defer rf.Close()
+
+	now := time.Now()
+	rf.now = func() time.Time { return now }
+
+	line := []byte(strings.Repeat("x", megabyte/2-1) + "\n")
+	for i := 0; i < 5; i++ {
+		_, err = rf.Write(line)
+		require.NoError(t, err)
+	}
+
+	// every rotation keeps its own backup
+	backups, err := rf.backups()
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+}
+
+func TestRotatingFile_MillMaxAgeInLocalZone(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	rf, err := newRotatingFile(configuration.LogFile{
+		Path:   filepath.Join(dir, "insolar.log"),
+		MaxAge: time.Hour,
+	})
+	require.NoError(t, err)
+	defer rf.Close()
+
+	for _, offset := range []int{-5, 5} {
+		now := time.Now().In(time.FixedZone("test", offset*3600))
+		rf.now = func() time.Time { return now }
+		kept := rf.backupName(now.Add(-50 * time.Minute))
+		require.NoError(t, ioutil.WriteFile(kept, []byte("data"), 0644))
+		outdated := rf.backupName(now.Add(-70 * time.Minute))
+		require.NoError(t, ioutil.WriteFile(outdated, []byte("data"), 0644))
+
+		rf.mill()
+
+		backups, err := rf.backups()
+		require.NoError(t, err)
+		require.Len(t, backups, 1)
+		require.Equal(t, kept, backups[0].path)
+		require.NoError(t, os.Remove(kept))
+	}
+}
+
+func TestNewLog_FileOutput(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "node", "insolar.log")
+	logger, err := NewLog(configuration.Log{
+		Level:      "info",
+		Adapter:    "zerolog",
+		Formatter:  "json",
+		OutputType: "file",
+		File:       configuration.LogFile{Path: path},
+	})
+	require.NoError(t, err)
+
+	logger.WithField("traceid", "test-trace").Info("to file")
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "to file")
+	require.Contains(t, string(data), `"traceid":"test-trace"`)
+	require.Contains(t, string(data), `"caller"`)
+}
@@ -17,6 +17,7 @@
 package log
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -56,6 +57,11 @@ type zerologAdapter struct {
 	logger       zerolog.Logger
 	level        zerolog.Level
 	callerConfig callerHookConfig
+	component    string
+
+	// output is a primary destination before formatting, sink is optional secondary destination.
+	output io.Writer
+	sink   io.Writer
 }
 
 type loglevelChangeHandler struct {
@@ -93,6 +99,41 @@ func (h *loglevelChangeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	_, _ = fmt.Fprintf(w, "New log level: '%v'\n", levelStr)
 }
 
+type componentLevelChangeHandler struct {
+}
+
+func NewComponentLevelChangeHandler() http.Handler {
+	handler := &componentLevelChangeHandler{}
+	return handler
+}
+
+// ServeHTTP is an HTTP handler that changes log level of particular component.
+// Without 'component' parameter it returns all component log level overrides.
+// Empty 'level' parameter removes override for component.
+func (h *componentLevelChangeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	values := r.URL.Query()
+	component := values.Get("component")
+	if component == "" {
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(ComponentLevels())
+		if err != nil {
+			w.WriteHeader(500)
+		}
+		return
+	}
+
+	levelStr := values.Get("level")
+	err := SetComponentLevel(component, levelStr)
+	if err != nil {
+		w.WriteHeader(500)
+		_, _ = fmt.Fprintf(w, "Invalid level '%v': %v\n", levelStr, err)
+		return
+	}
+
+	w.WriteHeader(200)
+	_, _ = fmt.Fprintf(w, "New log level for component '%v': '%v'\n", component, levelStr)
+}
+
 func InternalLevelToZerologLevel(level insolar.LogLevel) (zerolog.Level, error) {
 	switch level {
 	case insolar.DebugLevel:
@@ -111,9 +152,9 @@ func InternalLevelToZerologLevel(level insolar.LogLevel) (zerolog.Level, error)
 	return zerolog.NoLevel, errors.New("Unknown internal level")
 }
 
-func newDefaultTextOutput() io.Writer {
+func newDefaultTextOutput(out io.Writer) io.Writer {
 	return zerolog.ConsoleWriter{
-		Out:          os.Stderr,
+		Out:          out,
 		NoColor:      true,
 		TimeFormat:   timestampFormat,
 		PartsOrder:   fieldsOrder,
@@ -121,14 +162,14 @@ func newDefaultTextOutput() io.Writer {
 	}
 }
 
-func selectFormatter(format insolar.LogFormat) (io.Writer, error) {
+func selectFormatter(format insolar.LogFormat, out io.Writer) (io.Writer, error) {
 	var output io.Writer
 
 	switch format {
 	case insolar.TextFormat:
-		output = newDefaultTextOutput()
+		output = newDefaultTextOutput(out)
 	case insolar.JSONFormat:
-		output = os.Stderr
+		output = out
 	default:
 		return nil, errors.New("unknown formatter " + format.String())
 	}
@@ -136,21 +177,46 @@ func selectFormatter(format insolar.LogFormat) (io.Writer, error) {
 	return output, nil
 }
 
+// withSink adds secondary sink to formatted primary output.
+func withSink(output io.Writer, sink io.Writer) io.Writer {
+	if sink == nil {
+		return output
+	}
+	return zerolog.MultiLevelWriter(output, sink)
+}
+
 func newZerologAdapter(cfg configuration.Log) (*zerologAdapter, error) {
 	format, err := insolar.ParseFormat(cfg.Formatter)
 	if err != nil {
 		return nil, err
 	}
 
-	output, err := selectFormatter(format)
+	out, err := newOutput(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	logger := zerolog.New(output).Level(zerolog.InfoLevel).With().Timestamp().Logger()
+	sink, err := newSink(cfg.Syslog)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := selectFormatter(format, out)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := zerolog.New(withSink(output, sink)).Level(zerolog.InfoLevel).With().Timestamp().Logger()
+	if cfg.Sampling.DebugEvery > 1 {
+		logger = logger.Sample(zerolog.LevelSampler{
+			DebugSampler: &zerolog.BasicSampler{N: cfg.Sampling.DebugEvery},
+		})
+	}
 	za := &zerologAdapter{
 		logger: logger,
 		level:  zerolog.InfoLevel,
+		output: out,
+		sink:   sink,
 		callerConfig: callerHookConfig{
 			enabled:        true,
 			skipFrameCount: defaultCallerSkipFrameCount,
@@ -165,18 +231,16 @@ func (z *zerologAdapter) WithFields(fields map[string]interface{}) insolar.Logge
 	for key, value := range fields {
 		zCtx = zCtx.Interface(key, value)
 	}
-	return &zerologAdapter{
-		logger:       zCtx.Logger(),
-		callerConfig: z.callerConfig,
-	}
+	zCopy := *z
+	zCopy.logger = zCtx.Logger()
+	return &zCopy
 }
 
 // WithField return copy of adapter with predefined single field.
 func (z *zerologAdapter) WithField(key string, value interface{}) insolar.Logger {
-	return &zerologAdapter{
-		logger:       z.logger.With().Interface(key, value).Logger(),
-		callerConfig: z.callerConfig,
-	}
+	zCopy := *z
+	zCopy.logger = z.logger.With().Interface(key, value).Logger()
+	return &zCopy
 }
 
 // Debug logs a message at level Debug on the stdout.
@@ -267,6 +331,8 @@ func (z *zerologAdapter) WithLevelNumber(level insolar.LogLevel) (insolar.Logger
 func (z *zerologAdapter) WithOutput(w io.Writer) insolar.Logger {
 	zCopy := *z
 	zCopy.logger = z.logger.Output(w)
+	zCopy.output = w
+	zCopy.sink = nil
 	return &zCopy
 }
 
@@ -297,16 +363,28 @@ func (z *zerologAdapter) WithFuncName(flag bool) insolar.Logger {
 
 // WithFormat sets logger output format
 func (z *zerologAdapter) WithFormat(format insolar.LogFormat) (insolar.Logger, error) {
-	output, err := selectFormatter(format)
+	out := z.output
+	if out == nil {
+		out = os.Stderr
+	}
+	output, err := selectFormatter(format, out)
 	if err != nil {
 		return nil, err
 	}
 
-	return z.WithOutput(output), nil
+	zCopy := *z
+	zCopy.logger = z.logger.Output(withSink(output, z.sink))
+	return &zCopy, nil
 }
 
 func (z *zerologAdapter) loggerWithHooks() *zerolog.Logger {
 	l := z.logger
+	if z.component != "" {
+		l = l.With().Str(ComponentFieldName, z.component).Logger()
+	}
+	if level, ok := z.componentLevel(); ok {
+		l = l.Level(level)
+	}
 	if z.callerConfig.funcname {
 		l = l.Hook(newCallerHook(z.callerConfig.skipFrameCount + 2))
 	} else if z.callerConfig.enabled {
@@ -321,5 +399,18 @@ func (z *zerologAdapter) Is(level insolar.LogLevel) bool {
 		panic(err)
 	}
 
-	return zerologLevel >= z.level && zerologLevel >= zerolog.GlobalLevel()
+	minLevel := z.level
+	if override, ok := z.componentLevel(); ok {
+		minLevel = override
+	}
+
+	return zerologLevel >= minLevel && zerologLevel >= zerolog.GlobalLevel()
+}
+
+// componentLevel returns runtime level override for component logger.
+func (z *zerologAdapter) componentLevel() (zerolog.Level, bool) {
+	if z.component == "" {
+		return zerolog.NoLevel, false
+	}
+	return componentLevel(z.component)
 }
@@ -39,6 +39,9 @@ type Client interface {
 	// GetAbandonedRequest returns an incoming or outgoing request for an object.
 	GetAbandonedRequest(ctx context.Context, objectRef, reqRef insolar.Reference) (record.Request, error)
 
+	// GetResult returns result record of the request saved on ledger.
+	GetResult(ctx context.Context, objectRef, reqRef insolar.Reference) (*record.Result, error)
+
 	// GetPendings returns pending request IDs of an object.
 	GetPendings(ctx context.Context, objectRef insolar.Reference) ([]insolar.Reference, error)
 
@@ -56,6 +59,11 @@ type Client interface {
 	// provide methods for fetching all related data.
 	GetObject(ctx context.Context, head insolar.Reference) (ObjectDescriptor, error)
 
+	// GetState returns state record of the object by provided state id.
+	//
+	// Unlike GetObject, it returns any state of the object, not only the latest one.
+	GetState(ctx context.Context, head insolar.Reference, state insolar.ID) (record.State, error)
+
 	// DeployCode creates new code record in storage.
 	//
 	// Code records are used to activate prototype.
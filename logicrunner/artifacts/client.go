@@ -361,6 +361,74 @@ func (m *client) GetObject(
 	return desc, nil
 }
 
+// GetState returns state record of the object by provided state id.
+func (m *client) GetState(
+	ctx context.Context,
+	head insolar.Reference,
+	state insolar.ID,
+) (record.State, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetState")
+	instrumenter := instrument(ctx, "GetState").err(&err)
+	defer func() {
+		if err != nil {
+			instracer.AddError(span, err)
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	msg, err := payload.NewMessage(&payload.GetObject{
+		ObjectID: *head.Record(),
+		StateID:  state,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal message")
+	}
+
+	r := bus.NewRetrySender(m.sender, m.PulseAccessor, 3)
+	reps, done := r.SendRole(ctx, msg, insolar.DynamicRoleLightExecutor, head)
+	defer done()
+
+	for rep := range reps {
+		replyPayload, err := payload.UnmarshalFromMeta(rep.Payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal reply")
+		}
+
+		switch p := replyPayload.(type) {
+		case *payload.Index:
+			// Index is sent before the state, it's not needed here.
+			continue
+		case *payload.State:
+			rec := record.Material{}
+			err = rec.Unmarshal(p.Record)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to unmarshal state")
+			}
+			s, ok := record.Unwrap(&rec.Virtual).(record.State)
+			if !ok {
+				err = errors.New("wrong state record")
+				return nil, err
+			}
+			return s, nil
+		case *payload.Error:
+			if p.Code == payload.CodeDeactivated {
+				err = insolar.ErrDeactivated
+				return nil, err
+			}
+			err = errors.New(p.Text)
+			return nil, err
+		default:
+			err = fmt.Errorf("GetState: unexpected reply: %#v", p)
+			return nil, err
+		}
+	}
+
+	err = ErrNoReply
+	return nil, err
+}
+
 func (m *client) GetAbandonedRequest(
 	ctx context.Context, object, reqRef insolar.Reference,
 ) (record.Request, error) {
@@ -406,6 +474,51 @@ func (m *client) GetAbandonedRequest(
 	return result, nil
 }
 
+// GetResult returns result record of the request saved on ledger.
+func (m *client) GetResult(
+	ctx context.Context, object, reqRef insolar.Reference,
+) (*record.Result, error) {
+	var err error
+	instrumenter := instrument(ctx, "GetResult").err(&err)
+	ctx, span := instracer.StartSpan(ctx, "artifacts.GetResult")
+	defer func() {
+		if err != nil {
+			instracer.AddError(span, err)
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	pl, err := m.sendToLight(ctx, m.sender, &payload.GetResult{
+		ObjectID:  *object.Record(),
+		RequestID: *reqRef.Record(),
+	}, object)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send GetResult")
+	}
+
+	switch p := pl.(type) {
+	case *payload.ResultInfo:
+		rec := record.Material{}
+		err = rec.Unmarshal(p.Result)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal result record")
+		}
+		res, ok := record.Unwrap(&rec.Virtual).(*record.Result)
+		if !ok {
+			err = fmt.Errorf("GetResult: unexpected record %T", record.Unwrap(&rec.Virtual))
+			return nil, err
+		}
+		return res, nil
+	case *payload.Error:
+		err = errors.New(p.Text)
+		return nil, err
+	default:
+		err = fmt.Errorf("GetResult: unexpected reply %T", pl)
+		return nil, err
+	}
+}
+
 // GetPendings returns a list of pending requests
 func (m *client) GetPendings(ctx context.Context, object insolar.Reference) ([]insolar.Reference, error) {
 	var err error
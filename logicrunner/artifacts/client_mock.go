@@ -54,6 +54,18 @@ type ClientMock struct {
 	beforeGetPendingsCounter uint64
 	GetPendingsMock          mClientMockGetPendings
 
+	funcGetResult          func(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference) (rp1 *record.Result, err error)
+	inspectFuncGetResult   func(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference)
+	afterGetResultCounter  uint64
+	beforeGetResultCounter uint64
+	GetResultMock          mClientMockGetResult
+
+	funcGetState          func(ctx context.Context, head insolar.Reference, state insolar.ID) (s1 record.State, err error)
+	inspectFuncGetState   func(ctx context.Context, head insolar.Reference, state insolar.ID)
+	afterGetStateCounter  uint64
+	beforeGetStateCounter uint64
+	GetStateMock          mClientMockGetState
+
 	funcHasPendings          func(ctx context.Context, object insolar.Reference) (b1 bool, err error)
 	inspectFuncHasPendings   func(ctx context.Context, object insolar.Reference)
 	afterHasPendingsCounter  uint64
@@ -128,6 +140,12 @@ func NewClientMock(t minimock.Tester) *ClientMock {
 	m.GetPendingsMock = mClientMockGetPendings{mock: m}
 	m.GetPendingsMock.callArgs = []*ClientMockGetPendingsParams{}
 
+	m.GetResultMock = mClientMockGetResult{mock: m}
+	m.GetResultMock.callArgs = []*ClientMockGetResultParams{}
+
+	m.GetStateMock = mClientMockGetState{mock: m}
+	m.GetStateMock.callArgs = []*ClientMockGetStateParams{}
+
 	m.HasPendingsMock = mClientMockHasPendings{mock: m}
 	m.HasPendingsMock.callArgs = []*ClientMockHasPendingsParams{}
 
@@ -228,7 +246,7 @@ func (mmActivatePrototype *mClientMockActivatePrototype) Return(err error) *Clie
 	return mmActivatePrototype.mock
 }
 
-//Set uses given function f to mock the Client.ActivatePrototype method
+// Set uses given function f to mock the Client.ActivatePrototype method
 func (mmActivatePrototype *mClientMockActivatePrototype) Set(f func(ctx context.Context, request insolar.Reference, parent insolar.Reference, code insolar.Reference, memory []byte) (err error)) *ClientMock {
 	if mmActivatePrototype.defaultExpectation != nil {
 		mmActivatePrototype.mock.t.Fatalf("Default expectation is already set for the Client.ActivatePrototype method")
@@ -272,15 +290,15 @@ func (mmActivatePrototype *ClientMock) ActivatePrototype(ctx context.Context, re
 		mmActivatePrototype.inspectFuncActivatePrototype(ctx, request, parent, code, memory)
 	}
 
-	params := &ClientMockActivatePrototypeParams{ctx, request, parent, code, memory}
+	mm_params := &ClientMockActivatePrototypeParams{ctx, request, parent, code, memory}
 
 	// Record call args
 	mmActivatePrototype.ActivatePrototypeMock.mutex.Lock()
-	mmActivatePrototype.ActivatePrototypeMock.callArgs = append(mmActivatePrototype.ActivatePrototypeMock.callArgs, params)
+	mmActivatePrototype.ActivatePrototypeMock.callArgs = append(mmActivatePrototype.ActivatePrototypeMock.callArgs, mm_params)
 	mmActivatePrototype.ActivatePrototypeMock.mutex.Unlock()
 
 	for _, e := range mmActivatePrototype.ActivatePrototypeMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
@@ -288,17 +306,17 @@ func (mmActivatePrototype *ClientMock) ActivatePrototype(ctx context.Context, re
 
 	if mmActivatePrototype.ActivatePrototypeMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmActivatePrototype.ActivatePrototypeMock.defaultExpectation.Counter, 1)
-		want := mmActivatePrototype.ActivatePrototypeMock.defaultExpectation.params
-		got := ClientMockActivatePrototypeParams{ctx, request, parent, code, memory}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmActivatePrototype.t.Errorf("ClientMock.ActivatePrototype got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmActivatePrototype.ActivatePrototypeMock.defaultExpectation.params
+		mm_got := ClientMockActivatePrototypeParams{ctx, request, parent, code, memory}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmActivatePrototype.t.Errorf("ClientMock.ActivatePrototype got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmActivatePrototype.ActivatePrototypeMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmActivatePrototype.ActivatePrototypeMock.defaultExpectation.results
+		if mm_results == nil {
 			mmActivatePrototype.t.Fatal("No results are set for the ClientMock.ActivatePrototype")
 		}
-		return (*results).err
+		return (*mm_results).err
 	}
 	if mmActivatePrototype.funcActivatePrototype != nil {
 		return mmActivatePrototype.funcActivatePrototype(ctx, request, parent, code, memory)
@@ -448,7 +466,7 @@ func (mmDeployCode *mClientMockDeployCode) Return(ip1 *insolar.ID, err error) *C
 	return mmDeployCode.mock
 }
 
-//Set uses given function f to mock the Client.DeployCode method
+// Set uses given function f to mock the Client.DeployCode method
 func (mmDeployCode *mClientMockDeployCode) Set(f func(ctx context.Context, domain insolar.Reference, request insolar.Reference, code []byte, machineType insolar.MachineType) (ip1 *insolar.ID, err error)) *ClientMock {
 	if mmDeployCode.defaultExpectation != nil {
 		mmDeployCode.mock.t.Fatalf("Default expectation is already set for the Client.DeployCode method")
@@ -492,15 +510,15 @@ func (mmDeployCode *ClientMock) DeployCode(ctx context.Context, domain insolar.R
 		mmDeployCode.inspectFuncDeployCode(ctx, domain, request, code, machineType)
 	}
 
-	params := &ClientMockDeployCodeParams{ctx, domain, request, code, machineType}
+	mm_params := &ClientMockDeployCodeParams{ctx, domain, request, code, machineType}
 
 	// Record call args
 	mmDeployCode.DeployCodeMock.mutex.Lock()
-	mmDeployCode.DeployCodeMock.callArgs = append(mmDeployCode.DeployCodeMock.callArgs, params)
+	mmDeployCode.DeployCodeMock.callArgs = append(mmDeployCode.DeployCodeMock.callArgs, mm_params)
 	mmDeployCode.DeployCodeMock.mutex.Unlock()
 
 	for _, e := range mmDeployCode.DeployCodeMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.ip1, e.results.err
 		}
@@ -508,17 +526,17 @@ func (mmDeployCode *ClientMock) DeployCode(ctx context.Context, domain insolar.R
 
 	if mmDeployCode.DeployCodeMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmDeployCode.DeployCodeMock.defaultExpectation.Counter, 1)
-		want := mmDeployCode.DeployCodeMock.defaultExpectation.params
-		got := ClientMockDeployCodeParams{ctx, domain, request, code, machineType}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmDeployCode.t.Errorf("ClientMock.DeployCode got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmDeployCode.DeployCodeMock.defaultExpectation.params
+		mm_got := ClientMockDeployCodeParams{ctx, domain, request, code, machineType}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeployCode.t.Errorf("ClientMock.DeployCode got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmDeployCode.DeployCodeMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmDeployCode.DeployCodeMock.defaultExpectation.results
+		if mm_results == nil {
 			mmDeployCode.t.Fatal("No results are set for the ClientMock.DeployCode")
 		}
-		return (*results).ip1, (*results).err
+		return (*mm_results).ip1, (*mm_results).err
 	}
 	if mmDeployCode.funcDeployCode != nil {
 		return mmDeployCode.funcDeployCode(ctx, domain, request, code, machineType)
@@ -666,7 +684,7 @@ func (mmGetAbandonedRequest *mClientMockGetAbandonedRequest) Return(r1 record.Re
 	return mmGetAbandonedRequest.mock
 }
 
-//Set uses given function f to mock the Client.GetAbandonedRequest method
+// Set uses given function f to mock the Client.GetAbandonedRequest method
 func (mmGetAbandonedRequest *mClientMockGetAbandonedRequest) Set(f func(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference) (r1 record.Request, err error)) *ClientMock {
 	if mmGetAbandonedRequest.defaultExpectation != nil {
 		mmGetAbandonedRequest.mock.t.Fatalf("Default expectation is already set for the Client.GetAbandonedRequest method")
@@ -710,15 +728,15 @@ func (mmGetAbandonedRequest *ClientMock) GetAbandonedRequest(ctx context.Context
 		mmGetAbandonedRequest.inspectFuncGetAbandonedRequest(ctx, objectRef, reqRef)
 	}
 
-	params := &ClientMockGetAbandonedRequestParams{ctx, objectRef, reqRef}
+	mm_params := &ClientMockGetAbandonedRequestParams{ctx, objectRef, reqRef}
 
 	// Record call args
 	mmGetAbandonedRequest.GetAbandonedRequestMock.mutex.Lock()
-	mmGetAbandonedRequest.GetAbandonedRequestMock.callArgs = append(mmGetAbandonedRequest.GetAbandonedRequestMock.callArgs, params)
+	mmGetAbandonedRequest.GetAbandonedRequestMock.callArgs = append(mmGetAbandonedRequest.GetAbandonedRequestMock.callArgs, mm_params)
 	mmGetAbandonedRequest.GetAbandonedRequestMock.mutex.Unlock()
 
 	for _, e := range mmGetAbandonedRequest.GetAbandonedRequestMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.r1, e.results.err
 		}
@@ -726,17 +744,17 @@ func (mmGetAbandonedRequest *ClientMock) GetAbandonedRequest(ctx context.Context
 
 	if mmGetAbandonedRequest.GetAbandonedRequestMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmGetAbandonedRequest.GetAbandonedRequestMock.defaultExpectation.Counter, 1)
-		want := mmGetAbandonedRequest.GetAbandonedRequestMock.defaultExpectation.params
-		got := ClientMockGetAbandonedRequestParams{ctx, objectRef, reqRef}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmGetAbandonedRequest.t.Errorf("ClientMock.GetAbandonedRequest got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmGetAbandonedRequest.GetAbandonedRequestMock.defaultExpectation.params
+		mm_got := ClientMockGetAbandonedRequestParams{ctx, objectRef, reqRef}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAbandonedRequest.t.Errorf("ClientMock.GetAbandonedRequest got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmGetAbandonedRequest.GetAbandonedRequestMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmGetAbandonedRequest.GetAbandonedRequestMock.defaultExpectation.results
+		if mm_results == nil {
 			mmGetAbandonedRequest.t.Fatal("No results are set for the ClientMock.GetAbandonedRequest")
 		}
-		return (*results).r1, (*results).err
+		return (*mm_results).r1, (*mm_results).err
 	}
 	if mmGetAbandonedRequest.funcGetAbandonedRequest != nil {
 		return mmGetAbandonedRequest.funcGetAbandonedRequest(ctx, objectRef, reqRef)
@@ -883,7 +901,7 @@ func (mmGetCode *mClientMockGetCode) Return(c2 CodeDescriptor, err error) *Clien
 	return mmGetCode.mock
 }
 
-//Set uses given function f to mock the Client.GetCode method
+// Set uses given function f to mock the Client.GetCode method
 func (mmGetCode *mClientMockGetCode) Set(f func(ctx context.Context, ref insolar.Reference) (c2 CodeDescriptor, err error)) *ClientMock {
 	if mmGetCode.defaultExpectation != nil {
 		mmGetCode.mock.t.Fatalf("Default expectation is already set for the Client.GetCode method")
@@ -927,15 +945,15 @@ func (mmGetCode *ClientMock) GetCode(ctx context.Context, ref insolar.Reference)
 		mmGetCode.inspectFuncGetCode(ctx, ref)
 	}
 
-	params := &ClientMockGetCodeParams{ctx, ref}
+	mm_params := &ClientMockGetCodeParams{ctx, ref}
 
 	// Record call args
 	mmGetCode.GetCodeMock.mutex.Lock()
-	mmGetCode.GetCodeMock.callArgs = append(mmGetCode.GetCodeMock.callArgs, params)
+	mmGetCode.GetCodeMock.callArgs = append(mmGetCode.GetCodeMock.callArgs, mm_params)
 	mmGetCode.GetCodeMock.mutex.Unlock()
 
 	for _, e := range mmGetCode.GetCodeMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.c2, e.results.err
 		}
@@ -943,17 +961,17 @@ func (mmGetCode *ClientMock) GetCode(ctx context.Context, ref insolar.Reference)
 
 	if mmGetCode.GetCodeMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmGetCode.GetCodeMock.defaultExpectation.Counter, 1)
-		want := mmGetCode.GetCodeMock.defaultExpectation.params
-		got := ClientMockGetCodeParams{ctx, ref}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmGetCode.t.Errorf("ClientMock.GetCode got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmGetCode.GetCodeMock.defaultExpectation.params
+		mm_got := ClientMockGetCodeParams{ctx, ref}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCode.t.Errorf("ClientMock.GetCode got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmGetCode.GetCodeMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmGetCode.GetCodeMock.defaultExpectation.results
+		if mm_results == nil {
 			mmGetCode.t.Fatal("No results are set for the ClientMock.GetCode")
 		}
-		return (*results).c2, (*results).err
+		return (*mm_results).c2, (*mm_results).err
 	}
 	if mmGetCode.funcGetCode != nil {
 		return mmGetCode.funcGetCode(ctx, ref)
@@ -1100,7 +1118,7 @@ func (mmGetObject *mClientMockGetObject) Return(o1 ObjectDescriptor, err error)
 	return mmGetObject.mock
 }
 
-//Set uses given function f to mock the Client.GetObject method
+// Set uses given function f to mock the Client.GetObject method
 func (mmGetObject *mClientMockGetObject) Set(f func(ctx context.Context, head insolar.Reference) (o1 ObjectDescriptor, err error)) *ClientMock {
 	if mmGetObject.defaultExpectation != nil {
 		mmGetObject.mock.t.Fatalf("Default expectation is already set for the Client.GetObject method")
@@ -1144,15 +1162,15 @@ func (mmGetObject *ClientMock) GetObject(ctx context.Context, head insolar.Refer
 		mmGetObject.inspectFuncGetObject(ctx, head)
 	}
 
-	params := &ClientMockGetObjectParams{ctx, head}
+	mm_params := &ClientMockGetObjectParams{ctx, head}
 
 	// Record call args
 	mmGetObject.GetObjectMock.mutex.Lock()
-	mmGetObject.GetObjectMock.callArgs = append(mmGetObject.GetObjectMock.callArgs, params)
+	mmGetObject.GetObjectMock.callArgs = append(mmGetObject.GetObjectMock.callArgs, mm_params)
 	mmGetObject.GetObjectMock.mutex.Unlock()
 
 	for _, e := range mmGetObject.GetObjectMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.o1, e.results.err
 		}
@@ -1160,17 +1178,17 @@ func (mmGetObject *ClientMock) GetObject(ctx context.Context, head insolar.Refer
 
 	if mmGetObject.GetObjectMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmGetObject.GetObjectMock.defaultExpectation.Counter, 1)
-		want := mmGetObject.GetObjectMock.defaultExpectation.params
-		got := ClientMockGetObjectParams{ctx, head}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmGetObject.t.Errorf("ClientMock.GetObject got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmGetObject.GetObjectMock.defaultExpectation.params
+		mm_got := ClientMockGetObjectParams{ctx, head}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetObject.t.Errorf("ClientMock.GetObject got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmGetObject.GetObjectMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmGetObject.GetObjectMock.defaultExpectation.results
+		if mm_results == nil {
 			mmGetObject.t.Fatal("No results are set for the ClientMock.GetObject")
 		}
-		return (*results).o1, (*results).err
+		return (*mm_results).o1, (*mm_results).err
 	}
 	if mmGetObject.funcGetObject != nil {
 		return mmGetObject.funcGetObject(ctx, head)
@@ -1317,7 +1335,7 @@ func (mmGetPendings *mClientMockGetPendings) Return(ra1 []insolar.Reference, err
 	return mmGetPendings.mock
 }
 
-//Set uses given function f to mock the Client.GetPendings method
+// Set uses given function f to mock the Client.GetPendings method
 func (mmGetPendings *mClientMockGetPendings) Set(f func(ctx context.Context, objectRef insolar.Reference) (ra1 []insolar.Reference, err error)) *ClientMock {
 	if mmGetPendings.defaultExpectation != nil {
 		mmGetPendings.mock.t.Fatalf("Default expectation is already set for the Client.GetPendings method")
@@ -1361,15 +1379,15 @@ func (mmGetPendings *ClientMock) GetPendings(ctx context.Context, objectRef inso
 		mmGetPendings.inspectFuncGetPendings(ctx, objectRef)
 	}
 
-	params := &ClientMockGetPendingsParams{ctx, objectRef}
+	mm_params := &ClientMockGetPendingsParams{ctx, objectRef}
 
 	// Record call args
 	mmGetPendings.GetPendingsMock.mutex.Lock()
-	mmGetPendings.GetPendingsMock.callArgs = append(mmGetPendings.GetPendingsMock.callArgs, params)
+	mmGetPendings.GetPendingsMock.callArgs = append(mmGetPendings.GetPendingsMock.callArgs, mm_params)
 	mmGetPendings.GetPendingsMock.mutex.Unlock()
 
 	for _, e := range mmGetPendings.GetPendingsMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.ra1, e.results.err
 		}
@@ -1377,17 +1395,17 @@ func (mmGetPendings *ClientMock) GetPendings(ctx context.Context, objectRef inso
 
 	if mmGetPendings.GetPendingsMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmGetPendings.GetPendingsMock.defaultExpectation.Counter, 1)
-		want := mmGetPendings.GetPendingsMock.defaultExpectation.params
-		got := ClientMockGetPendingsParams{ctx, objectRef}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmGetPendings.t.Errorf("ClientMock.GetPendings got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmGetPendings.GetPendingsMock.defaultExpectation.params
+		mm_got := ClientMockGetPendingsParams{ctx, objectRef}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPendings.t.Errorf("ClientMock.GetPendings got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmGetPendings.GetPendingsMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmGetPendings.GetPendingsMock.defaultExpectation.results
+		if mm_results == nil {
 			mmGetPendings.t.Fatal("No results are set for the ClientMock.GetPendings")
 		}
-		return (*results).ra1, (*results).err
+		return (*mm_results).ra1, (*mm_results).err
 	}
 	if mmGetPendings.funcGetPendings != nil {
 		return mmGetPendings.funcGetPendings(ctx, objectRef)
@@ -1461,6 +1479,442 @@ func (m *ClientMock) MinimockGetPendingsInspect() {
 	}
 }
 
+type mClientMockGetResult struct {
+	mock               *ClientMock
+	defaultExpectation *ClientMockGetResultExpectation
+	expectations       []*ClientMockGetResultExpectation
+
+	callArgs []*ClientMockGetResultParams
+	mutex    sync.RWMutex
+}
+
+// ClientMockGetResultExpectation specifies expectation struct of the Client.GetResult
+type ClientMockGetResultExpectation struct {
+	mock    *ClientMock
+	params  *ClientMockGetResultParams
+	results *ClientMockGetResultResults
+	Counter uint64
+}
+
+// ClientMockGetResultParams contains parameters of the Client.GetResult
+type ClientMockGetResultParams struct {
+	ctx       context.Context
+	objectRef insolar.Reference
+	reqRef    insolar.Reference
+}
+
+// ClientMockGetResultResults contains results of the Client.GetResult
+type ClientMockGetResultResults struct {
+	rp1 *record.Result
+	err error
+}
+
+// Expect sets up expected params for Client.GetResult
+func (mmGetResult *mClientMockGetResult) Expect(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference) *mClientMockGetResult {
+	if mmGetResult.mock.funcGetResult != nil {
+		mmGetResult.mock.t.Fatalf("ClientMock.GetResult mock is already set by Set")
+	}
+
+	if mmGetResult.defaultExpectation == nil {
+		mmGetResult.defaultExpectation = &ClientMockGetResultExpectation{}
+	}
+
+	mmGetResult.defaultExpectation.params = &ClientMockGetResultParams{ctx, objectRef, reqRef}
+	for _, e := range mmGetResult.expectations {
+		if minimock.Equal(e.params, mmGetResult.defaultExpectation.params) {
+			mmGetResult.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetResult.defaultExpectation.params)
+		}
+	}
+
+	return mmGetResult
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.GetResult
+func (mmGetResult *mClientMockGetResult) Inspect(f func(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference)) *mClientMockGetResult {
+	if mmGetResult.mock.inspectFuncGetResult != nil {
+		mmGetResult.mock.t.Fatalf("Inspect function is already set for ClientMock.GetResult")
+	}
+
+	mmGetResult.mock.inspectFuncGetResult = f
+
+	return mmGetResult
+}
+
+// Return sets up results that will be returned by Client.GetResult
+func (mmGetResult *mClientMockGetResult) Return(rp1 *record.Result, err error) *ClientMock {
+	if mmGetResult.mock.funcGetResult != nil {
+		mmGetResult.mock.t.Fatalf("ClientMock.GetResult mock is already set by Set")
+	}
+
+	if mmGetResult.defaultExpectation == nil {
+		mmGetResult.defaultExpectation = &ClientMockGetResultExpectation{mock: mmGetResult.mock}
+	}
+	mmGetResult.defaultExpectation.results = &ClientMockGetResultResults{rp1, err}
+	return mmGetResult.mock
+}
+
+// Set uses given function f to mock the Client.GetResult method
+func (mmGetResult *mClientMockGetResult) Set(f func(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference) (rp1 *record.Result, err error)) *ClientMock {
+	if mmGetResult.defaultExpectation != nil {
+		mmGetResult.mock.t.Fatalf("Default expectation is already set for the Client.GetResult method")
+	}
+
+	if len(mmGetResult.expectations) > 0 {
+		mmGetResult.mock.t.Fatalf("Some expectations are already set for the Client.GetResult method")
+	}
+
+	mmGetResult.mock.funcGetResult = f
+	return mmGetResult.mock
+}
+
+// When sets expectation for the Client.GetResult which will trigger the result defined by the following
+// Then helper
+func (mmGetResult *mClientMockGetResult) When(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference) *ClientMockGetResultExpectation {
+	if mmGetResult.mock.funcGetResult != nil {
+		mmGetResult.mock.t.Fatalf("ClientMock.GetResult mock is already set by Set")
+	}
+
+	expectation := &ClientMockGetResultExpectation{
+		mock:   mmGetResult.mock,
+		params: &ClientMockGetResultParams{ctx, objectRef, reqRef},
+	}
+	mmGetResult.expectations = append(mmGetResult.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.GetResult return parameters for the expectation previously defined by the When method
+func (e *ClientMockGetResultExpectation) Then(rp1 *record.Result, err error) *ClientMock {
+	e.results = &ClientMockGetResultResults{rp1, err}
+	return e.mock
+}
+
+// GetResult implements Client
+func (mmGetResult *ClientMock) GetResult(ctx context.Context, objectRef insolar.Reference, reqRef insolar.Reference) (rp1 *record.Result, err error) {
+	mm_atomic.AddUint64(&mmGetResult.beforeGetResultCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetResult.afterGetResultCounter, 1)
+
+	if mmGetResult.inspectFuncGetResult != nil {
+		mmGetResult.inspectFuncGetResult(ctx, objectRef, reqRef)
+	}
+
+	mm_params := &ClientMockGetResultParams{ctx, objectRef, reqRef}
+
+	// Record call args
+	mmGetResult.GetResultMock.mutex.Lock()
+	mmGetResult.GetResultMock.callArgs = append(mmGetResult.GetResultMock.callArgs, mm_params)
+	mmGetResult.GetResultMock.mutex.Unlock()
+
+	for _, e := range mmGetResult.GetResultMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGetResult.GetResultMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetResult.GetResultMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetResult.GetResultMock.defaultExpectation.params
+		mm_got := ClientMockGetResultParams{ctx, objectRef, reqRef}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetResult.t.Errorf("ClientMock.GetResult got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetResult.GetResultMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetResult.t.Fatal("No results are set for the ClientMock.GetResult")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGetResult.funcGetResult != nil {
+		return mmGetResult.funcGetResult(ctx, objectRef, reqRef)
+	}
+	mmGetResult.t.Fatalf("Unexpected call to ClientMock.GetResult. %v %v %v", ctx, objectRef, reqRef)
+	return
+}
+
+// GetResultAfterCounter returns a count of finished ClientMock.GetResult invocations
+func (mmGetResult *ClientMock) GetResultAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetResult.afterGetResultCounter)
+}
+
+// GetResultBeforeCounter returns a count of ClientMock.GetResult invocations
+func (mmGetResult *ClientMock) GetResultBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetResult.beforeGetResultCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.GetResult.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetResult *mClientMockGetResult) Calls() []*ClientMockGetResultParams {
+	mmGetResult.mutex.RLock()
+
+	argCopy := make([]*ClientMockGetResultParams, len(mmGetResult.callArgs))
+	copy(argCopy, mmGetResult.callArgs)
+
+	mmGetResult.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetResultDone returns true if the count of the GetResult invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockGetResultDone() bool {
+	for _, e := range m.GetResultMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetResultMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetResultCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetResult != nil && mm_atomic.LoadUint64(&m.afterGetResultCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetResultInspect logs each unmet expectation
+func (m *ClientMock) MinimockGetResultInspect() {
+	for _, e := range m.GetResultMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.GetResult with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetResultMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetResultCounter) < 1 {
+		if m.GetResultMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.GetResult")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.GetResult with params: %#v", *m.GetResultMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetResult != nil && mm_atomic.LoadUint64(&m.afterGetResultCounter) < 1 {
+		m.t.Error("Expected call to ClientMock.GetResult")
+	}
+}
+
+type mClientMockGetState struct {
+	mock               *ClientMock
+	defaultExpectation *ClientMockGetStateExpectation
+	expectations       []*ClientMockGetStateExpectation
+
+	callArgs []*ClientMockGetStateParams
+	mutex    sync.RWMutex
+}
+
+// ClientMockGetStateExpectation specifies expectation struct of the Client.GetState
+type ClientMockGetStateExpectation struct {
+	mock    *ClientMock
+	params  *ClientMockGetStateParams
+	results *ClientMockGetStateResults
+	Counter uint64
+}
+
+// ClientMockGetStateParams contains parameters of the Client.GetState
+type ClientMockGetStateParams struct {
+	ctx   context.Context
+	head  insolar.Reference
+	state insolar.ID
+}
+
+// ClientMockGetStateResults contains results of the Client.GetState
+type ClientMockGetStateResults struct {
+	s1  record.State
+	err error
+}
+
+// Expect sets up expected params for Client.GetState
+func (mmGetState *mClientMockGetState) Expect(ctx context.Context, head insolar.Reference, state insolar.ID) *mClientMockGetState {
+	if mmGetState.mock.funcGetState != nil {
+		mmGetState.mock.t.Fatalf("ClientMock.GetState mock is already set by Set")
+	}
+
+	if mmGetState.defaultExpectation == nil {
+		mmGetState.defaultExpectation = &ClientMockGetStateExpectation{}
+	}
+
+	mmGetState.defaultExpectation.params = &ClientMockGetStateParams{ctx, head, state}
+	for _, e := range mmGetState.expectations {
+		if minimock.Equal(e.params, mmGetState.defaultExpectation.params) {
+			mmGetState.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetState.defaultExpectation.params)
+		}
+	}
+
+	return mmGetState
+}
+
+// Inspect accepts an inspector function that has same arguments as the Client.GetState
+func (mmGetState *mClientMockGetState) Inspect(f func(ctx context.Context, head insolar.Reference, state insolar.ID)) *mClientMockGetState {
+	if mmGetState.mock.inspectFuncGetState != nil {
+		mmGetState.mock.t.Fatalf("Inspect function is already set for ClientMock.GetState")
+	}
+
+	mmGetState.mock.inspectFuncGetState = f
+
+	return mmGetState
+}
+
+// Return sets up results that will be returned by Client.GetState
+func (mmGetState *mClientMockGetState) Return(s1 record.State, err error) *ClientMock {
+	if mmGetState.mock.funcGetState != nil {
+		mmGetState.mock.t.Fatalf("ClientMock.GetState mock is already set by Set")
+	}
+
+	if mmGetState.defaultExpectation == nil {
+		mmGetState.defaultExpectation = &ClientMockGetStateExpectation{mock: mmGetState.mock}
+	}
+	mmGetState.defaultExpectation.results = &ClientMockGetStateResults{s1, err}
+	return mmGetState.mock
+}
+
+// Set uses given function f to mock the Client.GetState method
+func (mmGetState *mClientMockGetState) Set(f func(ctx context.Context, head insolar.Reference, state insolar.ID) (s1 record.State, err error)) *ClientMock {
+	if mmGetState.defaultExpectation != nil {
+		mmGetState.mock.t.Fatalf("Default expectation is already set for the Client.GetState method")
+	}
+
+	if len(mmGetState.expectations) > 0 {
+		mmGetState.mock.t.Fatalf("Some expectations are already set for the Client.GetState method")
+	}
+
+	mmGetState.mock.funcGetState = f
+	return mmGetState.mock
+}
+
+// When sets expectation for the Client.GetState which will trigger the result defined by the following
+// Then helper
+func (mmGetState *mClientMockGetState) When(ctx context.Context, head insolar.Reference, state insolar.ID) *ClientMockGetStateExpectation {
+	if mmGetState.mock.funcGetState != nil {
+		mmGetState.mock.t.Fatalf("ClientMock.GetState mock is already set by Set")
+	}
+
+	expectation := &ClientMockGetStateExpectation{
+		mock:   mmGetState.mock,
+		params: &ClientMockGetStateParams{ctx, head, state},
+	}
+	mmGetState.expectations = append(mmGetState.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Client.GetState return parameters for the expectation previously defined by the When method
+func (e *ClientMockGetStateExpectation) Then(s1 record.State, err error) *ClientMock {
+	e.results = &ClientMockGetStateResults{s1, err}
+	return e.mock
+}
+
+// GetState implements Client
+func (mmGetState *ClientMock) GetState(ctx context.Context, head insolar.Reference, state insolar.ID) (s1 record.State, err error) {
+	mm_atomic.AddUint64(&mmGetState.beforeGetStateCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetState.afterGetStateCounter, 1)
+
+	if mmGetState.inspectFuncGetState != nil {
+		mmGetState.inspectFuncGetState(ctx, head, state)
+	}
+
+	mm_params := &ClientMockGetStateParams{ctx, head, state}
+
+	// Record call args
+	mmGetState.GetStateMock.mutex.Lock()
+	mmGetState.GetStateMock.callArgs = append(mmGetState.GetStateMock.callArgs, mm_params)
+	mmGetState.GetStateMock.mutex.Unlock()
+
+	for _, e := range mmGetState.GetStateMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetState.GetStateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetState.GetStateMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetState.GetStateMock.defaultExpectation.params
+		mm_got := ClientMockGetStateParams{ctx, head, state}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetState.t.Errorf("ClientMock.GetState got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetState.GetStateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetState.t.Fatal("No results are set for the ClientMock.GetState")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetState.funcGetState != nil {
+		return mmGetState.funcGetState(ctx, head, state)
+	}
+	mmGetState.t.Fatalf("Unexpected call to ClientMock.GetState. %v %v %v", ctx, head, state)
+	return
+}
+
+// GetStateAfterCounter returns a count of finished ClientMock.GetState invocations
+func (mmGetState *ClientMock) GetStateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetState.afterGetStateCounter)
+}
+
+// GetStateBeforeCounter returns a count of ClientMock.GetState invocations
+func (mmGetState *ClientMock) GetStateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetState.beforeGetStateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientMock.GetState.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetState *mClientMockGetState) Calls() []*ClientMockGetStateParams {
+	mmGetState.mutex.RLock()
+
+	argCopy := make([]*ClientMockGetStateParams, len(mmGetState.callArgs))
+	copy(argCopy, mmGetState.callArgs)
+
+	mmGetState.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetStateDone returns true if the count of the GetState invocations corresponds
+// the number of defined expectations
+func (m *ClientMock) MinimockGetStateDone() bool {
+	for _, e := range m.GetStateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetStateCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetState != nil && mm_atomic.LoadUint64(&m.afterGetStateCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetStateInspect logs each unmet expectation
+func (m *ClientMock) MinimockGetStateInspect() {
+	for _, e := range m.GetStateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientMock.GetState with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStateMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetStateCounter) < 1 {
+		if m.GetStateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ClientMock.GetState")
+		} else {
+			m.t.Errorf("Expected call to ClientMock.GetState with params: %#v", *m.GetStateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetState != nil && mm_atomic.LoadUint64(&m.afterGetStateCounter) < 1 {
+		m.t.Error("Expected call to ClientMock.GetState")
+	}
+}
+
 type mClientMockHasPendings struct {
 	mock               *ClientMock
 	defaultExpectation *ClientMockHasPendingsExpectation
@@ -1534,7 +1988,7 @@ func (mmHasPendings *mClientMockHasPendings) Return(b1 bool, err error) *ClientM
 	return mmHasPendings.mock
 }
 
-//Set uses given function f to mock the Client.HasPendings method
+// Set uses given function f to mock the Client.HasPendings method
 func (mmHasPendings *mClientMockHasPendings) Set(f func(ctx context.Context, object insolar.Reference) (b1 bool, err error)) *ClientMock {
 	if mmHasPendings.defaultExpectation != nil {
 		mmHasPendings.mock.t.Fatalf("Default expectation is already set for the Client.HasPendings method")
@@ -1578,15 +2032,15 @@ func (mmHasPendings *ClientMock) HasPendings(ctx context.Context, object insolar
 		mmHasPendings.inspectFuncHasPendings(ctx, object)
 	}
 
-	params := &ClientMockHasPendingsParams{ctx, object}
+	mm_params := &ClientMockHasPendingsParams{ctx, object}
 
 	// Record call args
 	mmHasPendings.HasPendingsMock.mutex.Lock()
-	mmHasPendings.HasPendingsMock.callArgs = append(mmHasPendings.HasPendingsMock.callArgs, params)
+	mmHasPendings.HasPendingsMock.callArgs = append(mmHasPendings.HasPendingsMock.callArgs, mm_params)
 	mmHasPendings.HasPendingsMock.mutex.Unlock()
 
 	for _, e := range mmHasPendings.HasPendingsMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.b1, e.results.err
 		}
@@ -1594,17 +2048,17 @@ func (mmHasPendings *ClientMock) HasPendings(ctx context.Context, object insolar
 
 	if mmHasPendings.HasPendingsMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmHasPendings.HasPendingsMock.defaultExpectation.Counter, 1)
-		want := mmHasPendings.HasPendingsMock.defaultExpectation.params
-		got := ClientMockHasPendingsParams{ctx, object}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmHasPendings.t.Errorf("ClientMock.HasPendings got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmHasPendings.HasPendingsMock.defaultExpectation.params
+		mm_got := ClientMockHasPendingsParams{ctx, object}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHasPendings.t.Errorf("ClientMock.HasPendings got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmHasPendings.HasPendingsMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmHasPendings.HasPendingsMock.defaultExpectation.results
+		if mm_results == nil {
 			mmHasPendings.t.Fatal("No results are set for the ClientMock.HasPendings")
 		}
-		return (*results).b1, (*results).err
+		return (*mm_results).b1, (*mm_results).err
 	}
 	if mmHasPendings.funcHasPendings != nil {
 		return mmHasPendings.funcHasPendings(ctx, object)
@@ -1745,7 +2199,7 @@ func (mmInjectCodeDescriptor *mClientMockInjectCodeDescriptor) Return() *ClientM
 	return mmInjectCodeDescriptor.mock
 }
 
-//Set uses given function f to mock the Client.InjectCodeDescriptor method
+// Set uses given function f to mock the Client.InjectCodeDescriptor method
 func (mmInjectCodeDescriptor *mClientMockInjectCodeDescriptor) Set(f func(r1 insolar.Reference, c1 CodeDescriptor)) *ClientMock {
 	if mmInjectCodeDescriptor.defaultExpectation != nil {
 		mmInjectCodeDescriptor.mock.t.Fatalf("Default expectation is already set for the Client.InjectCodeDescriptor method")
@@ -1768,15 +2222,15 @@ func (mmInjectCodeDescriptor *ClientMock) InjectCodeDescriptor(r1 insolar.Refere
 		mmInjectCodeDescriptor.inspectFuncInjectCodeDescriptor(r1, c1)
 	}
 
-	params := &ClientMockInjectCodeDescriptorParams{r1, c1}
+	mm_params := &ClientMockInjectCodeDescriptorParams{r1, c1}
 
 	// Record call args
 	mmInjectCodeDescriptor.InjectCodeDescriptorMock.mutex.Lock()
-	mmInjectCodeDescriptor.InjectCodeDescriptorMock.callArgs = append(mmInjectCodeDescriptor.InjectCodeDescriptorMock.callArgs, params)
+	mmInjectCodeDescriptor.InjectCodeDescriptorMock.callArgs = append(mmInjectCodeDescriptor.InjectCodeDescriptorMock.callArgs, mm_params)
 	mmInjectCodeDescriptor.InjectCodeDescriptorMock.mutex.Unlock()
 
 	for _, e := range mmInjectCodeDescriptor.InjectCodeDescriptorMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return
 		}
@@ -1784,10 +2238,10 @@ func (mmInjectCodeDescriptor *ClientMock) InjectCodeDescriptor(r1 insolar.Refere
 
 	if mmInjectCodeDescriptor.InjectCodeDescriptorMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmInjectCodeDescriptor.InjectCodeDescriptorMock.defaultExpectation.Counter, 1)
-		want := mmInjectCodeDescriptor.InjectCodeDescriptorMock.defaultExpectation.params
-		got := ClientMockInjectCodeDescriptorParams{r1, c1}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmInjectCodeDescriptor.t.Errorf("ClientMock.InjectCodeDescriptor got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmInjectCodeDescriptor.InjectCodeDescriptorMock.defaultExpectation.params
+		mm_got := ClientMockInjectCodeDescriptorParams{r1, c1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmInjectCodeDescriptor.t.Errorf("ClientMock.InjectCodeDescriptor got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
 		return
@@ -1916,7 +2370,7 @@ func (mmInjectFinish *mClientMockInjectFinish) Return() *ClientMock {
 	return mmInjectFinish.mock
 }
 
-//Set uses given function f to mock the Client.InjectFinish method
+// Set uses given function f to mock the Client.InjectFinish method
 func (mmInjectFinish *mClientMockInjectFinish) Set(f func()) *ClientMock {
 	if mmInjectFinish.defaultExpectation != nil {
 		mmInjectFinish.mock.t.Fatalf("Default expectation is already set for the Client.InjectFinish method")
@@ -2068,7 +2522,7 @@ func (mmInjectObjectDescriptor *mClientMockInjectObjectDescriptor) Return() *Cli
 	return mmInjectObjectDescriptor.mock
 }
 
-//Set uses given function f to mock the Client.InjectObjectDescriptor method
+// Set uses given function f to mock the Client.InjectObjectDescriptor method
 func (mmInjectObjectDescriptor *mClientMockInjectObjectDescriptor) Set(f func(r1 insolar.Reference, o1 ObjectDescriptor)) *ClientMock {
 	if mmInjectObjectDescriptor.defaultExpectation != nil {
 		mmInjectObjectDescriptor.mock.t.Fatalf("Default expectation is already set for the Client.InjectObjectDescriptor method")
@@ -2091,15 +2545,15 @@ func (mmInjectObjectDescriptor *ClientMock) InjectObjectDescriptor(r1 insolar.Re
 		mmInjectObjectDescriptor.inspectFuncInjectObjectDescriptor(r1, o1)
 	}
 
-	params := &ClientMockInjectObjectDescriptorParams{r1, o1}
+	mm_params := &ClientMockInjectObjectDescriptorParams{r1, o1}
 
 	// Record call args
 	mmInjectObjectDescriptor.InjectObjectDescriptorMock.mutex.Lock()
-	mmInjectObjectDescriptor.InjectObjectDescriptorMock.callArgs = append(mmInjectObjectDescriptor.InjectObjectDescriptorMock.callArgs, params)
+	mmInjectObjectDescriptor.InjectObjectDescriptorMock.callArgs = append(mmInjectObjectDescriptor.InjectObjectDescriptorMock.callArgs, mm_params)
 	mmInjectObjectDescriptor.InjectObjectDescriptorMock.mutex.Unlock()
 
 	for _, e := range mmInjectObjectDescriptor.InjectObjectDescriptorMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return
 		}
@@ -2107,10 +2561,10 @@ func (mmInjectObjectDescriptor *ClientMock) InjectObjectDescriptor(r1 insolar.Re
 
 	if mmInjectObjectDescriptor.InjectObjectDescriptorMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmInjectObjectDescriptor.InjectObjectDescriptorMock.defaultExpectation.Counter, 1)
-		want := mmInjectObjectDescriptor.InjectObjectDescriptorMock.defaultExpectation.params
-		got := ClientMockInjectObjectDescriptorParams{r1, o1}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmInjectObjectDescriptor.t.Errorf("ClientMock.InjectObjectDescriptor got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmInjectObjectDescriptor.InjectObjectDescriptorMock.defaultExpectation.params
+		mm_got := ClientMockInjectObjectDescriptorParams{r1, o1}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmInjectObjectDescriptor.t.Errorf("ClientMock.InjectObjectDescriptor got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
 		return
@@ -2262,7 +2716,7 @@ func (mmRegisterIncomingRequest *mClientMockRegisterIncomingRequest) Return(rp1
 	return mmRegisterIncomingRequest.mock
 }
 
-//Set uses given function f to mock the Client.RegisterIncomingRequest method
+// Set uses given function f to mock the Client.RegisterIncomingRequest method
 func (mmRegisterIncomingRequest *mClientMockRegisterIncomingRequest) Set(f func(ctx context.Context, request *record.IncomingRequest) (rp1 *payload.RequestInfo, err error)) *ClientMock {
 	if mmRegisterIncomingRequest.defaultExpectation != nil {
 		mmRegisterIncomingRequest.mock.t.Fatalf("Default expectation is already set for the Client.RegisterIncomingRequest method")
@@ -2306,15 +2760,15 @@ func (mmRegisterIncomingRequest *ClientMock) RegisterIncomingRequest(ctx context
 		mmRegisterIncomingRequest.inspectFuncRegisterIncomingRequest(ctx, request)
 	}
 
-	params := &ClientMockRegisterIncomingRequestParams{ctx, request}
+	mm_params := &ClientMockRegisterIncomingRequestParams{ctx, request}
 
 	// Record call args
 	mmRegisterIncomingRequest.RegisterIncomingRequestMock.mutex.Lock()
-	mmRegisterIncomingRequest.RegisterIncomingRequestMock.callArgs = append(mmRegisterIncomingRequest.RegisterIncomingRequestMock.callArgs, params)
+	mmRegisterIncomingRequest.RegisterIncomingRequestMock.callArgs = append(mmRegisterIncomingRequest.RegisterIncomingRequestMock.callArgs, mm_params)
 	mmRegisterIncomingRequest.RegisterIncomingRequestMock.mutex.Unlock()
 
 	for _, e := range mmRegisterIncomingRequest.RegisterIncomingRequestMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.rp1, e.results.err
 		}
@@ -2322,17 +2776,17 @@ func (mmRegisterIncomingRequest *ClientMock) RegisterIncomingRequest(ctx context
 
 	if mmRegisterIncomingRequest.RegisterIncomingRequestMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmRegisterIncomingRequest.RegisterIncomingRequestMock.defaultExpectation.Counter, 1)
-		want := mmRegisterIncomingRequest.RegisterIncomingRequestMock.defaultExpectation.params
-		got := ClientMockRegisterIncomingRequestParams{ctx, request}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmRegisterIncomingRequest.t.Errorf("ClientMock.RegisterIncomingRequest got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmRegisterIncomingRequest.RegisterIncomingRequestMock.defaultExpectation.params
+		mm_got := ClientMockRegisterIncomingRequestParams{ctx, request}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRegisterIncomingRequest.t.Errorf("ClientMock.RegisterIncomingRequest got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmRegisterIncomingRequest.RegisterIncomingRequestMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmRegisterIncomingRequest.RegisterIncomingRequestMock.defaultExpectation.results
+		if mm_results == nil {
 			mmRegisterIncomingRequest.t.Fatal("No results are set for the ClientMock.RegisterIncomingRequest")
 		}
-		return (*results).rp1, (*results).err
+		return (*mm_results).rp1, (*mm_results).err
 	}
 	if mmRegisterIncomingRequest.funcRegisterIncomingRequest != nil {
 		return mmRegisterIncomingRequest.funcRegisterIncomingRequest(ctx, request)
@@ -2479,7 +2933,7 @@ func (mmRegisterOutgoingRequest *mClientMockRegisterOutgoingRequest) Return(rp1
 	return mmRegisterOutgoingRequest.mock
 }
 
-//Set uses given function f to mock the Client.RegisterOutgoingRequest method
+// Set uses given function f to mock the Client.RegisterOutgoingRequest method
 func (mmRegisterOutgoingRequest *mClientMockRegisterOutgoingRequest) Set(f func(ctx context.Context, request *record.OutgoingRequest) (rp1 *payload.RequestInfo, err error)) *ClientMock {
 	if mmRegisterOutgoingRequest.defaultExpectation != nil {
 		mmRegisterOutgoingRequest.mock.t.Fatalf("Default expectation is already set for the Client.RegisterOutgoingRequest method")
@@ -2523,15 +2977,15 @@ func (mmRegisterOutgoingRequest *ClientMock) RegisterOutgoingRequest(ctx context
 		mmRegisterOutgoingRequest.inspectFuncRegisterOutgoingRequest(ctx, request)
 	}
 
-	params := &ClientMockRegisterOutgoingRequestParams{ctx, request}
+	mm_params := &ClientMockRegisterOutgoingRequestParams{ctx, request}
 
 	// Record call args
 	mmRegisterOutgoingRequest.RegisterOutgoingRequestMock.mutex.Lock()
-	mmRegisterOutgoingRequest.RegisterOutgoingRequestMock.callArgs = append(mmRegisterOutgoingRequest.RegisterOutgoingRequestMock.callArgs, params)
+	mmRegisterOutgoingRequest.RegisterOutgoingRequestMock.callArgs = append(mmRegisterOutgoingRequest.RegisterOutgoingRequestMock.callArgs, mm_params)
 	mmRegisterOutgoingRequest.RegisterOutgoingRequestMock.mutex.Unlock()
 
 	for _, e := range mmRegisterOutgoingRequest.RegisterOutgoingRequestMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.rp1, e.results.err
 		}
@@ -2539,17 +2993,17 @@ func (mmRegisterOutgoingRequest *ClientMock) RegisterOutgoingRequest(ctx context
 
 	if mmRegisterOutgoingRequest.RegisterOutgoingRequestMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmRegisterOutgoingRequest.RegisterOutgoingRequestMock.defaultExpectation.Counter, 1)
-		want := mmRegisterOutgoingRequest.RegisterOutgoingRequestMock.defaultExpectation.params
-		got := ClientMockRegisterOutgoingRequestParams{ctx, request}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmRegisterOutgoingRequest.t.Errorf("ClientMock.RegisterOutgoingRequest got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmRegisterOutgoingRequest.RegisterOutgoingRequestMock.defaultExpectation.params
+		mm_got := ClientMockRegisterOutgoingRequestParams{ctx, request}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRegisterOutgoingRequest.t.Errorf("ClientMock.RegisterOutgoingRequest got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmRegisterOutgoingRequest.RegisterOutgoingRequestMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmRegisterOutgoingRequest.RegisterOutgoingRequestMock.defaultExpectation.results
+		if mm_results == nil {
 			mmRegisterOutgoingRequest.t.Fatal("No results are set for the ClientMock.RegisterOutgoingRequest")
 		}
-		return (*results).rp1, (*results).err
+		return (*mm_results).rp1, (*mm_results).err
 	}
 	if mmRegisterOutgoingRequest.funcRegisterOutgoingRequest != nil {
 		return mmRegisterOutgoingRequest.funcRegisterOutgoingRequest(ctx, request)
@@ -2696,7 +3150,7 @@ func (mmRegisterResult *mClientMockRegisterResult) Return(err error) *ClientMock
 	return mmRegisterResult.mock
 }
 
-//Set uses given function f to mock the Client.RegisterResult method
+// Set uses given function f to mock the Client.RegisterResult method
 func (mmRegisterResult *mClientMockRegisterResult) Set(f func(ctx context.Context, request insolar.Reference, result RequestResult) (err error)) *ClientMock {
 	if mmRegisterResult.defaultExpectation != nil {
 		mmRegisterResult.mock.t.Fatalf("Default expectation is already set for the Client.RegisterResult method")
@@ -2740,15 +3194,15 @@ func (mmRegisterResult *ClientMock) RegisterResult(ctx context.Context, request
 		mmRegisterResult.inspectFuncRegisterResult(ctx, request, result)
 	}
 
-	params := &ClientMockRegisterResultParams{ctx, request, result}
+	mm_params := &ClientMockRegisterResultParams{ctx, request, result}
 
 	// Record call args
 	mmRegisterResult.RegisterResultMock.mutex.Lock()
-	mmRegisterResult.RegisterResultMock.callArgs = append(mmRegisterResult.RegisterResultMock.callArgs, params)
+	mmRegisterResult.RegisterResultMock.callArgs = append(mmRegisterResult.RegisterResultMock.callArgs, mm_params)
 	mmRegisterResult.RegisterResultMock.mutex.Unlock()
 
 	for _, e := range mmRegisterResult.RegisterResultMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
@@ -2756,17 +3210,17 @@ func (mmRegisterResult *ClientMock) RegisterResult(ctx context.Context, request
 
 	if mmRegisterResult.RegisterResultMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmRegisterResult.RegisterResultMock.defaultExpectation.Counter, 1)
-		want := mmRegisterResult.RegisterResultMock.defaultExpectation.params
-		got := ClientMockRegisterResultParams{ctx, request, result}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmRegisterResult.t.Errorf("ClientMock.RegisterResult got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmRegisterResult.RegisterResultMock.defaultExpectation.params
+		mm_got := ClientMockRegisterResultParams{ctx, request, result}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRegisterResult.t.Errorf("ClientMock.RegisterResult got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		results := mmRegisterResult.RegisterResultMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmRegisterResult.RegisterResultMock.defaultExpectation.results
+		if mm_results == nil {
 			mmRegisterResult.t.Fatal("No results are set for the ClientMock.RegisterResult")
 		}
-		return (*results).err
+		return (*mm_results).err
 	}
 	if mmRegisterResult.funcRegisterResult != nil {
 		return mmRegisterResult.funcRegisterResult(ctx, request, result)
@@ -2896,7 +3350,7 @@ func (mmState *mClientMockState) Return(ba1 []byte) *ClientMock {
 	return mmState.mock
 }
 
-//Set uses given function f to mock the Client.State method
+// Set uses given function f to mock the Client.State method
 func (mmState *mClientMockState) Set(f func() (ba1 []byte)) *ClientMock {
 	if mmState.defaultExpectation != nil {
 		mmState.mock.t.Fatalf("Default expectation is already set for the Client.State method")
@@ -2922,11 +3376,11 @@ func (mmState *ClientMock) State() (ba1 []byte) {
 	if mmState.StateMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmState.StateMock.defaultExpectation.Counter, 1)
 
-		results := mmState.StateMock.defaultExpectation.results
-		if results == nil {
+		mm_results := mmState.StateMock.defaultExpectation.results
+		if mm_results == nil {
 			mmState.t.Fatal("No results are set for the ClientMock.State")
 		}
-		return (*results).ba1
+		return (*mm_results).ba1
 	}
 	if mmState.funcState != nil {
 		return mmState.funcState()
@@ -2998,6 +3452,10 @@ func (m *ClientMock) MinimockFinish() {
 
 		m.MinimockGetPendingsInspect()
 
+		m.MinimockGetResultInspect()
+
+		m.MinimockGetStateInspect()
+
 		m.MinimockHasPendingsInspect()
 
 		m.MinimockInjectCodeDescriptorInspect()
@@ -3042,6 +3500,8 @@ func (m *ClientMock) minimockDone() bool {
 		m.MinimockGetCodeDone() &&
 		m.MinimockGetObjectDone() &&
 		m.MinimockGetPendingsDone() &&
+		m.MinimockGetResultDone() &&
+		m.MinimockGetStateDone() &&
 		m.MinimockHasPendingsDone() &&
 		m.MinimockInjectCodeDescriptorDone() &&
 		m.MinimockInjectFinishDone() &&
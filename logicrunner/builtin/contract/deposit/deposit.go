@@ -22,6 +22,7 @@ import (
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/logicrunner/builtin/foundation/safemath"
+	"github.com/insolar/insolar/logicrunner/builtin/proxy/deposit"
 	"github.com/insolar/insolar/logicrunner/builtin/proxy/wallet"
 
 	"github.com/insolar/insolar/logicrunner/builtin/foundation"
@@ -52,6 +53,8 @@ type Deposit struct {
 	Amount                  string              `json:"amount"`
 	Bonus                   string              `json:"bonus"`
 	TxHash                  string              `json:"ethTxHash"`
+	// HoldReleased is set by UnHold call scheduled to PulseDepositUnHold.
+	HoldReleased bool `json:"holdReleased"`
 }
 
 // GetTxHash gets transaction hash.
@@ -126,6 +129,11 @@ func (d *Deposit) Confirm(migrationDaemonIndex int, migrationDaemonRef string, t
 			}
 			d.PulseDepositHold = currentPulse
 			d.PulseDepositUnHold = calculateUnHoldPulse(currentPulse)
+
+			err = deposit.GetObject(d.GetReference()).UnHoldAtPulse(d.PulseDepositUnHold)
+			if err != nil {
+				return fmt.Errorf("failed to schedule unhold: %s", err.Error())
+			}
 		}
 		return nil
 	}
@@ -142,17 +150,22 @@ func (d *Deposit) canTransfer() error {
 		return fmt.Errorf("number of confirms is less then 3")
 	}
 
-	p, err := foundation.GetPulseNumber()
-	if err != nil {
-		return fmt.Errorf("failed to get pulse number: %s", err.Error())
-	}
-	if d.PulseDepositUnHold > p {
+	if !d.HoldReleased {
 		return fmt.Errorf("hold period didn't end")
 	}
 
 	return nil
 }
 
+// UnHold releases hold of deposit. It's scheduled by deposit itself to the end of hold period.
+func (d *Deposit) UnHold() error {
+	if *d.GetContext().Caller != d.GetReference() {
+		return fmt.Errorf("only deposit itself can release hold")
+	}
+	d.HoldReleased = true
+	return nil
+}
+
 // Transfer transfers money from deposit to wallet.It can be called only after deposit hold period.
 func (d *Deposit) Transfer(amountStr string, wallerRef insolar.Reference) (interface{}, error) {
 
@@ -0,0 +1,68 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package deposit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/logicrunner/builtin/contract/deposit"
+	depositProxy "github.com/insolar/insolar/logicrunner/builtin/proxy/deposit"
+	"github.com/insolar/insolar/logicrunner/contracttest"
+)
+
+func TestDeposit_UnHold(t *testing.T) {
+	h := contracttest.New()
+	daemons := []string{gen.Reference().String(), gen.Reference().String(), gen.Reference().String()}
+
+	d, err := h.Create(gen.Reference(), gen.Reference(), *depositProxy.PrototypeReference, "New",
+		[3]string{}, "tx_hash", "100")
+	require.NoError(t, err)
+
+	for i, daemon := range daemons {
+		res, err := h.Call(gen.Reference(), d, "Confirm", i, daemon, "tx_hash", "100")
+		require.NoError(t, err)
+		require.NoError(t, res.Decode())
+	}
+
+	var state deposit.Deposit
+	require.NoError(t, h.State(d, &state))
+	require.False(t, state.HoldReleased)
+
+	res, err := h.Call(gen.Reference(), d, "Transfer", "10", gen.Reference())
+	require.NoError(t, err)
+	var ret interface{}
+	err = res.Decode(&ret)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "hold period didn't end")
+
+	// Only deposit itself releases hold.
+	res, err = h.Call(gen.Reference(), d, "UnHold")
+	require.NoError(t, err)
+	require.Error(t, res.Decode())
+
+	require.NoError(t, h.ExecuteScheduled())
+	require.NoError(t, h.State(d, &state))
+	require.False(t, state.HoldReleased)
+
+	h.AdvancePulse(state.PulseDepositUnHold - h.Pulse())
+	require.NoError(t, h.ExecuteScheduled())
+	require.NoError(t, h.State(d, &state))
+	require.True(t, state.HoldReleased)
+}
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/logicrunner/builtin/contract/member/signer"
@@ -104,6 +105,7 @@ type Params struct {
 }
 
 // Call returns response on request. Method for authorized calls.
+// Handlers of call sites are marked with `ins:callSite` annotation that describes them in contract ABI.
 func (m *Member) Call(signedRequest []byte) (interface{}, error) {
 	var signature string
 	var pulseTimeStamp int64
@@ -159,6 +161,8 @@ func (m *Member) Call(signedRequest []byte) (interface{}, error) {
 		return m.getNodeRefCall(params)
 	case "migration.addBurnAddresses":
 		return m.addBurnAddressesCall(params)
+	case "contract.upgradeObject":
+		return m.upgradeObjectCall(params)
 	case "wallet.getBalance":
 		return m.getBalanceCall(params)
 	case "member.transfer":
@@ -171,6 +175,7 @@ func (m *Member) Call(signedRequest []byte) (interface{}, error) {
 	return nil, fmt.Errorf("unknown method: '%s'", request.Params.CallSite)
 }
 
+//ins:callSite contract.getNodeRef publicKey:string
 func (m *Member) getNodeRefCall(params map[string]interface{}) (interface{}, error) {
 
 	publicKey, ok := params["publicKey"].(string)
@@ -180,6 +185,8 @@ func (m *Member) getNodeRefCall(params map[string]interface{}) (interface{}, err
 
 	return m.getNodeRef(publicKey)
 }
+
+//ins:callSite contract.registerNode publicKey:string role:string
 func (m *Member) registerNodeCall(params map[string]interface{}) (interface{}, error) {
 
 	publicKey, ok := params["publicKey"].(string)
@@ -194,6 +201,8 @@ func (m *Member) registerNodeCall(params map[string]interface{}) (interface{}, e
 
 	return m.registerNode(publicKey, role)
 }
+
+//ins:callSite migration.addBurnAddresses burnAddresses:[]string
 func (m *Member) addBurnAddressesCall(params map[string]interface{}) (interface{}, error) {
 
 	burnAddressesI, ok := params["burnAddresses"].([]interface{})
@@ -224,11 +233,39 @@ func (m *Member) addBurnAddressesCall(params map[string]interface{}) (interface{
 	return nil, nil
 }
 
+//ins:callSite contract.upgradeObject reference:string prototype:string
+func (m *Member) upgradeObjectCall(params map[string]interface{}) (interface{}, error) {
+	referenceStr, ok := params["reference"].(string)
+	if !ok {
+		return nil, fmt.Errorf("incorect input: failed to get 'reference' param")
+	}
+	reference, err := insolar.NewReferenceFromBase58(referenceStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse 'reference': %s", err.Error())
+	}
+
+	prototypeStr, ok := params["prototype"].(string)
+	if !ok {
+		return nil, fmt.Errorf("incorect input: failed to get 'prototype' param")
+	}
+	prototype, err := insolar.NewReferenceFromBase58(prototypeStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse 'prototype': %s", err.Error())
+	}
+
+	err = rootdomain.GetObject(m.RootDomain).UpgradeObject(*reference, *prototype)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upgrade object: %s", err.Error())
+	}
+	return nil, nil
+}
+
 type GetBalanceResponse struct {
 	Balance  string                 `json:"balance"`
 	Deposits map[string]interface{} `json:"deposits"`
 }
 
+//ins:callSite wallet.getBalance reference:string
 func (m *Member) getBalanceCall(params map[string]interface{}) (interface{}, error) {
 	referenceStr, ok := params["reference"].(string)
 	if !ok {
@@ -277,6 +314,7 @@ type TransferResponse struct {
 	Fee string `json:"fee"`
 }
 
+//ins:callSite member.transfer amount:string toMemberReference:string
 func (m *Member) transferCall(params map[string]interface{}) (interface{}, error) {
 	recipientReferenceStr, ok := params["toMemberReference"].(string)
 	if !ok {
@@ -299,6 +337,7 @@ func (m *Member) transferCall(params map[string]interface{}) (interface{}, error
 	return wallet.GetObject(m.Wallet).Transfer(m.RootDomain, amount, recipientReference)
 }
 
+//ins:callSite deposit.transfer ethTxHash:string amount:string
 func (m *Member) depositTransferCall(params map[string]interface{}) (interface{}, error) {
 
 	ethTxHash, ok := params["ethTxHash"].(string)
@@ -323,6 +362,7 @@ func (m *Member) depositTransferCall(params map[string]interface{}) (interface{}
 	return d.Transfer(amount, m.Wallet)
 }
 
+//ins:callSite deposit.migration amount:string ethTxHash:string migrationAddress:string
 func (m *Member) depositMigrationCall(params map[string]interface{}) error {
 
 	amountStr, ok := params["amount"].(string)
@@ -392,6 +432,7 @@ type MigrationCreateResponse struct {
 	MigrationAddress string `json:"migrationAddress"`
 }
 
+//ins:callSite member.migrationCreate
 func (m *Member) memberMigrationCreate(key string) (*MigrationCreateResponse, error) {
 
 	rootDomain := rootdomain.GetObject(m.RootDomain)
@@ -412,6 +453,8 @@ func (m *Member) memberMigrationCreate(key string) (*MigrationCreateResponse, er
 
 	return &MigrationCreateResponse{Reference: created.Reference.String(), MigrationAddress: migrationAddress}, nil
 }
+
+//ins:callSite member.create
 func (m *Member) contractCreateMember(key string) (*CreateResponse, error) {
 
 	rootDomain := rootdomain.GetObject(m.RootDomain)
@@ -512,10 +555,15 @@ func (m *Member) GetDeposits() (map[string]interface{}, error) {
 	return m.getDeposits()
 }
 func (m *Member) getDeposits() (map[string]interface{}, error) {
-	result := map[string]interface{}{}
-	for tx, dRef := range m.Deposits {
+	txs := make([]string, 0, len(m.Deposits))
+	for tx := range m.Deposits {
+		txs = append(txs, tx)
+	}
+	sort.Strings(txs)
 
-		d := deposit.GetObject(dRef)
+	result := map[string]interface{}{}
+	for _, tx := range txs {
+		d := deposit.GetObject(m.Deposits[tx])
 
 		depositInfo, err := d.Itself()
 		if err != nil {
@@ -555,6 +603,7 @@ type GetResponse struct {
 	BurnAddress string `json:"migrationAddress,omitempty"`
 }
 
+//ins:callSite member.get
 func (m *Member) memberGet(publicKey string) (interface{}, error) {
 	rootDomain := rootdomain.GetObject(m.RootDomain)
 	ref, err := rootDomain.GetMemberByPublicKey(publicKey)
@@ -68,6 +68,15 @@ func (rd RootDomain) GetRootMemberRef() (insolar.Reference, error) {
 	return rd.RootMember, nil
 }
 
+// UpgradeObject switches object to the upgraded prototype. Only root member can upgrade objects of the domain.
+func (rd *RootDomain) UpgradeObject(object insolar.Reference, prototype insolar.Reference) error {
+	caller := rd.GetContext().Caller
+	if caller == nil || !caller.Equal(rd.RootMember) {
+		return fmt.Errorf("only root member can upgrade objects")
+	}
+	return foundation.UpgradeObject(object, prototype)
+}
+
 // GetMemberByPublicKey gets member reference by public key.
 func (rd RootDomain) GetMemberByPublicKey(publicKey string) (*insolar.Reference, error) {
 	trimmedPublicKey := trimPublicKey(publicKey)
@@ -274,26 +283,9 @@ func (rd *RootDomain) CreateHelloWorld() (string, error) {
 }
 
 func trimPublicKey(publicKey string) string {
-	return trimMigrationAddress(between(publicKey, "KEY-----", "-----END"))
+	return foundation.TrimPublicKey(publicKey)
 }
 
 func trimMigrationAddress(burnAddress string) string {
-	return strings.ToLower(strings.Join(strings.Split(strings.TrimSpace(burnAddress), "\n"), ""))
-}
-
-func between(value string, a string, b string) string {
-	// Get substring between two strings.
-	pos := strings.Index(value, a)
-	if pos == -1 {
-		return ""
-	}
-	posLast := strings.Index(value, b)
-	if posLast == -1 {
-		return ""
-	}
-	posFirst := pos + len(a)
-	if posFirst >= posLast {
-		return ""
-	}
-	return value[posFirst:posLast]
+	return foundation.TrimAddress(burnAddress)
 }
@@ -68,3 +68,45 @@ func (bc *BaseContract) GetContext() *insolar.LogicCallContext {
 func (bc *BaseContract) SelfDestruct() error {
 	return common.CurrentProxyCtx.DeactivateObject(bc.GetReference())
 }
+
+// UpgradeTo switches contract to the upgraded prototype when current call is completed. Contracts opt in
+// to upgrades by exposing a method that calls it, memory is converted by Migrate function of the prototype.
+func (bc *BaseContract) UpgradeTo(prototype insolar.Reference) error {
+	var args []byte
+	err := common.CurrentProxyCtx.Serialize([]interface{}{}, &args)
+	if err != nil {
+		return err
+	}
+
+	// Call to itself is queued after the current one, so it's not awaited.
+	_, err = common.CurrentProxyCtx.RouteCall(bc.GetReference(), false, false, false, insolar.UpgradeMethod, args, prototype)
+	return err
+}
+
+// UpgradeObject switches object to the upgraded prototype, memory of the object is converted by Migrate
+// function of the prototype. Objects accept upgrade from the root domain, see BaseContract.UpgradeTo for
+// upgrade the object opts in to.
+func UpgradeObject(object insolar.Reference, prototype insolar.Reference) error {
+	var args []byte
+	err := common.CurrentProxyCtx.Serialize([]interface{}{}, &args)
+	if err != nil {
+		return err
+	}
+
+	res, err := common.CurrentProxyCtx.RouteCall(object, true, false, false, insolar.UpgradeMethod, args, prototype)
+	if err != nil {
+		return err
+	}
+
+	ret := [1]interface{}{}
+	var ret0 *Error
+	ret[0] = &ret0
+	err = common.CurrentProxyCtx.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
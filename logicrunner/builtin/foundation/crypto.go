@@ -24,6 +24,7 @@ import (
 	"hash/fnv"
 	"math"
 	"math/big"
+	"strings"
 
 	"github.com/insolar/x-crypto/ecdsa"
 	"github.com/insolar/x-crypto/sha256"
@@ -89,3 +90,31 @@ func hash(s string) uint32 {
 	h.Write([]byte(s))
 	return h.Sum32()
 }
+
+// TrimPublicKey gets public key content without PEM headers, line breaks and case.
+// It's used as a key for public key shards.
+func TrimPublicKey(publicKey string) string {
+	return TrimAddress(between(publicKey, "KEY-----", "-----END"))
+}
+
+// TrimAddress gets address without line breaks and case. It's used as a key for migration address shards.
+func TrimAddress(address string) string {
+	return strings.ToLower(strings.Join(strings.Split(strings.TrimSpace(address), "\n"), ""))
+}
+
+func between(value string, a string, b string) string {
+	// Get substring between two strings.
+	pos := strings.Index(value, a)
+	if pos == -1 {
+		return ""
+	}
+	posLast := strings.Index(value, b)
+	if posLast == -1 {
+		return ""
+	}
+	posFirst := pos + len(a)
+	if posFirst >= posLast {
+		return ""
+	}
+	return value[posFirst:posLast]
+}
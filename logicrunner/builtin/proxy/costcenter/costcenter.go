@@ -199,6 +199,25 @@ func (r *CostCenter) GetFeeWalletRefNoWait() error {
 	return nil
 }
 
+// GetFeeWalletRefAtPulse is proxy generated method that schedules call to provided pulse
+func (r *CostCenter) GetFeeWalletRefAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetFeeWalletRef", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetFeeWalletRefAsImmutable is proxy generated method
 func (r *CostCenter) GetFeeWalletRefAsImmutable() (insolar.Reference, error) {
 	var args [0]interface{}
@@ -286,6 +305,26 @@ func (r *CostCenter) CalcFeeNoWait(amountStr string) error {
 	return nil
 }
 
+// CalcFeeAtPulse is proxy generated method that schedules call to provided pulse
+func (r *CostCenter) CalcFeeAtPulse(executeAt insolar.PulseNumber, amountStr string) error {
+	var args [1]interface{}
+	args[0] = amountStr
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "CalcFee", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // CalcFeeAsImmutable is proxy generated method
 func (r *CostCenter) CalcFeeAsImmutable(amountStr string) (string, error) {
 	var args [1]interface{}
@@ -203,6 +203,25 @@ func (r *Deposit) GetTxHashNoWait() error {
 	return nil
 }
 
+// GetTxHashAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Deposit) GetTxHashAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetTxHash", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetTxHashAsImmutable is proxy generated method
 func (r *Deposit) GetTxHashAsImmutable() (string, error) {
 	var args [0]interface{}
@@ -288,6 +307,25 @@ func (r *Deposit) GetAmountNoWait() error {
 	return nil
 }
 
+// GetAmountAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Deposit) GetAmountAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetAmount", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetAmountAsImmutable is proxy generated method
 func (r *Deposit) GetAmountAsImmutable() (string, error) {
 	var args [0]interface{}
@@ -373,6 +411,25 @@ func (r *Deposit) ItselfNoWait() error {
 	return nil
 }
 
+// ItselfAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Deposit) ItselfAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "Itself", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ItselfAsImmutable is proxy generated method
 func (r *Deposit) ItselfAsImmutable() (interface{}, error) {
 	var args [0]interface{}
@@ -464,6 +521,29 @@ func (r *Deposit) ConfirmNoWait(migrationDaemonIndex int, migrationDaemonRef str
 	return nil
 }
 
+// ConfirmAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Deposit) ConfirmAtPulse(executeAt insolar.PulseNumber, migrationDaemonIndex int, migrationDaemonRef string, txHash string, amountStr string) error {
+	var args [4]interface{}
+	args[0] = migrationDaemonIndex
+	args[1] = migrationDaemonRef
+	args[2] = txHash
+	args[3] = amountStr
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "Confirm", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ConfirmAsImmutable is proxy generated method
 func (r *Deposit) ConfirmAsImmutable(migrationDaemonIndex int, migrationDaemonRef string, txHash string, amountStr string) error {
 	var args [4]interface{}
@@ -499,6 +579,106 @@ func (r *Deposit) ConfirmAsImmutable(migrationDaemonIndex int, migrationDaemonRe
 	return nil
 }
 
+// UnHold is proxy generated method
+func (r *Deposit) UnHold() error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := common.CurrentProxyCtx.RouteCall(r.Reference, true, false, false, "UnHold", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
+// UnHoldNoWait is proxy generated method
+func (r *Deposit) UnHoldNoWait() error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = common.CurrentProxyCtx.RouteCall(r.Reference, false, false, false, "UnHold", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UnHoldAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Deposit) UnHoldAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "UnHold", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UnHoldAsImmutable is proxy generated method
+func (r *Deposit) UnHoldAsImmutable() error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := common.CurrentProxyCtx.RouteCall(r.Reference, true, true, false, "UnHold", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
 // Transfer is proxy generated method
 func (r *Deposit) Transfer(amountStr string, wallerRef insolar.Reference) (interface{}, error) {
 	var args [2]interface{}
@@ -555,6 +735,27 @@ func (r *Deposit) TransferNoWait(amountStr string, wallerRef insolar.Reference)
 	return nil
 }
 
+// TransferAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Deposit) TransferAtPulse(executeAt insolar.PulseNumber, amountStr string, wallerRef insolar.Reference) error {
+	var args [2]interface{}
+	args[0] = amountStr
+	args[1] = wallerRef
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "Transfer", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // TransferAsImmutable is proxy generated method
 func (r *Deposit) TransferAsImmutable(amountStr string, wallerRef insolar.Reference) (interface{}, error) {
 	var args [2]interface{}
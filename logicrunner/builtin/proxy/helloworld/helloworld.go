@@ -219,6 +219,25 @@ func (r *HelloWorld) ReturnObjNoWait() error {
 	return nil
 }
 
+// ReturnObjAtPulse is proxy generated method that schedules call to provided pulse
+func (r *HelloWorld) ReturnObjAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "ReturnObj", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ReturnObjAsImmutable is proxy generated method
 func (r *HelloWorld) ReturnObjAsImmutable() (interface{}, error) {
 	var args [0]interface{}
@@ -306,6 +325,26 @@ func (r *HelloWorld) GreetNoWait(name string) error {
 	return nil
 }
 
+// GreetAtPulse is proxy generated method that schedules call to provided pulse
+func (r *HelloWorld) GreetAtPulse(executeAt insolar.PulseNumber, name string) error {
+	var args [1]interface{}
+	args[0] = name
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "Greet", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GreetAsImmutable is proxy generated method
 func (r *HelloWorld) GreetAsImmutable(name string) (interface{}, error) {
 	var args [1]interface{}
@@ -392,6 +431,25 @@ func (r *HelloWorld) CountNoWait() error {
 	return nil
 }
 
+// CountAtPulse is proxy generated method that schedules call to provided pulse
+func (r *HelloWorld) CountAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "Count", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // CountAsImmutable is proxy generated method
 func (r *HelloWorld) CountAsImmutable() (interface{}, error) {
 	var args [0]interface{}
@@ -477,6 +535,25 @@ func (r *HelloWorld) ErroredNoWait() error {
 	return nil
 }
 
+// ErroredAtPulse is proxy generated method that schedules call to provided pulse
+func (r *HelloWorld) ErroredAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "Errored", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ErroredAsImmutable is proxy generated method
 func (r *HelloWorld) ErroredAsImmutable() (interface{}, error) {
 	var args [0]interface{}
@@ -562,6 +639,25 @@ func (r *HelloWorld) PulseNumberNoWait() error {
 	return nil
 }
 
+// PulseNumberAtPulse is proxy generated method that schedules call to provided pulse
+func (r *HelloWorld) PulseNumberAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "PulseNumber", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // PulseNumberAsImmutable is proxy generated method
 func (r *HelloWorld) PulseNumberAsImmutable() (insolar.PulseNumber, error) {
 	var args [0]interface{}
@@ -647,6 +743,25 @@ func (r *HelloWorld) CreateChildNoWait() error {
 	return nil
 }
 
+// CreateChildAtPulse is proxy generated method that schedules call to provided pulse
+func (r *HelloWorld) CreateChildAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "CreateChild", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // CreateChildAsImmutable is proxy generated method
 func (r *HelloWorld) CreateChildAsImmutable() (interface{}, error) {
 	var args [0]interface{}
@@ -734,6 +849,26 @@ func (r *HelloWorld) CallNoWait(signedRequest []byte) error {
 	return nil
 }
 
+// CallAtPulse is proxy generated method that schedules call to provided pulse
+func (r *HelloWorld) CallAtPulse(executeAt insolar.PulseNumber, signedRequest []byte) error {
+	var args [1]interface{}
+	args[0] = signedRequest
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "Call", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // CallAsImmutable is proxy generated method
 func (r *HelloWorld) CallAsImmutable(signedRequest []byte) (interface{}, error) {
 	var args [1]interface{}
@@ -236,6 +236,25 @@ func (r *Member) GetNameNoWait() error {
 	return nil
 }
 
+// GetNameAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Member) GetNameAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetName", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetNameAsImmutable is proxy generated method
 func (r *Member) GetNameAsImmutable() (string, error) {
 	var args [0]interface{}
@@ -321,6 +340,25 @@ func (r *Member) GetWalletNoWait() error {
 	return nil
 }
 
+// GetWalletAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Member) GetWalletAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetWallet", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetWalletAsImmutable is proxy generated method
 func (r *Member) GetWalletAsImmutable() (insolar.Reference, error) {
 	var args [0]interface{}
@@ -406,6 +444,25 @@ func (r *Member) GetPublicKeyNoWait() error {
 	return nil
 }
 
+// GetPublicKeyAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Member) GetPublicKeyAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetPublicKey", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetPublicKeyAsImmutable is proxy generated method
 func (r *Member) GetPublicKeyAsImmutable() (string, error) {
 	var args [0]interface{}
@@ -493,6 +550,26 @@ func (r *Member) CallNoWait(signedRequest []byte) error {
 	return nil
 }
 
+// CallAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Member) CallAtPulse(executeAt insolar.PulseNumber, signedRequest []byte) error {
+	var args [1]interface{}
+	args[0] = signedRequest
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "Call", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // CallAsImmutable is proxy generated method
 func (r *Member) CallAsImmutable(signedRequest []byte) (interface{}, error) {
 	var args [1]interface{}
@@ -579,6 +656,25 @@ func (r *Member) GetDepositsNoWait() error {
 	return nil
 }
 
+// GetDepositsAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Member) GetDepositsAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetDeposits", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetDepositsAsImmutable is proxy generated method
 func (r *Member) GetDepositsAsImmutable() (map[string]interface{}, error) {
 	var args [0]interface{}
@@ -668,6 +764,26 @@ func (r *Member) FindDepositNoWait(transactionsHash string) error {
 	return nil
 }
 
+// FindDepositAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Member) FindDepositAtPulse(executeAt insolar.PulseNumber, transactionsHash string) error {
+	var args [1]interface{}
+	args[0] = transactionsHash
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "FindDeposit", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // FindDepositAsImmutable is proxy generated method
 func (r *Member) FindDepositAsImmutable(transactionsHash string) (bool, insolar.Reference, error) {
 	var args [1]interface{}
@@ -758,6 +874,27 @@ func (r *Member) AddDepositNoWait(txId string, deposit insolar.Reference) error
 	return nil
 }
 
+// AddDepositAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Member) AddDepositAtPulse(executeAt insolar.PulseNumber, txId string, deposit insolar.Reference) error {
+	var args [2]interface{}
+	args[0] = txId
+	args[1] = deposit
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "AddDeposit", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AddDepositAsImmutable is proxy generated method
 func (r *Member) AddDepositAsImmutable(txId string, deposit insolar.Reference) error {
 	var args [2]interface{}
@@ -843,6 +980,25 @@ func (r *Member) GetBurnAddressNoWait() error {
 	return nil
 }
 
+// GetBurnAddressAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Member) GetBurnAddressAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetBurnAddress", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetBurnAddressAsImmutable is proxy generated method
 func (r *Member) GetBurnAddressAsImmutable() (string, error) {
 	var args [0]interface{}
@@ -200,6 +200,26 @@ func (r *MigrationShard) GetMigrationAddressesAmountNoWait(migrationAddresses []
 	return nil
 }
 
+// GetMigrationAddressesAmountAtPulse is proxy generated method that schedules call to provided pulse
+func (r *MigrationShard) GetMigrationAddressesAmountAtPulse(executeAt insolar.PulseNumber, migrationAddresses []string) error {
+	var args [1]interface{}
+	args[0] = migrationAddresses
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetMigrationAddressesAmount", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetMigrationAddressesAmountAsImmutable is proxy generated method
 func (r *MigrationShard) GetMigrationAddressesAmountAsImmutable(migrationAddresses []string) (int, error) {
 	var args [1]interface{}
@@ -286,6 +306,26 @@ func (r *MigrationShard) AddFreeMigrationAddressesNoWait(migrationAddresses []st
 	return nil
 }
 
+// AddFreeMigrationAddressesAtPulse is proxy generated method that schedules call to provided pulse
+func (r *MigrationShard) AddFreeMigrationAddressesAtPulse(executeAt insolar.PulseNumber, migrationAddresses []string) error {
+	var args [1]interface{}
+	args[0] = migrationAddresses
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "AddFreeMigrationAddresses", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AddFreeMigrationAddressesAsImmutable is proxy generated method
 func (r *MigrationShard) AddFreeMigrationAddressesAsImmutable(migrationAddresses []string) error {
 	var args [1]interface{}
@@ -370,6 +410,25 @@ func (r *MigrationShard) GetFreeMigrationAddressNoWait() error {
 	return nil
 }
 
+// GetFreeMigrationAddressAtPulse is proxy generated method that schedules call to provided pulse
+func (r *MigrationShard) GetFreeMigrationAddressAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetFreeMigrationAddress", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetFreeMigrationAddressAsImmutable is proxy generated method
 func (r *MigrationShard) GetFreeMigrationAddressAsImmutable() (string, error) {
 	var args [0]interface{}
@@ -457,6 +516,26 @@ func (r *MigrationShard) GetRefNoWait(key string) error {
 	return nil
 }
 
+// GetRefAtPulse is proxy generated method that schedules call to provided pulse
+func (r *MigrationShard) GetRefAtPulse(executeAt insolar.PulseNumber, key string) error {
+	var args [1]interface{}
+	args[0] = key
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetRef", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetRefAsImmutable is proxy generated method
 func (r *MigrationShard) GetRefAsImmutable(key string) (string, error) {
 	var args [1]interface{}
@@ -545,6 +624,27 @@ func (r *MigrationShard) SetRefNoWait(ma string, ref string) error {
 	return nil
 }
 
+// SetRefAtPulse is proxy generated method that schedules call to provided pulse
+func (r *MigrationShard) SetRefAtPulse(executeAt insolar.PulseNumber, ma string, ref string) error {
+	var args [2]interface{}
+	args[0] = ma
+	args[1] = ref
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "SetRef", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // SetRefAsImmutable is proxy generated method
 func (r *MigrationShard) SetRefAsImmutable(ma string, ref string) error {
 	var args [2]interface{}
@@ -202,6 +202,27 @@ func (r *NodeDomain) RegisterNodeNoWait(publicKey string, role string) error {
 	return nil
 }
 
+// RegisterNodeAtPulse is proxy generated method that schedules call to provided pulse
+func (r *NodeDomain) RegisterNodeAtPulse(executeAt insolar.PulseNumber, publicKey string, role string) error {
+	var args [2]interface{}
+	args[0] = publicKey
+	args[1] = role
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "RegisterNode", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // RegisterNodeAsImmutable is proxy generated method
 func (r *NodeDomain) RegisterNodeAsImmutable(publicKey string, role string) (string, error) {
 	var args [2]interface{}
@@ -291,6 +312,26 @@ func (r *NodeDomain) GetNodeRefByPublicKeyNoWait(publicKey string) error {
 	return nil
 }
 
+// GetNodeRefByPublicKeyAtPulse is proxy generated method that schedules call to provided pulse
+func (r *NodeDomain) GetNodeRefByPublicKeyAtPulse(executeAt insolar.PulseNumber, publicKey string) error {
+	var args [1]interface{}
+	args[0] = publicKey
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetNodeRefByPublicKey", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetNodeRefByPublicKeyAsImmutable is proxy generated method
 func (r *NodeDomain) GetNodeRefByPublicKeyAsImmutable(publicKey string) (string, error) {
 	var args [1]interface{}
@@ -377,6 +418,26 @@ func (r *NodeDomain) RemoveNodeNoWait(nodeRef insolar.Reference) error {
 	return nil
 }
 
+// RemoveNodeAtPulse is proxy generated method that schedules call to provided pulse
+func (r *NodeDomain) RemoveNodeAtPulse(executeAt insolar.PulseNumber, nodeRef insolar.Reference) error {
+	var args [1]interface{}
+	args[0] = nodeRef
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "RemoveNode", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // RemoveNodeAsImmutable is proxy generated method
 func (r *NodeDomain) RemoveNodeAsImmutable(nodeRef insolar.Reference) error {
 	var args [1]interface{}
@@ -205,6 +205,25 @@ func (r *NodeRecord) GetNodeInfoNoWait() error {
 	return nil
 }
 
+// GetNodeInfoAtPulse is proxy generated method that schedules call to provided pulse
+func (r *NodeRecord) GetNodeInfoAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetNodeInfo", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetNodeInfoAsImmutable is proxy generated method
 func (r *NodeRecord) GetNodeInfoAsImmutable() (RecordInfo, error) {
 	var args [0]interface{}
@@ -290,6 +309,25 @@ func (r *NodeRecord) GetPublicKeyNoWait() error {
 	return nil
 }
 
+// GetPublicKeyAtPulse is proxy generated method that schedules call to provided pulse
+func (r *NodeRecord) GetPublicKeyAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetPublicKey", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetPublicKeyAsImmutable is proxy generated method
 func (r *NodeRecord) GetPublicKeyAsImmutable() (string, error) {
 	var args [0]interface{}
@@ -375,6 +413,25 @@ func (r *NodeRecord) GetRoleNoWait() error {
 	return nil
 }
 
+// GetRoleAtPulse is proxy generated method that schedules call to provided pulse
+func (r *NodeRecord) GetRoleAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetRole", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetRoleAsImmutable is proxy generated method
 func (r *NodeRecord) GetRoleAsImmutable() (insolar.StaticRole, error) {
 	var args [0]interface{}
@@ -458,6 +515,25 @@ func (r *NodeRecord) DestroyNoWait() error {
 	return nil
 }
 
+// DestroyAtPulse is proxy generated method that schedules call to provided pulse
+func (r *NodeRecord) DestroyAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "Destroy", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // DestroyAsImmutable is proxy generated method
 func (r *NodeRecord) DestroyAsImmutable() error {
 	var args [0]interface{}
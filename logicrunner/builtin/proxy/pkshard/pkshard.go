@@ -200,6 +200,26 @@ func (r *PKShard) GetRefNoWait(key string) error {
 	return nil
 }
 
+// GetRefAtPulse is proxy generated method that schedules call to provided pulse
+func (r *PKShard) GetRefAtPulse(executeAt insolar.PulseNumber, key string) error {
+	var args [1]interface{}
+	args[0] = key
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetRef", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetRefAsImmutable is proxy generated method
 func (r *PKShard) GetRefAsImmutable(key string) (string, error) {
 	var args [1]interface{}
@@ -288,6 +308,27 @@ func (r *PKShard) SetRefNoWait(key string, ref string) error {
 	return nil
 }
 
+// SetRefAtPulse is proxy generated method that schedules call to provided pulse
+func (r *PKShard) SetRefAtPulse(executeAt insolar.PulseNumber, key string, ref string) error {
+	var args [2]interface{}
+	args[0] = key
+	args[1] = ref
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "SetRef", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // SetRefAsImmutable is proxy generated method
 func (r *PKShard) SetRefAsImmutable(key string, ref string) error {
 	var args [2]interface{}
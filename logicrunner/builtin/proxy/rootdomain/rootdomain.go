@@ -185,6 +185,25 @@ func (r *RootDomain) GetCostCenterRefNoWait() error {
 	return nil
 }
 
+// GetCostCenterRefAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) GetCostCenterRefAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetCostCenterRef", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetCostCenterRefAsImmutable is proxy generated method
 func (r *RootDomain) GetCostCenterRefAsImmutable() (insolar.Reference, error) {
 	var args [0]interface{}
@@ -270,6 +289,25 @@ func (r *RootDomain) GetMigrationWalletRefNoWait() error {
 	return nil
 }
 
+// GetMigrationWalletRefAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) GetMigrationWalletRefAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetMigrationWalletRef", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetMigrationWalletRefAsImmutable is proxy generated method
 func (r *RootDomain) GetMigrationWalletRefAsImmutable() (insolar.Reference, error) {
 	var args [0]interface{}
@@ -355,6 +393,25 @@ func (r *RootDomain) GetMigrationAdminMemberNoWait() error {
 	return nil
 }
 
+// GetMigrationAdminMemberAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) GetMigrationAdminMemberAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetMigrationAdminMember", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetMigrationAdminMemberAsImmutable is proxy generated method
 func (r *RootDomain) GetMigrationAdminMemberAsImmutable() (insolar.Reference, error) {
 	var args [0]interface{}
@@ -440,6 +497,25 @@ func (r *RootDomain) GetActiveMigrationDaemonMembersNoWait() error {
 	return nil
 }
 
+// GetActiveMigrationDaemonMembersAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) GetActiveMigrationDaemonMembersAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetActiveMigrationDaemonMembers", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetActiveMigrationDaemonMembersAsImmutable is proxy generated method
 func (r *RootDomain) GetActiveMigrationDaemonMembersAsImmutable() ([3]insolar.Reference, error) {
 	var args [0]interface{}
@@ -525,6 +601,25 @@ func (r *RootDomain) GetRootMemberRefNoWait() error {
 	return nil
 }
 
+// GetRootMemberRefAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) GetRootMemberRefAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetRootMemberRef", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetRootMemberRefAsImmutable is proxy generated method
 func (r *RootDomain) GetRootMemberRefAsImmutable() (insolar.Reference, error) {
 	var args [0]interface{}
@@ -558,6 +653,114 @@ func (r *RootDomain) GetRootMemberRefAsImmutable() (insolar.Reference, error) {
 	return ret0, nil
 }
 
+// UpgradeObject is proxy generated method
+func (r *RootDomain) UpgradeObject(object insolar.Reference, prototype insolar.Reference) error {
+	var args [2]interface{}
+	args[0] = object
+	args[1] = prototype
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := common.CurrentProxyCtx.RouteCall(r.Reference, true, false, false, "UpgradeObject", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
+// UpgradeObjectNoWait is proxy generated method
+func (r *RootDomain) UpgradeObjectNoWait(object insolar.Reference, prototype insolar.Reference) error {
+	var args [2]interface{}
+	args[0] = object
+	args[1] = prototype
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = common.CurrentProxyCtx.RouteCall(r.Reference, false, false, false, "UpgradeObject", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpgradeObjectAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) UpgradeObjectAtPulse(executeAt insolar.PulseNumber, object insolar.Reference, prototype insolar.Reference) error {
+	var args [2]interface{}
+	args[0] = object
+	args[1] = prototype
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "UpgradeObject", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpgradeObjectAsImmutable is proxy generated method
+func (r *RootDomain) UpgradeObjectAsImmutable(object insolar.Reference, prototype insolar.Reference) error {
+	var args [2]interface{}
+	args[0] = object
+	args[1] = prototype
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := common.CurrentProxyCtx.RouteCall(r.Reference, true, true, false, "UpgradeObject", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
 // GetMemberByPublicKey is proxy generated method
 func (r *RootDomain) GetMemberByPublicKey(publicKey string) (*insolar.Reference, error) {
 	var args [1]interface{}
@@ -612,6 +815,26 @@ func (r *RootDomain) GetMemberByPublicKeyNoWait(publicKey string) error {
 	return nil
 }
 
+// GetMemberByPublicKeyAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) GetMemberByPublicKeyAtPulse(executeAt insolar.PulseNumber, publicKey string) error {
+	var args [1]interface{}
+	args[0] = publicKey
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetMemberByPublicKey", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetMemberByPublicKeyAsImmutable is proxy generated method
 func (r *RootDomain) GetMemberByPublicKeyAsImmutable(publicKey string) (*insolar.Reference, error) {
 	var args [1]interface{}
@@ -700,6 +923,26 @@ func (r *RootDomain) GetMemberByMigrationAddressNoWait(migrationAddress string)
 	return nil
 }
 
+// GetMemberByMigrationAddressAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) GetMemberByMigrationAddressAtPulse(executeAt insolar.PulseNumber, migrationAddress string) error {
+	var args [1]interface{}
+	args[0] = migrationAddress
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetMemberByMigrationAddress", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetMemberByMigrationAddressAsImmutable is proxy generated method
 func (r *RootDomain) GetMemberByMigrationAddressAsImmutable(migrationAddress string) (*insolar.Reference, error) {
 	var args [1]interface{}
@@ -786,6 +1029,25 @@ func (r *RootDomain) GetCostCenterNoWait() error {
 	return nil
 }
 
+// GetCostCenterAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) GetCostCenterAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetCostCenter", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetCostCenterAsImmutable is proxy generated method
 func (r *RootDomain) GetCostCenterAsImmutable() (insolar.Reference, error) {
 	var args [0]interface{}
@@ -871,6 +1133,25 @@ func (r *RootDomain) GetNodeDomainRefNoWait() error {
 	return nil
 }
 
+// GetNodeDomainRefAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) GetNodeDomainRefAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetNodeDomainRef", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetNodeDomainRefAsImmutable is proxy generated method
 func (r *RootDomain) GetNodeDomainRefAsImmutable() (insolar.Reference, error) {
 	var args [0]interface{}
@@ -956,6 +1237,25 @@ func (r *RootDomain) InfoNoWait() error {
 	return nil
 }
 
+// InfoAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) InfoAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "Info", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // InfoAsImmutable is proxy generated method
 func (r *RootDomain) InfoAsImmutable() (interface{}, error) {
 	var args [0]interface{}
@@ -1041,6 +1341,26 @@ func (r *RootDomain) AddMigrationAddressesNoWait(migrationAddresses []string) er
 	return nil
 }
 
+// AddMigrationAddressesAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) AddMigrationAddressesAtPulse(executeAt insolar.PulseNumber, migrationAddresses []string) error {
+	var args [1]interface{}
+	args[0] = migrationAddresses
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "AddMigrationAddresses", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AddMigrationAddressesAsImmutable is proxy generated method
 func (r *RootDomain) AddMigrationAddressesAsImmutable(migrationAddresses []string) error {
 	var args [1]interface{}
@@ -1125,6 +1445,26 @@ func (r *RootDomain) AddMigrationAddressNoWait(migrationAddress string) error {
 	return nil
 }
 
+// AddMigrationAddressAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) AddMigrationAddressAtPulse(executeAt insolar.PulseNumber, migrationAddress string) error {
+	var args [1]interface{}
+	args[0] = migrationAddress
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "AddMigrationAddress", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AddMigrationAddressAsImmutable is proxy generated method
 func (r *RootDomain) AddMigrationAddressAsImmutable(migrationAddress string) error {
 	var args [1]interface{}
@@ -1211,6 +1551,26 @@ func (r *RootDomain) GetFreeMigrationAddressNoWait(publicKey string) error {
 	return nil
 }
 
+// GetFreeMigrationAddressAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) GetFreeMigrationAddressAtPulse(executeAt insolar.PulseNumber, publicKey string) error {
+	var args [1]interface{}
+	args[0] = publicKey
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetFreeMigrationAddress", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetFreeMigrationAddressAsImmutable is proxy generated method
 func (r *RootDomain) GetFreeMigrationAddressAsImmutable(publicKey string) (string, error) {
 	var args [1]interface{}
@@ -1301,6 +1661,28 @@ func (r *RootDomain) AddNewMemberToMapsNoWait(publicKey string, migrationAddress
 	return nil
 }
 
+// AddNewMemberToMapsAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) AddNewMemberToMapsAtPulse(executeAt insolar.PulseNumber, publicKey string, migrationAddress string, memberRef insolar.Reference) error {
+	var args [3]interface{}
+	args[0] = publicKey
+	args[1] = migrationAddress
+	args[2] = memberRef
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "AddNewMemberToMaps", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AddNewMemberToMapsAsImmutable is proxy generated method
 func (r *RootDomain) AddNewMemberToMapsAsImmutable(publicKey string, migrationAddress string, memberRef insolar.Reference) error {
 	var args [3]interface{}
@@ -1389,6 +1771,27 @@ func (r *RootDomain) AddNewMemberToPublicKeyMapNoWait(publicKey string, memberRe
 	return nil
 }
 
+// AddNewMemberToPublicKeyMapAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) AddNewMemberToPublicKeyMapAtPulse(executeAt insolar.PulseNumber, publicKey string, memberRef insolar.Reference) error {
+	var args [2]interface{}
+	args[0] = publicKey
+	args[1] = memberRef
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "AddNewMemberToPublicKeyMap", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AddNewMemberToPublicKeyMapAsImmutable is proxy generated method
 func (r *RootDomain) AddNewMemberToPublicKeyMapAsImmutable(publicKey string, memberRef insolar.Reference) error {
 	var args [2]interface{}
@@ -1474,6 +1877,25 @@ func (r *RootDomain) CreateHelloWorldNoWait() error {
 	return nil
 }
 
+// CreateHelloWorldAtPulse is proxy generated method that schedules call to provided pulse
+func (r *RootDomain) CreateHelloWorldAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "CreateHelloWorld", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // CreateHelloWorldAsImmutable is proxy generated method
 func (r *RootDomain) CreateHelloWorldAsImmutable() (string, error) {
 	var args [0]interface{}
@@ -205,6 +205,28 @@ func (r *Wallet) TransferNoWait(rootDomainRef insolar.Reference, amountStr strin
 	return nil
 }
 
+// TransferAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Wallet) TransferAtPulse(executeAt insolar.PulseNumber, rootDomainRef insolar.Reference, amountStr string, toMember *insolar.Reference) error {
+	var args [3]interface{}
+	args[0] = rootDomainRef
+	args[1] = amountStr
+	args[2] = toMember
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "Transfer", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // TransferAsImmutable is proxy generated method
 func (r *Wallet) TransferAsImmutable(rootDomainRef insolar.Reference, amountStr string, toMember *insolar.Reference) (interface{}, error) {
 	var args [3]interface{}
@@ -293,6 +315,26 @@ func (r *Wallet) AcceptNoWait(amountStr string) error {
 	return nil
 }
 
+// AcceptAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Wallet) AcceptAtPulse(executeAt insolar.PulseNumber, amountStr string) error {
+	var args [1]interface{}
+	args[0] = amountStr
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "Accept", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // AcceptAsImmutable is proxy generated method
 func (r *Wallet) AcceptAsImmutable(amountStr string) error {
 	var args [1]interface{}
@@ -377,6 +419,26 @@ func (r *Wallet) RollBackNoWait(amountStr string) error {
 	return nil
 }
 
+// RollBackAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Wallet) RollBackAtPulse(executeAt insolar.PulseNumber, amountStr string) error {
+	var args [1]interface{}
+	args[0] = amountStr
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "RollBack", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // RollBackAsImmutable is proxy generated method
 func (r *Wallet) RollBackAsImmutable(amountStr string) error {
 	var args [1]interface{}
@@ -461,6 +523,25 @@ func (r *Wallet) GetBalanceNoWait() error {
 	return nil
 }
 
+// GetBalanceAtPulse is proxy generated method that schedules call to provided pulse
+func (r *Wallet) GetBalanceAtPulse(executeAt insolar.PulseNumber) error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "GetBalance", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // GetBalanceAsImmutable is proxy generated method
 func (r *Wallet) GetBalanceAsImmutable() (string, error) {
 	var args [0]interface{}
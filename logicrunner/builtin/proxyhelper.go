@@ -19,6 +19,8 @@ package builtin
 import (
 	"reflect"
 
+	"github.com/pkg/errors"
+
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/logicrunner/builtin/foundation"
 	lrCommon "github.com/insolar/insolar/logicrunner/common"
@@ -79,6 +81,42 @@ func (h *ProxyHelper) RouteCall(ref insolar.Reference, wait bool, immutable bool
 	return res.Result, nil
 }
 
+func (h *ProxyHelper) ScheduleCall(ref insolar.Reference, pulse insolar.PulseNumber, method string, args []byte,
+	proxyPrototype insolar.Reference) error {
+
+	if h.GetSystemError() != nil {
+		return h.GetSystemError()
+	}
+
+	current, err := foundation.GetPulseNumber()
+	if err != nil {
+		return err
+	}
+	if pulse <= current {
+		// Not a system error, contract decides what to do with it.
+		return errors.Errorf("call should be scheduled after current pulse %v (got %v)", current, pulse)
+	}
+
+	res := rpctypes.UpRouteResp{}
+	req := rpctypes.UpRouteReq{
+		UpBaseReq: h.getUpBaseReq(),
+
+		Object:    ref,
+		ExecuteAt: pulse,
+		Method:    method,
+		Arguments: args,
+		Prototype: proxyPrototype,
+	}
+
+	err = h.methods.RouteCall(req, &res)
+	if err != nil {
+		h.SetSystemError(err)
+		return err
+	}
+
+	return nil
+}
+
 func (h *ProxyHelper) SaveAsChild(
 	parentRef, classRef insolar.Reference,
 	constructorName string, argsSerialized []byte,
@@ -29,6 +29,10 @@ type ProxyHelper interface {
 		wait bool, immutable bool, saga bool,
 		method string, args []byte, proxyPrototype insolar.Reference,
 	) (result []byte, err error)
+	ScheduleCall(
+		ref insolar.Reference, pulse insolar.PulseNumber,
+		method string, args []byte, proxyPrototype insolar.Reference,
+	) error
 	SaveAsChild(
 		parentRef, classRef insolar.Reference, constructorName string, argsSerialized []byte,
 	) (objRef *insolar.Reference, result []byte, err error)
@@ -0,0 +1,509 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package contracttest provides in-process harness for contract unit-tests.
+//
+// Harness keeps objects in memory and executes contracts through their wrappers, so contract calls
+// made by proxies (method calls, child creation, deactivation) don't require logic runner, ledger or network.
+// Builtin contracts are deployed on creation with their genesis prototypes. Goplugin contracts can be deployed
+// with wrapper generated by `insgocc wrapper -m builtin`, which provides `Initialize` function.
+//
+// Tests that use harness with builtin contracts should be placed in external test package (e.g. `member_test`).
+package contracttest
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/rootdomain"
+	"github.com/insolar/insolar/logicrunner/builtin"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+	"github.com/insolar/insolar/logicrunner/common"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+)
+
+// Object is a state of the object stored in harness.
+type Object struct {
+	Reference   insolar.Reference
+	Parent      insolar.Reference
+	Prototype   insolar.Reference
+	Memory      []byte
+	Deactivated bool
+}
+
+type prototype struct {
+	code    insolar.Reference
+	wrapper insolar.ContractWrapper
+}
+
+// Harness is a fake ledger and logic runner for contracts.
+type Harness struct {
+	lock       sync.Mutex
+	pulse      insolar.PulseNumber
+	sequence   uint64
+	objects    map[insolar.Reference]*Object
+	prototypes map[insolar.Reference]prototype
+	// sagas are saga calls registered during current top-level call.
+	sagas []rpctypes.UpRouteReq
+	// scheduled are calls scheduled to future pulses.
+	scheduled []rpctypes.UpRouteReq
+	// deactivated are objects deactivated during current calls.
+	deactivated map[insolar.Reference]bool
+}
+
+// New creates harness with deployed builtin contracts and installs it as proxy context for contracts.
+// Only one harness can be used at the moment.
+func New() *Harness {
+	h := &Harness{
+		pulse:       insolar.FirstPulseNumber,
+		objects:     map[insolar.Reference]*Object{},
+		prototypes:  map[insolar.Reference]prototype{},
+		deactivated: map[insolar.Reference]bool{},
+	}
+
+	wrappers := builtin.InitializeContractMethods()
+	codes := builtin.InitializeCodeRefs()
+	for _, proto := range builtin.InitializePrototypeDescriptors() {
+		code, err := proto.Code()
+		if err != nil {
+			panic(errors.Wrap(err, "failed to get code of builtin prototype"))
+		}
+		h.prototypes[*proto.HeadRef()] = prototype{code: *code, wrapper: wrappers[codes[*code]]}
+	}
+
+	common.CurrentProxyCtx = builtin.NewProxyHelper(h)
+	return h
+}
+
+// Deploy registers contract wrapper under provided prototype reference.
+func (h *Harness) Deploy(proto insolar.Reference, wrapper insolar.ContractWrapper) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.prototypes[proto] = prototype{code: h.newReference(), wrapper: wrapper}
+}
+
+// Pulse returns current pulse of harness.
+func (h *Harness) Pulse() insolar.PulseNumber {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.pulse
+}
+
+// AdvancePulse moves current pulse forward on provided delta.
+func (h *Harness) AdvancePulse(delta insolar.PulseNumber) insolar.PulseNumber {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.pulse += delta
+	return h.pulse
+}
+
+// Activate stores object with provided state like genesis does. Object reference is the genesis reference
+// of provided name.
+func (h *Harness) Activate(name string, parent, prototype insolar.Reference, state interface{}) (insolar.Reference, error) {
+	memory, err := insolar.Serialize(state)
+	if err != nil {
+		return insolar.Reference{}, errors.Wrap(err, "failed to serialize state")
+	}
+
+	ref := rootdomain.GenesisRef(name)
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if _, ok := h.objects[ref]; ok {
+		return insolar.Reference{}, errors.Errorf("object %s already exists", name)
+	}
+	h.objects[ref] = &Object{
+		Reference: ref,
+		Parent:    parent,
+		Prototype: prototype,
+		Memory:    memory,
+	}
+	return ref, nil
+}
+
+// Object returns copy of the stored object.
+func (h *Harness) Object(ref insolar.Reference) (Object, bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	obj, ok := h.objects[ref]
+	if !ok {
+		return Object{}, false
+	}
+	return *obj, true
+}
+
+// State deserializes memory of the object into provided contract struct.
+func (h *Harness) State(ref insolar.Reference, state interface{}) error {
+	obj, ok := h.Object(ref)
+	if !ok {
+		return errors.Errorf("object %s not found", ref.String())
+	}
+	return insolar.Deserialize(obj.Memory, state)
+}
+
+// Create calls constructor of the prototype on behalf of caller. Returned error is either system error or
+// error returned by constructor.
+func (h *Harness) Create(
+	caller, parent, prototype insolar.Reference, constructor string, args ...interface{},
+) (insolar.Reference, error) {
+	argsSerialized, err := serializeArgs(args)
+	if err != nil {
+		return insolar.Reference{}, err
+	}
+
+	ref, result, err := h.saveAsChild(h.topRequest(caller), parent, prototype, constructor, argsSerialized)
+	if err != nil {
+		return insolar.Reference{}, err
+	}
+	err = h.finishCall()
+	if err != nil {
+		return insolar.Reference{}, err
+	}
+
+	var constructorError *foundation.Error
+	err = insolar.Deserialize(result, []interface{}{&constructorError})
+	if err != nil {
+		return insolar.Reference{}, errors.Wrap(err, "failed to deserialize constructor result")
+	}
+	if constructorError != nil {
+		return insolar.Reference{}, constructorError
+	}
+	return *ref, nil
+}
+
+// Call calls method of the object on behalf of caller and returns serialized method results.
+func (h *Harness) Call(caller, object insolar.Reference, method string, args ...interface{}) (Result, error) {
+	return h.call(caller, object, false, method, args)
+}
+
+// CallImmutable calls method of the object without saving object's state.
+func (h *Harness) CallImmutable(caller, object insolar.Reference, method string, args ...interface{}) (Result, error) {
+	return h.call(caller, object, true, method, args)
+}
+
+func (h *Harness) call(
+	caller, object insolar.Reference, immutable bool, method string, args []interface{},
+) (Result, error) {
+	argsSerialized, err := serializeArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := h.Object(object)
+	if !ok {
+		return nil, errors.Errorf("object %s not found", object.String())
+	}
+
+	res := rpctypes.UpRouteResp{}
+	err = h.RouteCall(rpctypes.UpRouteReq{
+		UpBaseReq: h.topRequest(caller),
+		Wait:      true,
+		Immutable: immutable,
+		Object:    object,
+		Method:    method,
+		Arguments: argsSerialized,
+		Prototype: obj.Prototype,
+	}, &res)
+	if err != nil {
+		return nil, err
+	}
+	err = h.finishCall()
+	if err != nil {
+		return nil, err
+	}
+	return Result(res.Result), nil
+}
+
+// GetCode is not supported, contracts code is executed in-process.
+func (h *Harness) GetCode(rpctypes.UpGetCodeReq, *rpctypes.UpGetCodeResp) error {
+	return errors.New("GetCode is not supported by contract harness")
+}
+
+// RouteCall executes method of the object called through proxy.
+func (h *Harness) RouteCall(req rpctypes.UpRouteReq, resp *rpctypes.UpRouteResp) error {
+	if req.Saga {
+		// Saga calls are executed after the calling method is finished.
+		h.lock.Lock()
+		h.sagas = append(h.sagas, req)
+		h.lock.Unlock()
+		return nil
+	}
+	if req.ExecuteAt != 0 {
+		h.lock.Lock()
+		defer h.lock.Unlock()
+		if req.ExecuteAt <= h.pulse {
+			return errors.Errorf("call should be scheduled after pulse %v (got %v)", h.pulse, req.ExecuteAt)
+		}
+		h.scheduled = append(h.scheduled, req)
+		return nil
+	}
+
+	h.lock.Lock()
+	obj, ok := h.objects[req.Object]
+	if !ok || obj.Deactivated {
+		h.lock.Unlock()
+		return errors.Errorf("object %s not found", req.Object.String())
+	}
+	if !req.Prototype.IsEmpty() && !req.Prototype.Equal(obj.Prototype) {
+		h.lock.Unlock()
+		return errors.New("proxy call error: try to call method of prototype as method of another prototype")
+	}
+	proto, ok := h.prototypes[obj.Prototype]
+	if !ok {
+		h.lock.Unlock()
+		return errors.Errorf("prototype %s is not deployed", obj.Prototype.String())
+	}
+	memory := obj.Memory
+	callCtx := h.callContext(req.UpBaseReq, *obj, proto.code)
+	h.lock.Unlock()
+
+	methodFunc, ok := proto.wrapper.Methods[req.Method]
+	if !ok {
+		return errors.Errorf("method %s not found", req.Method)
+	}
+
+	var (
+		newMemory, result []byte
+		err               error
+	)
+	execute(callCtx, func() {
+		newMemory, result, err = methodFunc(memory, req.Arguments)
+	})
+	if err != nil {
+		return errors.Wrap(err, "executor error")
+	}
+
+	if !req.Immutable {
+		h.lock.Lock()
+		obj.Memory = newMemory
+		h.lock.Unlock()
+	}
+	resp.Result = result
+	return nil
+}
+
+// SaveAsChild executes constructor of the prototype called through proxy.
+func (h *Harness) SaveAsChild(req rpctypes.UpSaveAsChildReq, resp *rpctypes.UpSaveAsChildResp) error {
+	ref, result, err := h.saveAsChild(req.UpBaseReq, req.Parent, req.Prototype, req.ConstructorName, req.ArgsSerialized)
+	if err != nil {
+		return err
+	}
+	resp.Reference = ref
+	resp.Result = result
+	return nil
+}
+
+// DeactivateObject marks calling object as deactivated. Object is deactivated when the call is finished.
+func (h *Harness) DeactivateObject(req rpctypes.UpDeactivateObjectReq, resp *rpctypes.UpDeactivateObjectResp) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.deactivated[req.Callee] = true
+	return nil
+}
+
+func (h *Harness) saveAsChild(
+	base rpctypes.UpBaseReq, parent, protoRef insolar.Reference, constructor string, args []byte,
+) (*insolar.Reference, []byte, error) {
+	h.lock.Lock()
+	proto, ok := h.prototypes[protoRef]
+	if !ok {
+		h.lock.Unlock()
+		return nil, nil, errors.Errorf("prototype %s is not deployed", protoRef.String())
+	}
+	ref := h.newReference()
+	obj := Object{
+		Reference: ref,
+		Parent:    parent,
+		Prototype: protoRef,
+	}
+	// Reference of created object is the reference of its constructor request like in logic runner.
+	callCtx := h.callContext(base, obj, proto.code)
+	callCtx.Request = &ref
+	h.lock.Unlock()
+
+	constructorFunc, ok := proto.wrapper.Constructors[constructor]
+	if !ok {
+		return nil, nil, errors.Errorf("constructor %s not found", constructor)
+	}
+
+	var (
+		memory, result []byte
+		err            error
+	)
+	execute(callCtx, func() {
+		memory, result, err = constructorFunc(args)
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "executor error")
+	}
+	if memory == nil {
+		// Constructor returned logical error.
+		return nil, result, nil
+	}
+
+	h.lock.Lock()
+	obj.Memory = memory
+	h.objects[ref] = &obj
+	h.lock.Unlock()
+	return &ref, result, nil
+}
+
+// finishCall applies deactivations and executes saga calls registered during top-level call.
+func (h *Harness) finishCall() error {
+	h.lock.Lock()
+	for ref := range h.deactivated {
+		if obj, ok := h.objects[ref]; ok {
+			obj.Deactivated = true
+		}
+	}
+	h.deactivated = map[insolar.Reference]bool{}
+	sagas := h.sagas
+	h.sagas = nil
+	h.lock.Unlock()
+
+	for _, saga := range sagas {
+		saga.Saga = false
+		saga.UpBaseReq = h.topRequest(saga.Callee)
+		err := h.RouteCall(saga, &rpctypes.UpRouteResp{})
+		if err != nil {
+			return errors.Wrapf(err, "saga call %s failed", saga.Method)
+		}
+		err = h.finishCall()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExecuteScheduled executes calls scheduled to current or earlier pulses in order of scheduling.
+func (h *Harness) ExecuteScheduled() error {
+	h.lock.Lock()
+	var ready, rest []rpctypes.UpRouteReq
+	for _, req := range h.scheduled {
+		if req.ExecuteAt <= h.pulse {
+			ready = append(ready, req)
+		} else {
+			rest = append(rest, req)
+		}
+	}
+	h.scheduled = rest
+	h.lock.Unlock()
+
+	for _, req := range ready {
+		req.ExecuteAt = 0
+		req.UpBaseReq = h.topRequest(req.Callee)
+		err := h.RouteCall(req, &rpctypes.UpRouteResp{})
+		if err != nil {
+			return errors.Wrapf(err, "scheduled call %s failed", req.Method)
+		}
+		err = h.finishCall()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// topRequest creates request made by caller from outside of contracts.
+func (h *Harness) topRequest(caller insolar.Reference) rpctypes.UpBaseReq {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return rpctypes.UpBaseReq{
+		Mode:    insolar.ExecuteCallMode,
+		Callee:  caller,
+		Request: h.newReference(),
+	}
+}
+
+// callContext creates context of execution for the object called from base request. Should be called under lock.
+func (h *Harness) callContext(base rpctypes.UpBaseReq, obj Object, code insolar.Reference) *insolar.LogicCallContext {
+	request := h.newReference()
+	return &insolar.LogicCallContext{
+		Mode:            base.Mode,
+		Request:         &request,
+		Callee:          &obj.Reference,
+		Parent:          &obj.Parent,
+		Prototype:       &obj.Prototype,
+		Code:            &code,
+		Caller:          &base.Callee,
+		CallerPrototype: &base.CalleePrototype,
+	}
+}
+
+// newReference creates unique reference in current pulse. Should be called under lock.
+func (h *Harness) newReference() insolar.Reference {
+	h.sequence++
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, h.sequence)
+	hash := sha256.Sum256(buf)
+	return *insolar.NewReference(*insolar.NewID(h.pulse, hash[:]))
+}
+
+// execute runs contract function in separate goroutine with its own call context, so nested calls
+// don't override context of the caller.
+func execute(callCtx *insolar.LogicCallContext, f func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		foundation.SetLogicalContext(callCtx)
+		defer foundation.ClearContext()
+		f()
+	}()
+	<-done
+}
+
+func serializeArgs(args []interface{}) ([]byte, error) {
+	if args == nil {
+		args = []interface{}{}
+	}
+	res, err := insolar.Serialize(args)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize arguments")
+	}
+	return res, nil
+}
+
+// Result is serialized results of contract method.
+type Result []byte
+
+// Decode deserializes method results into provided pointers. Last result of contract method is
+// `*foundation.Error`, it's returned as error.
+func (r Result) Decode(results ...interface{}) error {
+	var contractErr *foundation.Error
+	ret := make([]interface{}, 0, len(results)+1)
+	ret = append(ret, results...)
+	ret = append(ret, &contractErr)
+	err := insolar.Deserialize(r, &ret)
+	if err != nil {
+		return errors.Wrap(err, "failed to deserialize results")
+	}
+	if contractErr != nil {
+		return contractErr
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package contracttest_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/logicrunner/builtin/contract/helloworld"
+	hwProxy "github.com/insolar/insolar/logicrunner/builtin/proxy/helloworld"
+	"github.com/insolar/insolar/logicrunner/contracttest"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+)
+
+func TestHarness_HelloWorld(t *testing.T) {
+	h := contracttest.New()
+	caller := gen.Reference()
+	parent := gen.Reference()
+
+	hw, err := h.Create(caller, parent, *hwProxy.PrototypeReference, "New")
+	require.NoError(t, err)
+
+	t.Run("mutable call changes state", func(t *testing.T) {
+		res, err := h.Call(caller, hw, "Greet", "Bob")
+		require.NoError(t, err)
+		var greeting string
+		require.NoError(t, res.Decode(&greeting))
+		require.Equal(t, "Hello Bob' world", greeting)
+
+		var state helloworld.HelloWorld
+		require.NoError(t, h.State(hw, &state))
+		require.Equal(t, 1, state.Greeted)
+	})
+
+	t.Run("immutable call doesn't change state", func(t *testing.T) {
+		_, err := h.CallImmutable(caller, hw, "Greet", "Alice")
+		require.NoError(t, err)
+
+		var state helloworld.HelloWorld
+		require.NoError(t, h.State(hw, &state))
+		require.Equal(t, 1, state.Greeted)
+	})
+
+	t.Run("contract error", func(t *testing.T) {
+		res, err := h.Call(caller, hw, "Errored")
+		require.NoError(t, err)
+		var ret interface{}
+		err = res.Decode(&ret)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "TestError")
+	})
+
+	t.Run("child creation through proxy", func(t *testing.T) {
+		res, err := h.Call(caller, hw, "CreateChild")
+		require.NoError(t, err)
+		var childStr string
+		require.NoError(t, res.Decode(&childStr))
+
+		child, err := insolar.NewReferenceFromBase58(childStr)
+		require.NoError(t, err)
+		obj, ok := h.Object(*child)
+		require.True(t, ok)
+		require.Equal(t, hw, obj.Parent)
+		require.Equal(t, *hwProxy.PrototypeReference, obj.Prototype)
+	})
+
+	t.Run("pulse advance", func(t *testing.T) {
+		pn := h.AdvancePulse(10)
+
+		res, err := h.Call(caller, hw, "PulseNumber")
+		require.NoError(t, err)
+		var got insolar.PulseNumber
+		require.NoError(t, res.Decode(&got))
+		require.Equal(t, pn, got)
+	})
+
+	t.Run("scheduled call", func(t *testing.T) {
+		args, err := insolar.Serialize([]interface{}{"Carol"})
+		require.NoError(t, err)
+		err = h.RouteCall(rpctypes.UpRouteReq{
+			UpBaseReq: rpctypes.UpBaseReq{Callee: caller},
+			ExecuteAt: h.Pulse() + 5,
+			Object:    hw,
+			Method:    "Greet",
+			Arguments: args,
+		}, &rpctypes.UpRouteResp{})
+		require.NoError(t, err)
+
+		var state helloworld.HelloWorld
+		require.NoError(t, h.ExecuteScheduled())
+		require.NoError(t, h.State(hw, &state))
+		require.Equal(t, 1, state.Greeted)
+
+		h.AdvancePulse(5)
+		require.NoError(t, h.ExecuteScheduled())
+		require.NoError(t, h.State(hw, &state))
+		require.Equal(t, 2, state.Greeted)
+	})
+
+	t.Run("unknown prototype", func(t *testing.T) {
+		_, err := h.Create(caller, parent, gen.Reference(), "New")
+		require.Error(t, err)
+	})
+}
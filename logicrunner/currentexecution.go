@@ -44,6 +44,7 @@ type Transcript struct {
 	Deactivate       bool
 	OutgoingRequests []OutgoingRequest
 	FromLedger       bool
+	Mode             insolar.CallMode
 }
 
 func NewTranscript(
@@ -10,6 +10,7 @@ import (
 
 	"github.com/gojuno/minimock"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
 )
 
 // ExecutionBrokerIMock implements ExecutionBrokerI
@@ -46,6 +47,12 @@ type ExecutionBrokerIMock struct {
 	beforeAddRequestsFromPrevExecutorCounter uint64
 	AddRequestsFromPrevExecutorMock          mExecutionBrokerIMockAddRequestsFromPrevExecutor
 
+	funcCheckQueueLimit          func(ctx context.Context, request *record.IncomingRequest) (err error)
+	inspectFuncCheckQueueLimit   func(ctx context.Context, request *record.IncomingRequest)
+	afterCheckQueueLimitCounter  uint64
+	beforeCheckQueueLimitCounter uint64
+	CheckQueueLimitMock          mExecutionBrokerIMockCheckQueueLimit
+
 	funcFetchMoreRequestsFromLedger          func(ctx context.Context)
 	inspectFuncFetchMoreRequestsFromLedger   func(ctx context.Context)
 	afterFetchMoreRequestsFromLedgerCounter  uint64
@@ -135,6 +142,9 @@ func NewExecutionBrokerIMock(t minimock.Tester) *ExecutionBrokerIMock {
 	m.AddRequestsFromPrevExecutorMock = mExecutionBrokerIMockAddRequestsFromPrevExecutor{mock: m}
 	m.AddRequestsFromPrevExecutorMock.callArgs = []*ExecutionBrokerIMockAddRequestsFromPrevExecutorParams{}
 
+	m.CheckQueueLimitMock = mExecutionBrokerIMockCheckQueueLimit{mock: m}
+	m.CheckQueueLimitMock.callArgs = []*ExecutionBrokerIMockCheckQueueLimitParams{}
+
 	m.FetchMoreRequestsFromLedgerMock = mExecutionBrokerIMockFetchMoreRequestsFromLedger{mock: m}
 	m.FetchMoreRequestsFromLedgerMock.callArgs = []*ExecutionBrokerIMockFetchMoreRequestsFromLedgerParams{}
 
@@ -1109,6 +1119,222 @@ func (m *ExecutionBrokerIMock) MinimockAddRequestsFromPrevExecutorInspect() {
 	}
 }
 
+type mExecutionBrokerIMockCheckQueueLimit struct {
+	mock               *ExecutionBrokerIMock
+	defaultExpectation *ExecutionBrokerIMockCheckQueueLimitExpectation
+	expectations       []*ExecutionBrokerIMockCheckQueueLimitExpectation
+
+	callArgs []*ExecutionBrokerIMockCheckQueueLimitParams
+	mutex    sync.RWMutex
+}
+
+// ExecutionBrokerIMockCheckQueueLimitExpectation specifies expectation struct of the ExecutionBrokerI.CheckQueueLimit
+type ExecutionBrokerIMockCheckQueueLimitExpectation struct {
+	mock    *ExecutionBrokerIMock
+	params  *ExecutionBrokerIMockCheckQueueLimitParams
+	results *ExecutionBrokerIMockCheckQueueLimitResults
+	Counter uint64
+}
+
+// ExecutionBrokerIMockCheckQueueLimitParams contains parameters of the ExecutionBrokerI.CheckQueueLimit
+type ExecutionBrokerIMockCheckQueueLimitParams struct {
+	ctx     context.Context
+	request *record.IncomingRequest
+}
+
+// ExecutionBrokerIMockCheckQueueLimitResults contains results of the ExecutionBrokerI.CheckQueueLimit
+type ExecutionBrokerIMockCheckQueueLimitResults struct {
+	err error
+}
+
+// Expect sets up expected params for ExecutionBrokerI.CheckQueueLimit
+func (mmCheckQueueLimit *mExecutionBrokerIMockCheckQueueLimit) Expect(ctx context.Context, request *record.IncomingRequest) *mExecutionBrokerIMockCheckQueueLimit {
+	if mmCheckQueueLimit.mock.funcCheckQueueLimit != nil {
+		mmCheckQueueLimit.mock.t.Fatalf("ExecutionBrokerIMock.CheckQueueLimit mock is already set by Set")
+	}
+
+	if mmCheckQueueLimit.defaultExpectation == nil {
+		mmCheckQueueLimit.defaultExpectation = &ExecutionBrokerIMockCheckQueueLimitExpectation{}
+	}
+
+	mmCheckQueueLimit.defaultExpectation.params = &ExecutionBrokerIMockCheckQueueLimitParams{ctx, request}
+	for _, e := range mmCheckQueueLimit.expectations {
+		if minimock.Equal(e.params, mmCheckQueueLimit.defaultExpectation.params) {
+			mmCheckQueueLimit.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckQueueLimit.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckQueueLimit
+}
+
+// Inspect accepts an inspector function that has same arguments as the ExecutionBrokerI.CheckQueueLimit
+func (mmCheckQueueLimit *mExecutionBrokerIMockCheckQueueLimit) Inspect(f func(ctx context.Context, request *record.IncomingRequest)) *mExecutionBrokerIMockCheckQueueLimit {
+	if mmCheckQueueLimit.mock.inspectFuncCheckQueueLimit != nil {
+		mmCheckQueueLimit.mock.t.Fatalf("Inspect function is already set for ExecutionBrokerIMock.CheckQueueLimit")
+	}
+
+	mmCheckQueueLimit.mock.inspectFuncCheckQueueLimit = f
+
+	return mmCheckQueueLimit
+}
+
+// Return sets up results that will be returned by ExecutionBrokerI.CheckQueueLimit
+func (mmCheckQueueLimit *mExecutionBrokerIMockCheckQueueLimit) Return(err error) *ExecutionBrokerIMock {
+	if mmCheckQueueLimit.mock.funcCheckQueueLimit != nil {
+		mmCheckQueueLimit.mock.t.Fatalf("ExecutionBrokerIMock.CheckQueueLimit mock is already set by Set")
+	}
+
+	if mmCheckQueueLimit.defaultExpectation == nil {
+		mmCheckQueueLimit.defaultExpectation = &ExecutionBrokerIMockCheckQueueLimitExpectation{mock: mmCheckQueueLimit.mock}
+	}
+	mmCheckQueueLimit.defaultExpectation.results = &ExecutionBrokerIMockCheckQueueLimitResults{err}
+	return mmCheckQueueLimit.mock
+}
+
+//Set uses given function f to mock the ExecutionBrokerI.CheckQueueLimit method
+func (mmCheckQueueLimit *mExecutionBrokerIMockCheckQueueLimit) Set(f func(ctx context.Context, request *record.IncomingRequest) (err error)) *ExecutionBrokerIMock {
+	if mmCheckQueueLimit.defaultExpectation != nil {
+		mmCheckQueueLimit.mock.t.Fatalf("Default expectation is already set for the ExecutionBrokerI.CheckQueueLimit method")
+	}
+
+	if len(mmCheckQueueLimit.expectations) > 0 {
+		mmCheckQueueLimit.mock.t.Fatalf("Some expectations are already set for the ExecutionBrokerI.CheckQueueLimit method")
+	}
+
+	mmCheckQueueLimit.mock.funcCheckQueueLimit = f
+	return mmCheckQueueLimit.mock
+}
+
+// When sets expectation for the ExecutionBrokerI.CheckQueueLimit which will trigger the result defined by the following
+// Then helper
+func (mmCheckQueueLimit *mExecutionBrokerIMockCheckQueueLimit) When(ctx context.Context, request *record.IncomingRequest) *ExecutionBrokerIMockCheckQueueLimitExpectation {
+	if mmCheckQueueLimit.mock.funcCheckQueueLimit != nil {
+		mmCheckQueueLimit.mock.t.Fatalf("ExecutionBrokerIMock.CheckQueueLimit mock is already set by Set")
+	}
+
+	expectation := &ExecutionBrokerIMockCheckQueueLimitExpectation{
+		mock:   mmCheckQueueLimit.mock,
+		params: &ExecutionBrokerIMockCheckQueueLimitParams{ctx, request},
+	}
+	mmCheckQueueLimit.expectations = append(mmCheckQueueLimit.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ExecutionBrokerI.CheckQueueLimit return parameters for the expectation previously defined by the When method
+func (e *ExecutionBrokerIMockCheckQueueLimitExpectation) Then(err error) *ExecutionBrokerIMock {
+	e.results = &ExecutionBrokerIMockCheckQueueLimitResults{err}
+	return e.mock
+}
+
+// CheckQueueLimit implements ExecutionBrokerI
+func (mmCheckQueueLimit *ExecutionBrokerIMock) CheckQueueLimit(ctx context.Context, request *record.IncomingRequest) (err error) {
+	mm_atomic.AddUint64(&mmCheckQueueLimit.beforeCheckQueueLimitCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckQueueLimit.afterCheckQueueLimitCounter, 1)
+
+	if mmCheckQueueLimit.inspectFuncCheckQueueLimit != nil {
+		mmCheckQueueLimit.inspectFuncCheckQueueLimit(ctx, request)
+	}
+
+	params := &ExecutionBrokerIMockCheckQueueLimitParams{ctx, request}
+
+	// Record call args
+	mmCheckQueueLimit.CheckQueueLimitMock.mutex.Lock()
+	mmCheckQueueLimit.CheckQueueLimitMock.callArgs = append(mmCheckQueueLimit.CheckQueueLimitMock.callArgs, params)
+	mmCheckQueueLimit.CheckQueueLimitMock.mutex.Unlock()
+
+	for _, e := range mmCheckQueueLimit.CheckQueueLimitMock.expectations {
+		if minimock.Equal(e.params, params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckQueueLimit.CheckQueueLimitMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckQueueLimit.CheckQueueLimitMock.defaultExpectation.Counter, 1)
+		want := mmCheckQueueLimit.CheckQueueLimitMock.defaultExpectation.params
+		got := ExecutionBrokerIMockCheckQueueLimitParams{ctx, request}
+		if want != nil && !minimock.Equal(*want, got) {
+			mmCheckQueueLimit.t.Errorf("ExecutionBrokerIMock.CheckQueueLimit got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		}
+
+		results := mmCheckQueueLimit.CheckQueueLimitMock.defaultExpectation.results
+		if results == nil {
+			mmCheckQueueLimit.t.Fatal("No results are set for the ExecutionBrokerIMock.CheckQueueLimit")
+		}
+		return (*results).err
+	}
+	if mmCheckQueueLimit.funcCheckQueueLimit != nil {
+		return mmCheckQueueLimit.funcCheckQueueLimit(ctx, request)
+	}
+	mmCheckQueueLimit.t.Fatalf("Unexpected call to ExecutionBrokerIMock.CheckQueueLimit. %v %v", ctx, request)
+	return
+}
+
+// CheckQueueLimitAfterCounter returns a count of finished ExecutionBrokerIMock.CheckQueueLimit invocations
+func (mmCheckQueueLimit *ExecutionBrokerIMock) CheckQueueLimitAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckQueueLimit.afterCheckQueueLimitCounter)
+}
+
+// CheckQueueLimitBeforeCounter returns a count of ExecutionBrokerIMock.CheckQueueLimit invocations
+func (mmCheckQueueLimit *ExecutionBrokerIMock) CheckQueueLimitBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckQueueLimit.beforeCheckQueueLimitCounter)
+}
+
+// Calls returns a list of arguments used in each call to ExecutionBrokerIMock.CheckQueueLimit.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckQueueLimit *mExecutionBrokerIMockCheckQueueLimit) Calls() []*ExecutionBrokerIMockCheckQueueLimitParams {
+	mmCheckQueueLimit.mutex.RLock()
+
+	argCopy := make([]*ExecutionBrokerIMockCheckQueueLimitParams, len(mmCheckQueueLimit.callArgs))
+	copy(argCopy, mmCheckQueueLimit.callArgs)
+
+	mmCheckQueueLimit.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckQueueLimitDone returns true if the count of the CheckQueueLimit invocations corresponds
+// the number of defined expectations
+func (m *ExecutionBrokerIMock) MinimockCheckQueueLimitDone() bool {
+	for _, e := range m.CheckQueueLimitMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckQueueLimitMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCheckQueueLimitCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckQueueLimit != nil && mm_atomic.LoadUint64(&m.afterCheckQueueLimitCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockCheckQueueLimitInspect logs each unmet expectation
+func (m *ExecutionBrokerIMock) MinimockCheckQueueLimitInspect() {
+	for _, e := range m.CheckQueueLimitMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ExecutionBrokerIMock.CheckQueueLimit with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckQueueLimitMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterCheckQueueLimitCounter) < 1 {
+		if m.CheckQueueLimitMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to ExecutionBrokerIMock.CheckQueueLimit")
+		} else {
+			m.t.Errorf("Expected call to ExecutionBrokerIMock.CheckQueueLimit with params: %#v", *m.CheckQueueLimitMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckQueueLimit != nil && mm_atomic.LoadUint64(&m.afterCheckQueueLimitCounter) < 1 {
+		m.t.Error("Expected call to ExecutionBrokerIMock.CheckQueueLimit")
+	}
+}
+
 type mExecutionBrokerIMockFetchMoreRequestsFromLedger struct {
 	mock               *ExecutionBrokerIMock
 	defaultExpectation *ExecutionBrokerIMockFetchMoreRequestsFromLedgerExpectation
@@ -3250,6 +3476,8 @@ func (m *ExecutionBrokerIMock) MinimockFinish() {
 
 		m.MinimockAddRequestsFromPrevExecutorInspect()
 
+		m.MinimockCheckQueueLimitInspect()
+
 		m.MinimockFetchMoreRequestsFromLedgerInspect()
 
 		m.MinimockGetActiveTranscriptInspect()
@@ -3299,6 +3527,7 @@ func (m *ExecutionBrokerIMock) minimockDone() bool {
 		m.MinimockAddFreshRequestDone() &&
 		m.MinimockAddRequestsFromLedgerDone() &&
 		m.MinimockAddRequestsFromPrevExecutorDone() &&
+		m.MinimockCheckQueueLimitDone() &&
 		m.MinimockFetchMoreRequestsFromLedgerDone() &&
 		m.MinimockGetActiveTranscriptDone() &&
 		m.MinimockIsKnownRequestDone() &&
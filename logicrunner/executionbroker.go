@@ -23,6 +23,7 @@ import (
 
 	watermillMsg "github.com/ThreeDotsLabs/watermill/message"
 	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/jet"
@@ -30,6 +31,7 @@ import (
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
 	"github.com/insolar/insolar/logicrunner/artifacts"
 )
 
@@ -40,6 +42,7 @@ type ExecutionBrokerI interface {
 	AddRequestsFromPrevExecutor(ctx context.Context, transcripts ...*Transcript)
 	AddRequestsFromLedger(ctx context.Context, transcripts ...*Transcript)
 	AddAdditionalRequestFromPrevExecutor(ctx context.Context, transcript *Transcript)
+	CheckQueueLimit(ctx context.Context, request *record.IncomingRequest) error
 
 	PendingState() insolar.PendingState
 	PrevExecutorStillExecuting(ctx context.Context)
@@ -68,6 +71,7 @@ type ExecutionBroker struct {
 	finished  *TranscriptDequeue
 
 	outgoingSender OutgoingRequestSender
+	queuePolicy    *QueuePolicy
 
 	currentList *CurrentExecutionList
 
@@ -101,8 +105,9 @@ func NewExecutionBroker(
 	artifactsManager artifacts.Client,
 	executionArchive ExecutionArchive,
 	outgoingSender OutgoingRequestSender,
+	queuePolicy *QueuePolicy,
 ) *ExecutionBroker {
-	return &ExecutionBroker{
+	q := &ExecutionBroker{
 		Ref: ref,
 
 		mutable:     NewTranscriptDequeue(),
@@ -111,6 +116,7 @@ func NewExecutionBroker(
 		currentList: NewCurrentExecutionList(),
 
 		outgoingSender: outgoingSender,
+		queuePolicy:    queuePolicy,
 
 		publisher:        publisher,
 		requestsExecutor: requestsExecutor,
@@ -123,6 +129,15 @@ func NewExecutionBroker(
 
 		deduplicationTable: make(map[insolar.Reference]bool),
 	}
+
+	if queuePolicy != nil {
+		laneOf := func(tr *Transcript) int {
+			return queuePolicy.Lane(tr.Request)
+		}
+		q.mutable.SetLanes(laneOf)
+		q.immutable.SetLanes(laneOf)
+	}
+	return q
 }
 
 func (q *ExecutionBroker) tryTakeProcessor(_ context.Context) bool {
@@ -700,6 +715,24 @@ func (q *ExecutionBroker) AddFreshRequest(
 	}
 
 	q.Put(ctx, true, tr)
+
+	ctx = insmetrics.InsertTag(ctx, tagObjectClass, objectClass(tr.Request))
+	stats.Record(ctx, statExecutionQueueDepth.M(int64(q.mutable.Length()+q.immutable.Length())))
+}
+
+// CheckQueueLimit returns ErrQueueLimitExceeded if queue of the object is full and new
+// request should be rejected. Requests from ledger and previous executor are never rejected.
+func (q *ExecutionBroker) CheckQueueLimit(ctx context.Context, request *record.IncomingRequest) error {
+	q.stateLock.Lock()
+	defer q.stateLock.Unlock()
+
+	err := q.queuePolicy.Check(q.mutable.Length() + q.immutable.Length())
+	if err != nil {
+		ctx = insmetrics.InsertTag(ctx, tagObjectClass, objectClass(request))
+		stats.Record(ctx, statExecutionQueueRejected.M(1))
+		return errors.Wrapf(err, "object %s", q.Ref.String())
+	}
+	return nil
 }
 
 func (q *ExecutionBroker) AddRequestsFromPrevExecutor(ctx context.Context, transcripts ...*Transcript) {
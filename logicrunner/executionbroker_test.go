@@ -25,9 +25,11 @@ import (
 
 	wmMessage "github.com/ThreeDotsLabs/watermill/message"
 	"github.com/gojuno/minimock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/insolar/jet"
@@ -120,7 +122,7 @@ func (s *ExecutionBrokerSuite) TestPut() {
 	ea := NewExecutionArchiveMock(s.T()).ArchiveMock.Return().DoneMock.Return(true)
 
 	objectRef := gen.Reference()
-	b := NewExecutionBroker(objectRef, nil, rem, nil, nil, nil, nil, ea, nil)
+	b := NewExecutionBroker(objectRef, nil, rem, nil, nil, nil, nil, ea, nil, nil)
 	b.pending = insolar.NotPending
 
 	tr := NewTranscript(s.Context, gen.Reference(), record.IncomingRequest{})
@@ -161,7 +163,7 @@ func (s *ExecutionBrokerSuite) TestPrepend() {
 	ea := NewExecutionArchiveMock(s.T()).ArchiveMock.Return().DoneMock.Return(true)
 
 	objectRef := gen.Reference()
-	b := NewExecutionBroker(objectRef, nil, rem, nil, nil, nil, nil, ea, nil)
+	b := NewExecutionBroker(objectRef, nil, rem, nil, nil, nil, nil, ea, nil, nil)
 	b.pending = insolar.NotPending
 
 	reqRef1 := gen.Reference()
@@ -205,7 +207,7 @@ func (s *ExecutionBrokerSuite) TestImmutable_NotPending() {
 	ea := NewExecutionArchiveMock(s.T()).ArchiveMock.Return().DoneMock.Return(true)
 
 	objectRef := gen.Reference()
-	b := NewExecutionBroker(objectRef, nil, rem, nil, nil, nil, nil, ea, nil)
+	b := NewExecutionBroker(objectRef, nil, rem, nil, nil, nil, nil, ea, nil, nil)
 	b.pending = insolar.NotPending
 
 	tr := NewTranscript(s.Context, gen.Reference(), record.IncomingRequest{Immutable: true})
@@ -250,7 +252,7 @@ func (s *ExecutionBrokerSuite) TestImmutable_InPending() {
 	ea := NewExecutionArchiveMock(s.T()).ArchiveMock.Return().DoneMock.Return(true)
 
 	objectRef := gen.Reference()
-	b := NewExecutionBroker(objectRef, nil, rem, nil, nil, nil, nil, ea, nil)
+	b := NewExecutionBroker(objectRef, nil, rem, nil, nil, nil, nil, ea, nil, nil)
 	b.pending = insolar.InPending
 
 	tr := NewTranscript(s.Context, gen.Reference(), record.IncomingRequest{Immutable: true})
@@ -278,9 +280,38 @@ func (s *ExecutionBrokerSuite) TestImmutable_InPending() {
 	s.Len(rotationResults.Finished, 0)
 }
 
+func (s *ExecutionBrokerSuite) TestQueuePolicy() {
+	objectRef := gen.Reference()
+	policy := NewQueuePolicy(&configuration.ExecutionQueue{
+		MaxLength:     3,
+		PriorityLanes: [][]string{{"Migrate"}},
+	})
+	b := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil, policy)
+
+	request := &record.IncomingRequest{Method: "Transfer"}
+	s.NoError(b.CheckQueueLimit(s.Context, request))
+
+	b.stateLock.Lock()
+	b.mutable.Push(
+		&Transcript{Nonce: 1, Request: &record.IncomingRequest{Method: "Transfer"}},
+		&Transcript{Nonce: 2, Request: &record.IncomingRequest{Method: "Migrate"}},
+	)
+	b.immutable.Push(&Transcript{Nonce: 3, Request: &record.IncomingRequest{Method: "Balance", Immutable: true}})
+	b.stateLock.Unlock()
+
+	err := b.CheckQueueLimit(s.Context, request)
+	s.Require().Error(err)
+	s.Equal(ErrQueueLimitExceeded, errors.Cause(err))
+
+	// requests from priority lane are executed first
+	s.Equal(uint64(2), b.mutable.Pop().Nonce)
+	s.Equal(uint64(1), b.mutable.Pop().Nonce)
+	s.NoError(b.CheckQueueLimit(s.Context, request))
+}
+
 func (s *ExecutionBrokerSuite) TestRotate() {
 	objectRef := gen.Reference()
-	b := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil)
+	b := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	b.pending = insolar.NotPending
 
 	for i := 0; i < 4; i++ {
@@ -341,7 +372,7 @@ func (s *ExecutionBrokerSuite) TestRotate() {
 
 func (s *ExecutionBrokerSuite) TestDeduplication() {
 	objectRef := gen.Reference()
-	b := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil)
+	b := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	b.pending = insolar.InPending
 
@@ -435,7 +466,7 @@ func (s *LogicRunnerTestSuite) TestImmutableOrder() {
 
 	// prepare default object and execution state
 	objectRef := gen.Reference()
-	broker := NewExecutionBroker(objectRef, nil, s.re, nil, nil, nil, nil, ea, nil)
+	broker := NewExecutionBroker(objectRef, nil, s.re, nil, nil, nil, nil, ea, nil, nil)
 	broker.pending = insolar.NotPending
 
 	// prepare request objects
@@ -538,7 +569,7 @@ func TestExecutionBroker_ExecuteImmutable(t *testing.T) {
 	// prepare default object and execution state
 	objectRef := gen.Reference()
 	re := NewRequestsExecutorMock(mc)
-	broker := NewExecutionBroker(objectRef, nil, re, nil, nil, nil, nil, ea, nil)
+	broker := NewExecutionBroker(objectRef, nil, re, nil, nil, nil, nil, ea, nil, nil)
 	broker.pending = insolar.NotPending
 
 	immutableRequestRef1 := gen.Reference()
@@ -577,7 +608,7 @@ func TestExecutionBroker_OnPulse(t *testing.T) {
 			name: "next is not me, not active, queue",
 			mocks: func(ctx context.Context, t minimock.Tester) *ExecutionBroker {
 				objectRef := gen.Reference()
-				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil)
+				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 				// fetcher is stopped
 				broker.requestsFetcher = NewRequestsFetcherMock(t).AbortMock.Return()
 				broker.mutable.Push(randTranscript(ctx), randTranscript(ctx))
@@ -592,7 +623,7 @@ func TestExecutionBroker_OnPulse(t *testing.T) {
 			name: "next is not me, active, no queue",
 			mocks: func(ctx context.Context, t minimock.Tester) *ExecutionBroker {
 				objectRef := gen.Reference()
-				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil)
+				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 				broker.currentList.SetOnce(randTranscript(ctx))
 				return broker
 			},
@@ -603,7 +634,7 @@ func TestExecutionBroker_OnPulse(t *testing.T) {
 			name: "next is not me, not confirmed pending",
 			mocks: func(ctx context.Context, t minimock.Tester) *ExecutionBroker {
 				objectRef := gen.Reference()
-				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil)
+				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 				broker.pending = insolar.InPending
 				return broker
 			},
@@ -616,7 +647,7 @@ func TestExecutionBroker_OnPulse(t *testing.T) {
 			name: "next is not me, not active, no pending, finished a request",
 			mocks: func(ctx context.Context, t minimock.Tester) *ExecutionBroker {
 				objectRef := gen.Reference()
-				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil)
+				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 				broker.finished.Push(randTranscript(ctx), randTranscript(ctx))
 				return broker
 			},
@@ -627,7 +658,7 @@ func TestExecutionBroker_OnPulse(t *testing.T) {
 			name: "next is not me, did nothing",
 			mocks: func(ctx context.Context, t minimock.Tester) *ExecutionBroker {
 				objectRef := gen.Reference()
-				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil)
+				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 				return broker
 			},
 			numberOfMessages: 0,
@@ -638,7 +669,7 @@ func TestExecutionBroker_OnPulse(t *testing.T) {
 			meNext: true,
 			mocks: func(ctx context.Context, t minimock.Tester) *ExecutionBroker {
 				objectRef := gen.Reference()
-				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil)
+				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 				broker.requestsFetcher = NewRequestsFetcherMock(t)
 				broker.currentList.SetOnce(randTranscript(ctx))
 				return broker
@@ -655,7 +686,7 @@ func TestExecutionBroker_OnPulse(t *testing.T) {
 			meNext: true,
 			mocks: func(ctx context.Context, t minimock.Tester) *ExecutionBroker {
 				objectRef := gen.Reference()
-				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil)
+				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 				broker.requestsFetcher = NewRequestsFetcherMock(t)
 				broker.pending = insolar.InPending
 				broker.PendingConfirmed = true
@@ -672,7 +703,7 @@ func TestExecutionBroker_OnPulse(t *testing.T) {
 			meNext: true,
 			mocks: func(ctx context.Context, t minimock.Tester) *ExecutionBroker {
 				objectRef := gen.Reference()
-				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil)
+				broker := NewExecutionBroker(objectRef, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 				broker.pending = insolar.InPending
 				broker.requestsFetcher = NewRequestsFetcherMock(t).
 					FetchPendingsMock.Return()
@@ -720,7 +751,7 @@ func TestExecutionBroker_AddFreshRequestWithOnPulse(t *testing.T) {
 
 				broker := NewExecutionBroker(objectRef,
 					nil, nil, nil,
-					nil, nil, am, nil, nil)
+					nil, nil, am, nil, nil, nil)
 
 				var msgs []insolar.Message
 				am.HasPendingsMock.Set(func(ctx context.Context, ref insolar.Reference) (bool, error) {
@@ -753,7 +784,7 @@ func TestExecutionBroker_AddFreshRequestWithOnPulse(t *testing.T) {
 					IsMeAuthorizedNowMock.Return(true, nil)
 				// pa := pulse.NewAccessorMock(t).LatestMock.Return(insolar.Pulse{}, nil)
 
-				broker := NewExecutionBroker(objectRef, nil, re, nil, jc, nil, am, ea, nil)
+				broker := NewExecutionBroker(objectRef, nil, re, nil, jc, nil, am, ea, nil, nil)
 
 				var msgs []insolar.Message
 				re.ExecuteAndSaveMock.Set(func(ctx context.Context, tr *Transcript) (insolar.Reply, error) {
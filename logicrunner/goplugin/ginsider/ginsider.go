@@ -333,6 +333,48 @@ func (gi *GoInsider) RouteCall(ref insolar.Reference, wait bool, immutable bool,
 	return []byte(res.Result), nil
 }
 
+// ScheduleCall registers call of the method that is executed at provided pulse
+func (gi *GoInsider) ScheduleCall(ref insolar.Reference, pulse insolar.PulseNumber, method string, args []byte, proxyPrototype insolar.Reference) error {
+	client, err := gi.Upstream()
+	if err != nil {
+		return err
+	}
+	if gi.GetSystemError() != nil {
+		return gi.GetSystemError()
+	}
+
+	current, err := foundation.GetPulseNumber()
+	if err != nil {
+		return err
+	}
+	if pulse <= current {
+		// Not a system error, contract decides what to do with it.
+		return errors.Errorf("call should be scheduled after current pulse %v (got %v)", current, pulse)
+	}
+
+	req := rpctypes.UpRouteReq{
+		UpBaseReq: MakeUpBaseReq(),
+		ExecuteAt: pulse,
+		Object:    ref,
+		Method:    method,
+		Arguments: args,
+		Prototype: proxyPrototype,
+	}
+
+	res := rpctypes.UpRouteResp{}
+	err = client.Call("RPC.RouteCall", req, &res)
+	if err != nil {
+		gi.SetSystemError(err)
+		if err == rpc.ErrShutdown {
+			log.Error("Insgorund can't connect to Insolard")
+			os.Exit(0)
+		}
+		return errors.Wrap(err, "[ ScheduleCall ] on calling main API")
+	}
+
+	return nil
+}
+
 // SaveAsChild ...
 func (gi *GoInsider) SaveAsChild(
 	parentRef, classRef insolar.Reference, constructorName string, argsSerialized []byte,
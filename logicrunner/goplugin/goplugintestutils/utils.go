@@ -83,6 +83,9 @@ type ContractsBuilder struct {
 
 	Prototypes map[string]*insolar.Reference
 	Codes      map[string]*insolar.Reference
+
+	// upgrades holds previous prototypes of contracts built as upgrades.
+	upgrades map[string][]insolar.Reference
 }
 
 // NewContractBuilder returns a new `ContractsBuilder`, takes in: path to tmp directory,
@@ -103,6 +106,8 @@ func NewContractBuilder(icc string, am artifacts.Client, pa pulse.Accessor, jc j
 
 		Prototypes: make(map[string]*insolar.Reference),
 		Codes:      make(map[string]*insolar.Reference),
+
+		upgrades: make(map[string][]insolar.Reference),
 	}
 	return cb
 }
@@ -188,13 +193,21 @@ func (cb *ContractsBuilder) Build(ctx context.Context, contracts map[string]stri
 		logger.Debugf("Deployed code %q for contract %q in %q", codeRef.String(), name, cb.root)
 		cb.Codes[name] = codeRef
 
+		var memory []byte
+		if previous, ok := cb.upgrades[name]; ok {
+			memory, err = insolar.Serialize(insolar.PrototypeUpgrade{Previous: previous})
+			if err != nil {
+				return errors.Wrap(err, "[ Build ] Can't serialize prototype upgrade")
+			}
+		}
+
 		// FIXME: It's a temporary fix and should not be here. Ii will NOT work properly on production. Remove it ASAP!
 		err = cb.artifactManager.ActivatePrototype(
 			ctx,
 			*cb.Prototypes[name],
 			insolar.GenesisRecord.Ref(), // FIXME: Only bootstrap can do this!
 			*codeRef,
-			nil,
+			memory,
 		)
 		if err != nil {
 			return errors.Wrap(err, "[ Build ] Can't ActivatePrototype")
@@ -204,6 +217,17 @@ func (cb *ContractsBuilder) Build(ctx context.Context, contracts map[string]stri
 	return nil
 }
 
+// BuildUpgrade builds new version of the contract. Objects of previous prototypes are migrated to the new
+// prototype with contract's Migrate function when root member upgrades them through root domain.
+func (cb *ContractsBuilder) BuildUpgrade(ctx context.Context, name string, code string, previous ...insolar.Reference) error {
+	if len(previous) == 0 {
+		return errors.New("[ BuildUpgrade ] previous prototypes are required")
+	}
+	cb.upgrades[name] = previous
+	defer delete(cb.upgrades, name)
+	return cb.Build(ctx, map[string]string{name: code})
+}
+
 // Using registerRequest without VM is a tmp solution while there is no logic of contract uploading in VM
 // Because of this we need copy some logic in test code
 func (cb *ContractsBuilder) registerRequest(ctx context.Context, request *record.IncomingRequest) (*insolar.ID, error) {
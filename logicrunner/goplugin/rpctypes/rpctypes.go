@@ -84,6 +84,8 @@ type UpRouteReq struct {
 	Wait      bool
 	Immutable bool
 	Saga      bool
+	// ExecuteAt is a pulse of scheduled call, zero for calls that are made right away.
+	ExecuteAt insolar.PulseNumber
 	Object    insolar.Reference
 	Method    string
 	Arguments insolar.Arguments
@@ -132,6 +132,15 @@ func (h *HandleCall) handleActual(
 
 	request := msg.IncomingRequest
 
+	if request.CallType == record.CTMethod && request.Object != nil {
+		// Check is made before registration, so rejected request doesn't stay opened on ledger.
+		if broker := h.dep.StateStorage.GetExecutionState(*request.Object); broker != nil {
+			if err := broker.CheckQueueLimit(ctx, &request); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	procRegisterRequest := NewRegisterIncomingRequest(request, h.dep)
 	if err := f.Procedure(ctx, procRegisterRequest, true); err != nil {
 		if err == flow.ErrCancelled {
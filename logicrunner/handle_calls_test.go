@@ -182,6 +182,7 @@ func TestHandleCall_Present(t *testing.T) {
 			dep: &Dependencies{
 				Publisher: nil,
 				StateStorage: NewStateStorageMock(mc).
+					GetExecutionStateMock.Expect(objRef).Return(nil).
 					GetExecutionArchiveMock.Expect(objRef).Return(
 					NewExecutionArchiveMock(mc).FindRequestLoopMock.Return(false),
 				).
@@ -239,6 +240,7 @@ func TestHandleCall_Present(t *testing.T) {
 			dep: &Dependencies{
 				Publisher: nil,
 				StateStorage: NewStateStorageMock(mc).
+					GetExecutionStateMock.Expect(objRef).Return(nil).
 					GetExecutionArchiveMock.Expect(objRef).Return(
 					NewExecutionArchiveMock(mc).FindRequestLoopMock.Return(false),
 				),
@@ -351,7 +353,8 @@ func TestHandleCall_Present(t *testing.T) {
 		objRef := gen.Reference()
 		handler := HandleCall{
 			dep: &Dependencies{
-				Publisher: nil,
+				Publisher:      nil,
+				StateStorage:   NewStateStorageMock(mc).GetExecutionStateMock.Expect(objRef).Return(nil),
 				ResultsMatcher: nil,
 				lr: &LogicRunner{
 					ArtifactManager: artifacts.NewClientMock(mc),
@@ -458,6 +461,7 @@ func TestHandleCall_Present(t *testing.T) {
 			dep: &Dependencies{
 				Publisher: nil,
 				StateStorage: NewStateStorageMock(mc).
+					GetExecutionStateMock.Expect(objRef).Return(nil).
 					GetExecutionArchiveMock.Expect(objRef).Return(nil),
 				ResultsMatcher: nil,
 				lr: &LogicRunner{
@@ -527,6 +531,7 @@ func TestHandleCall_Present(t *testing.T) {
 
 		handler := HandleCall{
 			dep: &Dependencies{
+				StateStorage: NewStateStorageMock(mc).GetExecutionStateMock.Expect(objRef).Return(nil),
 				lr: &LogicRunner{
 					ArtifactManager: artifacts.NewClientMock(mc),
 				},
@@ -547,4 +552,113 @@ func TestHandleCall_Present(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, &reply.RegisterRequest{Request: reqRef}, gotReply)
 	})
+
+	t.Run("execution queue limit exceeded", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := flow.TestContextWithPulse(inslogger.TestContext(t), gen.PulseNumber())
+		mc := minimock.NewController(t)
+		defer mc.Wait(time.Second)
+
+		fm := flow.NewFlowMock(mc)
+
+		fm.ProcedureMock.Set(func(ctx context.Context, proc flow.Procedure, cancelable bool) (err error) {
+			switch proc.(type) {
+			case *CheckOurRole:
+				return nil
+			case *RegisterIncomingRequest:
+				t.Fatalf("Shouldn't be called: %T", proc)
+			case *AddFreshRequest:
+				t.Fatalf("Shouldn't be called: %T", proc)
+			default:
+				t.Fatalf("Unknown procedure: %T", proc)
+			}
+			return nil
+		})
+
+		objRef := gen.Reference()
+		broker := NewExecutionBrokerIMock(mc).CheckQueueLimitMock.Return(ErrQueueLimitExceeded)
+		handler := HandleCall{
+			dep: &Dependencies{
+				StateStorage: NewStateStorageMock(mc).GetExecutionStateMock.Expect(objRef).Return(broker),
+				lr: &LogicRunner{
+					ArtifactManager: artifacts.NewClientMock(mc),
+					MessageBus:      testutils.NewMessageBusMock(mc),
+				},
+				WriteAccessor: writecontroller.NewAccessorMock(mc),
+			},
+			Message: payload.Meta{},
+		}
+
+		msg := message.CallMethod{
+			IncomingRequest: record.IncomingRequest{
+				CallType: record.CTMethod,
+				Object:   &objRef,
+			},
+		}
+
+		reply, err := handler.handleActual(ctx, &msg, fm)
+		assert.Nil(t, reply)
+		assert.Equal(t, ErrQueueLimitExceeded, err)
+	})
+
+	t.Run("node is draining and holds executor role", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := flow.TestContextWithPulse(inslogger.TestContext(t), gen.PulseNumber())
+		mc := minimock.NewController(t)
+		defer mc.Wait(time.Minute)
+
+		objRef := gen.Reference()
+		reqRef := gen.Reference()
+
+		resRecord := &record.Result{Payload: []byte{3, 2, 1}}
+		matRecord := record.Material{Virtual: record.Wrap(resRecord)}
+		matRecordSerialized, err := matRecord.Marshal()
+		require.NoError(t, err)
+
+		fm := flow.NewFlowMock(mc)
+		fm.ProcedureMock.Set(func(ctx context.Context, proc flow.Procedure, cancelable bool) (err error) {
+			switch p := proc.(type) {
+			case *CheckOurRole:
+				return nil
+			case *RegisterIncomingRequest:
+				p.result <- &payload.RequestInfo{
+					RequestID: *reqRef.Record(),
+					ObjectID:  *objRef.Record(),
+					Request:   []byte{1, 2, 3},
+					Result:    matRecordSerialized,
+				}
+				return nil
+			default:
+				t.Fatalf("Unknown procedure: %T", proc)
+			}
+			return nil
+		})
+
+		lr := &LogicRunner{
+			ArtifactManager: artifacts.NewClientMock(mc),
+		}
+		lr.StartDrain(ctx)
+
+		handler := HandleCall{
+			dep: &Dependencies{
+				StateStorage:     NewStateStorageMock(mc).GetExecutionStateMock.Expect(objRef).Return(nil),
+				lr:               lr,
+				RequestsExecutor: NewRequestsExecutorMock(mc).SendReplyMock.Return(),
+			},
+			Message: payload.Meta{},
+		}
+
+		msg := message.CallMethod{
+			IncomingRequest: record.IncomingRequest{
+				CallType: record.CTMethod,
+				Object:   &objRef,
+			},
+		}
+
+		gotReply, err := handler.handleActual(ctx, &msg, fm)
+		require.NoError(t, err)
+		require.Equal(t, &reply.RegisterRequest{Request: reqRef}, gotReply)
+	})
 }
@@ -48,6 +48,8 @@ type Dependencies struct {
 	WriteAccessor    writecontroller.Accessor
 	OutgoingSender   OutgoingRequestSender
 	RequestsExecutor RequestsExecutor
+	Validator        Validator
+	SagaTracker      SagaTracker
 }
 
 type Init struct {
@@ -109,6 +111,24 @@ func (s *Init) Present(ctx context.Context, f flow.Flow) error {
 			meta: meta,
 		}
 		return f.Handle(ctx, h.Present)
+	case payload.TypeValidate:
+		h := &HandleValidate{
+			dep:  s.dep,
+			meta: meta,
+		}
+		return f.Handle(ctx, h.Present)
+	case payload.TypeSagaStates:
+		h := &HandleSagaStates{
+			dep:  s.dep,
+			meta: meta,
+		}
+		return f.Handle(ctx, h.Present)
+	case payload.TypeGetSagaStates:
+		h := &HandleGetSagaStates{
+			dep:  s.dep,
+			meta: meta,
+		}
+		return f.Handle(ctx, h.Present)
 	default:
 		return fmt.Errorf("[ Init.Present ] no handler for message type %s", msgType)
 	}
@@ -34,6 +34,11 @@ func (h *HandleSagaCallAcceptNotification) Present(ctx context.Context, f flow.F
 	}
 
 	outgoingReqRef := insolar.NewReference(msg.DetachedRequestID)
-	_, _, _, err = h.dep.OutgoingSender.SendOutgoingRequest(ctx, *outgoingReqRef, outgoing)
-	return err
+	if outgoing.ReturnMode == record.ReturnScheduled {
+		// Scheduled call has no rollback. It stays opened on failure and LME
+		// notifies about it again as about abandoned request.
+		h.dep.OutgoingSender.SendAbandonedOutgoingRequest(ctx, *outgoingReqRef, outgoing)
+		return nil
+	}
+	return h.dep.SagaTracker.Accept(ctx, *insolar.NewReference(msg.ObjectID), *outgoingReqRef, outgoing)
 }
@@ -0,0 +1,43 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar/flow"
+	"github.com/insolar/insolar/insolar/payload"
+)
+
+// HandleSagaStates restores sagas handed over by the previous executor of the object.
+type HandleSagaStates struct {
+	dep  *Dependencies
+	meta payload.Meta
+}
+
+func (h *HandleSagaStates) Present(ctx context.Context, _ flow.Flow) error {
+	msg := payload.SagaStates{}
+	err := msg.Unmarshal(h.meta.Payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to unmarshal saga states")
+	}
+
+	h.dep.SagaTracker.Restore(ctx, msg.Object, sagasFromPayload(&msg))
+	return nil
+}
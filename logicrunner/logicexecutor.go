@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/genesisrefs"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/instrumentation/instracer"
@@ -69,6 +70,10 @@ func (le *logicExecutor) ExecuteMethod(ctx context.Context, transcript *Transcri
 		return nil, errors.Wrap(err, "couldn't get descriptors")
 	}
 
+	if request.Method == insolar.UpgradeMethod {
+		return le.upgrade(ctx, transcript, protoDesc)
+	}
+
 	// it's needed to assure that we call method on ref, that has same prototype as proxy, that we import in contract code
 	if request.Prototype != nil && !request.Prototype.Equal(*protoDesc.HeadRef()) {
 		return nil, errors.New("proxy call error: try to call method of prototype as method of another prototype")
@@ -107,6 +112,99 @@ func (le *logicExecutor) ExecuteMethod(ctx context.Context, transcript *Transcri
 	return res, nil
 }
 
+// upgrade switches object to the prototype requested by caller migrating its memory with Migrate
+// function of the prototype. Objects are upgraded by root domain, it accepts upgrades from the root
+// member which is the owner of the domain, or opt in to upgrade by calling it on themselves.
+// The requested prototype should be deployed in the same domain and should declare object's prototype
+// as previous one.
+func (le *logicExecutor) upgrade(
+	ctx context.Context, transcript *Transcript, protoDesc artifacts.ObjectDescriptor,
+) (artifacts.RequestResult, error) {
+	ctx, span := instracer.StartSpan(ctx, "logicExecutor.upgrade")
+	defer span.End()
+
+	request := transcript.Request
+	objDesc := transcript.ObjectDescriptor
+
+	if !request.Caller.Equal(genesisrefs.ContractRootDomain) && !request.Caller.Equal(*objDesc.HeadRef()) {
+		return nil, errors.New("object can be upgraded only by root domain or by itself")
+	}
+	if request.Immutable {
+		return nil, errors.New("upgrade can't be immutable")
+	}
+	if request.Prototype == nil {
+		return nil, errors.New("prototype reference is required")
+	}
+	if request.Prototype.Equal(*protoDesc.HeadRef()) {
+		return nil, errors.New("object already has requested prototype")
+	}
+
+	newProtoDesc, newCodeDesc, err := le.DescriptorsCache.ByPrototypeRef(ctx, *request.Prototype)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get descriptors of upgraded prototype")
+	}
+	if !newProtoDesc.Parent().Equal(*protoDesc.Parent()) {
+		return nil, errors.New("upgraded prototype belongs to another domain")
+	}
+	if !isUpgradeOf(newProtoDesc, *protoDesc.HeadRef()) {
+		return nil, errors.Errorf(
+			"prototype %s is not an upgrade of %s", request.Prototype.String(), protoDesc.HeadRef().String(),
+		)
+	}
+
+	executor, err := le.MachinesManager.GetExecutor(newCodeDesc.MachineType())
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get executor")
+	}
+
+	args, err := insolar.Serialize([]interface{}{objDesc.Memory()})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize migration arguments")
+	}
+
+	transcript.LogicContext = le.genLogicCallContext(ctx, transcript, newProtoDesc, newCodeDesc)
+	memory, result, err := executor.CallConstructor(
+		ctx, transcript.LogicContext, *newCodeDesc.Ref(), insolar.MigrateConstructor, args,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "migration error")
+	}
+	if result == nil {
+		return nil, errors.New("result is NIL")
+	}
+
+	res := newRequestResult(result, *objDesc.HeadRef())
+	if memory == nil {
+		// logical error of migration, object keeps its prototype
+		return res, nil
+	}
+	res.SetUpgrade(objDesc, *request.Prototype, memory)
+
+	inslogger.FromContext(ctx).Infof(
+		"object %s is upgraded from prototype %s to %s",
+		objDesc.HeadRef().String(), protoDesc.HeadRef().String(), request.Prototype.String(),
+	)
+	return res, nil
+}
+
+// isUpgradeOf checks if prototype declares provided prototype as previous one.
+func isUpgradeOf(protoDesc artifacts.ObjectDescriptor, previous insolar.Reference) bool {
+	if len(protoDesc.Memory()) == 0 {
+		return false
+	}
+	var upgrade insolar.PrototypeUpgrade
+	err := insolar.Deserialize(protoDesc.Memory(), &upgrade)
+	if err != nil {
+		return false
+	}
+	for _, ref := range upgrade.Previous {
+		if ref.Equal(previous) {
+			return true
+		}
+	}
+	return false
+}
+
 func (le *logicExecutor) ExecuteConstructor(
 	ctx context.Context, transcript *Transcript,
 ) (
@@ -120,6 +218,9 @@ func (le *logicExecutor) ExecuteConstructor(
 	if request.Prototype == nil {
 		return nil, errors.New("prototype reference is required")
 	}
+	if request.Method == insolar.MigrateConstructor {
+		return nil, errors.New("migration can't be called as constructor")
+	}
 
 	protoDesc, codeDesc, err := le.DescriptorsCache.ByPrototypeRef(ctx, *request.Prototype)
 	if err != nil {
@@ -157,7 +258,7 @@ func (le *logicExecutor) genLogicCallContext(
 	request := transcript.Request
 	reqRef := transcript.RequestRef
 	res := &insolar.LogicCallContext{
-		Mode: insolar.ExecuteCallMode,
+		Mode: transcript.Mode,
 
 		Request: &reqRef,
 
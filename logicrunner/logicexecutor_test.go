@@ -17,6 +17,7 @@
 package logicrunner
 
 import (
+	"context"
 	"testing"
 
 	"github.com/gojuno/minimock"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/genesisrefs"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/logicrunner/artifacts"
@@ -54,6 +56,14 @@ func TestLogicExecutor_ExecuteMethod(t *testing.T) {
 	objRecordID := gen.ID()
 	protoRef := gen.Reference()
 	codeRef := gen.Reference()
+	domainRef := gen.Reference()
+	newProtoRef := gen.Reference()
+	newCodeRef := gen.Reference()
+
+	upgradeMemory, err := insolar.Serialize(insolar.PrototypeUpgrade{Previous: []insolar.Reference{protoRef}})
+	require.NoError(t, err)
+	migrateArgs, err := insolar.Serialize([]interface{}{[]byte{1}})
+	require.NoError(t, err)
 
 	tests := []struct {
 		name       string
@@ -208,6 +218,198 @@ func TestLogicExecutor_ExecuteMethod(t *testing.T) {
 				objectReference: objRef,
 			},
 		},
+		{
+			name: "success, upgrade method",
+			transcript: &Transcript{
+				ObjectDescriptor: artifacts.NewObjectDescriptorMock(mc).
+					ParentMock.Return(&domainRef).
+					MemoryMock.Return([]byte{1}).
+					StateIDMock.Return(&objRecordID).
+					HeadRefMock.Return(&objRef),
+				Request: &record.IncomingRequest{
+					Caller:    genesisrefs.ContractRootDomain,
+					Prototype: &newProtoRef,
+					Method:    insolar.UpgradeMethod,
+				},
+			},
+			mm: NewMachinesManagerMock(mc).
+				GetExecutorMock.
+				Return(
+					testutils.NewMachineLogicExecutorMock(mc).
+						CallConstructorMock.Set(
+						func(_ context.Context, _ *insolar.LogicCallContext, code insolar.Reference, name string, args insolar.Arguments) ([]byte, insolar.Arguments, error) {
+							require.Equal(t, newCodeRef, code)
+							require.Equal(t, insolar.MigrateConstructor, name)
+							require.Equal(t, migrateArgs, []byte(args))
+							return []byte{2}, []byte{0}, nil
+						}),
+					nil,
+				),
+			dc: artifacts.NewDescriptorsCacheMock(mc).
+				ByObjectDescriptorMock.
+				Return(
+					artifacts.NewObjectDescriptorMock(mc).
+						ParentMock.Return(&domainRef).
+						HeadRefMock.Return(&protoRef),
+					artifacts.NewCodeDescriptorMock(mc),
+					nil,
+				).
+				ByPrototypeRefMock.
+				Return(
+					artifacts.NewObjectDescriptorMock(mc).
+						ParentMock.Return(&domainRef).
+						MemoryMock.Return(upgradeMemory).
+						HeadRefMock.Return(&newProtoRef),
+					artifacts.NewCodeDescriptorMock(mc).
+						RefMock.Return(&newCodeRef).
+						MachineTypeMock.Return(insolar.MachineTypeBuiltin),
+					nil,
+				),
+			res: &requestResult{
+				objectReference: objRef,
+				objectImage:     newProtoRef,
+				objectStateID:   objRecordID,
+				sideEffectType:  artifacts.RequestSideEffectAmend,
+				memory:          []byte{2},
+				result:          []byte{0},
+			},
+		},
+		{
+			name: "success, upgrade requested by the object",
+			transcript: &Transcript{
+				ObjectDescriptor: artifacts.NewObjectDescriptorMock(mc).
+					ParentMock.Return(&domainRef).
+					MemoryMock.Return([]byte{1}).
+					StateIDMock.Return(&objRecordID).
+					HeadRefMock.Return(&objRef),
+				Request: &record.IncomingRequest{
+					Caller:    objRef,
+					Prototype: &newProtoRef,
+					Method:    insolar.UpgradeMethod,
+				},
+			},
+			mm: NewMachinesManagerMock(mc).
+				GetExecutorMock.
+				Return(
+					testutils.NewMachineLogicExecutorMock(mc).
+						CallConstructorMock.Set(
+						func(_ context.Context, _ *insolar.LogicCallContext, code insolar.Reference, name string, args insolar.Arguments) ([]byte, insolar.Arguments, error) {
+							require.Equal(t, newCodeRef, code)
+							require.Equal(t, insolar.MigrateConstructor, name)
+							require.Equal(t, migrateArgs, []byte(args))
+							return []byte{2}, []byte{0}, nil
+						}),
+					nil,
+				),
+			dc: artifacts.NewDescriptorsCacheMock(mc).
+				ByObjectDescriptorMock.
+				Return(
+					artifacts.NewObjectDescriptorMock(mc).
+						ParentMock.Return(&domainRef).
+						HeadRefMock.Return(&protoRef),
+					artifacts.NewCodeDescriptorMock(mc),
+					nil,
+				).
+				ByPrototypeRefMock.
+				Return(
+					artifacts.NewObjectDescriptorMock(mc).
+						ParentMock.Return(&domainRef).
+						MemoryMock.Return(upgradeMemory).
+						HeadRefMock.Return(&newProtoRef),
+					artifacts.NewCodeDescriptorMock(mc).
+						RefMock.Return(&newCodeRef).
+						MachineTypeMock.Return(insolar.MachineTypeBuiltin),
+					nil,
+				),
+			res: &requestResult{
+				objectReference: objRef,
+				objectImage:     newProtoRef,
+				objectStateID:   objRecordID,
+				sideEffectType:  artifacts.RequestSideEffectAmend,
+				memory:          []byte{2},
+				result:          []byte{0},
+			},
+		},
+		{
+			name: "upgrade from another domain",
+			transcript: &Transcript{
+				ObjectDescriptor: artifacts.NewObjectDescriptorMock(mc),
+				Request: &record.IncomingRequest{
+					Caller:    genesisrefs.ContractRootDomain,
+					Prototype: &newProtoRef,
+					Method:    insolar.UpgradeMethod,
+				},
+			},
+			mm: NewMachinesManagerMock(mc),
+			dc: artifacts.NewDescriptorsCacheMock(mc).
+				ByObjectDescriptorMock.
+				Return(
+					artifacts.NewObjectDescriptorMock(mc).
+						ParentMock.Return(&domainRef).
+						HeadRefMock.Return(&protoRef),
+					artifacts.NewCodeDescriptorMock(mc),
+					nil,
+				).
+				ByPrototypeRefMock.
+				Return(
+					artifacts.NewObjectDescriptorMock(mc).
+						ParentMock.Return(&objRef),
+					artifacts.NewCodeDescriptorMock(mc),
+					nil,
+				),
+			error: true,
+		},
+		{
+			name: "upgrade not by root domain",
+			transcript: &Transcript{
+				ObjectDescriptor: artifacts.NewObjectDescriptorMock(mc).
+					HeadRefMock.Return(&objRef),
+				Request: &record.IncomingRequest{
+					Caller:    gen.Reference(),
+					Prototype: &newProtoRef,
+					Method:    insolar.UpgradeMethod,
+				},
+			},
+			mm: NewMachinesManagerMock(mc),
+			dc: artifacts.NewDescriptorsCacheMock(mc).
+				ByObjectDescriptorMock.
+				Return(
+					artifacts.NewObjectDescriptorMock(mc),
+					artifacts.NewCodeDescriptorMock(mc),
+					nil,
+				),
+			error: true,
+		},
+		{
+			name: "upgrade to not declared prototype",
+			transcript: &Transcript{
+				ObjectDescriptor: artifacts.NewObjectDescriptorMock(mc),
+				Request: &record.IncomingRequest{
+					Caller:    genesisrefs.ContractRootDomain,
+					Prototype: &newProtoRef,
+					Method:    insolar.UpgradeMethod,
+				},
+			},
+			mm: NewMachinesManagerMock(mc),
+			dc: artifacts.NewDescriptorsCacheMock(mc).
+				ByObjectDescriptorMock.
+				Return(
+					artifacts.NewObjectDescriptorMock(mc).
+						ParentMock.Return(&domainRef).
+						HeadRefMock.Return(&protoRef),
+					artifacts.NewCodeDescriptorMock(mc),
+					nil,
+				).
+				ByPrototypeRefMock.
+				Return(
+					artifacts.NewObjectDescriptorMock(mc).
+						ParentMock.Return(&domainRef).
+						MemoryMock.Return(nil),
+					artifacts.NewCodeDescriptorMock(mc),
+					nil,
+				),
+			error: true,
+		},
 		{
 			name: "parent mismatch",
 			transcript: &Transcript{
@@ -398,6 +600,21 @@ func TestLogicExecutor_ExecuteConstructor(t *testing.T) {
 				),
 			error: true,
 		},
+		{
+			name: "error, migration called as constructor",
+			transcript: &Transcript{
+				Request: &record.IncomingRequest{
+					Base:      &baseRef,
+					CallType:  record.CTSaveAsChild,
+					Caller:    callerRef,
+					Prototype: &protoRef,
+					Method:    insolar.MigrateConstructor,
+				},
+			},
+			mm:    NewMachinesManagerMock(mc),
+			dc:    artifacts.NewDescriptorsCacheMock(mc),
+			error: true,
+		},
 		{
 			name: "error, no machine type executor",
 			transcript: &Transcript{
@@ -36,6 +36,7 @@ import (
 	"github.com/insolar/insolar/insolar/flow/dispatcher"
 	"github.com/insolar/insolar/insolar/jet"
 	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/payload"
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
@@ -66,12 +67,14 @@ type LogicRunner struct {
 	RequestsExecutor           RequestsExecutor                   `inject:""`
 	MachinesManager            MachinesManager                    `inject:""`
 	JetStorage                 jet.Storage                        `inject:""`
+	Validator                  Validator                          `inject:""`
 	Publisher                  watermillMsg.Publisher
 	Sender                     bus.Sender
 	SenderWithRetry            *bus.WaitOKSender
 	StateStorage               StateStorage
 	ResultsMatcher             ResultMatcher
 	OutgoingSender             OutgoingRequestSender
+	SagaTracker                SagaTracker
 	WriteController            writecontroller.WriteController
 	FlowDispatcher             dispatcher.Dispatcher
 
@@ -103,7 +106,8 @@ func (lr *LogicRunner) LRI() {}
 
 func (lr *LogicRunner) Init(ctx context.Context) error {
 	as := system.New()
-	lr.OutgoingSender = NewOutgoingRequestSender(as, lr.ContractRequester, lr.ArtifactManager)
+	lr.SagaTracker = NewSagaTracker(lr.ContractRequester, lr.ArtifactManager, lr.JetCoordinator, lr.Sender)
+	lr.OutgoingSender = NewOutgoingRequestSender(as, lr.ContractRequester, lr.ArtifactManager, lr.SagaTracker)
 
 	lr.StateStorage = NewStateStorage(
 		lr.Publisher,
@@ -113,12 +117,13 @@ func (lr *LogicRunner) Init(ctx context.Context) error {
 		lr.PulseAccessor,
 		lr.ArtifactManager,
 		lr.OutgoingSender,
+		NewQueuePolicy(lr.Cfg.ExecutionQueue),
 	)
 
 	lr.SenderWithRetry = bus.NewWaitOKWithRetrySender(lr.Sender, lr.PulseAccessor, 3)
 
 	lr.rpc = lrCommon.NewRPC(
-		NewRPCMethods(lr.ArtifactManager, lr.DescriptorsCache, lr.ContractRequester, lr.StateStorage, lr.OutgoingSender),
+		NewRPCMethods(lr.ArtifactManager, lr.DescriptorsCache, lr.ContractRequester, lr.StateStorage, lr.OutgoingSender, lr.Validator, lr.PulseAccessor),
 		lr.Cfg,
 	)
 
@@ -144,6 +149,8 @@ func (lr *LogicRunner) initHandlers() {
 		WriteAccessor:    lr.WriteController,
 		OutgoingSender:   lr.OutgoingSender,
 		RequestsExecutor: lr.RequestsExecutor,
+		Validator:        lr.Validator,
+		SagaTracker:      lr.SagaTracker,
 	}
 
 	initHandle := func(msg *watermillMsg.Message) *Init {
@@ -165,7 +172,7 @@ func (lr *LogicRunner) initHandlers() {
 func (lr *LogicRunner) initializeBuiltin(_ context.Context) error {
 	bi := builtin.NewBuiltIn(
 		lr.ArtifactManager,
-		NewRPCMethods(lr.ArtifactManager, lr.DescriptorsCache, lr.ContractRequester, lr.StateStorage, lr.OutgoingSender),
+		NewRPCMethods(lr.ArtifactManager, lr.DescriptorsCache, lr.ContractRequester, lr.StateStorage, lr.OutgoingSender, lr.Validator, lr.PulseAccessor),
 	)
 	if err := lr.MachinesManager.RegisterExecutor(insolar.MachineTypeBuiltin, bi); err != nil {
 		return err
@@ -241,6 +248,60 @@ func (lr *LogicRunner) GracefulStop(ctx context.Context) error {
 	return nil
 }
 
+// StartDrain is called when the node starts draining. Calls are still executed while the node holds
+// the executor role, new calls go to other nodes after zero power of the node is applied.
+// Not finished executions are handed off to the next executor on pulse change.
+func (lr *LogicRunner) StartDrain(ctx context.Context) {
+	inslogger.FromContext(ctx).Info("LogicRunner executes calls until the node loses executor role")
+}
+
+// PendingWork returns amount of objects that are executed or have not handed off executions on this node.
+func (lr *LogicRunner) PendingWork(ctx context.Context) int {
+	lr.StateStorage.Lock()
+	defer lr.StateStorage.Unlock()
+
+	return lr.StateStorage.Len()
+}
+
+// OutstandingSagas returns sagas of the object that are not completed yet. Sagas are asked from
+// the current executor of the object if it's another node.
+func (lr *LogicRunner) OutstandingSagas(ctx context.Context, object insolar.Reference) ([]SagaState, error) {
+	if lr.SagaTracker == nil {
+		return nil, nil
+	}
+	isExecutor, err := lr.JetCoordinator.IsMeAuthorizedNow(ctx, insolar.DynamicRoleVirtualExecutor, *object.Record())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate executor of the object")
+	}
+	if isExecutor {
+		return lr.SagaTracker.Sagas(object), nil
+	}
+
+	msg, err := payload.NewMessage(&payload.GetSagaStates{Object: object})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create message")
+	}
+	reps, done := lr.Sender.SendRole(ctx, msg, insolar.DynamicRoleVirtualExecutor, object)
+	defer done()
+
+	rep, ok := <-reps
+	if !ok {
+		return nil, errors.New("no reply from executor of the object")
+	}
+	pl, err := payload.UnmarshalFromMeta(rep.Payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal reply")
+	}
+	switch p := pl.(type) {
+	case *payload.SagaStates:
+		return sagasFromPayload(p), nil
+	case *payload.Error:
+		return nil, errors.New(p.Text)
+	default:
+		return nil, errors.Errorf("unexpected reply %T", pl)
+	}
+}
+
 func loggerWithTargetID(ctx context.Context, msg insolar.Parcel) context.Context {
 	ctx, _ = inslogger.WithField(ctx, "targetid", msg.DefaultTarget().String())
 	return ctx
@@ -257,6 +318,9 @@ func (lr *LogicRunner) OnPulse(ctx context.Context, oldPulse insolar.Pulse, newP
 
 	lr.ResultsMatcher.Clear()
 
+	lr.Validator.OnPulse(ctx, oldPulse.PulseNumber)
+	lr.SagaTracker.OnPulse(ctx)
+
 	messages := lr.StateStorage.OnPulse(ctx, newPulse)
 
 	err = lr.WriteController.Open(ctx, newPulse.PulseNumber)
@@ -46,6 +46,7 @@ import (
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/instrumentation/instracer"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
 	"github.com/insolar/insolar/logicrunner/writecontroller"
 	"github.com/insolar/insolar/pulsar"
 	"github.com/insolar/insolar/pulsar/entropygenerator"
@@ -200,7 +201,8 @@ func (suite *LogicRunnerTestSuite) TestSagaCallAcceptNotificationHandler() {
 	buf, err := meta.Marshal()
 	msg.Payload = buf
 
-	dummyRequestRef := gen.Reference()
+	dummyResult, err := insolar.Serialize([]interface{}{(*foundation.Error)(nil)})
+	suite.Require().NoError(err)
 	callMethodChan := make(chan struct{})
 	var usedCaller insolar.Reference
 	var usedReason insolar.Reference
@@ -213,8 +215,8 @@ func (suite *LogicRunnerTestSuite) TestSagaCallAcceptNotificationHandler() {
 		usedReason = cm.Reason
 		usedReturnMode = cm.ReturnMode
 
-		result := &reply.RegisterRequest{
-			Request: dummyRequestRef,
+		result := &reply.CallMethod{
+			Result: dummyResult,
 		}
 		callMethodChan <- struct{}{}
 		return result, nil
@@ -237,11 +239,12 @@ func (suite *LogicRunnerTestSuite) TestSagaCallAcceptNotificationHandler() {
 	<-callMethodChan
 	suite.Require().Equal(outgoing.Caller, usedCaller)
 	suite.Require().Equal(outgoing.Reason, usedReason)
-	suite.Require().Equal(record.ReturnNoWait, usedReturnMode)
+	// Saga tracker waits for the result of accept call.
+	suite.Require().Equal(record.ReturnResult, usedReturnMode)
 
 	<-registerResultChan
 	suite.Require().Equal(outgoingRequestRef, &usedRequestRef)
-	suite.Require().Equal(dummyRequestRef.Bytes(), usedResult)
+	suite.Require().Equal(dummyResult, usedResult)
 
 	// In this test LME doesn't need any reply from VE. But if an reply was
 	// required you could check it like this:
@@ -439,6 +442,13 @@ func TestLogicRunner_OnPulse(t *testing.T) {
 				lr.WriteController = writecontroller.NewWriteController()
 				_ = lr.WriteController.Open(ctx, insolar.FirstPulseNumber)
 
+				lr.Validator = NewValidatorMock(mc).
+					OnPulseMock.Set(func(_ context.Context, pulse insolar.PulseNumber) {
+					require.Equal(t, insolar.PulseNumber(insolar.FirstPulseNumber), pulse)
+				})
+				lr.SagaTracker = NewSagaTrackerMock(mc).
+					OnPulseMock.Return()
+
 				return lr
 			},
 		},
@@ -459,6 +469,13 @@ func TestLogicRunner_OnPulse(t *testing.T) {
 				lr.WriteController = writecontroller.NewWriteController()
 				_ = lr.WriteController.Open(ctx, insolar.FirstPulseNumber)
 
+				lr.Validator = NewValidatorMock(mc).
+					OnPulseMock.Set(func(_ context.Context, pulse insolar.PulseNumber) {
+					require.Equal(t, insolar.PulseNumber(insolar.FirstPulseNumber), pulse)
+				})
+				lr.SagaTracker = NewSagaTrackerMock(mc).
+					OnPulseMock.Return()
+
 				return lr
 			},
 		},
@@ -525,6 +542,8 @@ func TestLogicRunner_OnPulse_Order(t *testing.T) {
 		LockMock.Return().
 		UnlockMock.Return().
 		IsEmptyMock.Return(true)
+	lr.Validator = NewValidatorMock(mc).OnPulseMock.Return()
+	lr.SagaTracker = NewSagaTrackerMock(mc).OnPulseMock.Return()
 
 	oldPulse := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber}
 	newPulse := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 1}
@@ -0,0 +1,143 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+var (
+	tagObjectClass = insmetrics.MustTagKey("objectClass")
+)
+
+var (
+	statValidationSent = stats.Int64(
+		"vm/validation/sent",
+		"Amount of executed transcripts sent to validators",
+		stats.UnitDimensionless,
+	)
+	statValidationSucceeded = stats.Int64(
+		"vm/validation/succeeded",
+		"Amount of re-executed transcripts with results matching executor's ones",
+		stats.UnitDimensionless,
+	)
+	statValidationMismatches = stats.Int64(
+		"vm/validation/mismatches",
+		"Amount of re-executed transcripts with results not matching executor's ones",
+		stats.UnitDimensionless,
+	)
+	statValidationErrors = stats.Int64(
+		"vm/validation/errors",
+		"Amount of transcripts failed to be restored for validation",
+		stats.UnitDimensionless,
+	)
+
+	statSagaRetries = stats.Int64(
+		"vm/saga/retries",
+		"Amount of retried saga calls",
+		stats.UnitDimensionless,
+	)
+	statSagaRollbacks = stats.Int64(
+		"vm/saga/rollbacks",
+		"Amount of permanently failed saga calls that were rolled back",
+		stats.UnitDimensionless,
+	)
+	statSagaRollbackFailures = stats.Int64(
+		"vm/saga/rollback_failures",
+		"Amount of saga calls which rollback failed permanently",
+		stats.UnitDimensionless,
+	)
+
+	statExecutionQueueDepth = stats.Int64(
+		"vm/execution_queue/depth",
+		"Amount of requests queued for an object when new request is added",
+		stats.UnitDimensionless,
+	)
+	statExecutionQueueRejected = stats.Int64(
+		"vm/execution_queue/rejected",
+		"Amount of requests rejected because execution queue of the object is full",
+		stats.UnitDimensionless,
+	)
+)
+
+func init() {
+	err := view.Register(
+		&view.View{
+			Name:        statValidationSent.Name(),
+			Description: statValidationSent.Description(),
+			Measure:     statValidationSent,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statValidationSucceeded.Name(),
+			Description: statValidationSucceeded.Description(),
+			Measure:     statValidationSucceeded,
+			Aggregation: view.Count(),
+		},
+		&view.View{
+			Name:        statValidationMismatches.Name(),
+			Description: statValidationMismatches.Description(),
+			Measure:     statValidationMismatches,
+			Aggregation: view.Count(),
+		},
+		&view.View{
+			Name:        statValidationErrors.Name(),
+			Description: statValidationErrors.Description(),
+			Measure:     statValidationErrors,
+			Aggregation: view.Count(),
+		},
+		&view.View{
+			Name:        statSagaRetries.Name(),
+			Description: statSagaRetries.Description(),
+			Measure:     statSagaRetries,
+			Aggregation: view.Count(),
+		},
+		&view.View{
+			Name:        statSagaRollbacks.Name(),
+			Description: statSagaRollbacks.Description(),
+			Measure:     statSagaRollbacks,
+			Aggregation: view.Count(),
+		},
+		&view.View{
+			Name:        statSagaRollbackFailures.Name(),
+			Description: statSagaRollbackFailures.Description(),
+			Measure:     statSagaRollbackFailures,
+			Aggregation: view.Count(),
+		},
+		&view.View{
+			Name:        statExecutionQueueDepth.Name(),
+			Description: statExecutionQueueDepth.Description(),
+			Measure:     statExecutionQueueDepth,
+			Aggregation: view.Distribution(1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000, 10000),
+			TagKeys:     []tag.Key{tagObjectClass},
+		},
+		&view.View{
+			Name:        statExecutionQueueRejected.Name(),
+			Description: statExecutionQueueRejected.Description(),
+			Measure:     statExecutionQueueRejected,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagObjectClass},
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -31,6 +31,7 @@ type OutgoingRequestSender interface {
 type outgoingRequestSender struct {
 	as        actor.System
 	senderPid actor.Pid
+	sagas     SagaTracker
 }
 
 // Currently actor has only one state.
@@ -59,7 +60,7 @@ type sendAbandonedOutgoingRequestMessage struct {
 	outgoingRequest  *record.OutgoingRequest // outgoing request body
 }
 
-func NewOutgoingRequestSender(as actor.System, cr insolar.ContractRequester, am artifacts.Client) OutgoingRequestSender {
+func NewOutgoingRequestSender(as actor.System, cr insolar.ContractRequester, am artifacts.Client, sagas SagaTracker) OutgoingRequestSender {
 	pid := as.Spawn(func(system actor.System, pid actor.Pid) (actor.Actor, int) {
 		state := newOutgoingSenderActorState(cr, am)
 		queueLimit := OutgoingRequestSenderDefaultQueueLimit
@@ -69,6 +70,7 @@ func NewOutgoingRequestSender(as actor.System, cr insolar.ContractRequester, am
 	return &outgoingRequestSender{
 		as:        as,
 		senderPid: pid,
+		sagas:     sagas,
 	}
 }
 
@@ -91,6 +93,15 @@ func (rs *outgoingRequestSender) SendOutgoingRequest(ctx context.Context, reqRef
 }
 
 func (rs *outgoingRequestSender) SendAbandonedOutgoingRequest(ctx context.Context, reqRef insolar.Reference, req *record.OutgoingRequest) {
+	if req.ReturnMode == record.ReturnSaga && rs.sagas != nil {
+		// Saga request is closed by tracker only when the saga is completed or rolled back.
+		err := rs.sagas.Accept(ctx, req.Caller, reqRef, req)
+		if err != nil {
+			inslogger.FromContext(ctx).Errorf("SagaTracker.Accept failed: %v", err)
+		}
+		return
+	}
+
 	msg := sendAbandonedOutgoingRequestMessage{
 		ctx:              ctx,
 		requestReference: reqRef,
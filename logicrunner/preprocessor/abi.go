@@ -0,0 +1,261 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package preprocessor
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// callSiteFlag marks function that handles `/api/call` request. It's followed by call site name and
+// `callParams` of the request as `name:type` pairs, e.g. `//ins:callSite wallet.getBalance reference:string`.
+var callSiteFlag = "ins:callSite"
+
+// ABI is a machine-readable description of contract interface.
+type ABI struct {
+	Contract     string        `json:"contract"`
+	Type         string        `json:"type"`
+	Constructors []ABIFunction `json:"constructors"`
+	Methods      []ABIFunction `json:"methods"`
+	// CallSites are `/api/call` requests handled by the contract.
+	CallSites []ABICallSite `json:"callSites"`
+}
+
+// ABIFunction describes contract method or constructor.
+type ABIFunction struct {
+	Name      string     `json:"name"`
+	Arguments []ABIParam `json:"arguments"`
+	// Results are method results without trailing error.
+	Results   []ABIParam `json:"results"`
+	Immutable bool       `json:"immutable,omitempty"`
+	Saga      bool       `json:"saga,omitempty"`
+	Rollback  string     `json:"rollback,omitempty"`
+}
+
+// ABICallSite describes `/api/call` request. Arguments are `callParams` of the request.
+type ABICallSite struct {
+	Name      string     `json:"name"`
+	Arguments []ABIParam `json:"arguments"`
+	// Results are handler results without trailing error.
+	Results []ABIParam `json:"results"`
+}
+
+// ABIParam describes argument or result of contract function. Type is a Go type expression.
+type ABIParam struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type"`
+}
+
+// ABI returns interface description of the contract.
+func (pf *ParsedFile) ABI() (*ABI, error) {
+	contract, err := pf.ProxyPackageName()
+	if err != nil {
+		return nil, err
+	}
+
+	methodsInfo := pf.functionInfoForWrapper(pf.methods[pf.contract])
+	err = pf.checkSagaRollbackMethodsExistAndMatch(methodsInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ABI{
+		Contract:     contract,
+		Type:         pf.contract,
+		Constructors: []ABIFunction{},
+		Methods:      []ABIFunction{},
+	}
+	for _, fun := range pf.constructors[pf.contract] {
+		// migration is called only by logic runner on upgrade
+		if fun.Name.Name == insolar.MigrateConstructor {
+			continue
+		}
+		res.Constructors = append(res.Constructors, pf.abiFunction(fun))
+	}
+	for _, fun := range pf.methods[pf.contract] {
+		f := pf.abiFunction(fun)
+		f.Immutable = isImmutable(fun)
+		saga := sagaInfo(pf, fun)
+		f.Saga = saga.IsSaga
+		f.Rollback = saga.RollbackMethodName
+		res.Methods = append(res.Methods, f)
+	}
+
+	res.CallSites, err = pf.abiCallSites()
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// abiCallSites returns call sites declared by annotations of contract functions. Handlers are usually
+// unexported, so all function declarations are checked.
+func (pf *ParsedFile) abiCallSites() ([]ABICallSite, error) {
+	res := []ABICallSite{}
+	names := map[string]bool{}
+	for _, decl := range pf.node.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Doc == nil {
+			continue
+		}
+		for _, comment := range fd.Doc.List {
+			callSite, ok, err := parseCallSite(comment.Text)
+			if err != nil {
+				return nil, errors.Wrapf(err, "bad call site annotation of %s", fd.Name.Name)
+			}
+			if !ok {
+				continue
+			}
+			if names[callSite.Name] {
+				return nil, errors.Errorf("call site %s is declared twice", callSite.Name)
+			}
+			names[callSite.Name] = true
+
+			callSite.Results = pf.abiParams(fd.Type.Results, "")
+			if last := len(callSite.Results) - 1; last >= 0 && callSite.Results[last].Type == errorType {
+				callSite.Results = callSite.Results[:last]
+			}
+			res = append(res, callSite)
+		}
+	}
+	return res, nil
+}
+
+// parseCallSite parses call site annotation. Returns false if comment is not a call site annotation.
+func parseCallSite(comment string) (ABICallSite, bool, error) {
+	text := strings.TrimSpace(strings.TrimPrefix(comment, "//"))
+	if !strings.HasPrefix(text, callSiteFlag) {
+		return ABICallSite{}, false, nil
+	}
+	fields := strings.Fields(strings.TrimPrefix(text, callSiteFlag))
+	if len(fields) == 0 {
+		return ABICallSite{}, false, errors.New("call site name is missing")
+	}
+
+	res := ABICallSite{Name: fields[0], Arguments: []ABIParam{}}
+	if !callSiteRegexp.MatchString(res.Name) {
+		return ABICallSite{}, false, errors.Errorf("invalid call site name %q", res.Name)
+	}
+	for _, field := range fields[1:] {
+		parts := strings.SplitN(field, ":", 2)
+		if len(parts) != 2 || !identRegexp.MatchString(parts[0]) {
+			return ABICallSite{}, false, errors.Errorf("invalid parameter %q, expected name:type", field)
+		}
+		if _, err := parser.ParseExpr(parts[1]); err != nil {
+			return ABICallSite{}, false, errors.Errorf("invalid type of parameter %q", field)
+		}
+		res.Arguments = append(res.Arguments, ABIParam{Name: parts[0], Type: parts[1]})
+	}
+	return res, true, nil
+}
+
+// WriteABI writes contract interface description as JSON into `out`.
+func (pf *ParsedFile) WriteABI(out io.Writer) error {
+	abi, err := pf.ABI()
+	if err != nil {
+		return errors.Wrap(err, "couldn't build ABI")
+	}
+
+	data, err := json.MarshalIndent(abi, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "couldn't marshal ABI")
+	}
+
+	_, err = out.Write(append(data, '\n'))
+	if err != nil {
+		return errors.Wrap(err, "couldn't write ABI to output")
+	}
+	return nil
+}
+
+// ReadABI reads contract interface description written by WriteABI.
+func ReadABI(in io.Reader) (*ABI, error) {
+	data, err := ioutil.ReadAll(in)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't read ABI")
+	}
+
+	abi := &ABI{}
+	err = json.Unmarshal(data, abi)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't unmarshal ABI")
+	}
+	if abi.Contract == "" {
+		return nil, errors.New("contract name is missing in ABI")
+	}
+	return abi, nil
+}
+
+func (pf *ParsedFile) abiFunction(fun *ast.FuncDecl) ABIFunction {
+	res := ABIFunction{
+		Name:      fun.Name.Name,
+		Arguments: pf.abiParams(fun.Type.Params, "arg"),
+		Results:   pf.abiParams(fun.Type.Results, ""),
+	}
+	if last := len(res.Results) - 1; last >= 0 && res.Results[last].Type == errorType {
+		res.Results = res.Results[:last]
+	}
+	return res
+}
+
+func (pf *ParsedFile) abiParams(list *ast.FieldList, unnamed string) []ABIParam {
+	res := []ABIParam{}
+	if list == nil {
+		return res
+	}
+	for _, field := range list.List {
+		typeName := pf.codeOfNode(field.Type)
+		if len(field.Names) == 0 {
+			res = append(res, ABIParam{Name: unnamedParam(unnamed, len(res)), Type: typeName})
+			continue
+		}
+		for _, name := range field.Names {
+			if name.Name == "_" {
+				res = append(res, ABIParam{Name: unnamedParam(unnamed, len(res)), Type: typeName})
+				continue
+			}
+			res = append(res, ABIParam{Name: name.Name, Type: typeName})
+		}
+	}
+	return res
+}
+
+func unnamedParam(prefix string, i int) string {
+	if prefix == "" {
+		return ""
+	}
+	return prefix + strconv.Itoa(i)
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
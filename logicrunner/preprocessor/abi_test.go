@@ -0,0 +1,233 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package preprocessor
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+var abiTestContract = `
+package main
+
+import (
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+)
+
+type Wallet struct {
+	foundation.BaseContract
+	Balance uint64
+}
+
+func New(balance uint64) (*Wallet, error) {
+	return &Wallet{Balance: balance}, nil
+}
+
+func Migrate(old []byte) (*Wallet, error) {
+	return &Wallet{}, nil
+}
+
+//ins:immutable
+func (w *Wallet) GetBalance() (uint64, error) {
+	return w.Balance, nil
+}
+
+func (w *Wallet) Owners(limit int) ([]insolar.Reference, map[string]uint64, error) {
+	return nil, nil, nil
+}
+
+//ins:saga(Rollback)
+func (w *Wallet) Accept(amount uint64) error {
+	w.Balance += amount
+	return nil
+}
+
+func (w *Wallet) Rollback(amount uint64) error {
+	w.Balance -= amount
+	return nil
+}
+
+//ins:callSite wallet.getBalance reference:string
+func (w *Wallet) getBalanceCall(params map[string]interface{}) (uint64, error) {
+	return w.Balance, nil
+}
+
+//ins:callSite wallet.owners limit:int
+func (w *Wallet) ownersCall(params map[string]interface{}) ([]insolar.Reference, map[string]uint64, error) {
+	return nil, nil, nil
+}
+
+//ins:callSite wallet.accept amount:uint64
+func (w *Wallet) acceptCall(params map[string]interface{}) error {
+	return nil
+}
+`
+
+func parseABITestContract(t *testing.T) *ParsedFile {
+	tmpDir, err := ioutil.TempDir("", "abi-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	fileName := filepath.Join(tmpDir, "wallet", "main.go")
+	require.NoError(t, os.MkdirAll(filepath.Dir(fileName), 0755))
+	err = ioutil.WriteFile(fileName, []byte(abiTestContract), 0644)
+	require.NoError(t, err)
+
+	parsed, err := ParseFile(fileName, insolar.MachineTypeGoPlugin)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestParsedFile_ABI(t *testing.T) {
+	parsed := parseABITestContract(t)
+
+	var buf bytes.Buffer
+	err := parsed.WriteABI(&buf)
+	require.NoError(t, err)
+
+	abi, err := ReadABI(&buf)
+	require.NoError(t, err)
+	require.Equal(t, &ABI{
+		Contract: "wallet",
+		Type:     "Wallet",
+		Constructors: []ABIFunction{
+			{Name: "New", Arguments: []ABIParam{{Name: "balance", Type: "uint64"}}, Results: []ABIParam{{Type: "*Wallet"}}},
+		},
+		Methods: []ABIFunction{
+			{
+				Name:      "GetBalance",
+				Arguments: []ABIParam{},
+				Results:   []ABIParam{{Type: "uint64"}},
+				Immutable: true,
+			},
+			{
+				Name:      "Owners",
+				Arguments: []ABIParam{{Name: "limit", Type: "int"}},
+				Results:   []ABIParam{{Type: "[]insolar.Reference"}, {Type: "map[string]uint64"}},
+			},
+			{
+				Name:      "Accept",
+				Arguments: []ABIParam{{Name: "amount", Type: "uint64"}},
+				Results:   []ABIParam{},
+				Saga:      true,
+				Rollback:  "Rollback",
+			},
+			{
+				Name:      "Rollback",
+				Arguments: []ABIParam{{Name: "amount", Type: "uint64"}},
+				Results:   []ABIParam{},
+			},
+		},
+		CallSites: []ABICallSite{
+			{
+				Name:      "wallet.getBalance",
+				Arguments: []ABIParam{{Name: "reference", Type: "string"}},
+				Results:   []ABIParam{{Type: "uint64"}},
+			},
+			{
+				Name:      "wallet.owners",
+				Arguments: []ABIParam{{Name: "limit", Type: "int"}},
+				Results:   []ABIParam{{Type: "[]insolar.Reference"}, {Type: "map[string]uint64"}},
+			},
+			{
+				Name:      "wallet.accept",
+				Arguments: []ABIParam{{Name: "amount", Type: "uint64"}},
+				Results:   []ABIParam{},
+			},
+		},
+	}, abi)
+}
+
+func TestWriteGoClient(t *testing.T) {
+	abi, err := parseABITestContract(t).ABI()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = WriteGoClient(&buf, abi, "walletclient")
+	require.NoError(t, err)
+
+	code := buf.String()
+	_, err = parser.ParseFile(token.NewFileSet(), "client.go", code, 0)
+	require.NoError(t, err)
+	require.Contains(t, code, "package walletclient")
+	require.Contains(t, code, "func (client *WalletClient) WalletGetBalance(ctx context.Context, reference string) (uint64, error)")
+	require.Contains(t, code, `"reference": reference,`)
+	require.Contains(t, code, "func (client *WalletClient) WalletOwners(ctx context.Context, limit int) ([]string, map[string]uint64, error)")
+	require.Contains(t, code, `client.call(ctx, "wallet.accept"`)
+	require.NotContains(t, code, "Rollback")
+}
+
+func TestWriteTypeScriptClient(t *testing.T) {
+	abi, err := parseABITestContract(t).ABI()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = WriteTypeScriptClient(&buf, abi)
+	require.NoError(t, err)
+
+	code := buf.String()
+	require.Contains(t, code, "export class WalletClient {")
+	require.Contains(t, code, "public async walletGetBalance(reference: string): Promise<number> {")
+	require.Contains(t, code, "public async walletOwners(limit: number): Promise<[string[], { [key: string]: number }]> {")
+	require.Contains(t, code, "public async walletAccept(amount: number): Promise<void> {")
+	require.NotContains(t, code, "rollback")
+}
+
+func TestWriteGoClient_InvalidABI(t *testing.T) {
+	abi := &ABI{Contract: "wallet", Type: "Wallet", CallSites: []ABICallSite{
+		{Name: `wallet.transfer", nil); panic("`},
+	}}
+	err := WriteGoClient(ioutil.Discard, abi, "wallet")
+	require.Error(t, err)
+}
+
+func TestParseCallSite(t *testing.T) {
+	callSite, ok, err := parseCallSite("//ins:callSite member.transfer amount:string toMemberReference:string")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, ABICallSite{
+		Name: "member.transfer",
+		Arguments: []ABIParam{
+			{Name: "amount", Type: "string"},
+			{Name: "toMemberReference", Type: "string"},
+		},
+	}, callSite)
+
+	_, ok, err = parseCallSite("//ins:immutable")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	for _, comment := range []string{
+		"//ins:callSite",
+		"//ins:callSite transfer",
+		"//ins:callSite member.transfer amount",
+		"//ins:callSite member.transfer amount:[",
+	} {
+		_, _, err = parseCallSite(comment)
+		require.Error(t, err, comment)
+	}
+}
@@ -0,0 +1,260 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package preprocessor
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// clientParam is argument or result of client method.
+type clientParam struct {
+	// Name is a name of variable in generated code.
+	Name string
+	// Key is a name of parameter in `callParams` of `/api/call` request.
+	Key  string
+	Type string
+}
+
+var (
+	identRegexp    = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	callSiteRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+\.[A-Za-z0-9_]+$`)
+)
+
+type clientMethod struct {
+	Name      string
+	CallSite  string
+	Arguments []clientParam
+	Results   []clientParam
+}
+
+// clientMethods returns methods of client for call sites of the contract.
+func clientMethods(abi *ABI, typeName func(string) string) ([]clientMethod, error) {
+	if !identRegexp.MatchString(abi.Contract) || !identRegexp.MatchString(abi.Type) {
+		return nil, errors.Errorf("invalid contract %q with type %q", abi.Contract, abi.Type)
+	}
+
+	res := make([]clientMethod, 0, len(abi.CallSites))
+	for _, c := range abi.CallSites {
+		if !callSiteRegexp.MatchString(c.Name) {
+			return nil, errors.Errorf("invalid call site %q", c.Name)
+		}
+		method := clientMethod{Name: callSiteMethod(c.Name), CallSite: c.Name}
+		for _, arg := range c.Arguments {
+			if !identRegexp.MatchString(arg.Name) {
+				return nil, errors.Errorf("invalid argument name %q of call site %s", arg.Name, c.Name)
+			}
+			name := arg.Name
+			if name == "ctx" || name == "client" {
+				name += "Arg"
+			}
+			method.Arguments = append(method.Arguments, clientParam{Name: name, Key: arg.Name, Type: typeName(arg.Type)})
+		}
+		for i, ret := range c.Results {
+			method.Results = append(method.Results, clientParam{Name: unnamedParam("ret", i), Type: typeName(ret.Type)})
+		}
+		res = append(res, method)
+	}
+	return res, nil
+}
+
+// callSiteMethod returns name of client method for call site, e.g. MemberTransfer for member.transfer.
+func callSiteMethod(callSite string) string {
+	var name string
+	for _, part := range strings.Split(callSite, ".") {
+		name += strings.Title(part)
+	}
+	return name
+}
+
+// WriteGoClient generates and writes into `out` source code of typed Go client for the contract.
+func WriteGoClient(out io.Writer, abi *ABI, packageName string) error {
+	methods, err := clientMethods(abi, goClientType)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{
+		"PackageName":  packageName,
+		"Contract":     abi.Contract,
+		"ContractType": abi.Type,
+		"Methods":      methods,
+	}
+	return formatAndWrite(out, "client", data)
+}
+
+// WriteTypeScriptClient generates and writes into `out` source code of typed TypeScript client for the contract.
+func WriteTypeScriptClient(out io.Writer, abi *ABI) error {
+	methods, err := clientMethods(abi, tsClientType)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := openTemplate(path.Join(TemplateDirectory, "client.ts.tpl"))
+	if err != nil {
+		return errors.Wrap(err, "couldn't open template file for client")
+	}
+
+	type tsMethod struct {
+		clientMethod
+		Function string
+		Result   string
+	}
+	tsMethods := make([]tsMethod, 0, len(methods))
+	for _, m := range methods {
+		tsMethods = append(tsMethods, tsMethod{
+			clientMethod: m,
+			Function:     lowerFirst(m.Name),
+			Result:       tsResultType(m.Results),
+		})
+	}
+
+	data := map[string]interface{}{
+		"Contract":     abi.Contract,
+		"ContractType": abi.Type,
+		"Methods":      tsMethods,
+	}
+
+	var buff bytes.Buffer
+	err = tmpl.Execute(&buff, data)
+	if err != nil {
+		return errors.Wrap(err, "couldn't write code output handle")
+	}
+
+	_, err = out.Write(buff.Bytes())
+	if err != nil {
+		return errors.Wrap(err, "couldn't write code to output")
+	}
+	return nil
+}
+
+// goClientType converts type of contract function to type in client. Client can't use types declared
+// in contract, so they are replaced with interface{}. References are passed as strings in `/api/call`.
+func goClientType(contractType string) string {
+	expr, err := parser.ParseExpr(contractType)
+	if err != nil {
+		return "interface{}"
+	}
+	return goClientTypeOf(expr)
+}
+
+func goClientTypeOf(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if isBasicType(t.Name) {
+			return t.Name
+		}
+	case *ast.SelectorExpr:
+		if isReferenceType(t) {
+			return "string"
+		}
+	case *ast.StarExpr:
+		return goClientTypeOf(t.X)
+	case *ast.ArrayType:
+		if t.Len == nil {
+			return "[]" + goClientTypeOf(t.Elt)
+		}
+	case *ast.MapType:
+		if key, ok := t.Key.(*ast.Ident); ok && key.Name == "string" {
+			return "map[string]" + goClientTypeOf(t.Value)
+		}
+	}
+	return "interface{}"
+}
+
+// tsClientType converts type of contract function to TypeScript type.
+func tsClientType(contractType string) string {
+	expr, err := parser.ParseExpr(contractType)
+	if err != nil {
+		return "any"
+	}
+	return tsClientTypeOf(expr)
+}
+
+func tsClientTypeOf(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		switch {
+		case t.Name == "string":
+			return "string"
+		case t.Name == "bool":
+			return "boolean"
+		case isBasicType(t.Name):
+			return "number"
+		}
+	case *ast.SelectorExpr:
+		if isReferenceType(t) {
+			return "string"
+		}
+	case *ast.StarExpr:
+		return tsClientTypeOf(t.X)
+	case *ast.ArrayType:
+		if elt, ok := t.Elt.(*ast.Ident); ok && elt.Name == "byte" {
+			// JSON encodes byte slices as base64 strings.
+			return "string"
+		}
+		if t.Len == nil {
+			return tsClientTypeOf(t.Elt) + "[]"
+		}
+	case *ast.MapType:
+		if key, ok := t.Key.(*ast.Ident); ok && key.Name == "string" {
+			return "{ [key: string]: " + tsClientTypeOf(t.Value) + " }"
+		}
+	}
+	return "any"
+}
+
+// tsResultType returns type of promise returned by TypeScript client method.
+func tsResultType(results []clientParam) string {
+	switch len(results) {
+	case 0:
+		return "void"
+	case 1:
+		return results[0].Type
+	}
+	res := "["
+	for i, r := range results {
+		if i > 0 {
+			res += ", "
+		}
+		res += r.Type
+	}
+	return res + "]"
+}
+
+func isBasicType(name string) bool {
+	switch name {
+	case "string", "bool", "byte", "rune",
+		"int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64",
+		"float32", "float64":
+		return true
+	}
+	return false
+}
+
+func isReferenceType(t *ast.SelectorExpr) bool {
+	pkg, ok := t.X.(*ast.Ident)
+	return ok && pkg.Name == "insolar" && t.Sel.Name == "Reference"
+}
@@ -0,0 +1,453 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package preprocessor
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// allowedImports are packages contracts can import without breaking re-execution on validators.
+var allowedImports = map[string]bool{
+	"bytes":           true,
+	"encoding/base64": true,
+	"encoding/hex":    true,
+	"encoding/json":   true,
+	"errors":          true,
+	"fmt":             true,
+	"math":            true,
+	"math/big":        true,
+	"sort":            true,
+	"strconv":         true,
+	"strings":         true,
+	"unicode":         true,
+	"unicode/utf8":    true,
+
+	"github.com/insolar/insolar/insolar": true,
+	"github.com/pkg/errors":              true,
+}
+
+// allowedImportPrefixes are trees of packages contracts can import: foundation, proxies and other contracts.
+var allowedImportPrefixes = []string{
+	"github.com/insolar/insolar/logicrunner/builtin/foundation",
+	"github.com/insolar/insolar/logicrunner/builtin/proxy/",
+	"github.com/insolar/insolar/logicrunner/builtin/contract/",
+	"github.com/insolar/insolar/application/proxy/",
+}
+
+// forbiddenImportReasons explains why the most common non-deterministic packages are not allowed.
+var forbiddenImportReasons = map[string]string{
+	"time":        "current time differs between executor and validators",
+	"math/rand":   "random numbers differ between executor and validators",
+	"crypto/rand": "random numbers differ between executor and validators",
+	"os":          "environment of executor and validators differs",
+	"sync":        "contracts are executed in one goroutine",
+	"unsafe":      "memory layout differs between executor and validators",
+}
+
+// knownMapTypes are map types of allowed packages, they are recognized even if the package fails to type check.
+var knownMapTypes = map[string]bool{
+	"github.com/insolar/insolar/logicrunner/builtin/foundation.StableMap": true,
+}
+
+// sharedImporter is shared between checks to type check packages imported by contracts once.
+var sharedImporter = &sourceImporter{
+	fileSet:  token.NewFileSet(),
+	packages: map[string]*types.Package{},
+}
+
+// Diagnostic is a problem found in contract code.
+type Diagnostic struct {
+	Pos     token.Position
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s: %s", d.Pos, d.Message)
+}
+
+func isAllowedImport(importPath string) bool {
+	if allowedImports[importPath] {
+		return true
+	}
+	for _, prefix := range allowedImportPrefixes {
+		if strings.HasPrefix(importPath, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckDeterminism walks contract code and reports constructs that give different results
+// on executor and validators: non-whitelisted imports, goroutines, channels and map iteration.
+func (pf *ParsedFile) CheckDeterminism() []Diagnostic {
+	var res []Diagnostic
+	report := func(pos token.Pos, format string, args ...interface{}) {
+		res = append(res, Diagnostic{Pos: pf.fileSet.Position(pos), Message: fmt.Sprintf(format, args...)})
+	}
+
+	for _, spec := range pf.node.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || isAllowedImport(importPath) {
+			continue
+		}
+		if reason, ok := forbiddenImportReasons[importPath]; ok {
+			report(spec.Pos(), "import %q is not allowed in contracts: %s", importPath, reason)
+			continue
+		}
+		report(spec.Pos(), "import %q is not allowed in contracts", importPath)
+	}
+
+	info := pf.typesInfo()
+	sorted := pf.sortedKeysCollections()
+	ast.Inspect(pf.node, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.GoStmt:
+			report(node.Pos(), "goroutines are not allowed in contracts")
+		case *ast.SelectStmt:
+			report(node.Pos(), "select is not allowed in contracts")
+		case *ast.ChanType:
+			report(node.Pos(), "channels are not allowed in contracts")
+		case *ast.RangeStmt:
+			if pf.isMap(info, node.X) && !sorted[node] {
+				report(node.Pos(), "iteration order over map is random, iterate over sorted keys instead")
+			}
+		}
+		return true
+	})
+	return res
+}
+
+// typesInfo type checks contract code with packages it imports, see sourceImporter. Types that failed to type check are left
+// invalid, see isMap.
+func (pf *ParsedFile) typesInfo() *types.Info {
+	info := &types.Info{Types: map[ast.Expr]types.TypeAndValue{}}
+	conf := types.Config{
+		Importer: sharedImporter,
+		Error:    func(error) {},
+	}
+	_, _ = conf.Check(pf.node.Name.Name, pf.fileSet, []*ast.File{pf.node}, info)
+	return info
+}
+
+// isMap checks if expression is a map. If type of expression is unknown, e.g. its package failed to import,
+// expression is checked by its declaration in contract code.
+func (pf *ParsedFile) isMap(info *types.Info, expr ast.Expr) bool {
+	t := info.TypeOf(expr)
+	if t == nil || t == types.Typ[types.Invalid] {
+		return pf.isDeclaredMap(expr)
+	}
+	_, ok := t.Underlying().(*types.Map)
+	return ok
+}
+
+// isDeclaredMap checks if variable, parameter or field is declared with map type in contract code.
+func (pf *ParsedFile) isDeclaredMap(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return pf.isDeclaredMap(e.X)
+	case *ast.SelectorExpr:
+		return pf.isMapField(e.Sel.Name)
+	case *ast.Ident:
+		if e.Obj == nil {
+			return false
+		}
+		switch decl := e.Obj.Decl.(type) {
+		case *ast.Field:
+			return pf.isMapType(decl.Type)
+		case *ast.ValueSpec:
+			if decl.Type != nil {
+				return pf.isMapType(decl.Type)
+			}
+			for i, name := range decl.Names {
+				if name.Name == e.Name && i < len(decl.Values) {
+					return pf.isMapValue(decl.Values[i])
+				}
+			}
+		case *ast.AssignStmt:
+			for i, lhs := range decl.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && ident.Name == e.Name && i < len(decl.Rhs) {
+					return pf.isMapValue(decl.Rhs[i])
+				}
+			}
+		}
+	}
+	return false
+}
+
+// isMapField checks if any struct declared in contract code has a map field with the name.
+func (pf *ParsedFile) isMapField(name string) bool {
+	found := false
+	ast.Inspect(pf.node, func(n ast.Node) bool {
+		st, ok := n.(*ast.StructType)
+		if !ok || found {
+			return !found
+		}
+		for _, field := range st.Fields.List {
+			for _, fieldName := range field.Names {
+				if fieldName.Name == name && pf.isMapType(field.Type) {
+					found = true
+				}
+			}
+		}
+		return false
+	})
+	return found
+}
+
+// isMapValue checks if expression is a map literal or a map created with make.
+func (pf *ParsedFile) isMapValue(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.CompositeLit:
+		return pf.isMapType(e.Type)
+	case *ast.CallExpr:
+		if fn, ok := e.Fun.(*ast.Ident); ok && fn.Name == "make" && len(e.Args) > 0 {
+			return pf.isMapType(e.Args[0])
+		}
+	}
+	return false
+}
+
+// isMapType checks if type expression is a map, a type declared as a map in contract code or a known map type.
+func (pf *ParsedFile) isMapType(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.MapType:
+		return true
+	case *ast.ParenExpr:
+		return pf.isMapType(e.X)
+	case *ast.Ident:
+		if e.Obj == nil {
+			return false
+		}
+		if spec, ok := e.Obj.Decl.(*ast.TypeSpec); ok {
+			return pf.isMapType(spec.Type)
+		}
+	case *ast.SelectorExpr:
+		pkg, ok := e.X.(*ast.Ident)
+		if !ok {
+			return false
+		}
+		importPath, ok := pf.importPath(pkg.Name)
+		return ok && knownMapTypes[importPath+"."+e.Sel.Name]
+	}
+	return false
+}
+
+// importPath returns path of package imported with the name.
+func (pf *ParsedFile) importPath(name string) (string, bool) {
+	for _, spec := range pf.node.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		importName := importPath[strings.LastIndex(importPath, "/")+1:]
+		if spec.Name != nil {
+			importName = spec.Name.Name
+		}
+		if importName == name {
+			return importPath, true
+		}
+	}
+	return "", false
+}
+
+// sortedKeysCollections finds range statements that only collect keys of a map into a slice that is sorted
+// with sort package later in the same block. It's the first step of iteration over sorted keys.
+func (pf *ParsedFile) sortedKeysCollections() map[*ast.RangeStmt]bool {
+	res := map[*ast.RangeStmt]bool{}
+	sortName, ok := pf.importName("sort")
+	if !ok {
+		return res
+	}
+
+	check := func(list []ast.Stmt) {
+		for i, stmt := range list {
+			rs, ok := stmt.(*ast.RangeStmt)
+			if !ok {
+				continue
+			}
+			keys, ok := keysCollection(rs)
+			if ok && isSorted(list[i+1:], sortName, keys) {
+				res[rs] = true
+			}
+		}
+	}
+	ast.Inspect(pf.node, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.BlockStmt:
+			check(node.List)
+		case *ast.CaseClause:
+			check(node.Body)
+		case *ast.CommClause:
+			check(node.Body)
+		}
+		return true
+	})
+	return res
+}
+
+// importName returns name the package is imported with.
+func (pf *ParsedFile) importName(importPath string) (string, bool) {
+	for _, spec := range pf.node.Imports {
+		p, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || p != importPath {
+			continue
+		}
+		if spec.Name != nil {
+			return spec.Name.Name, spec.Name.Name != "_"
+		}
+		return importPath[strings.LastIndex(importPath, "/")+1:], true
+	}
+	return "", false
+}
+
+// keysCollection checks if range statement only collects keys of the map: `keys = append(keys, k)`,
+// and returns name of the slice keys are collected to.
+func keysCollection(rs *ast.RangeStmt) (string, bool) {
+	key, ok := rs.Key.(*ast.Ident)
+	if !ok || rs.Value != nil || len(rs.Body.List) != 1 {
+		return "", false
+	}
+	assign, ok := rs.Body.List[0].(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return "", false
+	}
+	keys, ok := assign.Lhs[0].(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return "", false
+	}
+	if fn, ok := call.Fun.(*ast.Ident); !ok || fn.Name != "append" {
+		return "", false
+	}
+	if slice, ok := call.Args[0].(*ast.Ident); !ok || slice.Name != keys.Name {
+		return "", false
+	}
+	arg, ok := call.Args[1].(*ast.Ident)
+	return keys.Name, ok && arg.Name == key.Name
+}
+
+// isSorted checks if statements call a function of sort package with the slice as an argument,
+// e.g. `sort.Strings(keys)` or `sort.Sort(sort.StringSlice(keys))`.
+func isSorted(list []ast.Stmt, sortName string, slice string) bool {
+	found := false
+	for _, stmt := range list {
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || found {
+				return !found
+			}
+			if !isSortCall(call, sortName) {
+				return true
+			}
+			for _, arg := range call.Args {
+				ast.Inspect(arg, func(n ast.Node) bool {
+					if ident, ok := n.(*ast.Ident); ok && ident.Name == slice {
+						found = true
+					}
+					return !found
+				})
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+func isSortCall(call *ast.CallExpr, sortName string) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && pkg.Name == sortName
+}
+
+// sourceImporter type checks allowed non-standard packages from source without their own dependencies.
+// This is enough to find types declared in them, e.g. foundation.StableMap, types that depend on other
+// packages are left invalid. Other packages are empty.
+type sourceImporter struct {
+	lock     sync.Mutex
+	fileSet  *token.FileSet
+	packages map[string]*types.Package
+}
+
+func (si *sourceImporter) Import(importPath string) (*types.Package, error) {
+	return si.ImportFrom(importPath, "", 0)
+}
+
+func (si *sourceImporter) ImportFrom(importPath, dir string, _ types.ImportMode) (*types.Package, error) {
+	si.lock.Lock()
+	defer si.lock.Unlock()
+
+	if pkg, ok := si.packages[importPath]; ok {
+		return pkg, nil
+	}
+	pkg := si.importSource(importPath, dir)
+	si.packages[importPath] = pkg
+	return pkg, nil
+}
+
+func (si *sourceImporter) importSource(importPath, dir string) *types.Package {
+	if !isAllowedImport(importPath) || !strings.Contains(importPath, ".") {
+		return emptyPackage(importPath)
+	}
+	bp, err := build.Import(importPath, dir, 0)
+	if err != nil {
+		return emptyPackage(importPath)
+	}
+
+	var files []*ast.File
+	for _, name := range bp.GoFiles {
+		file, err := parser.ParseFile(si.fileSet, filepath.Join(bp.Dir, name), nil, 0)
+		if err == nil {
+			files = append(files, file)
+		}
+	}
+	conf := types.Config{
+		Importer: emptyImporter{},
+		Error:    func(error) {},
+	}
+	pkg, _ := conf.Check(importPath, si.fileSet, files, nil)
+	pkg.MarkComplete()
+	return pkg
+}
+
+// emptyImporter returns empty packages.
+type emptyImporter struct{}
+
+func (emptyImporter) Import(importPath string) (*types.Package, error) {
+	return emptyPackage(importPath), nil
+}
+
+func emptyPackage(importPath string) *types.Package {
+	pkg := types.NewPackage(importPath, path.Base(importPath))
+	pkg.MarkComplete()
+	return pkg
+}
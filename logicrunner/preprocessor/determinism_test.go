@@ -0,0 +1,187 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package preprocessor
+
+import (
+	"go/ast"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+var nonDeterministicContract = `
+package main
+
+import (
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+	"github.com/insolar/insolar/network"
+)
+
+type Counter struct {
+	foundation.BaseContract
+	Values map[string]int
+	Owners map[string]insolar.Reference
+}
+
+func (c *Counter) Sum() (int, error) {
+	sum := 0
+	for _, v := range c.Values {
+		sum = sum*31 + v
+	}
+	return sum, nil
+}
+
+func (c *Counter) SortedSum() (int, error) {
+	keys := make([]string, 0, len(c.Values))
+	for k := range c.Values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	sum := 0
+	for _, k := range keys {
+		sum = sum*31 + c.Values[k]
+	}
+	return sum, nil
+}
+
+func (c *Counter) FirstOwner() (string, error) {
+	for k := range c.Owners {
+		return k, nil
+	}
+	return "", nil
+}
+
+func (c *Counter) Async() error {
+	done := make(chan struct{})
+	go func() {
+		close(done)
+	}()
+	select {
+	case <-done:
+	}
+	return nil
+}
+
+func (c *Counter) Random() (int64, error) {
+	return rand.Int63() + time.Now().Unix(), nil
+}
+
+func (c *Counter) Unsorted(index foundation.StableMap) ([]string, error) {
+	var keys []string
+	for k := range c.Values {
+		keys = append(keys, k)
+	}
+	for k := range index {
+		keys = append(keys, k)
+	}
+	return keys, nil
+}
+
+func (c *Counter) Sorted(index foundation.StableMap) ([]string, error) {
+	keys := []string{}
+	for k := range index {
+		keys = append(keys, k)
+	}
+	sort.Sort(sort.StringSlice(keys))
+	return keys, nil
+}
+`
+
+func TestParsedFile_CheckDeterminism(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "determinism-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	fileName := filepath.Join(tmpDir, "main.go")
+	err = ioutil.WriteFile(fileName, []byte(nonDeterministicContract), 0644)
+	require.NoError(t, err)
+
+	parsed, err := ParseFile(fileName, insolar.MachineTypeGoPlugin)
+	require.NoError(t, err)
+
+	var found []string
+	for _, d := range parsed.CheckDeterminism() {
+		require.Equal(t, fileName, d.Pos.Filename)
+		found = append(found, d.String()[len(fileName)+1:])
+	}
+	require.Equal(t, []string{
+		`5:2: import "math/rand" is not allowed in contracts: random numbers differ between executor and validators`,
+		`7:2: import "time" is not allowed in contracts: current time differs between executor and validators`,
+		`11:2: import "github.com/insolar/insolar/network" is not allowed in contracts`,
+		`22:2: iteration order over map is random, iterate over sorted keys instead`,
+		`43:2: iteration order over map is random, iterate over sorted keys instead`,
+		`50:15: channels are not allowed in contracts`,
+		`51:2: goroutines are not allowed in contracts`,
+		`54:2: select is not allowed in contracts`,
+		`66:2: iteration order over map is random, iterate over sorted keys instead`,
+		`69:2: iteration order over map is random, iterate over sorted keys instead`,
+	}, found)
+}
+
+func TestParsedFile_IsMap_UnknownTypes(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "determinism-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	fileName := filepath.Join(tmpDir, "main.go")
+	err = ioutil.WriteFile(fileName, []byte(nonDeterministicContract), 0644)
+	require.NoError(t, err)
+
+	parsed, err := ParseFile(fileName, insolar.MachineTypeGoPlugin)
+	require.NoError(t, err)
+
+	// types are unknown, maps are found by their declarations
+	info := &types.Info{Types: map[ast.Expr]types.TypeAndValue{}}
+	found := map[int]bool{}
+	ast.Inspect(parsed.node, func(n ast.Node) bool {
+		if rs, ok := n.(*ast.RangeStmt); ok {
+			found[parsed.fileSet.Position(rs.Pos()).Line] = parsed.isMap(info, rs.X)
+		}
+		return true
+	})
+	require.Equal(t, map[int]bool{22: true, 30: true, 36: false, 43: true, 66: true, 69: true, 77: true}, found)
+}
+
+func TestParsedFile_CheckDeterminism_BuiltinContracts(t *testing.T) {
+	contracts, err := filepath.Glob("../builtin/contract/*/*.go")
+	require.NoError(t, err)
+
+	for _, fileName := range contracts {
+		if strings.HasSuffix(fileName, ".wrapper.go") || strings.HasSuffix(fileName, "_test.go") {
+			continue
+		}
+		parsed, err := ParseFile(fileName, insolar.MachineTypeBuiltin)
+		if err != nil {
+			// Not a contract file.
+			continue
+		}
+		require.Empty(t, parsed.CheckDeterminism(), fileName)
+	}
+}
@@ -183,10 +183,17 @@ func (pf *ParsedFile) parseFunctionsAndMethods() error {
 
 func (pf *ParsedFile) parseConstructor(fd *ast.FuncDecl) error {
 	name := fd.Name.Name
-	if !strings.HasPrefix(name, "New") {
+	if !strings.HasPrefix(name, "New") && name != insolar.MigrateConstructor {
 		return nil // doesn't look like a constructor
 	}
 
+	if name == insolar.MigrateConstructor {
+		params := fd.Type.Params
+		if params.NumFields() != 1 || pf.typeName(params.List[0].Type) != "[]byte" {
+			return errors.Errorf("Migration function %q should accept exactly one '[]byte' argument", name)
+		}
+	}
+
 	res := fd.Type.Results
 
 	if res.NumFields() != 2 {
@@ -319,6 +326,7 @@ func (pf *ParsedFile) WriteWrapper(out io.Writer, packageName string) error {
 		"FoundationPath":     foundationPath,
 		"Imports":            pf.generateImports(true),
 		"GenerateInitialize": pf.machineType == insolar.MachineTypeBuiltin,
+		"SagaRollbackSuffix": insolar.SagaRollbackSuffix,
 	}
 
 	return formatAndWrite(out, "wrapper", data)
@@ -428,7 +436,16 @@ func (pf *ParsedFile) WriteProxy(classReference string, out io.Writer) error {
 		return err
 	}
 
-	constructorProxies := pf.functionInfoForProxy(pf.constructors[pf.contract])
+	// migration is called only by logic runner on upgrade, so it has no proxy
+	var proxyConstructors []*ast.FuncDecl //nolint:prealloc
+	for _, fd := range pf.constructors[pf.contract] {
+		if fd.Name.Name == insolar.MigrateConstructor {
+			continue
+		}
+		proxyConstructors = append(proxyConstructors, fd)
+	}
+
+	constructorProxies := pf.functionInfoForProxy(proxyConstructors)
 	for _, fi := range constructorProxies {
 		if fi["SagaInfo"].(*SagaInfo).IsSaga {
 			return fmt.Errorf("semantic error: '%s' can't be a saga because it's a constructor", fi["Name"].(string))
@@ -376,6 +376,65 @@ func (a *A) Get(
 	s.Contains(bufWrapper.String(), "args[3] = &args3")
 }
 
+func (s *PreprocessorSuite) TestMigrationParsing() {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) // nolint: errcheck
+
+	code := `
+package main
+
+type One struct {
+	foundation.BaseContract
+}
+
+func New() (*One, error) {
+	return &One{}, nil
+}
+
+func Migrate(old []byte) (*One, error) {
+	return &One{}, nil
+}
+`
+
+	err = goplugintestutils.WriteFile(tmpDir, "one.go", code)
+	s.NoError(err)
+
+	info, err := ParseFile(filepath.Join(tmpDir, "one.go"), insolar.MachineTypeGoPlugin)
+	s.NoError(err)
+
+	s.Equal(2, len(info.constructors["One"]))
+	s.Equal(insolar.MigrateConstructor, info.constructors["One"][1].Name.Name)
+
+	var bufProxy bytes.Buffer
+	err = info.WriteProxy(testutils.RandomRef().String(), &bufProxy)
+	s.NoError(err)
+	s.NotContains(bufProxy.String(), insolar.MigrateConstructor)
+
+	var bufWrapper bytes.Buffer
+	err = info.WriteWrapper(&bufWrapper, "main")
+	s.NoError(err)
+	s.Contains(bufWrapper.String(), insolar.MigrateConstructor)
+
+	code = `
+package main
+
+type One struct {
+	foundation.BaseContract
+}
+
+func Migrate(old string) (*One, error) {
+	return &One{}, nil
+}
+`
+
+	err = goplugintestutils.WriteFile(tmpDir, "code1", code)
+	s.NoError(err)
+
+	_, err = ParseFile(filepath.Join(tmpDir, "code1"), insolar.MachineTypeGoPlugin)
+	s.Error(err)
+}
+
 func (s *PreprocessorSuite) TestConstructorsWrapper() {
 	tmpDir, err := ioutil.TempDir("", "test-")
 	s.NoError(err)
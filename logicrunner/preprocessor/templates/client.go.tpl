@@ -0,0 +1,103 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package {{ .PackageName }}
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/api/requester"
+)
+
+// {{ .ContractType }}Client calls methods of {{ .Contract }} contract through signed `/api/call` requests.
+type {{ .ContractType }}Client struct {
+	url  string
+	user *requester.UserConfigJSON
+}
+
+// New{{ .ContractType }}Client creates client that sends requests to API at url (e.g. http://localhost:19101/api)
+// signed with keys of user.
+func New{{ .ContractType }}Client(url string, user *requester.UserConfigJSON) *{{ .ContractType }}Client {
+	return &{{ .ContractType }}Client{url: url, user: user}
+}
+{{ range $method := .Methods }}
+// {{ $method.Name }} calls `{{ $method.CallSite }}`.
+func (client *{{ $.ContractType }}Client) {{ $method.Name }}(ctx context.Context{{ range $arg := $method.Arguments }}, {{ $arg.Name }} {{ $arg.Type }}{{ end }}) ({{ range $ret := $method.Results }}{{ $ret.Type }}, {{ end }}error) {
+	{{- range $ret := $method.Results }}
+	var {{ $ret.Name }} {{ $ret.Type }}
+	{{- end }}
+	err := client.call(ctx, "{{ $method.CallSite }}", map[string]interface{}{
+		{{- range $arg := $method.Arguments }}
+		"{{ $arg.Key }}": {{ $arg.Name }},
+		{{- end }}
+	}{{ range $ret := $method.Results }}, &{{ $ret.Name }}{{ end }})
+	return {{ range $ret := $method.Results }}{{ $ret.Name }}, {{ end }}err
+}
+{{ end }}
+func (client *{{ .ContractType }}Client) call(ctx context.Context, callSite string, params map[string]interface{}, results ...interface{}) error {
+	body, err := requester.Send(ctx, client.url, client.user, &requester.Request{
+		JSONRPC: requester.JSONRPCVersion,
+		ID:      1,
+		Method:  "api.call",
+		Params: requester.Params{
+			CallSite:   callSite,
+			CallParams: params,
+			PublicKey:  client.user.PublicKey,
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to call %s", callSite)
+	}
+
+	answer := requester.ContractAnswer{}
+	err = json.Unmarshal(body, &answer)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal response of %s", callSite)
+	}
+	if answer.Error != nil {
+		return errors.Errorf("%s failed: %s", callSite, answer.Error.Message)
+	}
+	if len(results) == 0 || answer.Result == nil {
+		return nil
+	}
+
+	raw, err := json.Marshal(answer.Result.ContractResult)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read result of %s", callSite)
+	}
+	if len(results) == 1 {
+		return errors.Wrapf(json.Unmarshal(raw, results[0]), "failed to decode result of %s", callSite)
+	}
+
+	var list []json.RawMessage
+	err = json.Unmarshal(raw, &list)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode results of %s", callSite)
+	}
+	if len(list) != len(results) {
+		return errors.Errorf("%s returned %d results, expected %d", callSite, len(list), len(results))
+	}
+	for i, r := range list {
+		err = json.Unmarshal(r, results[i])
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode result %d of %s", i, callSite)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright 2019 Insolar Technologies GmbH
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/**
+ * Transport signs and sends `api.call` request to `/api/call` and resolves with `callResult` of response.
+ * It gets seed from `node.getSeed`, signs request body with member's key and sets `Digest` and
+ * `Signature` headers.
+ */
+export interface Transport {
+    call(callSite: string, callParams: { [key: string]: any }): Promise<any>;
+}
+
+/**
+ * {{ .ContractType }}Client calls methods of {{ .Contract }} contract.
+ */
+export class {{ .ContractType }}Client {
+    constructor(private readonly transport: Transport) {}
+{{ range $method := .Methods }}
+    /**
+     * {{ $method.Name }} calls `{{ $method.CallSite }}`.
+     */
+    public async {{ $method.Function }}({{ range $i, $arg := $method.Arguments }}{{ if $i }}, {{ end }}{{ $arg.Name }}: {{ $arg.Type }}{{ end }}): Promise<{{ $method.Result }}> {
+        return this.transport.call("{{ $method.CallSite }}", {
+        {{- if $method.Arguments }}
+            {{- range $arg := $method.Arguments }}
+            {{ $arg.Key }}: {{ $arg.Name }},
+            {{- end }}
+        {{ end -}}
+        });
+    }
+{{ end -}}
+}
@@ -210,6 +210,24 @@ func (r *{{ $.ContractType }}) {{ $method.Name }}NoWait( {{ $method.Arguments }}
 	return nil
 }
 
+// {{ $method.Name }}AtPulse is proxy generated method that schedules call to provided pulse
+func (r *{{ $.ContractType }}) {{ $method.Name }}AtPulse( executeAt insolar.PulseNumber{{if $method.Arguments}}, {{ $method.Arguments }}{{end}} ) error {
+	{{ $method.InitArgs }}
+	var argsSerialized []byte
+
+	err := common.CurrentProxyCtx.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	err = common.CurrentProxyCtx.ScheduleCall(r.Reference, executeAt, "{{ $method.Name }}", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // {{ $method.Name }}AsImmutable is proxy generated method
 func (r *{{ $.ContractType }}) {{ $method.Name }}{{if not $method.Immutable}}AsImmutable{{end}}( {{ $method.Arguments }} ) ( {{ $method.ResultsTypes }} ) {
 	{{ $method.InitArgs }}
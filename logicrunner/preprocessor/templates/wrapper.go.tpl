@@ -163,6 +163,14 @@ func INSMETHOD_{{ $method.Name }}(object []byte, data []byte) ([]byte, []byte, e
 	return state, ret, err
 }
 {{ end }}
+{{ range $method := .Methods }}
+{{- if and $method.SagaInfo.IsSaga (ne $method.SagaInfo.RollbackMethodName "INS_FLAG_NO_ROLLBACK_METHOD") }}
+// INSMETHOD_{{ $method.Name }}{{ $.SagaRollbackSuffix }} rolls back saga call of {{ $method.Name }}.
+func INSMETHOD_{{ $method.Name }}{{ $.SagaRollbackSuffix }}(object []byte, data []byte) ([]byte, []byte, error) {
+	return INSMETHOD_{{ $method.SagaInfo.RollbackMethodName }}(object, data)
+}
+{{ end }}
+{{- end }}
 
 
 {{ range $f := .Functions }}
@@ -211,6 +219,9 @@ func Initialize() XXX_insolar.ContractWrapper {
         Methods: XXX_insolar.ContractMethods{
             {{ range $method := .Methods -}}
                     "{{ $method.Name }}": INSMETHOD_{{ $method.Name }},
+                    {{- if and $method.SagaInfo.IsSaga (ne $method.SagaInfo.RollbackMethodName "INS_FLAG_NO_ROLLBACK_METHOD") }}
+                    "{{ $method.Name }}{{ $.SagaRollbackSuffix }}": INSMETHOD_{{ $method.Name }}{{ $.SagaRollbackSuffix }},
+                    {{- end }}
             {{ end }}
         },
         Constructors: XXX_insolar.ContractConstructors{
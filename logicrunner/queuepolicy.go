@@ -0,0 +1,87 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar/record"
+)
+
+// ErrQueueLimitExceeded is returned to callers when too many requests are queued for the object.
+var ErrQueueLimitExceeded = errors.New("execution queue limit exceeded")
+
+// QueuePolicy limits length of execution queues and assigns priority lanes to requests.
+// Nil policy means unlimited queues with one lane.
+type QueuePolicy struct {
+	maxLength int
+	lanes     map[string]int
+	// defaultLane is a lane of requests whose methods are not listed in configuration.
+	defaultLane int
+}
+
+// NewQueuePolicy creates policy from configuration. Returns nil if configuration is not provided.
+func NewQueuePolicy(cfg *configuration.ExecutionQueue) *QueuePolicy {
+	if cfg == nil {
+		return nil
+	}
+
+	p := &QueuePolicy{
+		maxLength:   cfg.MaxLength,
+		lanes:       map[string]int{},
+		defaultLane: len(cfg.PriorityLanes),
+	}
+	for lane, methods := range cfg.PriorityLanes {
+		for _, method := range methods {
+			if _, ok := p.lanes[method]; !ok {
+				p.lanes[method] = lane
+			}
+		}
+	}
+	return p
+}
+
+// Lane returns lane of the request, requests from lanes with lower numbers are executed first.
+func (p *QueuePolicy) Lane(request *record.IncomingRequest) int {
+	if p == nil || request == nil {
+		return 0
+	}
+	if lane, ok := p.lanes[request.Method]; ok {
+		return lane
+	}
+	return p.defaultLane
+}
+
+// Check returns ErrQueueLimitExceeded if one more request can't be added to queue of provided length.
+func (p *QueuePolicy) Check(length int) error {
+	if p == nil || p.maxLength <= 0 {
+		return nil
+	}
+	if length >= p.maxLength {
+		return ErrQueueLimitExceeded
+	}
+	return nil
+}
+
+// objectClass returns prototype of the request that is used to group queue metrics.
+func objectClass(request *record.IncomingRequest) string {
+	if request == nil || request.Prototype == nil {
+		return "unknown"
+	}
+	return request.Prototype.String()
+}
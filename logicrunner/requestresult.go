@@ -74,6 +74,13 @@ func (s *requestResult) SetAmend(object artifacts.ObjectDescriptor, memory []byt
 	s.objectImage = *prototype
 }
 
+func (s *requestResult) SetUpgrade(object artifacts.ObjectDescriptor, image insolar.Reference, memory []byte) {
+	s.sideEffectType = artifacts.RequestSideEffectAmend
+	s.memory = memory
+	s.objectStateID = *object.StateID()
+	s.objectImage = image
+}
+
 func (s *requestResult) SetDeactivate(object artifacts.ObjectDescriptor) {
 	s.sideEffectType = artifacts.RequestSideEffectDeactivate
 	s.objectStateID = *object.StateID()
@@ -46,6 +46,7 @@ type requestsExecutor struct {
 	LogicExecutor   LogicExecutor      `inject:""`
 	ArtifactManager artifacts.Client   `inject:""`
 	PulseAccessor   pulse.Accessor     `inject:""`
+	Validator       Validator          `inject:""`
 }
 
 func NewRequestsExecutor() RequestsExecutor {
@@ -113,6 +114,8 @@ func (e *requestsExecutor) Save(
 		return nil, errors.Wrapf(err, "couldn't save result with %s side effect", res.Type().String())
 	}
 
+	e.Validator.AddResult(ctx, transcript, res)
+
 	objRef := res.ObjectReference()
 	return &reply.CallMethod{Result: res.Result(), Object: &objRef}, nil
 }
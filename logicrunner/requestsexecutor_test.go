@@ -80,7 +80,11 @@ func TestRequestsExecutor_ExecuteAndSave(t *testing.T) {
 	for _, test := range table {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			re := &requestsExecutor{ArtifactManager: test.am, LogicExecutor: test.le}
+			validator := NewValidatorMock(mc)
+			if !test.error {
+				validator.AddResultMock.Return()
+			}
+			re := &requestsExecutor{ArtifactManager: test.am, LogicExecutor: test.le, Validator: validator}
 			res, err := re.ExecuteAndSave(ctx, test.transcript)
 			if !test.error {
 				require.NoError(t, err)
@@ -305,7 +309,11 @@ func TestRequestsExecutor_Save(t *testing.T) {
 	for _, test := range table {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			re := &requestsExecutor{ArtifactManager: test.am}
+			validator := NewValidatorMock(mc)
+			if !test.error {
+				validator.AddResultMock.Expect(ctx, test.transcript, test.result).Return()
+			}
+			re := &requestsExecutor{ArtifactManager: test.am, Validator: validator}
 			replyVal, err := re.Save(ctx, test.transcript, test.result)
 			if !test.error {
 				require.NoError(t, err)
@@ -22,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/instrumentation/instracer"
@@ -40,6 +41,7 @@ type ProxyImplementation interface {
 
 type RPCMethods struct {
 	ss         StateStorage
+	validator  Validator
 	execution  ProxyImplementation
 	validation ProxyImplementation
 }
@@ -50,10 +52,13 @@ func NewRPCMethods(
 	cr insolar.ContractRequester,
 	ss StateStorage,
 	outgoingSender OutgoingRequestSender,
+	validator Validator,
+	pa pulse.Accessor,
 ) *RPCMethods {
 	return &RPCMethods{
 		ss:         ss,
-		execution:  NewExecutionProxyImplementation(dc, cr, am, outgoingSender),
+		validator:  validator,
+		execution:  NewExecutionProxyImplementation(dc, cr, am, outgoingSender, pa),
 		validation: NewValidationProxyImplementation(dc),
 	}
 }
@@ -76,6 +81,13 @@ func (m *RPCMethods) getCurrent(
 		}
 
 		return m.execution, transcript, nil
+	case insolar.ValidateCallMode:
+		transcript := m.validator.ValidatingTranscript(reqRef)
+		if transcript == nil {
+			return nil, nil, errors.New("No validating transcript for request")
+		}
+
+		return m.validation, transcript, nil
 	default:
 		panic("not implemented")
 	}
@@ -126,6 +138,7 @@ type executionProxyImplementation struct {
 	cr             insolar.ContractRequester
 	am             artifacts.Client
 	outgoingSender OutgoingRequestSender
+	pa             pulse.Accessor
 }
 
 func NewExecutionProxyImplementation(
@@ -133,12 +146,14 @@ func NewExecutionProxyImplementation(
 	cr insolar.ContractRequester,
 	am artifacts.Client,
 	outgoingSender OutgoingRequestSender,
+	pa pulse.Accessor,
 ) ProxyImplementation {
 	return &executionProxyImplementation{
 		dc:             dc,
 		cr:             cr,
 		am:             am,
 		outgoingSender: outgoingSender,
+		pa:             pa,
 	}
 }
 
@@ -164,6 +179,17 @@ func (m *executionProxyImplementation) RouteCall(
 ) error {
 	inslogger.FromContext(ctx).Debug("RPC.RouteCall")
 
+	if req.ExecuteAt != 0 {
+		latest, err := m.pa.Latest(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to get current pulse")
+		}
+		// Ledger rejects such request anyway, but it's a mistake of the contract and shouldn't reach ledger.
+		if req.ExecuteAt <= latest.PulseNumber {
+			return errors.Errorf("call should be scheduled after current pulse %v (got %v)", latest.PulseNumber, req.ExecuteAt)
+		}
+	}
+
 	outgoing := buildOutgoingRequest(ctx, current, req)
 
 	// Step 1. Register outgoing request.
@@ -177,9 +203,10 @@ func (m *executionProxyImplementation) RouteCall(
 		return err
 	}
 
-	if req.Saga {
+	if req.Saga || req.ExecuteAt != 0 {
 		// Saga methods are not executed right away. LME will send a method
 		// to the VE when current object finishes the execution and validation.
+		// Scheduled methods are fetched by VE from pendings when their pulse comes.
 		return nil
 	}
 
@@ -335,11 +362,11 @@ func buildIncomingRequestFromOutgoing(outgoing *record.OutgoingRequest) *record.
 		Reason:       outgoing.Reason,
 	}
 
-	if outgoing.ReturnMode == record.ReturnSaga {
-		// We never wait for a result of saga call
+	if outgoing.IsDetached() {
+		// We never wait for a result of saga or scheduled call
 		incoming.ReturnMode = record.ReturnNoWait
 	} else {
-		// If this is not a saga call just copy the ReturnMode
+		// If this is not a detached call just copy the ReturnMode
 		incoming.ReturnMode = outgoing.ReturnMode
 	}
 
@@ -372,6 +399,11 @@ func buildOutgoingRequest(
 		// OutgoingRequest with ReturnMode = ReturnSaga will be called by LME
 		// when current object finishes the execution and validation.
 		outgoing.ReturnMode = record.ReturnSaga
+	} else if req.ExecuteAt != 0 {
+		// OutgoingRequest with ReturnMode = ReturnScheduled will be called by VE
+		// that is responsible for the caller at ExecuteAt pulse.
+		outgoing.ReturnMode = record.ReturnScheduled
+		outgoing.ExecuteAt = req.ExecuteAt
 	} else if !req.Wait {
 		outgoing.ReturnMode = record.ReturnNoWait
 	}
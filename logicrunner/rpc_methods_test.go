@@ -30,6 +30,7 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
@@ -45,6 +46,8 @@ func TestRPCMethods_New(t *testing.T) {
 		testutils.NewContractRequesterMock(t),
 		NewStateStorageMock(t),
 		NewOutgoingRequestSenderMock(t),
+		NewValidatorMock(t),
+		pulse.NewAccessorMock(t),
 	)
 	require.NotNil(t, m)
 }
@@ -313,11 +316,11 @@ func TestRouteCallRegistersOutgoingRequestWithValidReason(t *testing.T) {
 	dc := artifacts.NewDescriptorsCacheMock(t)
 	cr := testutils.NewContractRequesterMock(t)
 	as := system.New()
-	os := NewOutgoingRequestSender(as, cr, am)
+	os := NewOutgoingRequestSender(as, cr, am, nil)
 
 	requestRef := gen.Reference()
 
-	rpcm := NewExecutionProxyImplementation(dc, cr, am, os)
+	rpcm := NewExecutionProxyImplementation(dc, cr, am, os, nil)
 	ctx := context.Background()
 	transcript := NewTranscript(ctx, requestRef, record.IncomingRequest{})
 	req := rpctypes.UpRouteReq{Wait: true}
@@ -358,7 +361,7 @@ func TestRouteCallRegistersSaga(t *testing.T) {
 
 	requestRef := gen.Reference()
 
-	rpcm := NewExecutionProxyImplementation(dc, cr, am, os)
+	rpcm := NewExecutionProxyImplementation(dc, cr, am, os, nil)
 	ctx := context.Background()
 	transcript := NewTranscript(ctx, requestRef, record.IncomingRequest{})
 	req := rpctypes.UpRouteReq{Saga: true}
@@ -383,6 +386,69 @@ func TestRouteCallRegistersSaga(t *testing.T) {
 	require.Equal(t, requestRef, outreq.Reason)
 }
 
+func TestRouteCallRegistersScheduled(t *testing.T) {
+	t.Parallel()
+
+	am := artifacts.NewClientMock(t)
+	dc := artifacts.NewDescriptorsCacheMock(t)
+	cr := testutils.NewContractRequesterMock(t)
+	os := NewOutgoingRequestSenderMock(t)
+
+	requestRef := gen.Reference()
+	executeAt := insolar.PulseNumber(insolar.FirstPulseNumber + 10)
+	pa := pulse.NewAccessorMock(t).LatestMock.Return(insolar.Pulse{PulseNumber: insolar.FirstPulseNumber}, nil)
+
+	rpcm := NewExecutionProxyImplementation(dc, cr, am, os, pa)
+	ctx := context.Background()
+	transcript := NewTranscript(ctx, requestRef, record.IncomingRequest{})
+	req := rpctypes.UpRouteReq{ExecuteAt: executeAt}
+	resp := &rpctypes.UpRouteResp{}
+
+	var outreq *record.OutgoingRequest
+	// Make sure an outgoing request is registered
+	am.RegisterOutgoingRequestMock.Set(func(ctx context.Context, r *record.OutgoingRequest) (*payload.RequestInfo, error) {
+		require.Nil(t, outreq)
+		require.Equal(t, record.ReturnScheduled, r.ReturnMode)
+		require.Equal(t, executeAt, r.ExecuteAt)
+		outreq = r
+		return &payload.RequestInfo{RequestID: gen.ID()}, nil
+	})
+
+	// cr.CallMethod and am.RegisterResults are NOT called
+
+	err := rpcm.RouteCall(ctx, transcript, req, resp)
+	require.NoError(t, err)
+	require.NotNil(t, outreq)
+	require.Equal(t, requestRef, outreq.Reason)
+	require.Equal(t, record.ReturnNoWait, buildIncomingRequestFromOutgoing(outreq).ReturnMode)
+}
+
+func TestRouteCallScheduledToPastPulse(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	pa := pulse.NewAccessorMock(mc).LatestMock.Return(insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 10}, nil)
+	// Outgoing request is not registered.
+	rpcm := NewExecutionProxyImplementation(
+		artifacts.NewDescriptorsCacheMock(mc),
+		testutils.NewContractRequesterMock(mc),
+		artifacts.NewClientMock(mc),
+		NewOutgoingRequestSenderMock(mc),
+		pa,
+	)
+	ctx := context.Background()
+	transcript := NewTranscript(ctx, gen.Reference(), record.IncomingRequest{})
+
+	for _, executeAt := range []insolar.PulseNumber{insolar.FirstPulseNumber, insolar.FirstPulseNumber + 10} {
+		req := rpctypes.UpRouteReq{ExecuteAt: executeAt}
+		err := rpcm.RouteCall(ctx, transcript, req, &rpctypes.UpRouteResp{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "should be scheduled after current pulse")
+	}
+}
+
 func TestSaveAsChildRegistersOutgoingRequestWithValidReason(t *testing.T) {
 	t.Parallel()
 
@@ -393,7 +459,7 @@ func TestSaveAsChildRegistersOutgoingRequestWithValidReason(t *testing.T) {
 
 	requestRef := gen.Reference()
 
-	rpcm := NewExecutionProxyImplementation(dc, cr, am, os)
+	rpcm := NewExecutionProxyImplementation(dc, cr, am, os, nil)
 	ctx := context.Background()
 	transcript := NewTranscript(ctx, requestRef, record.IncomingRequest{})
 	req := rpctypes.UpSaveAsChildReq{}
@@ -0,0 +1,509 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+)
+
+const (
+	// sagaMaxAttempts is amount of failed calls after which accept or rollback call of saga is failed permanently.
+	sagaMaxAttempts = 5
+	// sagaMaxBackoff is maximum amount of pulses between attempts.
+	sagaMaxBackoff = 16
+)
+
+//go:generate stringer -type=SagaStatus
+//go:generate minimock -i github.com/insolar/insolar/logicrunner.SagaTracker -o ./ -s _mock.go -g
+
+// SagaStatus is a stage of saga processing.
+type SagaStatus int
+
+const (
+	// SagaAccepting means that accept call is in progress or scheduled for retry.
+	SagaAccepting SagaStatus = iota
+	// SagaRollingBack means that accept call failed permanently and rollback call is in progress or scheduled for retry.
+	SagaRollingBack
+	// SagaRollbackFailed means that rollback call failed permanently. Such sagas are kept for inspection.
+	SagaRollbackFailed
+)
+
+// SagaState describes saga that is not completed yet.
+type SagaState struct {
+	Object insolar.Reference
+	// Request is detached outgoing request of accept call.
+	Request insolar.Reference
+	// Rollback is detached outgoing request of rollback call, it's registered when accept call fails permanently.
+	Rollback *insolar.Reference
+	Method   string
+	Status   SagaStatus
+	// Attempts is amount of failed calls on current stage.
+	Attempts int
+	// RetryIn is amount of pulses before next attempt.
+	RetryIn   int
+	LastError string
+}
+
+// SagaAccessor provides sagas that are not completed yet.
+type SagaAccessor interface {
+	// OutstandingSagas returns sagas of the object that are not completed yet. Sagas are tracked by
+	// the current executor of the object.
+	OutstandingSagas(ctx context.Context, object insolar.Reference) ([]SagaState, error)
+}
+
+// SagaTracker executes saga calls, retries failed ones across pulses and rolls them back on permanent failure.
+//
+// Saga state is stored on ledger. Accept call is registered as detached outgoing request, it stays opened
+// until the call succeeds or fails permanently. In the latter case rollback call is registered as detached
+// request with accept request as the reason, so ledger makes it ready when accept request is closed.
+// Opened requests are fetched from pendings by executors of the object. Attempts, backoff and failed
+// rollbacks are held in memory of the tracker and are handed over to the next executor of the object.
+type SagaTracker interface {
+	// Sagas returns sagas of the object tracked by the node.
+	Sagas(object insolar.Reference) []SagaState
+	// Restore adds sagas of the object handed over by its previous executor. Attempts of the previous
+	// executor are counted once, the call itself is continued when ledger notifies about the request.
+	Restore(ctx context.Context, object insolar.Reference, sagas []SagaState)
+	// Accept asynchronously calls saga accept or rollback method of detached outgoing request and waits
+	// for its result. Failed call is scheduled for retry.
+	Accept(ctx context.Context, object insolar.Reference, reqRef insolar.Reference, outgoing *record.OutgoingRequest) error
+	// OnPulse retries failed calls whose backoff is over and hands over sagas of objects the node
+	// doesn't execute anymore to their next executor.
+	OnPulse(ctx context.Context)
+}
+
+type saga struct {
+	state    SagaState
+	accept   *record.OutgoingRequest
+	rollback *record.OutgoingRequest
+	// aborted means that accept request is closed on ledger with the error of accept call.
+	aborted bool
+	running bool
+	// restored means that attempts of the previous executor are added to the saga.
+	restored bool
+}
+
+type sagaTracker struct {
+	cr     insolar.ContractRequester
+	am     artifacts.Client
+	jc     jet.Coordinator
+	sender bus.Sender
+
+	lock  sync.Mutex
+	sagas map[insolar.Reference]*saga
+}
+
+// NewSagaTracker creates new SagaTracker.
+func NewSagaTracker(
+	cr insolar.ContractRequester, am artifacts.Client, jc jet.Coordinator, sender bus.Sender,
+) SagaTracker {
+	return &sagaTracker{
+		cr:     cr,
+		am:     am,
+		jc:     jc,
+		sender: sender,
+		sagas:  map[insolar.Reference]*saga{},
+	}
+}
+
+func (t *sagaTracker) Accept(
+	ctx context.Context, object insolar.Reference, reqRef insolar.Reference, outgoing *record.OutgoingRequest,
+) error {
+	isRollback := strings.HasSuffix(outgoing.Method, insolar.SagaRollbackSuffix)
+	// Saga is tracked by its accept request, rollback request is registered with accept request as the reason.
+	acceptRef := reqRef
+	if isRollback {
+		if outgoing.Reason.IsEmpty() {
+			return errors.Errorf("rollback request %s has no reason", reqRef.String())
+		}
+		acceptRef = outgoing.Reason
+	}
+
+	t.lock.Lock()
+	s, ok := t.sagas[acceptRef]
+	if !ok {
+		s = &saga{
+			state: SagaState{
+				Object:  object,
+				Request: acceptRef,
+				Method:  strings.TrimSuffix(outgoing.Method, insolar.SagaRollbackSuffix),
+				Status:  SagaAccepting,
+			},
+		}
+		t.sagas[acceptRef] = s
+	}
+	switch {
+	case s.running:
+	case isRollback && s.state.Status == SagaAccepting:
+		// Ledger makes rollback request ready only when accept request is closed.
+		s.rollback = outgoing
+		s.aborted = true
+		s.state.Status = SagaRollingBack
+		s.state.Rollback = &reqRef
+		s.state.Attempts = 0
+		s.state.RetryIn = 0
+	case isRollback && s.rollback == nil:
+		// Saga is restored from the previous executor that already registered rollback request.
+		s.rollback = outgoing
+		s.aborted = true
+		s.state.Rollback = &reqRef
+	case !isRollback && s.accept == nil:
+		s.accept = outgoing
+	}
+	if s.running || s.state.Status == SagaRollbackFailed || s.state.RetryIn > 0 {
+		// Notification is re-sent by ledger while the saga is being processed.
+		t.lock.Unlock()
+		return nil
+	}
+	s.running = true
+	t.lock.Unlock()
+
+	go t.run(ctx, s)
+	return nil
+}
+
+func (t *sagaTracker) Restore(ctx context.Context, object insolar.Reference, sagas []SagaState) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, state := range sagas {
+		state.Object = object
+		s, ok := t.sagas[state.Request]
+		if !ok {
+			// Saga call is continued when ledger notifies about its request, failed rollbacks are kept for inspection.
+			t.sagas[state.Request] = &saga{state: state, restored: true}
+			continue
+		}
+		// Ledger notified about the request before the previous executor handed over the saga.
+		if !s.restored && s.state.Status == state.Status {
+			s.state.Attempts += state.Attempts
+			if s.state.LastError == "" {
+				s.state.LastError = state.LastError
+			}
+		}
+		s.restored = true
+	}
+	inslogger.FromContext(ctx).Debugf("restored %d sagas of object %s", len(sagas), object.String())
+}
+
+func (t *sagaTracker) OnPulse(ctx context.Context) {
+	var ready []*saga
+	handover := map[insolar.Reference][]SagaState{}
+
+	t.lock.Lock()
+	for ref, s := range t.sagas {
+		if s.running {
+			continue
+		}
+		meNext, _ := t.jc.IsMeAuthorizedNow(ctx, insolar.DynamicRoleVirtualExecutor, *s.state.Object.Record())
+		if !meNext {
+			// Uncompleted saga is opened on ledger, next executor of the object fetches it from pendings
+			// and continues with attempts handed over here.
+			handover[s.state.Object] = append(handover[s.state.Object], s.state)
+			delete(t.sagas, ref)
+			continue
+		}
+		if s.state.Status == SagaRollbackFailed {
+			continue
+		}
+		if s.state.RetryIn > 0 {
+			s.state.RetryIn--
+		}
+		// Restored saga is called when ledger notifies about its request.
+		if s.state.RetryIn == 0 && (s.accept != nil || s.rollback != nil) {
+			s.running = true
+			ready = append(ready, s)
+		}
+	}
+	t.lock.Unlock()
+
+	for object, sagas := range handover {
+		t.handOver(ctx, object, sagas)
+	}
+
+	if len(ready) == 0 {
+		return
+	}
+
+	go func() {
+		for _, s := range ready {
+			stats.Record(ctx, statSagaRetries.M(1))
+			t.run(ctx, s)
+		}
+	}()
+}
+
+// handOver sends sagas of the object to its next executor.
+func (t *sagaTracker) handOver(ctx context.Context, object insolar.Reference, sagas []SagaState) {
+	msg, err := payload.NewMessage(sagasToPayload(object, sagas))
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrap(err, "failed to create saga states message"))
+		return
+	}
+	_, done := t.sender.SendRole(ctx, msg, insolar.DynamicRoleVirtualExecutor, object)
+	done()
+}
+
+func (t *sagaTracker) Sagas(object insolar.Reference) []SagaState {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var res []SagaState
+	for _, s := range t.sagas {
+		if s.state.Object.Equal(object) {
+			res = append(res, s.state)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Request.Compare(res[j].Request) < 0
+	})
+	return res
+}
+
+func (t *sagaTracker) run(ctx context.Context, s *saga) {
+	err := t.call(ctx, s)
+	if err != nil {
+		inslogger.FromContext(ctx).Warn(err)
+	}
+}
+
+// call makes accept or rollback call of saga depending on its status.
+func (t *sagaTracker) call(ctx context.Context, s *saga) error {
+	logger := inslogger.FromContext(ctx).WithField("saga", s.state.Request.String())
+
+	var err error
+	switch s.state.Status {
+	case SagaAccepting:
+		err = t.accept(ctx, s)
+		if err == nil {
+			t.done(s)
+			return nil
+		}
+		err = errors.Wrapf(err, "saga call %s failed", s.state.Method)
+	case SagaRollingBack:
+		err = t.rollback(ctx, s)
+		if err == nil {
+			stats.Record(ctx, statSagaRollbacks.M(1))
+			logger.Infof("saga call %s is rolled back", s.state.Method)
+			t.done(s)
+			return nil
+		}
+		err = errors.Wrapf(err, "rollback of saga call %s failed", s.state.Method)
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	s.running = false
+	s.state.Attempts++
+	s.state.LastError = err.Error()
+	s.state.RetryIn = backoff(s.state.Attempts)
+	if s.state.Attempts < sagaMaxAttempts {
+		return err
+	}
+
+	s.state.Attempts = 0
+	s.state.RetryIn = 0
+	switch s.state.Status {
+	case SagaAccepting:
+		logger.Errorf("saga call %s failed permanently, rolling back: %s", s.state.Method, err.Error())
+		s.state.Status = SagaRollingBack
+	case SagaRollingBack:
+		stats.Record(ctx, statSagaRollbackFailures.M(1))
+		logger.Errorf("rollback of saga call %s failed permanently: %s", s.state.Method, err.Error())
+		s.state.Status = SagaRollbackFailed
+		if s.state.Rollback != nil {
+			// Failure is stored on ledger as the result of rollback request, so it's not fetched from pendings anymore.
+			go t.closeRequest(ctx, *s.state.Rollback, s.rollback.Caller, err)
+		}
+	}
+	return err
+}
+
+// accept calls saga accept method and closes accept request with its result.
+func (t *sagaTracker) accept(ctx context.Context, s *saga) error {
+	result, err := t.send(ctx, s.accept)
+	if err != nil {
+		return err
+	}
+	err = t.am.RegisterResult(ctx, s.state.Request, newRequestResult(result, s.accept.Caller))
+	return errors.Wrap(err, "failed to close accept request")
+}
+
+// rollback registers rollback request, closes accept request with the error of accept call
+// and calls rollback method of saga. Every step is stored on ledger, so the saga is continued
+// from pendings by the next executor of the object.
+func (t *sagaTracker) rollback(ctx context.Context, s *saga) error {
+	if s.rollback == nil {
+		rollback := *s.accept
+		rollback.Method = s.accept.Method + insolar.SagaRollbackSuffix
+		// Accept request is opened until it's closed here, so it can be the reason of rollback request.
+		rollback.Reason = s.state.Request
+
+		// Rollback request is already registered if the saga is restored from the previous executor.
+		rollbackRef := s.state.Rollback
+		if rollbackRef == nil {
+			info, err := t.am.RegisterOutgoingRequest(ctx, &rollback)
+			if err != nil {
+				return errors.Wrap(err, "failed to register rollback request")
+			}
+			rollbackRef = insolar.NewReference(info.RequestID)
+		}
+
+		t.lock.Lock()
+		s.rollback = &rollback
+		s.state.Rollback = rollbackRef
+		t.lock.Unlock()
+	}
+
+	if !s.aborted {
+		result, err := failedResult(s.accept.Caller, s.state.LastError)
+		if err != nil {
+			return err
+		}
+		err = t.am.RegisterResult(ctx, s.state.Request, result)
+		if err != nil {
+			return errors.Wrap(err, "failed to close accept request")
+		}
+		s.aborted = true
+	}
+
+	result, err := t.send(ctx, s.rollback)
+	if err != nil {
+		return err
+	}
+	err = t.am.RegisterResult(ctx, *s.state.Rollback, newRequestResult(result, s.rollback.Caller))
+	return errors.Wrap(err, "failed to close rollback request")
+}
+
+// send makes saga call and waits for its result. Error returned by contract method fails the call.
+func (t *sagaTracker) send(ctx context.Context, outgoing *record.OutgoingRequest) (insolar.Arguments, error) {
+	incoming := buildIncomingRequestFromOutgoing(outgoing)
+	// Saga call isn't awaited by its caller, but tracker needs to know if it succeeded.
+	incoming.ReturnMode = record.ReturnResult
+
+	res, err := t.cr.Call(ctx, &message.CallMethod{IncomingRequest: *incoming})
+	if err != nil {
+		return nil, err
+	}
+	r, ok := res.(*reply.CallMethod)
+	if !ok {
+		return nil, errors.Errorf("unexpected reply %T", res)
+	}
+
+	var contractErr *foundation.Error
+	_, err = insolar.UnMarshalResponse(r.Result, []interface{}{&contractErr})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal result")
+	}
+	if contractErr != nil {
+		return nil, contractErr
+	}
+	return r.Result, nil
+}
+
+func (t *sagaTracker) closeRequest(ctx context.Context, reqRef, caller insolar.Reference, callErr error) {
+	result, err := failedResult(caller, callErr.Error())
+	if err == nil {
+		err = t.am.RegisterResult(ctx, reqRef, result)
+	}
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrapf(err, "failed to close request %s", reqRef.String()))
+	}
+}
+
+func (t *sagaTracker) done(s *saga) {
+	t.lock.Lock()
+	delete(t.sagas, s.state.Request)
+	t.lock.Unlock()
+}
+
+// failedResult is the result of saga request that failed permanently. It's serialized the same way
+// as the result of saga method that returned an error.
+func failedResult(caller insolar.Reference, callErr string) (artifacts.RequestResult, error) {
+	result, err := insolar.Serialize([]interface{}{&foundation.Error{S: callErr}})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize saga error")
+	}
+	return newRequestResult(result, caller), nil
+}
+
+// backoff returns amount of pulses to wait after provided amount of failed attempts.
+func backoff(attempts int) int {
+	res := 1
+	for i := 1; i < attempts && res < sagaMaxBackoff; i++ {
+		res *= 2
+	}
+	return res
+}
+
+func sagasToPayload(object insolar.Reference, sagas []SagaState) *payload.SagaStates {
+	pl := &payload.SagaStates{Object: object}
+	for _, s := range sagas {
+		state := payload.SagaState{
+			Request:   s.Request,
+			Method:    s.Method,
+			Status:    int32(s.Status),
+			Attempts:  int32(s.Attempts),
+			RetryIn:   int32(s.RetryIn),
+			LastError: s.LastError,
+		}
+		if s.Rollback != nil {
+			state.Rollback = *s.Rollback
+		}
+		pl.Sagas = append(pl.Sagas, state)
+	}
+	return pl
+}
+
+func sagasFromPayload(pl *payload.SagaStates) []SagaState {
+	var res []SagaState
+	for _, s := range pl.Sagas {
+		state := SagaState{
+			Object:    pl.Object,
+			Request:   s.Request,
+			Method:    s.Method,
+			Status:    SagaStatus(s.Status),
+			Attempts:  int(s.Attempts),
+			RetryIn:   int(s.RetryIn),
+			LastError: s.LastError,
+		}
+		if !s.Rollback.IsEmpty() {
+			rollback := s.Rollback
+			state.Rollback = &rollback
+		}
+		res = append(res, state)
+	}
+	return res
+}
@@ -0,0 +1,345 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	wmMessage "github.com/ThreeDotsLabs/watermill/message"
+	"github.com/gojuno/minimock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/builtin/foundation"
+	"github.com/insolar/insolar/testutils"
+)
+
+func sagaCallResult(t *testing.T, contractErr *foundation.Error) *reply.CallMethod {
+	result, err := insolar.Serialize([]interface{}{contractErr})
+	require.NoError(t, err)
+	return &reply.CallMethod{Result: result}
+}
+
+func TestSagaTracker_Accept(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	object := gen.Reference()
+	reqRef := gen.Reference()
+	outgoing := randomOutgoingRequest()
+	outgoing.ReturnMode = record.ReturnSaga
+
+	result := sagaCallResult(t, nil)
+	cr := testutils.NewContractRequesterMock(mc).CallMock.Set(
+		func(_ context.Context, msg insolar.Message) (insolar.Reply, error) {
+			cm := msg.(*message.CallMethod)
+			require.Equal(t, outgoing.Method, cm.Method)
+			require.Equal(t, record.ReturnResult, cm.ReturnMode)
+			return result, nil
+		})
+	done := make(chan struct{})
+	am := artifacts.NewClientMock(mc).RegisterResultMock.Set(
+		func(_ context.Context, ref insolar.Reference, res artifacts.RequestResult) error {
+			require.Equal(t, reqRef, ref)
+			require.Equal(t, result.Result, res.Result())
+			close(done)
+			return nil
+		})
+	tracker := NewSagaTracker(cr, am, jet.NewCoordinatorMock(mc), bus.NewSenderMock(mc))
+
+	err := tracker.Accept(ctx, object, reqRef, outgoing)
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(time.Minute):
+		t.Fatal("accept request is not closed")
+	}
+	mc.Wait(time.Minute)
+}
+
+func TestSagaTracker_Retry(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	object := gen.Reference()
+	reqRef := gen.Reference()
+	outgoing := randomOutgoingRequest()
+	outgoing.ReturnMode = record.ReturnSaga
+
+	// Error returned by contract method fails the call, accept request stays opened on ledger.
+	cr := testutils.NewContractRequesterMock(mc).
+		CallMock.Return(sagaCallResult(t, &foundation.Error{S: "some error"}), nil)
+	jc := jet.NewCoordinatorMock(mc).IsMeAuthorizedNowMock.Return(true, nil)
+	tracker := NewSagaTracker(cr, artifacts.NewClientMock(mc), jc, bus.NewSenderMock(mc)).(*sagaTracker)
+
+	tracker.sagas[reqRef] = &saga{
+		state:   SagaState{Object: object, Request: reqRef, Method: outgoing.Method},
+		accept:  outgoing,
+		running: true,
+	}
+	err := tracker.call(ctx, tracker.sagas[reqRef])
+	require.Error(t, err)
+
+	sagas := tracker.Sagas(object)
+	require.Len(t, sagas, 1)
+	require.Equal(t, reqRef, sagas[0].Request)
+	require.Equal(t, SagaAccepting, sagas[0].Status)
+	require.Equal(t, 1, sagas[0].Attempts)
+	require.Equal(t, 1, sagas[0].RetryIn)
+	require.Contains(t, sagas[0].LastError, "some error")
+
+	// Re-sent notification doesn't trigger a call until retry is scheduled.
+	err = tracker.Accept(ctx, object, reqRef, outgoing)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), cr.CallAfterCounter())
+
+	tracker.sagas[reqRef].running = true
+	err = tracker.call(ctx, tracker.sagas[reqRef])
+	require.Error(t, err)
+	require.Equal(t, 2, tracker.Sagas(object)[0].RetryIn)
+
+	// Backoff is decremented every pulse.
+	tracker.sagas[reqRef].state.RetryIn = 3
+	tracker.OnPulse(ctx)
+	require.Equal(t, 2, tracker.Sagas(object)[0].RetryIn)
+}
+
+func TestSagaTracker_OnPulse_NotExecutor(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	object := gen.Reference()
+	reqRef := gen.Reference()
+
+	jc := jet.NewCoordinatorMock(mc).IsMeAuthorizedNowMock.Expect(
+		ctx, insolar.DynamicRoleVirtualExecutor, *object.Record(),
+	).Return(false, nil)
+	state := SagaState{Object: object, Request: reqRef, Method: "Accept", Attempts: 3, RetryIn: 2, LastError: "some error"}
+	sender := bus.NewSenderMock(mc).SendRoleMock.Set(
+		func(_ context.Context, msg *wmMessage.Message, role insolar.DynamicRole, target insolar.Reference) (<-chan *wmMessage.Message, func()) {
+			require.Equal(t, insolar.DynamicRoleVirtualExecutor, role)
+			require.Equal(t, object, target)
+			pl, err := payload.Unmarshal(msg.Payload)
+			require.NoError(t, err)
+			states, ok := pl.(*payload.SagaStates)
+			require.True(t, ok)
+			require.Equal(t, []SagaState{state}, sagasFromPayload(states))
+			return nil, func() {}
+		})
+	tracker := NewSagaTracker(testutils.NewContractRequesterMock(mc), artifacts.NewClientMock(mc), jc, sender).(*sagaTracker)
+	tracker.sagas[reqRef] = &saga{
+		state:  state,
+		accept: randomOutgoingRequest(),
+	}
+
+	// Saga is continued by the next executor from pendings with attempts handed over to it.
+	tracker.OnPulse(ctx)
+	require.Empty(t, tracker.Sagas(object))
+	require.Equal(t, uint64(1), sender.SendRoleAfterCounter())
+}
+
+func TestSagaTracker_Restore(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	object := gen.Reference()
+	reqRef := gen.Reference()
+	outgoing := randomOutgoingRequest()
+	outgoing.ReturnMode = record.ReturnSaga
+
+	cr := testutils.NewContractRequesterMock(mc).
+		CallMock.Return(sagaCallResult(t, &foundation.Error{S: "some error"}), nil)
+	jc := jet.NewCoordinatorMock(mc).IsMeAuthorizedNowMock.Return(true, nil)
+	tracker := NewSagaTracker(cr, artifacts.NewClientMock(mc), jc, bus.NewSenderMock(mc)).(*sagaTracker)
+
+	tracker.Restore(ctx, object, []SagaState{
+		{Request: reqRef, Method: outgoing.Method, Attempts: sagaMaxAttempts - 1, RetryIn: 2, LastError: "some error"},
+	})
+	sagas := tracker.Sagas(object)
+	require.Len(t, sagas, 1)
+	require.Equal(t, object, sagas[0].Object)
+	require.Equal(t, sagaMaxAttempts-1, sagas[0].Attempts)
+
+	// Restored saga isn't called until ledger notifies about its request.
+	tracker.OnPulse(ctx)
+	require.Equal(t, uint64(0), cr.CallAfterCounter())
+
+	// Notification doesn't trigger a call until retry is scheduled.
+	err := tracker.Accept(ctx, object, reqRef, outgoing)
+	require.NoError(t, err)
+	require.Equal(t, outgoing, tracker.sagas[reqRef].accept)
+	require.Equal(t, uint64(0), cr.CallAfterCounter())
+
+	tracker.sagas[reqRef].running = true
+
+	// Attempts of the previous executor are counted, so the next failure is permanent.
+	err = tracker.call(ctx, tracker.sagas[reqRef])
+	require.Error(t, err)
+	sagas = tracker.Sagas(object)
+	require.Len(t, sagas, 1)
+	require.Equal(t, SagaRollingBack, sagas[0].Status)
+}
+
+func TestSagaTracker_Restore_AfterAccept(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	object := gen.Reference()
+	reqRef := gen.Reference()
+	tracker := NewSagaTracker(
+		testutils.NewContractRequesterMock(mc), artifacts.NewClientMock(mc), jet.NewCoordinatorMock(mc), bus.NewSenderMock(mc),
+	).(*sagaTracker)
+	tracker.sagas[reqRef] = &saga{
+		state:   SagaState{Object: object, Request: reqRef, Attempts: 1},
+		accept:  randomOutgoingRequest(),
+		running: true,
+	}
+
+	// Attempts are added once if ledger notified about the request before handover.
+	states := []SagaState{{Request: reqRef, Attempts: 2, LastError: "some error"}}
+	tracker.Restore(ctx, object, states)
+	tracker.Restore(ctx, object, states)
+
+	sagas := tracker.Sagas(object)
+	require.Len(t, sagas, 1)
+	require.Equal(t, 3, sagas[0].Attempts)
+	require.Equal(t, "some error", sagas[0].LastError)
+}
+
+func TestSagaTracker_Rollback(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	object := gen.Reference()
+	reqRef := gen.Reference()
+	rollbackID := gen.ID()
+	outgoing := randomOutgoingRequest()
+	outgoing.ReturnMode = record.ReturnSaga
+	outgoing.Method = "Accept"
+
+	rollbackResult := sagaCallResult(t, nil)
+	cr := testutils.NewContractRequesterMock(mc).CallMock.Set(
+		func(_ context.Context, msg insolar.Message) (insolar.Reply, error) {
+			cm := msg.(*message.CallMethod)
+			if cm.Method == "Accept" {
+				return nil, errors.New("accept failed")
+			}
+			require.Equal(t, "Accept"+insolar.SagaRollbackSuffix, cm.Method)
+			return rollbackResult, nil
+		})
+	var closed []insolar.Reference
+	am := artifacts.NewClientMock(mc).
+		RegisterOutgoingRequestMock.Set(func(_ context.Context, req *record.OutgoingRequest) (*payload.RequestInfo, error) {
+		require.Equal(t, "Accept"+insolar.SagaRollbackSuffix, req.Method)
+		require.Equal(t, record.ReturnSaga, req.ReturnMode)
+		require.Equal(t, reqRef, req.Reason)
+		return &payload.RequestInfo{RequestID: rollbackID}, nil
+	}).
+		RegisterResultMock.Set(func(_ context.Context, ref insolar.Reference, result artifacts.RequestResult) error {
+		closed = append(closed, ref)
+		if ref.Equal(reqRef) {
+			var contractErr *foundation.Error
+			_, err := insolar.UnMarshalResponse(result.Result(), []interface{}{&contractErr})
+			require.NoError(t, err)
+			require.Contains(t, contractErr.Error(), "accept failed")
+			return nil
+		}
+		require.Equal(t, rollbackResult.Result, result.Result())
+		return nil
+	})
+	tracker := NewSagaTracker(cr, am, jet.NewCoordinatorMock(mc), bus.NewSenderMock(mc)).(*sagaTracker)
+
+	tracker.sagas[reqRef] = &saga{
+		state:  SagaState{Object: object, Request: reqRef, Method: outgoing.Method},
+		accept: outgoing,
+	}
+	for i := 0; i < sagaMaxAttempts; i++ {
+		err := tracker.call(ctx, tracker.sagas[reqRef])
+		require.Error(t, err)
+	}
+
+	sagas := tracker.Sagas(object)
+	require.Len(t, sagas, 1)
+	require.Equal(t, SagaRollingBack, sagas[0].Status)
+	require.Equal(t, 0, sagas[0].Attempts)
+
+	err := tracker.call(ctx, tracker.sagas[reqRef])
+	require.NoError(t, err)
+	require.Empty(t, tracker.Sagas(object))
+	// Accept request is closed before rollback call, so ledger notifies about rollback request.
+	require.Equal(t, []insolar.Reference{reqRef, *insolar.NewReference(rollbackID)}, closed)
+}
+
+func TestSagaTracker_Accept_Rollback(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	object := gen.Reference()
+	acceptRef := gen.Reference()
+	rollbackRef := gen.Reference()
+	rollback := randomOutgoingRequest()
+	rollback.ReturnMode = record.ReturnSaga
+	rollback.Method = "Accept" + insolar.SagaRollbackSuffix
+	rollback.Reason = acceptRef
+
+	cr := testutils.NewContractRequesterMock(mc).CallMock.Return(nil, errors.New("rollback failed"))
+	tracker := NewSagaTracker(cr, artifacts.NewClientMock(mc), jet.NewCoordinatorMock(mc), bus.NewSenderMock(mc)).(*sagaTracker)
+	tracker.sagas[acceptRef] = &saga{
+		state: SagaState{Object: object, Request: acceptRef, Method: "Accept", Attempts: 2},
+	}
+
+	// Rollback request fetched from ledger continues saga from rollback stage.
+	err := tracker.Accept(ctx, object, rollbackRef, rollback)
+	require.NoError(t, err)
+	mc.Wait(time.Minute)
+
+	s := tracker.sagas[acceptRef]
+	require.NotNil(t, s)
+	tracker.lock.Lock()
+	defer tracker.lock.Unlock()
+	require.Equal(t, SagaRollingBack, s.state.Status)
+	require.Equal(t, &rollbackRef, s.state.Rollback)
+	require.Equal(t, "Accept", s.state.Method)
+	require.True(t, s.aborted)
+}
+
+func TestSagaBackoff(t *testing.T) {
+	require.Equal(t, 1, backoff(1))
+	require.Equal(t, 2, backoff(2))
+	require.Equal(t, 8, backoff(4))
+	require.Equal(t, sagaMaxBackoff, backoff(10))
+}
@@ -34,6 +34,12 @@ type StateStorageMock struct {
 	beforeIsEmptyCounter uint64
 	IsEmptyMock          mStateStorageMockIsEmpty
 
+	funcLen          func() (i1 int)
+	inspectFuncLen   func()
+	afterLenCounter  uint64
+	beforeLenCounter uint64
+	LenMock          mStateStorageMockLen
+
 	funcLock          func()
 	inspectFuncLock   func()
 	afterLockCounter  uint64
@@ -74,6 +80,8 @@ func NewStateStorageMock(t minimock.Tester) *StateStorageMock {
 
 	m.IsEmptyMock = mStateStorageMockIsEmpty{mock: m}
 
+	m.LenMock = mStateStorageMockLen{mock: m}
+
 	m.LockMock = mStateStorageMockLock{mock: m}
 
 	m.OnPulseMock = mStateStorageMockOnPulse{mock: m}
@@ -660,6 +668,149 @@ func (m *StateStorageMock) MinimockIsEmptyInspect() {
 	}
 }
 
+type mStateStorageMockLen struct {
+	mock               *StateStorageMock
+	defaultExpectation *StateStorageMockLenExpectation
+	expectations       []*StateStorageMockLenExpectation
+}
+
+// StateStorageMockLenExpectation specifies expectation struct of the StateStorage.Len
+type StateStorageMockLenExpectation struct {
+	mock *StateStorageMock
+
+	results *StateStorageMockLenResults
+	Counter uint64
+}
+
+// StateStorageMockLenResults contains results of the StateStorage.Len
+type StateStorageMockLenResults struct {
+	i1 int
+}
+
+// Expect sets up expected params for StateStorage.Len
+func (mmLen *mStateStorageMockLen) Expect() *mStateStorageMockLen {
+	if mmLen.mock.funcLen != nil {
+		mmLen.mock.t.Fatalf("StateStorageMock.Len mock is already set by Set")
+	}
+
+	if mmLen.defaultExpectation == nil {
+		mmLen.defaultExpectation = &StateStorageMockLenExpectation{}
+	}
+
+	return mmLen
+}
+
+// Inspect accepts an inspector function that has same arguments as the StateStorage.Len
+func (mmLen *mStateStorageMockLen) Inspect(f func()) *mStateStorageMockLen {
+	if mmLen.mock.inspectFuncLen != nil {
+		mmLen.mock.t.Fatalf("Inspect function is already set for StateStorageMock.Len")
+	}
+
+	mmLen.mock.inspectFuncLen = f
+
+	return mmLen
+}
+
+// Return sets up results that will be returned by StateStorage.Len
+func (mmLen *mStateStorageMockLen) Return(i1 int) *StateStorageMock {
+	if mmLen.mock.funcLen != nil {
+		mmLen.mock.t.Fatalf("StateStorageMock.Len mock is already set by Set")
+	}
+
+	if mmLen.defaultExpectation == nil {
+		mmLen.defaultExpectation = &StateStorageMockLenExpectation{mock: mmLen.mock}
+	}
+	mmLen.defaultExpectation.results = &StateStorageMockLenResults{i1}
+	return mmLen.mock
+}
+
+//Set uses given function f to mock the StateStorage.Len method
+func (mmLen *mStateStorageMockLen) Set(f func() (i1 int)) *StateStorageMock {
+	if mmLen.defaultExpectation != nil {
+		mmLen.mock.t.Fatalf("Default expectation is already set for the StateStorage.Len method")
+	}
+
+	if len(mmLen.expectations) > 0 {
+		mmLen.mock.t.Fatalf("Some expectations are already set for the StateStorage.Len method")
+	}
+
+	mmLen.mock.funcLen = f
+	return mmLen.mock
+}
+
+// Len implements StateStorage
+func (mmLen *StateStorageMock) Len() (i1 int) {
+	mm_atomic.AddUint64(&mmLen.beforeLenCounter, 1)
+	defer mm_atomic.AddUint64(&mmLen.afterLenCounter, 1)
+
+	if mmLen.inspectFuncLen != nil {
+		mmLen.inspectFuncLen()
+	}
+
+	if mmLen.LenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLen.LenMock.defaultExpectation.Counter, 1)
+
+		results := mmLen.LenMock.defaultExpectation.results
+		if results == nil {
+			mmLen.t.Fatal("No results are set for the StateStorageMock.Len")
+		}
+		return (*results).i1
+	}
+	if mmLen.funcLen != nil {
+		return mmLen.funcLen()
+	}
+	mmLen.t.Fatalf("Unexpected call to StateStorageMock.Len.")
+	return
+}
+
+// LenAfterCounter returns a count of finished StateStorageMock.Len invocations
+func (mmLen *StateStorageMock) LenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLen.afterLenCounter)
+}
+
+// LenBeforeCounter returns a count of StateStorageMock.Len invocations
+func (mmLen *StateStorageMock) LenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLen.beforeLenCounter)
+}
+
+// MinimockLenDone returns true if the count of the Len invocations corresponds
+// the number of defined expectations
+func (m *StateStorageMock) MinimockLenDone() bool {
+	for _, e := range m.LenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LenMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLenCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLen != nil && mm_atomic.LoadUint64(&m.afterLenCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockLenInspect logs each unmet expectation
+func (m *StateStorageMock) MinimockLenInspect() {
+	for _, e := range m.LenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to StateStorageMock.Len")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LenMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterLenCounter) < 1 {
+		m.t.Error("Expected call to StateStorageMock.Len")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLen != nil && mm_atomic.LoadUint64(&m.afterLenCounter) < 1 {
+		m.t.Error("Expected call to StateStorageMock.Len")
+	}
+}
+
 type mStateStorageMockLock struct {
 	mock               *StateStorageMock
 	defaultExpectation *StateStorageMockLockExpectation
@@ -1370,6 +1521,8 @@ func (m *StateStorageMock) MinimockFinish() {
 
 		m.MinimockIsEmptyInspect()
 
+		m.MinimockLenInspect()
+
 		m.MinimockLockInspect()
 
 		m.MinimockOnPulseInspect()
@@ -1403,6 +1556,7 @@ func (m *StateStorageMock) minimockDone() bool {
 		m.MinimockGetExecutionArchiveDone() &&
 		m.MinimockGetExecutionStateDone() &&
 		m.MinimockIsEmptyDone() &&
+		m.MinimockLenDone() &&
 		m.MinimockLockDone() &&
 		m.MinimockOnPulseDone() &&
 		m.MinimockUnlockDone() &&
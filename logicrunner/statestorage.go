@@ -45,6 +45,7 @@ type StateStorage interface {
 	GetExecutionArchive(ref insolar.Reference) ExecutionArchive
 
 	IsEmpty() bool
+	Len() int
 	OnPulse(ctx context.Context, pulse insolar.Pulse) []insolar.Message
 }
 
@@ -58,6 +59,7 @@ type stateStorage struct {
 	pulseAccessor    pulse.Accessor
 	artifactsManager artifacts.Client
 	outgoingSender   OutgoingRequestSender
+	queuePolicy      *QueuePolicy
 
 	state map[insolar.Reference]*ObjectState // if object exists, we are validating or executing it right now
 }
@@ -70,7 +72,7 @@ func NewStateStorage(
 	pulseAccessor pulse.Accessor,
 	artifactsManager artifacts.Client,
 	outgoingSender OutgoingRequestSender,
-
+	queuePolicy *QueuePolicy,
 ) StateStorage {
 	ss := &stateStorage{
 		state: make(map[insolar.Reference]*ObjectState),
@@ -82,6 +84,7 @@ func NewStateStorage(
 		pulseAccessor:    pulseAccessor,
 		artifactsManager: artifactsManager,
 		outgoingSender:   outgoingSender,
+		queuePolicy:      queuePolicy,
 	}
 	return ss
 }
@@ -106,6 +109,7 @@ func (ss *stateStorage) UpsertExecutionState(ref insolar.Reference) ExecutionBro
 			ss.artifactsManager,
 			os.ExecutionArchive,
 			ss.outgoingSender,
+			ss.queuePolicy,
 		)
 	}
 	return os.ExecutionBroker
@@ -165,6 +169,11 @@ func (ss *stateStorage) IsEmpty() bool {
 	return len(ss.state) == 0
 }
 
+// Len returns amount of objects that are executed, validated or have pending executions on this node.
+func (ss *stateStorage) Len() int {
+	return len(ss.state)
+}
+
 func (ss *stateStorage) OnPulse(ctx context.Context, pulse insolar.Pulse) []insolar.Message {
 	ss.Lock()
 	defer ss.Unlock()
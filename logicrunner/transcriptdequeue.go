@@ -26,6 +26,7 @@ type TranscriptDequeueElement struct {
 	prev  *TranscriptDequeueElement
 	next  *TranscriptDequeueElement
 	value *Transcript
+	lane  int
 }
 
 // TODO: probably it's better to rewrite it using linked list
@@ -34,6 +35,8 @@ type TranscriptDequeue struct {
 	first  *TranscriptDequeueElement
 	last   *TranscriptDequeueElement
 	length int
+	// laneOf returns lane of transcript, transcripts are ordered by lanes. Nil means single lane.
+	laneOf func(*Transcript) int
 }
 
 func NewTranscriptDequeue() *TranscriptDequeue {
@@ -45,21 +48,54 @@ func NewTranscriptDequeue() *TranscriptDequeue {
 	}
 }
 
-func (d *TranscriptDequeue) pushOne(el *Transcript) {
+// SetLanes makes dequeue keep transcripts ordered by lanes, lanes with lower numbers go first.
+// Order of transcripts inside a lane is kept. Should be called before any transcript is added.
+func (d *TranscriptDequeue) SetLanes(laneOf func(*Transcript) int) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.laneOf = laneOf
+}
+
+func (d *TranscriptDequeue) newElement(el *Transcript) *TranscriptDequeueElement {
 	newElement := &TranscriptDequeueElement{value: el}
-	lastElement := d.last
+	if d.laneOf != nil {
+		newElement.lane = d.laneOf(el)
+	}
+	return newElement
+}
 
-	if lastElement != nil {
-		newElement.prev = lastElement
-		lastElement.next = newElement
-		d.last = newElement
+// insertAfter inserts element after provided one, nil means the head of dequeue.
+func (d *TranscriptDequeue) insertAfter(prev, newElement *TranscriptDequeueElement) {
+	var next *TranscriptDequeueElement
+	if prev != nil {
+		next = prev.next
+		prev.next = newElement
+	} else {
+		next = d.first
+		d.first = newElement
+	}
+	if next != nil {
+		next.prev = newElement
 	} else {
-		d.first, d.last = newElement, newElement
+		d.last = newElement
 	}
+	newElement.prev, newElement.next = prev, next
 
 	d.length++
 }
 
+func (d *TranscriptDequeue) pushOne(el *Transcript) {
+	newElement := d.newElement(el)
+
+	// Push goes to the tail of the lane.
+	prev := d.last
+	for prev != nil && prev.lane > newElement.lane {
+		prev = prev.prev
+	}
+	d.insertAfter(prev, newElement)
+}
+
 func (d *TranscriptDequeue) Push(els ...*Transcript) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -70,18 +106,14 @@ func (d *TranscriptDequeue) Push(els ...*Transcript) {
 }
 
 func (d *TranscriptDequeue) prependOne(el *Transcript) {
-	newElement := &TranscriptDequeueElement{value: el}
-	firstElement := d.first
+	newElement := d.newElement(el)
 
-	if firstElement != nil {
-		newElement.next = firstElement
-		firstElement.prev = newElement
-		d.first = newElement
-	} else {
-		d.first, d.last = newElement, newElement
+	// Prepend goes to the head of the lane.
+	var prev *TranscriptDequeueElement
+	for next := d.first; next != nil && next.lane < newElement.lane; next = next.next {
+		prev = next
 	}
-
-	d.length++
+	d.insertAfter(prev, newElement)
 }
 
 func (d *TranscriptDequeue) Prepend(els ...*Transcript) {
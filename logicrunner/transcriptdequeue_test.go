@@ -213,3 +213,23 @@ func (s *TranscriptDequeueSuite) TestTake() {
 	s.Require().NotNil(d)
 	s.Len(trs, 0)
 }
+
+func (s *TranscriptDequeueSuite) TestLanes() {
+	d := NewTranscriptDequeue()
+	d.SetLanes(func(tr *Transcript) int {
+		return int(tr.Nonce / 10)
+	})
+
+	// [1, 2, 11]
+	d.Push(&Transcript{Nonce: 11}, &Transcript{Nonce: 1}, &Transcript{Nonce: 2})
+	// [3, 1, 2, 12, 11]
+	d.Prepend(&Transcript{Nonce: 12}, &Transcript{Nonce: 3})
+	// [3, 1, 2, 4, 12, 11]
+	d.Push(&Transcript{Nonce: 4})
+
+	var nonces []uint64
+	for _, tr := range d.Rotate() {
+		nonces = append(nonces, tr.Nonce)
+	}
+	s.Equal([]uint64{3, 1, 2, 4, 12, 11}, nonces)
+}
@@ -0,0 +1,411 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/node"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/instracer"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+)
+
+//go:generate minimock -i github.com/insolar/insolar/logicrunner.Validator -o ./ -s _mock.go -g
+
+// Validator checks results of virtual executors by re-executing their transcripts on validator nodes.
+type Validator interface {
+	// AddResult remembers saved result of executed request. Remembered transcripts are sent
+	// to validators of the object on pulse change.
+	AddResult(ctx context.Context, transcript *Transcript, res artifacts.RequestResult)
+	// OnPulse sends transcripts executed in provided pulse to validators of their objects.
+	OnPulse(ctx context.Context, pulse insolar.PulseNumber)
+	// Validate re-executes transcripts of executor and reports results mismatches.
+	Validate(ctx context.Context, executor insolar.Reference, msg *payload.Validate) error
+	// ValidatingTranscript returns transcript that is being validated for provided request.
+	ValidatingTranscript(reqRef insolar.Reference) *Transcript
+}
+
+type validator struct {
+	ArtifactManager            artifacts.Client                   `inject:""`
+	LogicExecutor              LogicExecutor                      `inject:""`
+	JetCoordinator             jet.Coordinator                    `inject:""`
+	Nodes                      node.Accessor                      `inject:""`
+	PlatformCryptographyScheme insolar.PlatformCryptographyScheme `inject:""`
+	Sender                     bus.Sender                         `inject:""`
+
+	validating *CurrentExecutionList
+
+	lock     sync.Mutex
+	executed map[insolar.Reference][]payload.ValidationTranscript
+}
+
+// NewValidator creates new Validator.
+func NewValidator() Validator {
+	return &validator{
+		validating: NewCurrentExecutionList(),
+		executed:   map[insolar.Reference][]payload.ValidationTranscript{},
+	}
+}
+
+func (v *validator) AddResult(ctx context.Context, transcript *Transcript, res artifacts.RequestResult) {
+	vt := payload.ValidationTranscript{
+		Request: transcript.RequestRef,
+	}
+	for _, out := range transcript.OutgoingRequests {
+		vo := payload.ValidationOutgoing{
+			Request:   out.Request,
+			Response:  out.Response,
+			NewObject: out.NewObject,
+		}
+		if out.Error != nil {
+			vo.Error = out.Error.Error()
+		}
+		vt.Outgoings = append(vt.Outgoings, vo)
+	}
+
+	object := res.ObjectReference()
+
+	v.lock.Lock()
+	v.executed[object] = append(v.executed[object], vt)
+	v.lock.Unlock()
+}
+
+func (v *validator) OnPulse(ctx context.Context, pulse insolar.PulseNumber) {
+	v.lock.Lock()
+	executed := v.executed
+	v.executed = map[insolar.Reference][]payload.ValidationTranscript{}
+	v.lock.Unlock()
+
+	if len(executed) == 0 {
+		return
+	}
+
+	go v.sendToValidators(ctx, pulse, executed)
+}
+
+func (v *validator) sendToValidators(
+	ctx context.Context, pulse insolar.PulseNumber, executed map[insolar.Reference][]payload.ValidationTranscript,
+) {
+	ctx, span := instracer.StartSpan(ctx, "validator.sendToValidators")
+	defer span.End()
+
+	logger := inslogger.FromContext(ctx)
+	me := v.JetCoordinator.Me()
+	for object, transcripts := range executed {
+		validators, err := v.JetCoordinator.VirtualValidatorsForObject(ctx, *object.Record(), pulse)
+		if err != nil {
+			logger.Error(errors.Wrapf(err, "failed to calculate validators for object %s", object.String()))
+			continue
+		}
+
+		for _, node := range validators {
+			if node.Equal(me) {
+				continue
+			}
+			msg, err := payload.NewMessage(&payload.Validate{
+				Object:      object,
+				Pulse:       pulse,
+				Transcripts: transcripts,
+			})
+			if err != nil {
+				logger.Error(errors.Wrap(err, "failed to create validation message"))
+				return
+			}
+			_, done := v.Sender.SendTarget(ctx, msg, node)
+			done()
+		}
+		stats.Record(ctx, statValidationSent.M(int64(len(transcripts))))
+	}
+}
+
+func (v *validator) Validate(ctx context.Context, executor insolar.Reference, msg *payload.Validate) error {
+	if len(msg.Transcripts) == 0 {
+		return errors.New("nothing to validate")
+	}
+
+	ctx, span := instracer.StartSpan(ctx, "validator.Validate")
+	defer span.End()
+
+	// Executor sends only requests and outgoing calls, states and results are taken from ledger.
+	history, err := v.history(ctx, msg.Object, msg.Pulse)
+	if err != nil {
+		stats.Record(ctx, statValidationErrors.M(int64(len(msg.Transcripts))))
+		return errors.Wrapf(err, "failed to fetch states of object %s", msg.Object.String())
+	}
+
+	logger := inslogger.FromContext(ctx).WithField("object", msg.Object.String())
+	state := history.before
+	for _, vt := range msg.Transcripts {
+		produced, hasProduced := history.produced[vt.Request]
+		before := state
+		if hasProduced {
+			state = &produced
+		}
+
+		transcript, err := v.transcript(ctx, msg.Object, vt, history, before)
+		if err != nil {
+			stats.Record(ctx, statValidationErrors.M(1))
+			logger.Error(errors.Wrapf(err, "failed to restore transcript for request %s", vt.Request.String()))
+			continue
+		}
+		var producedState record.State
+		if hasProduced {
+			producedState = produced.record
+		}
+		expected, err := v.expectedHash(ctx, msg.Object, vt.Request, producedState)
+		if err != nil {
+			stats.Record(ctx, statValidationErrors.M(1))
+			logger.Error(errors.Wrapf(err, "failed to fetch result of request %s", vt.Request.String()))
+			continue
+		}
+
+		mismatch := &payload.ValidationMismatch{
+			Object:   msg.Object,
+			Request:  vt.Request,
+			Pulse:    msg.Pulse,
+			Executor: executor,
+			Expected: expected,
+		}
+
+		res, err := v.execute(ctx, transcript)
+		if err != nil {
+			mismatch.Reason = err.Error()
+		} else {
+			mismatch.Actual = resultHash(v.PlatformCryptographyScheme, res)
+			if bytes.Equal(mismatch.Actual, mismatch.Expected) {
+				stats.Record(ctx, statValidationSucceeded.M(1))
+				continue
+			}
+			mismatch.Reason = "result hash mismatch"
+		}
+
+		stats.Record(ctx, statValidationMismatches.M(1))
+		logger.Errorf(
+			"validation failed for request %s executed by %s: %s",
+			vt.Request.String(), executor.String(), mismatch.Reason,
+		)
+		v.report(ctx, mismatch)
+	}
+	return nil
+}
+
+func (v *validator) ValidatingTranscript(reqRef insolar.Reference) *Transcript {
+	return v.validating.Get(reqRef)
+}
+
+// ledgerState is a state record of the object saved on ledger.
+type ledgerState struct {
+	id     insolar.ID
+	record record.State
+}
+
+// objectHistory contains states of the object saved on ledger in validated pulse and later.
+type objectHistory struct {
+	parent       insolar.Reference
+	childPointer *insolar.ID
+	// before is the state object had before validated pulse, nil if object was activated in the pulse.
+	before *ledgerState
+	// produced are states saved as results of requests.
+	produced map[insolar.Reference]ledgerState
+}
+
+// history walks object states on ledger from the latest one back to the last state saved before provided pulse.
+func (v *validator) history(
+	ctx context.Context, object insolar.Reference, pulse insolar.PulseNumber,
+) (*objectHistory, error) {
+	desc, err := v.ArtifactManager.GetObject(ctx, object)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+
+	history := &objectHistory{
+		parent:       *desc.Parent(),
+		childPointer: desc.ChildPointer(),
+		produced:     map[insolar.Reference]ledgerState{},
+	}
+	id := *desc.StateID()
+	for {
+		state, err := v.ArtifactManager.GetState(ctx, object, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get state %s", id.DebugString())
+		}
+		if id.Pulse() < pulse {
+			history.before = &ledgerState{id: id, record: state}
+			return history, nil
+		}
+
+		request, err := stateRequest(state)
+		if err != nil {
+			return nil, err
+		}
+		history.produced[request] = ledgerState{id: id, record: state}
+
+		prev := state.PrevStateID()
+		if prev == nil {
+			return history, nil
+		}
+		id = *prev
+	}
+}
+
+// stateRequest returns request which result the state is.
+func stateRequest(state record.State) (insolar.Reference, error) {
+	switch s := state.(type) {
+	case *record.Activate:
+		return s.Request, nil
+	case *record.Amend:
+		return s.Request, nil
+	case *record.Deactivate:
+		return s.Request, nil
+	}
+	return insolar.Reference{}, errors.Errorf("unexpected state record %T", state)
+}
+
+// transcript restores transcript of request from ledger and outgoing calls of executor.
+func (v *validator) transcript(
+	ctx context.Context,
+	object insolar.Reference,
+	vt payload.ValidationTranscript,
+	history *objectHistory,
+	before *ledgerState,
+) (*Transcript, error) {
+	req, err := v.ArtifactManager.GetAbandonedRequest(ctx, object, vt.Request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get request")
+	}
+	incoming, ok := req.(*record.IncomingRequest)
+	if !ok {
+		return nil, errors.Errorf("unexpected request type %T", req)
+	}
+
+	transcript := NewTranscript(ctx, vt.Request, *incoming)
+	transcript.Mode = insolar.ValidateCallMode
+	for _, out := range vt.Outgoings {
+		var outErr error
+		if out.Error != "" {
+			outErr = errors.New(out.Error)
+		}
+		transcript.AddOutgoingRequest(ctx, out.Request, out.Response, out.NewObject, outErr)
+	}
+
+	if incoming.CallType != record.CTMethod {
+		return transcript, nil
+	}
+
+	if before == nil {
+		return nil, errors.New("object has no state before request")
+	}
+	transcript.ObjectDescriptor = artifacts.NewObjectDescriptor(
+		object,
+		before.id,
+		before.record.GetImage(),
+		before.record.GetIsPrototype(),
+		history.childPointer,
+		before.record.GetMemory(),
+		history.parent,
+	)
+	return transcript, nil
+}
+
+// expectedHash calculates hash of request result and the state produced by request saved on ledger.
+func (v *validator) expectedHash(
+	ctx context.Context, object, request insolar.Reference, produced record.State,
+) ([]byte, error) {
+	res, err := v.ArtifactManager.GetResult(ctx, object, request)
+	if err != nil {
+		return nil, err
+	}
+
+	sideEffect := artifacts.RequestSideEffectNone
+	var memory []byte
+	switch s := produced.(type) {
+	case *record.Activate:
+		sideEffect = artifacts.RequestSideEffectActivate
+		memory = s.Memory
+	case *record.Amend:
+		sideEffect = artifacts.RequestSideEffectAmend
+		memory = s.Memory
+	case *record.Deactivate:
+		sideEffect = artifacts.RequestSideEffectDeactivate
+	}
+	return hashResult(v.PlatformCryptographyScheme, sideEffect, memory, res.Payload), nil
+}
+
+func (v *validator) execute(ctx context.Context, transcript *Transcript) (artifacts.RequestResult, error) {
+	err := v.validating.SetOnce(transcript)
+	if err != nil {
+		return nil, errors.Wrap(err, "request is already being validated")
+	}
+	defer v.validating.Delete(transcript.RequestRef)
+
+	return v.LogicExecutor.Execute(ctx, transcript)
+}
+
+// report sends validation mismatch to every heavy active in validated pulse. Heavies persist mismatches,
+// so the report can't be lost or dropped by the faulty executor.
+func (v *validator) report(ctx context.Context, mismatch *payload.ValidationMismatch) {
+	logger := inslogger.FromContext(ctx)
+	mismatch.Validator = v.JetCoordinator.Me()
+
+	heavies, err := v.Nodes.InRole(mismatch.Pulse, insolar.StaticRoleHeavyMaterial)
+	if err != nil {
+		logger.Error(errors.Wrapf(err, "failed to fetch heavy nodes for pulse %v", mismatch.Pulse))
+		return
+	}
+	for _, heavy := range heavies {
+		msg, err := payload.NewMessage(mismatch)
+		if err != nil {
+			logger.Error(errors.Wrap(err, "failed to create validation mismatch message"))
+			return
+		}
+		_, done := v.Sender.SendTarget(ctx, msg, heavy.ID)
+		done()
+	}
+}
+
+// resultHash calculates hash of request result and object state produced by request.
+func resultHash(pcs insolar.PlatformCryptographyScheme, res artifacts.RequestResult) []byte {
+	var memory []byte
+	switch res.Type() {
+	case artifacts.RequestSideEffectActivate:
+		_, _, memory = res.Activate()
+	case artifacts.RequestSideEffectAmend:
+		_, _, memory = res.Amend()
+	}
+	return hashResult(pcs, res.Type(), memory, res.Result())
+}
+
+func hashResult(
+	pcs insolar.PlatformCryptographyScheme, sideEffect artifacts.RequestResultType, memory []byte, result []byte,
+) []byte {
+	h := pcs.IntegrityHasher()
+	_, _ = h.Write([]byte{byte(sideEffect)})
+	_, _ = h.Write(memory)
+	_, _ = h.Write(result)
+	return h.Sum(nil)
+}
@@ -0,0 +1,272 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/bus"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/node"
+	"github.com/insolar/insolar/insolar/payload"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func TestValidator_OnPulse_SendsToValidators(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	pn := gen.PulseNumber()
+	me := gen.Reference()
+	other := gen.Reference()
+	requestRef := gen.Reference()
+	res := &requestResult{
+		sideEffectType:  artifacts.RequestSideEffectActivate,
+		memory:          []byte{1, 2, 3},
+		objectReference: requestRef,
+	}
+
+	var (
+		lock sync.Mutex
+		sent []*payload.Validate
+		wg   sync.WaitGroup
+	)
+	wg.Add(1)
+	sender := bus.NewSenderMock(mc).SendTargetMock.Set(
+		func(_ context.Context, msg *message.Message, target insolar.Reference) (<-chan *message.Message, func()) {
+			require.Equal(t, other, target)
+			pl, err := payload.Unmarshal(msg.Payload)
+			require.NoError(t, err)
+			lock.Lock()
+			sent = append(sent, pl.(*payload.Validate))
+			lock.Unlock()
+			wg.Done()
+			return make(chan *message.Message), func() {}
+		})
+	coordinator := jet.NewCoordinatorMock(mc).
+		MeMock.Return(me).
+		VirtualValidatorsForObjectMock.Set(
+		func(_ context.Context, objID insolar.ID, pulse insolar.PulseNumber) ([]insolar.Reference, error) {
+			require.Equal(t, *requestRef.Record(), objID)
+			require.Equal(t, pn, pulse)
+			return []insolar.Reference{me, other}, nil
+		})
+
+	v := NewValidator().(*validator)
+	v.PlatformCryptographyScheme = pcs
+	v.JetCoordinator = coordinator
+	v.Sender = sender
+
+	v.AddResult(ctx, &Transcript{RequestRef: requestRef, Request: &record.IncomingRequest{}}, res)
+	v.OnPulse(ctx, pn)
+	wg.Wait()
+
+	require.Len(t, sent, 1)
+	require.Equal(t, requestRef, sent[0].Object)
+	require.Equal(t, pn, sent[0].Pulse)
+	require.Len(t, sent[0].Transcripts, 1)
+	require.Equal(t, requestRef, sent[0].Transcripts[0].Request)
+
+	// Transcripts are sent only once.
+	v.OnPulse(ctx, pn+1)
+}
+
+func TestValidator_Validate(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+
+	pn := gen.PulseNumber()
+	executor := gen.Reference()
+	requestRef := gen.Reference()
+	protoRef := gen.Reference()
+	parentRef := gen.Reference()
+	stateID := gen.IDWithPulse(pn)
+	request := &record.IncomingRequest{CallType: record.CTSaveAsChild, Prototype: &protoRef}
+	saved := &record.Activate{Request: requestRef, Image: protoRef, Memory: []byte{1, 2, 3}}
+	result := []byte{4, 5, 6}
+
+	table := []struct {
+		name     string
+		memory   []byte
+		mismatch bool
+	}{
+		{name: "same result", memory: []byte{1, 2, 3}},
+		{name: "different result", memory: []byte{3, 2, 1}, mismatch: true},
+	}
+
+	for _, test := range table {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			mc := minimock.NewController(t)
+			defer mc.Wait(time.Minute)
+			defer mc.Finish()
+
+			v := NewValidator().(*validator)
+			v.PlatformCryptographyScheme = pcs
+			v.ArtifactManager = artifacts.NewClientMock(mc).
+				GetObjectMock.Set(
+				func(_ context.Context, head insolar.Reference) (artifacts.ObjectDescriptor, error) {
+					require.Equal(t, requestRef, head)
+					return artifacts.NewObjectDescriptor(head, stateID, &protoRef, false, nil, saved.Memory, parentRef), nil
+				}).
+				GetStateMock.Set(
+				func(_ context.Context, head insolar.Reference, state insolar.ID) (record.State, error) {
+					require.Equal(t, requestRef, head)
+					require.Equal(t, stateID, state)
+					return saved, nil
+				}).
+				GetResultMock.Set(
+				func(_ context.Context, objectRef, reqRef insolar.Reference) (*record.Result, error) {
+					require.Equal(t, requestRef, objectRef)
+					require.Equal(t, requestRef, reqRef)
+					return &record.Result{Object: *requestRef.Record(), Request: reqRef, Payload: result}, nil
+				}).
+				GetAbandonedRequestMock.Set(
+				func(_ context.Context, objectRef, reqRef insolar.Reference) (record.Request, error) {
+					require.Equal(t, requestRef, objectRef)
+					require.Equal(t, requestRef, reqRef)
+					return request, nil
+				})
+			v.LogicExecutor = NewLogicExecutorMock(mc).ExecuteMock.Set(
+				func(_ context.Context, transcript *Transcript) (artifacts.RequestResult, error) {
+					require.Equal(t, insolar.ValidateCallMode, transcript.Mode)
+					require.Equal(t, transcript, v.ValidatingTranscript(requestRef))
+					return &requestResult{
+						sideEffectType:  artifacts.RequestSideEffectActivate,
+						result:          result,
+						memory:          test.memory,
+						objectReference: requestRef,
+					}, nil
+				})
+			sender := bus.NewSenderMock(mc)
+			if test.mismatch {
+				heavy := gen.Reference()
+				validator := gen.Reference()
+				v.JetCoordinator = jet.NewCoordinatorMock(mc).MeMock.Return(validator)
+				v.Nodes = node.NewAccessorMock(mc).InRoleMock.Expect(pn, insolar.StaticRoleHeavyMaterial).Return(
+					[]insolar.Node{{ID: heavy, Role: insolar.StaticRoleHeavyMaterial}}, nil,
+				)
+				sender.SendTargetMock.Set(
+					func(_ context.Context, msg *message.Message, target insolar.Reference) (<-chan *message.Message, func()) {
+						require.Equal(t, heavy, target)
+						pl, err := payload.Unmarshal(msg.Payload)
+						require.NoError(t, err)
+						mismatch := pl.(*payload.ValidationMismatch)
+						require.Equal(t, requestRef, mismatch.Object)
+						require.Equal(t, executor, mismatch.Executor)
+						require.Equal(t, validator, mismatch.Validator)
+						expected := hashResult(pcs, artifacts.RequestSideEffectActivate, saved.Memory, result)
+						require.Equal(t, expected, mismatch.Expected)
+						return make(chan *message.Message), func() {}
+					})
+			}
+			v.Sender = sender
+
+			err := v.Validate(ctx, executor, &payload.Validate{
+				Object:      requestRef,
+				Pulse:       pn,
+				Transcripts: []payload.ValidationTranscript{{Request: requestRef}},
+			})
+			require.NoError(t, err)
+			require.Nil(t, v.ValidatingTranscript(requestRef))
+		})
+	}
+}
+
+func TestValidator_Validate_MethodStatesFromLedger(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	pn := gen.PulseNumber()
+	executor := gen.Reference()
+	objectRef := gen.Reference()
+	protoRef := gen.Reference()
+	parentRef := gen.Reference()
+	first := gen.Reference()
+	second := gen.Reference()
+
+	beforeID := gen.IDWithPulse(pn - 1)
+	firstID := gen.IDWithPulse(pn)
+	secondID := gen.IDWithPulse(pn)
+	states := map[insolar.ID]record.State{
+		beforeID: &record.Amend{Image: protoRef, Memory: []byte{1}},
+		firstID:  &record.Amend{Request: first, Image: protoRef, Memory: []byte{2}, PrevState: beforeID},
+		secondID: &record.Amend{Request: second, Image: protoRef, Memory: []byte{3}, PrevState: firstID},
+	}
+	// Memory each request gets before execution and saves after it.
+	memory := map[insolar.Reference][2][]byte{
+		first:  {{1}, {2}},
+		second: {{2}, {3}},
+	}
+
+	v := NewValidator().(*validator)
+	v.PlatformCryptographyScheme = pcs
+	v.Sender = bus.NewSenderMock(mc)
+	v.ArtifactManager = artifacts.NewClientMock(mc).
+		GetObjectMock.Return(
+		artifacts.NewObjectDescriptor(objectRef, secondID, &protoRef, false, nil, []byte{3}, parentRef), nil,
+	).
+		GetStateMock.Set(
+		func(_ context.Context, head insolar.Reference, state insolar.ID) (record.State, error) {
+			require.Equal(t, objectRef, head)
+			return states[state], nil
+		}).
+		GetResultMock.Set(
+		func(_ context.Context, _, reqRef insolar.Reference) (*record.Result, error) {
+			return &record.Result{Request: reqRef, Payload: reqRef.Bytes()}, nil
+		}).
+		GetAbandonedRequestMock.Return(&record.IncomingRequest{CallType: record.CTMethod, Object: &objectRef}, nil)
+	v.LogicExecutor = NewLogicExecutorMock(mc).ExecuteMock.Set(
+		func(_ context.Context, transcript *Transcript) (artifacts.RequestResult, error) {
+			mem := memory[transcript.RequestRef]
+			require.Equal(t, mem[0], transcript.ObjectDescriptor.Memory())
+			require.Equal(t, parentRef, *transcript.ObjectDescriptor.Parent())
+			prototype, err := transcript.ObjectDescriptor.Prototype()
+			require.NoError(t, err)
+			require.Equal(t, protoRef, *prototype)
+
+			res := newRequestResult(transcript.RequestRef.Bytes(), objectRef)
+			res.SetAmend(transcript.ObjectDescriptor, mem[1])
+			return res, nil
+		})
+
+	err := v.Validate(ctx, executor, &payload.Validate{
+		Object: objectRef,
+		Pulse:  pn,
+		Transcripts: []payload.ValidationTranscript{
+			{Request: first},
+			{Request: second},
+		},
+	})
+	require.NoError(t, err)
+}
@@ -59,6 +59,7 @@ func NewMetrics(ctx context.Context, cfg configuration.Metrics, registry *promet
 	mux.Handle("/metrics", promhandler)
 	mux.Handle("/_status", newProcStatus())
 	mux.Handle("/debug/loglevel", log.NewLoglevelChangeHandler())
+	mux.Handle("/debug/loglevel/components", log.NewComponentLevelChangeHandler())
 	pprof.Handle(mux)
 	if cfg.ZpagesEnabled {
 		// https://opencensus.io/zpages/
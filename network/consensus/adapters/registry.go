@@ -51,6 +51,13 @@
 package adapters
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.opencensus.io/stats"
+
 	"github.com/insolar/insolar/network/consensus/common/cryptkit"
 	"github.com/insolar/insolar/network/consensus/common/endpoints"
 	"github.com/insolar/insolar/network/consensus/common/pulse"
@@ -59,17 +66,231 @@ import (
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/profiles"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/proofs"
 
+	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
 	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/storage"
 )
 
-type MisbehaviorRegistry struct{}
+type pulseCommitter interface {
+	CommitPulse(pn insolar.PulseNumber)
+}
+
+type misbehaviorCounters struct {
+	frauds map[insolar.ShortNodeID]int
+	blames map[insolar.ShortNodeID]int
+	// evidence holds the last fraud of a node, it's absent for counters restored from the storage.
+	evidence map[insolar.ShortNodeID]*misbehavior.FraudError
+}
+
+func newMisbehaviorCounters() misbehaviorCounters {
+	return misbehaviorCounters{
+		frauds:   make(map[insolar.ShortNodeID]int),
+		blames:   make(map[insolar.ShortNodeID]int),
+		evidence: make(map[insolar.ShortNodeID]*misbehavior.FraudError),
+	}
+}
+
+type MisbehaviorRegistry struct {
+	ctx     context.Context
+	storage storage.MisbehaviorStorage
+	policy  configuration.Misbehavior
+
+	lock  sync.RWMutex
+	pulse insolar.PulseNumber
+	// window holds counters of the last pulses, counters of the current pulse are the last ones.
+	window []misbehaviorCounters
+}
+
+// NewMisbehaviorRegistry creates MisbehaviorRegistry and restores counters of the last pulses from the storage.
+func NewMisbehaviorRegistry(ctx context.Context, storage storage.MisbehaviorStorage, policy configuration.Misbehavior) *MisbehaviorRegistry {
+	mr := &MisbehaviorRegistry{
+		ctx:     ctx,
+		storage: storage,
+		policy:  policy,
+	}
+	if err := mr.restore(); err != nil {
+		inslogger.FromContext(ctx).Error("failed to restore misbehavior reports: ", err)
+	}
+	if len(mr.window) == 0 {
+		mr.window = []misbehaviorCounters{newMisbehaviorCounters()}
+	}
+	return mr
+}
+
+func (mr *MisbehaviorRegistry) restore() error {
+	pulses, err := mr.storage.LastPulses(mr.windowSize())
+	if err != nil {
+		return err
+	}
+
+	window := make([]misbehaviorCounters, 0, len(pulses))
+	for _, pn := range pulses {
+		reports, err := mr.storage.ForPulseNumber(pn)
+		if err != nil {
+			return err
+		}
+		counters := newMisbehaviorCounters()
+		for _, report := range reports {
+			if report.NodeID.IsAbsent() {
+				continue
+			}
+			if report.Fraud {
+				counters.frauds[report.NodeID]++
+			} else {
+				counters.blames[report.NodeID]++
+			}
+		}
+		window = append(window, counters)
+	}
+
+	if len(pulses) > 0 {
+		mr.pulse = pulses[len(pulses)-1]
+		mr.window = window
+	}
+	return nil
+}
 
-func NewMisbehaviorRegistry() *MisbehaviorRegistry {
-	return &MisbehaviorRegistry{}
+func (mr *MisbehaviorRegistry) windowSize() int {
+	if mr.policy.Window < 1 {
+		return 1
+	}
+	return mr.policy.Window
 }
 
 func (mr *MisbehaviorRegistry) AddReport(report misbehavior.Report) {
+	record := storage.MisbehaviorReport{
+		Fraud:   report.MisbehaviorType().Category() == misbehavior.Fraud,
+		Type:    report.MisbehaviorType().Type(),
+		Details: fmt.Sprint(report),
+	}
+	if node := report.ViolatorNode(); node != nil {
+		record.NodeID = node.GetNodeID()
+	} else {
+		record.Host = string(report.ViolatorHost().Addr)
+	}
+
+	mr.lock.Lock()
+	record.PulseNumber = mr.pulse
+	if !record.NodeID.IsAbsent() {
+		counters := mr.window[len(mr.window)-1]
+		if record.Fraud {
+			counters.frauds[record.NodeID]++
+			if fraud, ok := report.(*misbehavior.FraudError); ok {
+				counters.evidence[record.NodeID] = fraud
+			}
+		} else {
+			counters.blames[record.NodeID]++
+		}
+	}
+	mr.lock.Unlock()
+
+	category := "blame"
+	if record.Fraud {
+		category = "fraud"
+	}
+	stats.Record(insmetrics.InsertTag(mr.ctx, network.TagMisbehaviorCategory, category), network.MisbehaviorReports.M(1))
+
+	if err := mr.storage.Append(record); err != nil {
+		inslogger.FromContext(mr.ctx).Error("failed to store misbehavior report: ", err)
+	}
+}
+
+func (mr *MisbehaviorRegistry) CommitPulse(pn insolar.PulseNumber) {
+	mr.lock.Lock()
+	if pn <= mr.pulse {
+		mr.lock.Unlock()
+		return
+	}
+	mr.pulse = pn
+	mr.window = append(mr.window, newMisbehaviorCounters())
+	if window := mr.windowSize(); len(mr.window) > window {
+		mr.window = mr.window[len(mr.window)-window:]
+	}
+	excluded := len(mr.excludedNodes())
+	mr.lock.Unlock()
+
+	stats.Record(mr.ctx, network.ExcludedNodes.M(int64(excluded)))
+
+	if err := mr.storage.Commit(pn); err != nil {
+		inslogger.FromContext(mr.ctx).Error("failed to commit misbehavior pulse: ", err)
+	}
+}
+
+// GetPenaltyEvidence returns the last fraud of the node when the node has reached the fraud limit.
+// Blames carry no evidence which can be verified by other nodes, so they never cause a penalty in consensus.
+func (mr *MisbehaviorRegistry) GetPenaltyEvidence(id insolar.ShortNodeID) (misbehavior.FraudError, bool) {
+	mr.lock.RLock()
+	defer mr.lock.RUnlock()
+
+	if mr.policy.FraudLimit <= 0 {
+		return misbehavior.FraudError{}, false
+	}
+
+	frauds := 0
+	var evidence *misbehavior.FraudError
+	for _, counters := range mr.window {
+		frauds += counters.frauds[id]
+		if fraud, ok := counters.evidence[id]; ok {
+			evidence = fraud
+		}
+	}
+	if frauds < mr.policy.FraudLimit || evidence == nil {
+		return misbehavior.FraudError{}, false
+	}
+	return *evidence, true
+}
+
+func (mr *MisbehaviorRegistry) IsExcluded(id insolar.ShortNodeID) bool {
+	mr.lock.RLock()
+	defer mr.lock.RUnlock()
+
+	return mr.isExcluded(id)
+}
+
+func (mr *MisbehaviorRegistry) isExcluded(id insolar.ShortNodeID) bool {
+	frauds, blames := 0, 0
+	for _, counters := range mr.window {
+		frauds += counters.frauds[id]
+		blames += counters.blames[id]
+	}
+	return (mr.policy.FraudLimit > 0 && frauds >= mr.policy.FraudLimit) ||
+		(mr.policy.BlameLimit > 0 && blames >= mr.policy.BlameLimit)
+}
+
+func (mr *MisbehaviorRegistry) ExcludedNodes() []insolar.ShortNodeID {
+	mr.lock.RLock()
+	defer mr.lock.RUnlock()
+
+	return mr.excludedNodes()
+}
+
+func (mr *MisbehaviorRegistry) excludedNodes() []insolar.ShortNodeID {
+	violators := make(map[insolar.ShortNodeID]struct{})
+	for _, counters := range mr.window {
+		for id := range counters.frauds {
+			violators[id] = struct{}{}
+		}
+		for id := range counters.blames {
+			violators[id] = struct{}{}
+		}
+	}
+
+	excluded := make([]insolar.ShortNodeID, 0, len(violators))
+	for id := range violators {
+		if mr.isExcluded(id) {
+			excluded = append(excluded, id)
+		}
+	}
+	sort.Slice(excluded, func(i, j int) bool { return excluded[i] < excluded[j] })
+	return excluded
+}
+
+// Reports returns reports registered in the pulse.
+func (mr *MisbehaviorRegistry) Reports(pn insolar.PulseNumber) ([]storage.MisbehaviorReport, error) {
+	return mr.storage.ForPulseNumber(pn)
 }
 
 type MandateRegistry struct {
@@ -150,6 +371,9 @@ func NewVersionedRegistries(
 
 func (c *VersionedRegistries) CommitNextPulse(pd pulse.Data, population census.OnlinePopulation) census.VersionedRegistries {
 	pd.EnsurePulseData()
+	if committer, ok := c.misbehaviorRegistry.(pulseCommitter); ok {
+		committer.CommitPulse(insolar.PulseNumber(pd.PulseNumber))
+	}
 	cp := *c
 	cp.pulseData = pd
 	return &cp
@@ -0,0 +1,176 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network/consensus/gcpv2/api/misbehavior"
+	"github.com/insolar/insolar/network/consensus/gcpv2/api/profiles"
+	"github.com/insolar/insolar/network/storage"
+)
+
+func newTestReport(mc *minimock.Controller, id insolar.ShortNodeID, category misbehavior.Category) misbehavior.Report {
+	return misbehavior.NewReportMock(mc).
+		MisbehaviorTypeMock.Return(category.Of(1)).
+		ViolatorNodeMock.Return(profiles.NewBaseNodeMock(mc).GetNodeIDMock.Return(id))
+}
+
+func TestMisbehaviorRegistry(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	ms := storage.NewMemoryMisbehaviorStorage()
+	mr := NewMisbehaviorRegistry(context.Background(), ms, configuration.Misbehavior{
+		FraudLimit: 2,
+		BlameLimit: 3,
+		Window:     2,
+	})
+
+	mr.CommitPulse(insolar.PulseNumber(100))
+	mr.AddReport(newTestReport(mc, 1, misbehavior.Fraud))
+	mr.AddReport(newTestReport(mc, 2, misbehavior.Blame))
+	mr.AddReport(newTestReport(mc, 2, misbehavior.Blame))
+	require.False(t, mr.IsExcluded(1))
+	require.False(t, mr.IsExcluded(2))
+
+	reports, err := mr.Reports(insolar.PulseNumber(100))
+	require.NoError(t, err)
+	require.Len(t, reports, 3)
+	require.Equal(t, insolar.ShortNodeID(1), reports[0].NodeID)
+	require.True(t, reports[0].Fraud)
+	require.False(t, reports[1].Fraud)
+
+	mr.CommitPulse(insolar.PulseNumber(110))
+	mr.AddReport(newTestReport(mc, 1, misbehavior.Fraud))
+	mr.AddReport(newTestReport(mc, 2, misbehavior.Blame))
+	require.True(t, mr.IsExcluded(1))
+	require.True(t, mr.IsExcluded(2))
+	require.Equal(t, []insolar.ShortNodeID{1, 2}, mr.ExcludedNodes())
+
+	// Reports of pulse 100 are out of window.
+	mr.CommitPulse(insolar.PulseNumber(120))
+	require.False(t, mr.IsExcluded(1))
+	require.False(t, mr.IsExcluded(2))
+	require.Empty(t, mr.ExcludedNodes())
+
+	reports, err = mr.Reports(insolar.PulseNumber(110))
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+}
+
+func TestMisbehaviorRegistry_GetPenaltyEvidence(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	mr := NewMisbehaviorRegistry(context.Background(), storage.NewMemoryMisbehaviorStorage(), configuration.Misbehavior{
+		FraudLimit: 2,
+		BlameLimit: 1,
+		Window:     2,
+	})
+	node := profiles.NewBaseNodeMock(mc).GetNodeIDMock.Return(1)
+	blamed := profiles.NewBaseNodeMock(mc).GetNodeIDMock.Return(2)
+	frauds := misbehavior.NewFraudFactory(nil)
+
+	mr.CommitPulse(insolar.PulseNumber(100))
+	first := frauds.NewNodeFraud(misbehavior.FraudMultipleNsh, "first", node, 1)
+	mr.AddReport(&first)
+	blame := misbehavior.NewBlameFactory(nil).NewNodeBlame(misbehavior.BlameExcessiveIntro, "blame", blamed)
+	mr.AddReport(&blame)
+	_, ok := mr.GetPenaltyEvidence(1)
+	require.False(t, ok)
+
+	mr.CommitPulse(insolar.PulseNumber(110))
+	second := frauds.NewNodeFraud(misbehavior.MismatchedRank, "second", node, 2)
+	mr.AddReport(&second)
+	evidence, ok := mr.GetPenaltyEvidence(1)
+	require.True(t, ok)
+	require.Equal(t, misbehavior.MismatchedRank, evidence.FraudType())
+	require.Equal(t, []interface{}{2}, evidence.Details())
+
+	// Blames are not penalized in consensus.
+	require.True(t, mr.IsExcluded(2))
+	_, ok = mr.GetPenaltyEvidence(2)
+	require.False(t, ok)
+}
+
+func TestMisbehaviorRegistry_Restore(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	policy := configuration.Misbehavior{
+		FraudLimit: 2,
+		Window:     2,
+	}
+	ms := storage.NewMemoryMisbehaviorStorage()
+	mr := NewMisbehaviorRegistry(context.Background(), ms, policy)
+	mr.CommitPulse(insolar.PulseNumber(100))
+	mr.AddReport(newTestReport(mc, 1, misbehavior.Fraud))
+	mr.CommitPulse(insolar.PulseNumber(110))
+	mr.CommitPulse(insolar.PulseNumber(120))
+	mr.AddReport(newTestReport(mc, 2, misbehavior.Fraud))
+	mr.AddReport(newTestReport(mc, 2, misbehavior.Fraud))
+
+	restored := NewMisbehaviorRegistry(context.Background(), ms, policy)
+	require.Equal(t, []insolar.ShortNodeID{2}, restored.ExcludedNodes())
+
+	// Counters of pulse 110 are dropped from the window, pulse 100 wasn't restored at all.
+	restored.CommitPulse(insolar.PulseNumber(130))
+	require.Equal(t, []insolar.ShortNodeID{2}, restored.ExcludedNodes())
+	restored.CommitPulse(insolar.PulseNumber(140))
+	require.Empty(t, restored.ExcludedNodes())
+}
@@ -74,6 +74,7 @@ import (
 	"github.com/insolar/insolar/network/consensus/serialization"
 	"github.com/insolar/insolar/network/node"
 	"github.com/insolar/insolar/network/nodenetwork"
+	"github.com/insolar/insolar/network/storage"
 	"github.com/insolar/insolar/network/transport"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
@@ -193,6 +194,12 @@ func initNodes(ctx context.Context, mode consensus.Mode, nodes GeneratedNodes, s
 			NodeKeeper:        nodeKeeper,
 			DatagramTransport: delayTransport,
 
+			MisbehaviorRegistry: adapters.NewMisbehaviorRegistry(
+				ctx,
+				storage.NewMemoryMisbehaviorStorage(),
+				configuration.NewServiceNetwork().Misbehavior,
+			),
+
 			StateGetter: &nshGen{nshDelay: defaultNshGenerationDelay},
 			PulseChanger: &pulseChanger{
 				nodeKeeper: nodeKeeper,
@@ -50,6 +50,8 @@
 
 package capacity
 
+import "fmt"
+
 type Level uint8
 
 const (
@@ -62,6 +64,25 @@ const (
 
 const LevelCount = LevelMax + 1
 
+var levelNames = [LevelCount]string{"zero", "minimal", "reduced", "normal", "extended"}
+
+func (v Level) String() string {
+	if v >= LevelCount {
+		return fmt.Sprintf("Level(%d)", v)
+	}
+	return levelNames[v]
+}
+
+// ParseLevel returns level by its name as returned by String()
+func ParseLevel(name string) (Level, bool) {
+	for i, n := range levelNames {
+		if n == name {
+			return Level(i), true
+		}
+	}
+	return LevelZero, false
+}
+
 func (v Level) DefaultPercent() int {
 	// 0, 25, 75, 100, 125
 	return v.ChooseInt([...]int{0, 20, 60, 80, 100})
@@ -70,3 +70,20 @@ func TestChooseInt(t *testing.T) {
 
 	require.Panics(t, func() { LevelCount.ChooseInt(options) })
 }
+
+func TestLevelString(t *testing.T) {
+	require.Equal(t, "reduced", LevelReduced.String())
+	require.Equal(t, "extended", LevelMax.String())
+	require.Equal(t, "Level(5)", LevelCount.String())
+}
+
+func TestParseLevel(t *testing.T) {
+	for l := LevelZero; l < LevelCount; l++ {
+		parsed, ok := ParseLevel(l.String())
+		require.True(t, ok)
+		require.Equal(t, l, parsed)
+	}
+
+	_, ok := ParseLevel("unknown")
+	require.False(t, ok)
+}
@@ -115,6 +115,8 @@ type Dep struct {
 	NodeKeeper        network.NodeKeeper
 	DatagramTransport transport.DatagramTransport
 
+	MisbehaviorRegistry *adapters.MisbehaviorRegistry
+
 	StateGetter         adapters.StateGetter
 	PulseChanger        adapters.PulseChanger
 	StateUpdater        adapters.StateUpdater
@@ -153,7 +155,7 @@ func newConstructor(ctx context.Context, dep *Dep) *constructor {
 		).AsDigestHolder(),
 		c.consensusConfiguration,
 	)
-	c.misbehaviorRegistry = adapters.NewMisbehaviorRegistry()
+	c.misbehaviorRegistry = dep.MisbehaviorRegistry
 	c.offlinePopulation = adapters.NewOfflinePopulation(
 		dep.NodeKeeper,
 		dep.CertificateManager,
@@ -8,6 +8,7 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock"
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/misbehavior"
 )
 
@@ -20,6 +21,12 @@ type MisbehaviorRegistryMock struct {
 	afterAddReportCounter  uint64
 	beforeAddReportCounter uint64
 	AddReportMock          mMisbehaviorRegistryMockAddReport
+
+	funcGetPenaltyEvidence          func(id insolar.ShortNodeID) (f1 misbehavior.FraudError, b1 bool)
+	inspectFuncGetPenaltyEvidence   func(id insolar.ShortNodeID)
+	afterGetPenaltyEvidenceCounter  uint64
+	beforeGetPenaltyEvidenceCounter uint64
+	GetPenaltyEvidenceMock          mMisbehaviorRegistryMockGetPenaltyEvidence
 }
 
 // NewMisbehaviorRegistryMock returns a mock for MisbehaviorRegistry
@@ -32,6 +39,9 @@ func NewMisbehaviorRegistryMock(t minimock.Tester) *MisbehaviorRegistryMock {
 	m.AddReportMock = mMisbehaviorRegistryMockAddReport{mock: m}
 	m.AddReportMock.callArgs = []*MisbehaviorRegistryMockAddReportParams{}
 
+	m.GetPenaltyEvidenceMock = mMisbehaviorRegistryMockGetPenaltyEvidence{mock: m}
+	m.GetPenaltyEvidenceMock.callArgs = []*MisbehaviorRegistryMockGetPenaltyEvidenceParams{}
+
 	return m
 }
 
@@ -124,15 +134,15 @@ func (mmAddReport *MisbehaviorRegistryMock) AddReport(report misbehavior.Report)
 		mmAddReport.inspectFuncAddReport(report)
 	}
 
-	params := &MisbehaviorRegistryMockAddReportParams{report}
+	mm_params := &MisbehaviorRegistryMockAddReportParams{report}
 
 	// Record call args
 	mmAddReport.AddReportMock.mutex.Lock()
-	mmAddReport.AddReportMock.callArgs = append(mmAddReport.AddReportMock.callArgs, params)
+	mmAddReport.AddReportMock.callArgs = append(mmAddReport.AddReportMock.callArgs, mm_params)
 	mmAddReport.AddReportMock.mutex.Unlock()
 
 	for _, e := range mmAddReport.AddReportMock.expectations {
-		if minimock.Equal(e.params, params) {
+		if minimock.Equal(e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return
 		}
@@ -140,10 +150,10 @@ func (mmAddReport *MisbehaviorRegistryMock) AddReport(report misbehavior.Report)
 
 	if mmAddReport.AddReportMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmAddReport.AddReportMock.defaultExpectation.Counter, 1)
-		want := mmAddReport.AddReportMock.defaultExpectation.params
-		got := MisbehaviorRegistryMockAddReportParams{report}
-		if want != nil && !minimock.Equal(*want, got) {
-			mmAddReport.t.Errorf("MisbehaviorRegistryMock.AddReport got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		mm_want := mmAddReport.AddReportMock.defaultExpectation.params
+		mm_got := MisbehaviorRegistryMockAddReportParams{report}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddReport.t.Errorf("MisbehaviorRegistryMock.AddReport got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
 		return
@@ -222,10 +232,228 @@ func (m *MisbehaviorRegistryMock) MinimockAddReportInspect() {
 	}
 }
 
+type mMisbehaviorRegistryMockGetPenaltyEvidence struct {
+	mock               *MisbehaviorRegistryMock
+	defaultExpectation *MisbehaviorRegistryMockGetPenaltyEvidenceExpectation
+	expectations       []*MisbehaviorRegistryMockGetPenaltyEvidenceExpectation
+
+	callArgs []*MisbehaviorRegistryMockGetPenaltyEvidenceParams
+	mutex    sync.RWMutex
+}
+
+// MisbehaviorRegistryMockGetPenaltyEvidenceExpectation specifies expectation struct of the MisbehaviorRegistry.GetPenaltyEvidence
+type MisbehaviorRegistryMockGetPenaltyEvidenceExpectation struct {
+	mock    *MisbehaviorRegistryMock
+	params  *MisbehaviorRegistryMockGetPenaltyEvidenceParams
+	results *MisbehaviorRegistryMockGetPenaltyEvidenceResults
+	Counter uint64
+}
+
+// MisbehaviorRegistryMockGetPenaltyEvidenceParams contains parameters of the MisbehaviorRegistry.GetPenaltyEvidence
+type MisbehaviorRegistryMockGetPenaltyEvidenceParams struct {
+	id insolar.ShortNodeID
+}
+
+// MisbehaviorRegistryMockGetPenaltyEvidenceResults contains results of the MisbehaviorRegistry.GetPenaltyEvidence
+type MisbehaviorRegistryMockGetPenaltyEvidenceResults struct {
+	f1 misbehavior.FraudError
+	b1 bool
+}
+
+// Expect sets up expected params for MisbehaviorRegistry.GetPenaltyEvidence
+func (mmGetPenaltyEvidence *mMisbehaviorRegistryMockGetPenaltyEvidence) Expect(id insolar.ShortNodeID) *mMisbehaviorRegistryMockGetPenaltyEvidence {
+	if mmGetPenaltyEvidence.mock.funcGetPenaltyEvidence != nil {
+		mmGetPenaltyEvidence.mock.t.Fatalf("MisbehaviorRegistryMock.GetPenaltyEvidence mock is already set by Set")
+	}
+
+	if mmGetPenaltyEvidence.defaultExpectation == nil {
+		mmGetPenaltyEvidence.defaultExpectation = &MisbehaviorRegistryMockGetPenaltyEvidenceExpectation{}
+	}
+
+	mmGetPenaltyEvidence.defaultExpectation.params = &MisbehaviorRegistryMockGetPenaltyEvidenceParams{id}
+	for _, e := range mmGetPenaltyEvidence.expectations {
+		if minimock.Equal(e.params, mmGetPenaltyEvidence.defaultExpectation.params) {
+			mmGetPenaltyEvidence.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPenaltyEvidence.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPenaltyEvidence
+}
+
+// Inspect accepts an inspector function that has same arguments as the MisbehaviorRegistry.GetPenaltyEvidence
+func (mmGetPenaltyEvidence *mMisbehaviorRegistryMockGetPenaltyEvidence) Inspect(f func(id insolar.ShortNodeID)) *mMisbehaviorRegistryMockGetPenaltyEvidence {
+	if mmGetPenaltyEvidence.mock.inspectFuncGetPenaltyEvidence != nil {
+		mmGetPenaltyEvidence.mock.t.Fatalf("Inspect function is already set for MisbehaviorRegistryMock.GetPenaltyEvidence")
+	}
+
+	mmGetPenaltyEvidence.mock.inspectFuncGetPenaltyEvidence = f
+
+	return mmGetPenaltyEvidence
+}
+
+// Return sets up results that will be returned by MisbehaviorRegistry.GetPenaltyEvidence
+func (mmGetPenaltyEvidence *mMisbehaviorRegistryMockGetPenaltyEvidence) Return(f1 misbehavior.FraudError, b1 bool) *MisbehaviorRegistryMock {
+	if mmGetPenaltyEvidence.mock.funcGetPenaltyEvidence != nil {
+		mmGetPenaltyEvidence.mock.t.Fatalf("MisbehaviorRegistryMock.GetPenaltyEvidence mock is already set by Set")
+	}
+
+	if mmGetPenaltyEvidence.defaultExpectation == nil {
+		mmGetPenaltyEvidence.defaultExpectation = &MisbehaviorRegistryMockGetPenaltyEvidenceExpectation{mock: mmGetPenaltyEvidence.mock}
+	}
+	mmGetPenaltyEvidence.defaultExpectation.results = &MisbehaviorRegistryMockGetPenaltyEvidenceResults{f1, b1}
+	return mmGetPenaltyEvidence.mock
+}
+
+//Set uses given function f to mock the MisbehaviorRegistry.GetPenaltyEvidence method
+func (mmGetPenaltyEvidence *mMisbehaviorRegistryMockGetPenaltyEvidence) Set(f func(id insolar.ShortNodeID) (f1 misbehavior.FraudError, b1 bool)) *MisbehaviorRegistryMock {
+	if mmGetPenaltyEvidence.defaultExpectation != nil {
+		mmGetPenaltyEvidence.mock.t.Fatalf("Default expectation is already set for the MisbehaviorRegistry.GetPenaltyEvidence method")
+	}
+
+	if len(mmGetPenaltyEvidence.expectations) > 0 {
+		mmGetPenaltyEvidence.mock.t.Fatalf("Some expectations are already set for the MisbehaviorRegistry.GetPenaltyEvidence method")
+	}
+
+	mmGetPenaltyEvidence.mock.funcGetPenaltyEvidence = f
+	return mmGetPenaltyEvidence.mock
+}
+
+// When sets expectation for the MisbehaviorRegistry.GetPenaltyEvidence which will trigger the result defined by the following
+// Then helper
+func (mmGetPenaltyEvidence *mMisbehaviorRegistryMockGetPenaltyEvidence) When(id insolar.ShortNodeID) *MisbehaviorRegistryMockGetPenaltyEvidenceExpectation {
+	if mmGetPenaltyEvidence.mock.funcGetPenaltyEvidence != nil {
+		mmGetPenaltyEvidence.mock.t.Fatalf("MisbehaviorRegistryMock.GetPenaltyEvidence mock is already set by Set")
+	}
+
+	expectation := &MisbehaviorRegistryMockGetPenaltyEvidenceExpectation{
+		mock:   mmGetPenaltyEvidence.mock,
+		params: &MisbehaviorRegistryMockGetPenaltyEvidenceParams{id},
+	}
+	mmGetPenaltyEvidence.expectations = append(mmGetPenaltyEvidence.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MisbehaviorRegistry.GetPenaltyEvidence return parameters for the expectation previously defined by the When method
+func (e *MisbehaviorRegistryMockGetPenaltyEvidenceExpectation) Then(f1 misbehavior.FraudError, b1 bool) *MisbehaviorRegistryMock {
+	e.results = &MisbehaviorRegistryMockGetPenaltyEvidenceResults{f1, b1}
+	return e.mock
+}
+
+// GetPenaltyEvidence implements MisbehaviorRegistry
+func (mmGetPenaltyEvidence *MisbehaviorRegistryMock) GetPenaltyEvidence(id insolar.ShortNodeID) (f1 misbehavior.FraudError, b1 bool) {
+	mm_atomic.AddUint64(&mmGetPenaltyEvidence.beforeGetPenaltyEvidenceCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPenaltyEvidence.afterGetPenaltyEvidenceCounter, 1)
+
+	if mmGetPenaltyEvidence.inspectFuncGetPenaltyEvidence != nil {
+		mmGetPenaltyEvidence.inspectFuncGetPenaltyEvidence(id)
+	}
+
+	mm_params := &MisbehaviorRegistryMockGetPenaltyEvidenceParams{id}
+
+	// Record call args
+	mmGetPenaltyEvidence.GetPenaltyEvidenceMock.mutex.Lock()
+	mmGetPenaltyEvidence.GetPenaltyEvidenceMock.callArgs = append(mmGetPenaltyEvidence.GetPenaltyEvidenceMock.callArgs, mm_params)
+	mmGetPenaltyEvidence.GetPenaltyEvidenceMock.mutex.Unlock()
+
+	for _, e := range mmGetPenaltyEvidence.GetPenaltyEvidenceMock.expectations {
+		if minimock.Equal(e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.f1, e.results.b1
+		}
+	}
+
+	if mmGetPenaltyEvidence.GetPenaltyEvidenceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPenaltyEvidence.GetPenaltyEvidenceMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPenaltyEvidence.GetPenaltyEvidenceMock.defaultExpectation.params
+		mm_got := MisbehaviorRegistryMockGetPenaltyEvidenceParams{id}
+		if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPenaltyEvidence.t.Errorf("MisbehaviorRegistryMock.GetPenaltyEvidence got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPenaltyEvidence.GetPenaltyEvidenceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPenaltyEvidence.t.Fatal("No results are set for the MisbehaviorRegistryMock.GetPenaltyEvidence")
+		}
+		return (*mm_results).f1, (*mm_results).b1
+	}
+	if mmGetPenaltyEvidence.funcGetPenaltyEvidence != nil {
+		return mmGetPenaltyEvidence.funcGetPenaltyEvidence(id)
+	}
+	mmGetPenaltyEvidence.t.Fatalf("Unexpected call to MisbehaviorRegistryMock.GetPenaltyEvidence. %v", id)
+	return
+}
+
+// GetPenaltyEvidenceAfterCounter returns a count of finished MisbehaviorRegistryMock.GetPenaltyEvidence invocations
+func (mmGetPenaltyEvidence *MisbehaviorRegistryMock) GetPenaltyEvidenceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPenaltyEvidence.afterGetPenaltyEvidenceCounter)
+}
+
+// GetPenaltyEvidenceBeforeCounter returns a count of MisbehaviorRegistryMock.GetPenaltyEvidence invocations
+func (mmGetPenaltyEvidence *MisbehaviorRegistryMock) GetPenaltyEvidenceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPenaltyEvidence.beforeGetPenaltyEvidenceCounter)
+}
+
+// Calls returns a list of arguments used in each call to MisbehaviorRegistryMock.GetPenaltyEvidence.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPenaltyEvidence *mMisbehaviorRegistryMockGetPenaltyEvidence) Calls() []*MisbehaviorRegistryMockGetPenaltyEvidenceParams {
+	mmGetPenaltyEvidence.mutex.RLock()
+
+	argCopy := make([]*MisbehaviorRegistryMockGetPenaltyEvidenceParams, len(mmGetPenaltyEvidence.callArgs))
+	copy(argCopy, mmGetPenaltyEvidence.callArgs)
+
+	mmGetPenaltyEvidence.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPenaltyEvidenceDone returns true if the count of the GetPenaltyEvidence invocations corresponds
+// the number of defined expectations
+func (m *MisbehaviorRegistryMock) MinimockGetPenaltyEvidenceDone() bool {
+	for _, e := range m.GetPenaltyEvidenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPenaltyEvidenceMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPenaltyEvidenceCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPenaltyEvidence != nil && mm_atomic.LoadUint64(&m.afterGetPenaltyEvidenceCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockGetPenaltyEvidenceInspect logs each unmet expectation
+func (m *MisbehaviorRegistryMock) MinimockGetPenaltyEvidenceInspect() {
+	for _, e := range m.GetPenaltyEvidenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MisbehaviorRegistryMock.GetPenaltyEvidence with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPenaltyEvidenceMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterGetPenaltyEvidenceCounter) < 1 {
+		if m.GetPenaltyEvidenceMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to MisbehaviorRegistryMock.GetPenaltyEvidence")
+		} else {
+			m.t.Errorf("Expected call to MisbehaviorRegistryMock.GetPenaltyEvidence with params: %#v", *m.GetPenaltyEvidenceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPenaltyEvidence != nil && mm_atomic.LoadUint64(&m.afterGetPenaltyEvidenceCounter) < 1 {
+		m.t.Error("Expected call to MisbehaviorRegistryMock.GetPenaltyEvidence")
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *MisbehaviorRegistryMock) MinimockFinish() {
 	if !m.minimockDone() {
 		m.MinimockAddReportInspect()
+
+		m.MinimockGetPenaltyEvidenceInspect()
 		m.t.FailNow()
 	}
 }
@@ -249,5 +477,6 @@ func (m *MisbehaviorRegistryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *MisbehaviorRegistryMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockAddReportDone()
+		m.MinimockAddReportDone() &&
+		m.MinimockGetPenaltyEvidenceDone()
 }
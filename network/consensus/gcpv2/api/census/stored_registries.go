@@ -51,6 +51,7 @@
 package census
 
 import (
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/network/consensus/common/cryptkit"
 	"github.com/insolar/insolar/network/consensus/common/endpoints"
 	"github.com/insolar/insolar/network/consensus/common/pulse"
@@ -76,6 +77,8 @@ type VersionedRegistries interface {
 
 type MisbehaviorRegistry interface {
 	AddReport(report misbehavior.Report)
+	// GetPenaltyEvidence returns a fraud evidence when penalty policy doesn't allow the node to take part in consensus.
+	GetPenaltyEvidence(id insolar.ShortNodeID) (misbehavior.FraudError, bool)
 }
 
 //go:generate minimock -i github.com/insolar/insolar/network/consensus/gcpv2/api/census.MandateRegistry -o . -s _mock.go -g
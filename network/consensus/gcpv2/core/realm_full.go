@@ -324,6 +324,27 @@ func (r *FullRealm) initPopulation(needsDynamic bool, population census.OnlinePo
 		r.populationHook, r.postponedPacketFn)
 
 	notifyAll()
+	r.applyPenalties()
+}
+
+/* Nodes excluded by the penalty policy are registered as frauds with the evidence collected by the registry */
+func (r *FullRealm) applyPenalties() {
+	registry := r.census.GetMisbehaviorRegistry()
+	/* no capture - the evidence was already reported */
+	frauds := misbehavior.NewFraudFactory(nil)
+
+	for _, n := range r.population.GetIndexedNodes() {
+		if n == nil || n.IsLocal() {
+			continue
+		}
+		evidence, ok := registry.GetPenaltyEvidence(n.GetNodeID())
+		if !ok {
+			continue
+		}
+		inslogger.FromContext(r.roundContext).Warnf("Node is excluded by misbehavior penalty: s=%d, t=%d, evidence=%v",
+			r.GetSelfNodeID(), n.GetNodeID(), evidence)
+		_ = n.RegisterFraud(frauds.NewNodeFraud(evidence.FraudType(), "misbehavior penalty", n.GetProfile(), evidence.Details()...))
+	}
 }
 
 func (r *FullRealm) initSelf() {
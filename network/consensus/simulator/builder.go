@@ -48,7 +48,7 @@
 //    whether it competes with the products or services of Insolar Technologies GmbH.
 //
 
-package tests
+package simulator
 
 import (
 	"context"
@@ -64,33 +64,42 @@ import (
 	"github.com/insolar/insolar/network/consensus/gcpv2/core"
 )
 
-func newEmuNetworkBuilder(ctx context.Context, netStrategy NetStrategy,
-	roundStrategyFactory core.RoundStrategyFactory) emuNetworkBuilder {
+func NewNetworkBuilder(ctx context.Context, netStrategy NetStrategy,
+	roundStrategyFactory core.RoundStrategyFactory) *NetworkBuilder {
 
-	r := emuNetworkBuilder{}
+	r := NetworkBuilder{}
 	r.network = NewEmuNetwork(netStrategy, ctx)
 	r.config = NewEmuLocalConfig(ctx)
 	r.primingCloudStateHash = EmuPrimingHash
 	r.strategyFactory = roundStrategyFactory
-	return r
+	return &r
 }
 
-type emuNetworkBuilder struct {
+// NetworkBuilder creates emulated consensus nodes connected to an emulated network.
+type NetworkBuilder struct {
 	network               *EmuNetwork
 	config                api.LocalNodeConfiguration
 	primingCloudStateHash cryptkit.DigestHolder
 	strategyFactory       core.RoundStrategyFactory
+	observer              Observer
+}
+
+// SetObserver sets observer of consensus events for nodes connected after the call.
+func (p *NetworkBuilder) SetObserver(observer Observer) {
+	p.observer = observer
+}
+
+func (p *NetworkBuilder) Network() *EmuNetwork {
+	return p.network
 }
 
-func (p *emuNetworkBuilder) StartNetwork(ctx context.Context) {
+func (p *NetworkBuilder) StartNetwork(ctx context.Context) {
 	p.network.Start(ctx)
 }
 
-func (p *emuNetworkBuilder) StartPulsar(pulseCount int, pulseDelta uint16, pulsarAddr endpoints.Name,
+func (p *NetworkBuilder) StartPulsar(pulseCount int, pulseDelta uint16, pulsarAddr endpoints.Name,
 	nodes []profiles.StaticProfile) {
 
-	attempts := 4 + len(nodes)/10
-
 	senderChan := make(chan interface{})
 	go func() {
 		for {
@@ -98,48 +107,68 @@ func (p *emuNetworkBuilder) StartPulsar(pulseCount int, pulseDelta uint16, pulsa
 			if !ok {
 				return
 			}
-			for i := 0; i < attempts; i++ {
-				sendTo := nodes[rand.Intn(len(nodes))].GetDefaultEndpoint().GetNameAddress()
-				p.network.SendToHost(sendTo, payload, pulsarAddr)
-			}
+			p.SendPulse(payload, pulsarAddr, nodes)
 		}
 	}()
 
 	go CreateGenerator(pulseCount, pulseDelta, senderChan)
 }
 
-const fmtNodeName = "%s%04d"
-
-func (p *emuNetworkBuilder) connectEmuNode(nodes []profiles.StaticProfile, selfIndex int) {
-	p._connectEmuNode(nodes, selfIndex, false)
+// SendPulse sends pulsar packet to a few random nodes, other nodes receive it from them during consensus.
+func (p *NetworkBuilder) SendPulse(payload interface{}, pulsarAddr endpoints.Name, nodes []profiles.StaticProfile) {
+	if len(nodes) == 0 {
+		return
+	}
+	attempts := 4 + len(nodes)/10
+	for i := 0; i < attempts; i++ {
+		sendTo := nodes[rand.Intn(len(nodes))].GetDefaultEndpoint().GetNameAddress()
+		p.network.SendToHost(sendTo, payload, pulsarAddr)
+	}
 }
 
-func (p *emuNetworkBuilder) _connectEmuNode(nodes []profiles.StaticProfile, selfIndex int, asJoiner bool) {
-
-	controlFeeder := &EmuControlFeeder{}
-	candidateFeeder := &coreapi.SequentialCandidateFeeder{}
-	ephemeralFeeder := &EmuEphemeralFeeder{}
+const fmtNodeName = "%s%04d"
 
-	switch {
-	case !asJoiner && selfIndex == 1:
+// ConnectEmuNode connects node with the given index to the network. Node with index 1 introduces a few joiners.
+func (p *NetworkBuilder) ConnectEmuNode(nodes []profiles.StaticProfile, selfIndex int) {
+	var joiners []*EmuNodeIntro
+	if selfIndex == 1 {
 		for i := 5000; i < 8000; i += 1000 {
 			introID := i + selfIndex
-			intro := NewEmuNodeIntroByName(introID, fmt.Sprintf(fmtNodeName, "V", introID))
-			candidateFeeder.AddJoinCandidate(intro)
-			p._connectEmuNode([]profiles.StaticProfile{intro}, 0, true)
+			joiners = append(joiners, NewEmuNodeIntroByName(introID, fmt.Sprintf(fmtNodeName, "V", introID)))
 		}
+	}
 
-		// case selfIndex%5 == 2:
-		//	controlFeeder.leaveReason = uint32(selfIndex) // simulate leave
+	host := p.ConnectNode(nodes, selfIndex)
+	for _, intro := range joiners {
+		host.AddJoinCandidate(intro)
+		p.ConnectJoiner(intro)
 	}
+}
+
+// ConnectNode connects node with the given index as a member of initial population.
+func (p *NetworkBuilder) ConnectNode(nodes []profiles.StaticProfile, selfIndex int) *EmuHostConsensusAdapter {
+	return p.connectEmuNode(nodes, selfIndex, false)
+}
+
+// ConnectJoiner connects node that has to be introduced to the network by one of its members.
+func (p *NetworkBuilder) ConnectJoiner(intro profiles.StaticProfile) *EmuHostConsensusAdapter {
+	return p.connectEmuNode([]profiles.StaticProfile{intro}, 0, true)
+}
+
+func (p *NetworkBuilder) connectEmuNode(nodes []profiles.StaticProfile, selfIndex int, asJoiner bool) *EmuHostConsensusAdapter {
+
+	controlFeeder := &EmuControlFeeder{}
+	candidateFeeder := &coreapi.SequentialCandidateFeeder{}
+	ephemeralFeeder := &EmuEphemeralFeeder{}
 
 	chronicles := NewEmuChronicles(nodes, selfIndex, asJoiner, p.primingCloudStateHash)
 	self := nodes[selfIndex]
 	node := NewConsensusHost(self.GetDefaultEndpoint().GetNameAddress())
-	node.ConnectTo(chronicles, p.network, p.strategyFactory, candidateFeeder, controlFeeder, ephemeralFeeder, p.config)
+	node.ConnectTo(chronicles, p.network, p.strategyFactory, candidateFeeder, controlFeeder, ephemeralFeeder, p.config, p.observer)
+	return node
 }
 
-func generateNameList(countNeutral, countHeavy, countLight, countVirtual int) []string {
+func GenerateNameList(countNeutral, countHeavy, countLight, countVirtual int) []string {
 	r := make([]string, 0, countNeutral+countHeavy+countLight+countVirtual)
 
 	r = appendNameList(len(r), r, fmtNodeName, "N", countNeutral)
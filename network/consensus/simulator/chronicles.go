@@ -48,7 +48,7 @@
 //    whether it competes with the products or services of Insolar Technologies GmbH.
 //
 
-package tests
+package simulator
 
 import (
 	"fmt"
@@ -147,6 +147,10 @@ func (c *EmuVersionedRegistries) FindRegisteredProfile(identity endpoints.Inboun
 func (c *EmuVersionedRegistries) AddReport(report misbehavior.Report) {
 }
 
+func (c *EmuVersionedRegistries) GetPenaltyEvidence(id insolar.ShortNodeID) (misbehavior.FraudError, bool) {
+	return misbehavior.FraudError{}, false
+}
+
 func (c *EmuVersionedRegistries) CommitNextPulse(pd pulse.Data, population census.OnlinePopulation) census.VersionedRegistries {
 	pd.EnsurePulseData()
 	cp := *c
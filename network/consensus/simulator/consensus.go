@@ -48,12 +48,14 @@
 //    whether it competes with the products or services of Insolar Technologies GmbH.
 //
 
-package tests
+package simulator
 
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/rand"
+	"sync/atomic"
 	"time"
 
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/census"
@@ -72,6 +74,7 @@ import (
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/network/consensus/gcpv2"
 	"github.com/insolar/insolar/network/consensus/gcpv2/core"
+	"github.com/insolar/insolar/network/consensus/gcpv2/core/coreapi"
 )
 
 func NewConsensusHost(hostAddr endpoints.Name) *EmuHostConsensusAdapter {
@@ -81,18 +84,25 @@ func NewConsensusHost(hostAddr endpoints.Name) *EmuHostConsensusAdapter {
 type EmuHostConsensusAdapter struct {
 	controller api.ConsensusController
 
-	hostAddr endpoints.Name
-	inbound  <-chan Packet
-	outbound chan<- Packet
+	hostAddr        endpoints.Name
+	inbound         <-chan Packet
+	outbound        chan<- Packet
+	candidateFeeder api.CandidateControlFeeder
+	controlFeeder   api.ConsensusControlFeeder
 }
 
 func (h *EmuHostConsensusAdapter) ConnectTo(chronicles api.ConsensusChronicles, network *EmuNetwork,
 	strategyFactory core.RoundStrategyFactory, candidateFeeder api.CandidateControlFeeder,
-	controlFeeder api.ConsensusControlFeeder, ephemeralFeeder api.EphemeralControlFeeder, config api.LocalNodeConfiguration) {
+	controlFeeder api.ConsensusControlFeeder, ephemeralFeeder api.EphemeralControlFeeder, config api.LocalNodeConfiguration,
+	observer Observer) {
 
 	ctx := network.ctx
-	// &EmuConsensusStrategy{ctx: ctx}
 	upstream := NewEmuUpstreamPulseController(ctx, defaultNshGenerationDelay)
+	upstream.host = h.hostAddr
+	upstream.observer = observer
+
+	h.candidateFeeder = candidateFeeder
+	h.controlFeeder = controlFeeder
 
 	h.controller = gcpv2.NewConsensusMemberController(
 		chronicles, upstream,
@@ -106,40 +116,39 @@ func (h *EmuHostConsensusAdapter) ConnectTo(chronicles api.ConsensusChronicles,
 	go h.run(ctx)
 }
 
+func (h *EmuHostConsensusAdapter) GetHostAddress() endpoints.Name {
+	return h.hostAddr
+}
+
+// AddJoinCandidate makes the node introduce the candidate to the network.
+func (h *EmuHostConsensusAdapter) AddJoinCandidate(candidate transport.FullIntroductionReader) bool {
+	feeder, ok := h.candidateFeeder.(*coreapi.SequentialCandidateFeeder)
+	if ok {
+		feeder.AddJoinCandidate(candidate)
+	}
+	return ok
+}
+
+// Leave makes the node request graceful leave with the given reason.
+func (h *EmuHostConsensusAdapter) Leave(reason uint32) bool {
+	feeder, ok := h.controlFeeder.(*EmuControlFeeder)
+	if ok {
+		feeder.Leave(reason)
+	}
+	return ok
+}
+
 func (h *EmuHostConsensusAdapter) run(ctx context.Context) {
-	defer func() {
-		// r := recover()
-		// inslogger.FromContext(ctx).Errorf("host has died: %v, %v", h.hostAddr, r)
-		// TODO print stacktrace
-		close(h.outbound)
-	}()
+	defer close(h.outbound)
 
 	for {
-		var err error
 		payload, from, err := h.receive(ctx)
 		if err == nil {
 			if payload == nil && from == nil {
 				h.controller.Abort()
 				return
 			}
-
-			var packet transport.PacketParser
-
-			packet, err = h.parsePayload(payload)
-			if err == nil {
-				if packet != nil {
-					hostFrom := endpoints.InboundConnection{Addr: *from}
-
-					sourceID := packet.GetSourceID()
-					targetID := packet.GetTargetID()
-
-					if sourceID != 0 && sourceID == targetID { // TODO for debugging
-						panic("must not")
-					}
-
-					err = h.controller.ProcessPacket(ctx, packet, &hostFrom)
-				}
-			}
+			err = h.process(ctx, payload, from)
 		}
 
 		if err != nil {
@@ -148,6 +157,22 @@ func (h *EmuHostConsensusAdapter) run(ctx context.Context) {
 	}
 }
 
+// process passes received packet to consensus controller.
+func (h *EmuHostConsensusAdapter) process(ctx context.Context, payload interface{}, from *endpoints.Name) error {
+	packet, err := h.parsePayload(payload)
+	if err != nil || packet == nil {
+		return err
+	}
+
+	sourceID := packet.GetSourceID()
+	if sourceID != 0 && sourceID == packet.GetTargetID() {
+		return fmt.Errorf("host %s received packet addressed from node %d to itself", h.hostAddr, sourceID)
+	}
+
+	hostFrom := endpoints.InboundConnection{Addr: *from}
+	return h.controller.ProcessPacket(ctx, packet, &hostFrom)
+}
+
 func (h *EmuHostConsensusAdapter) SendPacketToTransport(ctx context.Context, t transport.TargetProfile, sendOptions transport.PacketSendOptions, payload interface{}) {
 	h.send(t.GetStatic().GetDefaultEndpoint(), payload)
 }
@@ -175,7 +200,6 @@ func (h *EmuHostConsensusAdapter) send(target endpoints.Outbound, payload interf
 	}()
 	parser := payload.(transport.PacketParser)
 	pkt := Packet{Host: target.GetNameAddress(), Payload: WrapPacketParser(parser)}
-	// fmt.Println(">SEND> ", pkt)
 	h.outbound <- pkt
 }
 
@@ -249,7 +273,16 @@ func (*EmuControlFeeder) GetRequiredPowerLevel() power.Request {
 }
 
 func (p *EmuControlFeeder) GetRequiredGracefulLeave() (bool, uint32) {
-	return p.leaveReason != 0, p.leaveReason
+	reason := atomic.LoadUint32(&p.leaveReason)
+	return reason != 0, reason
+}
+
+// Leave requests graceful leave with non-zero reason.
+func (p *EmuControlFeeder) Leave(reason uint32) {
+	if reason == 0 {
+		reason = 1
+	}
+	atomic.StoreUint32(&p.leaveReason, reason)
 }
 
 func (*EmuControlFeeder) OnAppliedGracefulLeave(exitCode uint32, effectiveSince pulse.Number) {
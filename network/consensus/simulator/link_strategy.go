@@ -0,0 +1,227 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package simulator
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/network/consensus/common/endpoints"
+)
+
+// LinkConf describes delivery of packets sent from one host to another.
+type LinkConf struct {
+	MinDelay time.Duration `yaml:"min_delay" json:"min_delay"`
+	MaxDelay time.Duration `yaml:"max_delay" json:"max_delay"`
+	// Loss is a probability of a packet to be lost, in [0, 1].
+	Loss float32 `yaml:"loss" json:"loss"`
+}
+
+func (c LinkConf) validate() error {
+	if c.MinDelay < 0 || c.MinDelay > c.MaxDelay {
+		return errors.Errorf("invalid delay range [%v, %v]", c.MinDelay, c.MaxDelay)
+	}
+	if c.Loss < 0 || c.Loss > 1 {
+		return errors.Errorf("loss must be in [0, 1], got %v", c.Loss)
+	}
+	return nil
+}
+
+func (c LinkConf) delay() time.Duration {
+	if c.MaxDelay <= c.MinDelay {
+		return c.MinDelay
+	}
+	return c.MinDelay + time.Duration(rand.Int63n(int64(c.MaxDelay-c.MinDelay)))
+}
+
+// Behaviour is a byzantine behaviour of a host emulated on its outgoing link.
+type Behaviour string
+
+const (
+	// BehaviourHonest host sends packets as is.
+	BehaviourHonest Behaviour = ""
+	// BehaviourMute host is alive, but doesn't send any packets.
+	BehaviourMute Behaviour = "mute"
+	// BehaviourSplit host sends packets only to a half of other hosts, so they see it differently.
+	BehaviourSplit Behaviour = "split"
+	// BehaviourLate host sends packets after consensus phases are over.
+	BehaviourLate Behaviour = "late"
+	// BehaviourReplay host sends every packet twice.
+	BehaviourReplay Behaviour = "replay"
+)
+
+// lateDelay is a delay of packets sent by hosts with BehaviourLate.
+const lateDelay = 2 * time.Second
+
+func (b Behaviour) validate() error {
+	switch b {
+	case BehaviourHonest, BehaviourMute, BehaviourSplit, BehaviourLate, BehaviourReplay:
+		return nil
+	}
+	return errors.Errorf("unknown behaviour %q", b)
+}
+
+type linkKey struct {
+	from, to endpoints.Name
+}
+
+// LinkNetStrategy applies per-link delays and losses and byzantine behaviours of hosts to outgoing packets.
+type LinkNetStrategy struct {
+	defaultLink LinkConf
+
+	lock       sync.RWMutex
+	links      map[linkKey]LinkConf
+	behaviours map[endpoints.Name]Behaviour
+}
+
+func NewLinkNetStrategy(defaultLink LinkConf) *LinkNetStrategy {
+	return &LinkNetStrategy{
+		defaultLink: defaultLink,
+		links:       map[linkKey]LinkConf{},
+		behaviours:  map[endpoints.Name]Behaviour{},
+	}
+}
+
+// SetLink overrides delivery of packets sent from one host to another.
+func (s *LinkNetStrategy) SetLink(from, to endpoints.Name, conf LinkConf) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.links[linkKey{from: from, to: to}] = conf
+}
+
+// SetBehaviour changes behaviour of the host. BehaviourHonest restores normal behaviour.
+func (s *LinkNetStrategy) SetBehaviour(host endpoints.Name, behaviour Behaviour) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if behaviour == BehaviourHonest {
+		delete(s.behaviours, host)
+		return
+	}
+	s.behaviours[host] = behaviour
+}
+
+func (s *LinkNetStrategy) GetLinkStrategy(hostAddress endpoints.Name) LinkStrategy {
+	return &hostLinkStrategy{host: hostAddress, parent: s}
+}
+
+func (s *LinkNetStrategy) route(from, to endpoints.Name) (LinkConf, Behaviour) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	conf, ok := s.links[linkKey{from: from, to: to}]
+	if !ok {
+		conf = s.defaultLink
+	}
+	return conf, s.behaviours[from]
+}
+
+type hostLinkStrategy struct {
+	host   endpoints.Name
+	parent *LinkNetStrategy
+}
+
+func (s *hostLinkStrategy) BeforeSend(packet *Packet, out PacketFunc) {
+	conf, behaviour := s.parent.route(s.host, packet.Host)
+
+	copies := 1
+	delay := time.Duration(0)
+	switch behaviour {
+	case BehaviourMute:
+		return
+	case BehaviourSplit:
+		if !sameHalf(s.host, packet.Host) {
+			return
+		}
+	case BehaviourLate:
+		delay = lateDelay
+	case BehaviourReplay:
+		copies = 2
+	}
+
+	for i := 0; i < copies; i++ {
+		if conf.Loss > 0 && rand.Float32() < conf.Loss {
+			continue
+		}
+		send(packet, delay+conf.delay(), out)
+	}
+}
+
+func (s *hostLinkStrategy) BeforeReceive(packet *Packet, out PacketFunc) {
+	out(packet)
+}
+
+func send(packet *Packet, delay time.Duration, out PacketFunc) {
+	if delay <= 0 {
+		out(packet)
+		return
+	}
+	cp := *packet
+	time.AfterFunc(delay, func() {
+		out(&cp)
+	})
+}
+
+// sameHalf deterministically splits hosts into two halves.
+func sameHalf(a, b endpoints.Name) bool {
+	return nameHash(a)%2 == nameHash(b)%2
+}
+
+func nameHash(n endpoints.Name) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(n))
+	return h.Sum32()
+}
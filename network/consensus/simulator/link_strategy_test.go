@@ -0,0 +1,136 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package simulator
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/network/consensus/common/endpoints"
+)
+
+type sentPackets struct {
+	lock    sync.Mutex
+	packets []*Packet
+}
+
+func (s *sentPackets) out(p *Packet) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.packets = append(s.packets, p)
+}
+
+func (s *sentPackets) count() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.packets)
+}
+
+func TestLinkNetStrategy_Behaviour(t *testing.T) {
+	strategy := NewLinkNetStrategy(LinkConf{})
+	link := strategy.GetLinkStrategy("A")
+
+	sent := &sentPackets{}
+	link.BeforeSend(&Packet{Host: "B"}, sent.out)
+	require.Equal(t, 1, sent.count())
+
+	strategy.SetBehaviour("A", BehaviourReplay)
+	sent = &sentPackets{}
+	link.BeforeSend(&Packet{Host: "B"}, sent.out)
+	require.Equal(t, 2, sent.count())
+
+	strategy.SetBehaviour("A", BehaviourMute)
+	sent = &sentPackets{}
+	link.BeforeSend(&Packet{Host: "B"}, sent.out)
+	require.Equal(t, 0, sent.count())
+
+	strategy.SetBehaviour("A", BehaviourHonest)
+	sent = &sentPackets{}
+	link.BeforeSend(&Packet{Host: "B"}, sent.out)
+	require.Equal(t, 1, sent.count())
+}
+
+func TestLinkNetStrategy_Split(t *testing.T) {
+	strategy := NewLinkNetStrategy(LinkConf{})
+	strategy.SetBehaviour("A", BehaviourSplit)
+	link := strategy.GetLinkStrategy("A")
+
+	sent := &sentPackets{}
+	total := 0
+	for _, h := range GenerateNameList(0, 0, 0, 20) {
+		total++
+		link.BeforeSend(&Packet{Host: endpoints.Name(h)}, sent.out)
+	}
+	require.True(t, sent.count() > 0)
+	require.True(t, sent.count() < total)
+}
+
+func TestLinkNetStrategy_Link(t *testing.T) {
+	strategy := NewLinkNetStrategy(LinkConf{})
+	strategy.SetLink("A", "B", LinkConf{Loss: 1})
+	strategy.SetLink("A", "C", LinkConf{MinDelay: 50 * time.Millisecond, MaxDelay: 50 * time.Millisecond})
+	link := strategy.GetLinkStrategy("A")
+
+	sent := &sentPackets{}
+	link.BeforeSend(&Packet{Host: "B"}, sent.out)
+	require.Equal(t, 0, sent.count())
+
+	link.BeforeSend(&Packet{Host: "C"}, sent.out)
+	require.Equal(t, 0, sent.count())
+	time.Sleep(200 * time.Millisecond)
+	require.Equal(t, 1, sent.count())
+
+	link.BeforeSend(&Packet{Host: "D"}, sent.out)
+	require.Equal(t, 2, sent.count())
+}
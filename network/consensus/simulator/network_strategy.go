@@ -48,7 +48,7 @@
 //    whether it competes with the products or services of Insolar Technologies GmbH.
 //
 
-package tests
+package simulator
 
 import (
 	"math/rand"
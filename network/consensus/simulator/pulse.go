@@ -48,7 +48,7 @@
 //    whether it competes with the products or services of Insolar Technologies GmbH.
 //
 
-package tests
+package simulator
 
 import (
 	"math/rand"
@@ -64,6 +64,13 @@ func randBits256() longbits.Bits256 {
 	return v
 }
 
+// NewPulsarPacket creates wrapped pulsar packet with random entropy.
+func NewPulsarPacket(pn pulse.Number, pulseDelta, prevDelta uint16) interface{} {
+	return WrapPacketParser(&EmuPulsarNetPacket{
+		pulseData: pulse.NewPulsarData(pn, pulseDelta, prevDelta, randBits256()),
+	})
+}
+
 func CreateGenerator(pulseCount int, pulseDelta uint16, output chan<- interface{}) {
 	var pulseNum pulse.Number = 100000
 	for i := 0; i < pulseCount; i++ {
@@ -71,9 +78,7 @@ func CreateGenerator(pulseCount int, pulseDelta uint16, output chan<- interface{
 		if i == 0 {
 			prevDelta = 0
 		}
-		output <- WrapPacketParser(&EmuPulsarNetPacket{
-			pulseData: pulse.NewPulsarData(pulseNum, pulseDelta, prevDelta, randBits256()),
-		})
+		output <- NewPulsarPacket(pulseNum, pulseDelta, prevDelta)
 
 		pulseNum += pulse.Number(pulseDelta)
 		time.Sleep(time.Duration(pulseDelta) * time.Second)
@@ -0,0 +1,241 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package simulator
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/insolar/insolar/network/consensus/common/endpoints"
+	"github.com/insolar/insolar/network/consensus/common/pulse"
+	"github.com/insolar/insolar/network/consensus/gcpv2/api"
+	"github.com/insolar/insolar/network/consensus/gcpv2/api/census"
+)
+
+// NodeOutcome is a result of consensus round on one node.
+type NodeOutcome struct {
+	Host string `json:"host"`
+	// Duration is a time from sending pulse by pulsar to the end of consensus on the node.
+	Duration time.Duration `json:"duration"`
+	// Population is a size of population the node has agreed on.
+	Population int `json:"population"`
+	// Valid is false when the node has built population with issues.
+	Valid     bool   `json:"valid"`
+	StateHash string `json:"state_hash"`
+}
+
+// PulseReport describes consensus round of one pulse.
+type PulseReport struct {
+	Index       int          `json:"index"`
+	PulseNumber pulse.Number `json:"pulse_number"`
+	StartedAt   time.Time    `json:"started_at"`
+	Events      []string     `json:"events,omitempty"`
+	// Expected is amount of connected nodes when pulse was sent.
+	Expected int `json:"expected"`
+	// Finished is amount of nodes that finished consensus for this pulse.
+	Finished int      `json:"finished"`
+	Aborted  []string `json:"aborted,omitempty"`
+	// Agreed is true when all nodes finished consensus with the same valid population.
+	Agreed      bool          `json:"agreed"`
+	MinDuration time.Duration `json:"min_duration"`
+	MaxDuration time.Duration `json:"max_duration"`
+	AvgDuration time.Duration `json:"avg_duration"`
+	Nodes       []NodeOutcome `json:"nodes"`
+}
+
+// Outcome returns short description of consensus round result.
+func (r PulseReport) Outcome() string {
+	switch {
+	case r.Finished == 0:
+		return "failed"
+	case !r.Agreed:
+		return "disagreed"
+	case r.Finished < r.Expected:
+		return "partial"
+	}
+	return "ok"
+}
+
+// Report is a result of scenario emulation.
+type Report struct {
+	Scenario string        `json:"scenario"`
+	Pulses   []PulseReport `json:"pulses"`
+}
+
+// WriteText writes human readable report.
+func (r *Report) WriteText(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "scenario: %s\n", r.Scenario)
+	fmt.Fprintf(&b, "%5s %12s %10s %8s %8s %10s %10s %10s  %s\n",
+		"#", "pulse", "outcome", "done", "aborted", "min", "avg", "max", "events")
+	for _, p := range r.Pulses {
+		fmt.Fprintf(&b, "%5d %12d %10s %4d/%-3d %8d %10s %10s %10s  %s\n",
+			p.Index, p.PulseNumber, p.Outcome(), p.Finished, p.Expected, len(p.Aborted),
+			p.MinDuration.Round(time.Millisecond), p.AvgDuration.Round(time.Millisecond), p.MaxDuration.Round(time.Millisecond),
+			strings.Join(p.Events, ", "))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Recorder collects consensus events of emulated nodes into Report.
+type Recorder struct {
+	lock     sync.Mutex
+	pulses   []*PulseReport
+	byNumber map[pulse.Number]*PulseReport
+}
+
+var _ Observer = &Recorder{}
+
+func NewRecorder() *Recorder {
+	return &Recorder{
+		byNumber: map[pulse.Number]*PulseReport{},
+	}
+}
+
+// PulseStarted registers pulse sent by pulsar to the given amount of connected nodes.
+func (r *Recorder) PulseStarted(pn pulse.Number, startedAt time.Time, expected int, events []string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	p := &PulseReport{
+		Index:       len(r.pulses),
+		PulseNumber: pn,
+		StartedAt:   startedAt,
+		Expected:    expected,
+		Events:      events,
+	}
+	r.pulses = append(r.pulses, p)
+	r.byNumber[pn] = p
+}
+
+func (r *Recorder) ConsensusFinished(host endpoints.Name, report api.UpstreamReport, expectedCensus census.Operational) {
+	now := time.Now()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	p, ok := r.byNumber[report.PulseNumber]
+	if !ok {
+		return
+	}
+
+	outcome := NodeOutcome{
+		Host:     string(host),
+		Duration: now.Sub(p.StartedAt),
+	}
+	if pop := expectedCensus.GetOnlinePopulation(); pop != nil {
+		outcome.Population = pop.GetIndexedCount()
+		outcome.Valid = pop.IsValid()
+	}
+	if gsh := expectedCensus.GetGlobulaStateHash(); gsh != nil {
+		outcome.StateHash = fmt.Sprintf("%016x", gsh.FoldToUint64())
+	}
+	p.Nodes = append(p.Nodes, outcome)
+}
+
+func (r *Recorder) ConsensusAborted(host endpoints.Name) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if len(r.pulses) == 0 {
+		return
+	}
+	p := r.pulses[len(r.pulses)-1]
+	p.Aborted = append(p.Aborted, string(host))
+}
+
+// Report returns collected results.
+func (r *Recorder) Report(scenario string) *Report {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	res := &Report{Scenario: scenario, Pulses: make([]PulseReport, 0, len(r.pulses))}
+	for _, p := range r.pulses {
+		res.Pulses = append(res.Pulses, summarize(*p))
+	}
+	return res
+}
+
+func summarize(p PulseReport) PulseReport {
+	p.Nodes = append([]NodeOutcome(nil), p.Nodes...)
+	sort.Slice(p.Nodes, func(i, j int) bool {
+		return p.Nodes[i].Host < p.Nodes[j].Host
+	})
+	p.Aborted = append([]string(nil), p.Aborted...)
+	p.Finished = len(p.Nodes)
+	if p.Finished == 0 {
+		return p
+	}
+
+	p.Agreed = true
+	total := time.Duration(0)
+	p.MinDuration = p.Nodes[0].Duration
+	for _, n := range p.Nodes {
+		total += n.Duration
+		if n.Duration < p.MinDuration {
+			p.MinDuration = n.Duration
+		}
+		if n.Duration > p.MaxDuration {
+			p.MaxDuration = n.Duration
+		}
+		first := p.Nodes[0]
+		if !n.Valid || n.StateHash != first.StateHash || n.Population != first.Population {
+			p.Agreed = false
+		}
+	}
+	p.AvgDuration = total / time.Duration(p.Finished)
+	return p
+}
@@ -0,0 +1,253 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package simulator
+
+import (
+	"io/ioutil"
+	"sort"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/insolar/insolar/network/consensus/common/endpoints"
+)
+
+const defaultPulseDelta = 2
+
+// Scenario describes emulated network and events happening in it.
+type Scenario struct {
+	Name string `yaml:"name" json:"name"`
+	// Nodes is an initial population. Nodes are named by role prefix (N, H, L, V) and sequential
+	// number in the order of roles, e.g. N0000, H0001, L0002, V0003.
+	Nodes NodesConf `yaml:"nodes" json:"nodes"`
+	// Pulses is amount of pulses to emulate.
+	Pulses int `yaml:"pulses" json:"pulses"`
+	// PulseDelta is a pulse duration in seconds.
+	PulseDelta uint16 `yaml:"pulse_delta" json:"pulse_delta"`
+	// Link is a default delivery of packets between hosts.
+	Link LinkConf `yaml:"link" json:"link"`
+	// Links overrides delivery of packets between specific hosts.
+	Links  []LinkOverride `yaml:"links" json:"links"`
+	Events []Event        `yaml:"events" json:"events"`
+}
+
+// NodesConf is amount of nodes of every role in initial population.
+type NodesConf struct {
+	Neutral int `yaml:"neutral" json:"neutral"`
+	Heavy   int `yaml:"heavy" json:"heavy"`
+	Light   int `yaml:"light" json:"light"`
+	Virtual int `yaml:"virtual" json:"virtual"`
+}
+
+func (c NodesConf) total() int {
+	return c.Neutral + c.Heavy + c.Light + c.Virtual
+}
+
+// LinkOverride sets delivery of packets sent from one host to another.
+type LinkOverride struct {
+	From     string `yaml:"from" json:"from"`
+	To       string `yaml:"to" json:"to"`
+	LinkConf `yaml:",inline"`
+}
+
+// Action is a change of a node during emulation.
+type Action string
+
+const (
+	// ActionJoin starts a new node that is introduced to the network by one of its members.
+	ActionJoin Action = "join"
+	// ActionLeave makes node request graceful leave.
+	ActionLeave Action = "leave"
+	// ActionCrash disconnects node from the network without notice.
+	ActionCrash Action = "crash"
+	// ActionBehave changes byzantine behaviour of node.
+	ActionBehave Action = "behave"
+)
+
+// Event is a change of a node happening before pulse with the given index is sent.
+type Event struct {
+	Pulse     int       `yaml:"pulse" json:"pulse"`
+	Action    Action    `yaml:"action" json:"action"`
+	Node      string    `yaml:"node" json:"node"`
+	Behaviour Behaviour `yaml:"behaviour" json:"behaviour"`
+}
+
+// LoadScenario reads scenario from YAML file.
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read scenario")
+	}
+	return ParseScenario(data)
+}
+
+// ParseScenario parses YAML scenario, fills defaults and validates it.
+func ParseScenario(data []byte) (*Scenario, error) {
+	s := &Scenario{}
+	if err := yaml.UnmarshalStrict(data, s); err != nil {
+		return nil, errors.Wrap(err, "failed to parse scenario")
+	}
+	if s.PulseDelta == 0 {
+		s.PulseDelta = defaultPulseDelta
+	}
+	if err := s.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid scenario")
+	}
+	return s, nil
+}
+
+// NodeNames returns names of nodes of initial population.
+func (s *Scenario) NodeNames() []string {
+	return GenerateNameList(s.Nodes.Neutral, s.Nodes.Heavy, s.Nodes.Light, s.Nodes.Virtual)
+}
+
+// SortedEvents returns events ordered by pulse, events of the same pulse keep their order.
+func (s *Scenario) SortedEvents() []Event {
+	events := append([]Event(nil), s.Events...)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Pulse < events[j].Pulse
+	})
+	return events
+}
+
+// Validate checks that scenario can be emulated.
+func (s *Scenario) Validate() error {
+	if s.Nodes.total() == 0 {
+		return errors.New("no nodes")
+	}
+	if s.Nodes.Neutral < 0 || s.Nodes.Heavy < 0 || s.Nodes.Light < 0 || s.Nodes.Virtual < 0 {
+		return errors.New("negative amount of nodes")
+	}
+	if s.Pulses <= 0 {
+		return errors.New("amount of pulses must be positive")
+	}
+	if s.PulseDelta == 0 {
+		return errors.New("pulse delta must be positive")
+	}
+	if err := s.Link.validate(); err != nil {
+		return errors.Wrap(err, "invalid default link")
+	}
+
+	known := map[string]bool{}
+	for _, n := range s.NodeNames() {
+		known[n] = true
+	}
+	// joiners can be used in links and events too
+	for _, ev := range s.Events {
+		if ev.Action == ActionJoin {
+			known[ev.Node] = true
+		}
+	}
+
+	for i, l := range s.Links {
+		if !known[l.From] || !known[l.To] {
+			return errors.Errorf("link %d: unknown node", i)
+		}
+		if err := l.validate(); err != nil {
+			return errors.Wrapf(err, "link %d", i)
+		}
+	}
+
+	present := map[string]bool{}
+	for _, n := range s.NodeNames() {
+		present[n] = true
+	}
+	for i, ev := range s.SortedEvents() {
+		if ev.Pulse < 0 || ev.Pulse >= s.Pulses {
+			return errors.Errorf("event %d: pulse %d is out of scenario", i, ev.Pulse)
+		}
+		switch ev.Action {
+		case ActionJoin:
+			if present[ev.Node] {
+				return errors.Errorf("event %d: node %s already exists", i, ev.Node)
+			}
+			if ev.Node == "" || !isRolePrefix(ev.Node[0]) {
+				return errors.Errorf("event %d: joiner name must start with role prefix N, H, L or V", i)
+			}
+			present[ev.Node] = true
+		case ActionLeave, ActionCrash, ActionBehave:
+			if !present[ev.Node] {
+				return errors.Errorf("event %d: unknown node %q", i, ev.Node)
+			}
+			if ev.Action == ActionBehave {
+				if err := ev.Behaviour.validate(); err != nil {
+					return errors.Wrapf(err, "event %d", i)
+				}
+			}
+		default:
+			return errors.Errorf("event %d: unknown action %q", i, ev.Action)
+		}
+	}
+	return nil
+}
+
+func isRolePrefix(c byte) bool {
+	switch c {
+	case 'N', 'H', 'L', 'V':
+		return true
+	}
+	return false
+}
+
+func (ev Event) String() string {
+	if ev.Action == ActionBehave {
+		b := ev.Behaviour
+		if b == BehaviourHonest {
+			b = "honest"
+		}
+		return string(ev.Action) + " " + ev.Node + " " + string(b)
+	}
+	return string(ev.Action) + " " + ev.Node
+}
+
+func (ev Event) host() endpoints.Name {
+	return endpoints.Name(ev.Node)
+}
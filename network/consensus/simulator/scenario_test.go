@@ -0,0 +1,132 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package simulator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testScenario = `
+name: byzantine
+nodes:
+  heavy: 1
+  light: 2
+  virtual: 3
+pulses: 5
+link:
+  min_delay: 10ms
+  max_delay: 30ms
+  loss: 0.01
+links:
+  - from: L0001
+    to: V0003
+    max_delay: 200ms
+events:
+  - pulse: 2
+    action: crash
+    node: V0005
+  - pulse: 1
+    action: join
+    node: V0100
+  - pulse: 1
+    action: behave
+    node: L0002
+    behaviour: split
+  - pulse: 3
+    action: leave
+    node: V0100
+`
+
+func TestParseScenario(t *testing.T) {
+	s, err := ParseScenario([]byte(testScenario))
+	require.NoError(t, err)
+
+	require.Equal(t, "byzantine", s.Name)
+	require.Equal(t, uint16(defaultPulseDelta), s.PulseDelta)
+	require.Equal(t, LinkConf{MinDelay: 10 * time.Millisecond, MaxDelay: 30 * time.Millisecond, Loss: 0.01}, s.Link)
+	require.Equal(t, []LinkOverride{{From: "L0001", To: "V0003", LinkConf: LinkConf{MaxDelay: 200 * time.Millisecond}}}, s.Links)
+	require.Equal(t, []string{"H0000", "L0001", "L0002", "V0003", "V0004", "V0005"}, s.NodeNames())
+
+	events := s.SortedEvents()
+	require.Len(t, events, 4)
+	require.Equal(t, "join V0100", events[0].String())
+	require.Equal(t, "behave L0002 split", events[1].String())
+	require.Equal(t, "crash V0005", events[2].String())
+	require.Equal(t, "leave V0100", events[3].String())
+}
+
+func TestParseScenario_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"no nodes":        "pulses: 1",
+		"no pulses":       "nodes: {virtual: 1}",
+		"unknown field":   "nodes: {virtual: 1}\npulses: 1\nfoo: 1",
+		"bad delay":       "nodes: {virtual: 1}\npulses: 1\nlink: {min_delay: 2s, max_delay: 1s}",
+		"bad loss":        "nodes: {virtual: 1}\npulses: 1\nlink: {loss: 2}",
+		"unknown link":    "nodes: {virtual: 1}\npulses: 1\nlinks: [{from: V0000, to: V0001}]",
+		"event out":       "nodes: {virtual: 1}\npulses: 1\nevents: [{pulse: 1, action: crash, node: V0000}]",
+		"unknown node":    "nodes: {virtual: 1}\npulses: 1\nevents: [{pulse: 0, action: crash, node: V0001}]",
+		"duplicate join":  "nodes: {virtual: 1}\npulses: 1\nevents: [{pulse: 0, action: join, node: V0000}]",
+		"joiner role":     "nodes: {virtual: 1}\npulses: 1\nevents: [{pulse: 0, action: join, node: X0001}]",
+		"leave before":    "nodes: {virtual: 1}\npulses: 2\nevents: [{pulse: 1, action: join, node: V0001}, {pulse: 0, action: leave, node: V0001}]",
+		"bad behaviour":   "nodes: {virtual: 1}\npulses: 1\nevents: [{pulse: 0, action: behave, node: V0000, behaviour: evil}]",
+		"unknown action":  "nodes: {virtual: 1}\npulses: 1\nevents: [{pulse: 0, action: dance, node: V0000}]",
+		"negative number": "nodes: {virtual: 1, light: -1}\npulses: 1",
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseScenario([]byte(data))
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package simulator
+
+import (
+	"context"
+	"time"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/consensus/common/endpoints"
+	"github.com/insolar/insolar/network/consensus/common/pulse"
+	"github.com/insolar/insolar/network/consensus/gcpv2/api/profiles"
+)
+
+const (
+	firstPulseNumber pulse.Number   = 100000
+	pulsarAddress    endpoints.Name = "pulsar0"
+	// joinerBaseID is a first node id given to joiners, so they don't intersect with initial population.
+	joinerBaseID = 10000
+	// leaveReason is a reason of graceful leave requested by ActionLeave.
+	leaveReason = 1
+)
+
+// Simulator runs scenario on emulated network and collects report on every consensus round.
+type Simulator struct {
+	scenario *Scenario
+	strategy *LinkNetStrategy
+	recorder *Recorder
+
+	builder *NetworkBuilder
+	hosts   map[endpoints.Name]*EmuHostConsensusAdapter
+	initial []profiles.StaticProfile
+	// alive are hosts connected to the network, including byzantine ones.
+	alive   []endpoints.Name
+	joiners int
+}
+
+func NewSimulator(scenario *Scenario) *Simulator {
+	strategy := NewLinkNetStrategy(scenario.Link)
+	for _, l := range scenario.Links {
+		strategy.SetLink(endpoints.Name(l.From), endpoints.Name(l.To), l.LinkConf)
+	}
+
+	return &Simulator{
+		scenario: scenario,
+		strategy: strategy,
+		recorder: NewRecorder(),
+		hosts:    map[endpoints.Name]*EmuHostConsensusAdapter{},
+	}
+}
+
+// Run emulates all pulses of the scenario and returns report. Run blocks for about (Pulses+1)*PulseDelta seconds.
+func (s *Simulator) Run(ctx context.Context) *Report {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	logger := inslogger.FromContext(ctx)
+
+	s.builder = NewNetworkBuilder(ctx, s.strategy, &EmuRoundStrategyFactory{})
+	s.builder.SetObserver(s.recorder)
+
+	s.initial = NewEmuNodeIntros(s.scenario.NodeNames()...)
+	for i := range s.initial {
+		host := s.builder.ConnectNode(s.initial, i)
+		s.addHost(host)
+	}
+	s.builder.StartNetwork(ctx)
+
+	events := s.scenario.SortedEvents()
+	delta := s.scenario.PulseDelta
+	period := time.Duration(delta) * time.Second
+	pn := firstPulseNumber
+
+	for i := 0; i < s.scenario.Pulses; i++ {
+		var applied []string
+		for len(events) > 0 && events[0].Pulse == i {
+			ev := events[0]
+			events = events[1:]
+			if s.apply(ev) {
+				applied = append(applied, ev.String())
+			} else {
+				logger.Warnf("simulator: event %q was not applied", ev.String())
+			}
+		}
+
+		prevDelta := delta
+		if i == 0 {
+			prevDelta = 0
+		}
+		s.recorder.PulseStarted(pn, time.Now(), len(s.alive), applied)
+		s.builder.SendPulse(NewPulsarPacket(pn, delta, prevDelta), pulsarAddress, s.aliveProfiles())
+		pn += pulse.Number(delta)
+
+		select {
+		case <-ctx.Done():
+			return s.recorder.Report(s.scenario.Name)
+		case <-time.After(period):
+		}
+	}
+
+	// gives consensus of the last pulse time to finish
+	select {
+	case <-ctx.Done():
+	case <-time.After(period):
+	}
+	return s.recorder.Report(s.scenario.Name)
+}
+
+func (s *Simulator) apply(ev Event) bool {
+	host := ev.host()
+	switch ev.Action {
+	case ActionJoin:
+		introducer := s.introducer()
+		if introducer == nil {
+			return false
+		}
+		intro := NewEmuNodeIntroByName(joinerBaseID+s.joiners, ev.Node)
+		s.joiners++
+		if !introducer.AddJoinCandidate(intro) {
+			return false
+		}
+		s.addHost(s.builder.ConnectJoiner(intro))
+	case ActionLeave:
+		h, ok := s.hosts[host]
+		if !ok {
+			return false
+		}
+		return h.Leave(leaveReason)
+	case ActionCrash:
+		if !s.builder.Network().DropHost(host) {
+			return false
+		}
+		s.removeHost(host)
+	case ActionBehave:
+		if _, ok := s.hosts[host]; !ok {
+			return false
+		}
+		s.strategy.SetBehaviour(host, ev.Behaviour)
+	default:
+		return false
+	}
+	return true
+}
+
+// introducer returns the first alive host of initial population.
+func (s *Simulator) introducer() *EmuHostConsensusAdapter {
+	for _, p := range s.initial {
+		if h, ok := s.hosts[p.GetDefaultEndpoint().GetNameAddress()]; ok {
+			return h
+		}
+	}
+	return nil
+}
+
+func (s *Simulator) addHost(h *EmuHostConsensusAdapter) {
+	name := h.GetHostAddress()
+	s.hosts[name] = h
+	s.alive = append(s.alive, name)
+}
+
+func (s *Simulator) removeHost(name endpoints.Name) {
+	delete(s.hosts, name)
+	for i, n := range s.alive {
+		if n == name {
+			s.alive = append(s.alive[:i], s.alive[i+1:]...)
+			return
+		}
+	}
+}
+
+// aliveProfiles returns profiles of alive hosts of initial population, pulsar sends pulses to them.
+func (s *Simulator) aliveProfiles() []profiles.StaticProfile {
+	res := make([]profiles.StaticProfile, 0, len(s.initial))
+	for _, p := range s.initial {
+		if _, ok := s.hosts[p.GetDefaultEndpoint().GetNameAddress()]; ok {
+			res = append(res, p)
+		}
+	}
+	return res
+}
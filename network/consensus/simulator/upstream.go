@@ -48,7 +48,7 @@
 //    whether it competes with the products or services of Insolar Technologies GmbH.
 //
 
-package tests
+package simulator
 
 import (
 	"context"
@@ -56,6 +56,7 @@ import (
 	"time"
 
 	"github.com/insolar/insolar/network/consensus/common/cryptkit"
+	"github.com/insolar/insolar/network/consensus/common/endpoints"
 	"github.com/insolar/insolar/network/consensus/common/longbits"
 	"github.com/insolar/insolar/network/consensus/common/pulse"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api"
@@ -69,12 +70,25 @@ func NewEmuUpstreamPulseController(ctx context.Context, nshDelay time.Duration)
 
 var _ api.UpstreamController = &EmuUpstreamPulseController{}
 
+// Observer receives consensus events of emulated nodes. It is called from consensus goroutines.
+type Observer interface {
+	// ConsensusFinished is called when node finished consensus round.
+	ConsensusFinished(host endpoints.Name, report api.UpstreamReport, expectedCensus census.Operational)
+	// ConsensusAborted is called when node stopped participating in consensus.
+	ConsensusAborted(host endpoints.Name)
+}
+
 type EmuUpstreamPulseController struct {
 	ctx      context.Context
 	nshDelay time.Duration
+	host     endpoints.Name
+	observer Observer
 }
 
-func (*EmuUpstreamPulseController) ConsensusAborted() {
+func (r *EmuUpstreamPulseController) ConsensusAborted() {
+	if r.observer != nil {
+		r.observer.ConsensusAborted(r.host)
+	}
 }
 
 func (r *EmuUpstreamPulseController) PreparePulseChange(report api.UpstreamReport, c chan<- api.UpstreamState) {
@@ -96,7 +110,10 @@ func (*EmuUpstreamPulseController) CommitPulseChange(report api.UpstreamReport,
 func (*EmuUpstreamPulseController) CancelPulseChange() {
 }
 
-func (*EmuUpstreamPulseController) ConsensusFinished(report api.UpstreamReport, expectedCensus census.Operational) {
+func (r *EmuUpstreamPulseController) ConsensusFinished(report api.UpstreamReport, expectedCensus census.Operational) {
+	if r.observer != nil {
+		r.observer.ConsensusFinished(r.host, report, expectedCensus)
+	}
 }
 
 func NewEmuNodeStateHash(v uint64) *EmuNodeStateHash {
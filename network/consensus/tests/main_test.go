@@ -60,6 +60,7 @@ import (
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/consensus/simulator"
 )
 
 func TestConsensusMain(t *testing.T) {
@@ -73,19 +74,19 @@ func TestConsensusMain(t *testing.T) {
 
 	ctx = inslogger.SetLogger(ctx, logger)
 
-	netStrategy := NewDelayNetStrategy(DelayStrategyConf{
+	netStrategy := simulator.NewDelayNetStrategy(simulator.DelayStrategyConf{
 		MinDelay:         10 * time.Millisecond,
 		MaxDelay:         30 * time.Millisecond,
 		Variance:         0.2,
 		SpikeProbability: 0.1,
 	})
-	strategyFactory := &EmuRoundStrategyFactory{}
+	strategyFactory := &simulator.EmuRoundStrategyFactory{}
 
-	nodes := NewEmuNodeIntros(generateNameList(0, 1, 3, 5)...)
-	netBuilder := newEmuNetworkBuilder(ctx, netStrategy, strategyFactory)
+	nodes := simulator.NewEmuNodeIntros(simulator.GenerateNameList(0, 1, 3, 5)...)
+	netBuilder := simulator.NewNetworkBuilder(ctx, netStrategy, strategyFactory)
 
 	for i := range nodes {
-		netBuilder.connectEmuNode(nodes, i)
+		netBuilder.ConnectEmuNode(nodes, i)
 	}
 
 	netBuilder.StartNetwork(ctx)
@@ -93,7 +94,7 @@ func TestConsensusMain(t *testing.T) {
 	netBuilder.StartPulsar(10, 2, "pulsar0", nodes)
 
 	// time.AfterFunc(time.Second, func() {
-	//	netBuilder.network.DropHost("V0007")
+	//	netBuilder.Network().DropHost("V0007")
 	// })
 
 	for {
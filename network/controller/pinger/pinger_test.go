@@ -55,9 +55,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/hostnetwork/future"
+	"github.com/insolar/insolar/network/hostnetwork/guard"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
 
@@ -76,7 +79,7 @@ func TestPing_Errors(t *testing.T) {
 	f := transport.NewFactory(configuration.NewHostNetwork().Transport)
 	n, err := hostnetwork.NewHostNetwork(insolar.Reference{}.String())
 	require.NoError(t, err)
-	cm.Inject(f, n, testutils.NewRoutingTableMock(t))
+	cm.Inject(f, n, testutils.NewRoutingTableMock(t), newPeerGuard(t))
 
 	pinger := NewPinger(n)
 	_, err = pinger.Ping(context.Background(), "invalid", time.Second)
@@ -97,7 +100,9 @@ func TestPing_HappyPath(t *testing.T) {
 		return n2.BuildResponse(ctx, request, &packet.Ping{}), nil
 	})
 	resolver2 := testutils.NewRoutingTableMock(t)
-	cm2.Inject(f2, n2, resolver2)
+	// pinging node is not in the network
+	resolver2.ResolveMock.Return(nil, errors.New("no such node"))
+	cm2.Inject(f2, n2, resolver2, newPeerGuard(t))
 	err = cm2.Init(ctx)
 	require.NoError(t, err)
 	err = cm2.Start(ctx)
@@ -109,7 +114,7 @@ func TestPing_HappyPath(t *testing.T) {
 	defer n.Stop(ctx)
 	require.NoError(t, err)
 	resolver := testutils.NewRoutingTableMock(t)
-	cm.Inject(f, n, resolver)
+	cm.Inject(f, n, resolver, newPeerGuard(t))
 	err = cm.Init(ctx)
 	require.NoError(t, err)
 	err = cm.Start(ctx)
@@ -140,7 +145,9 @@ func TestPing_Timeout(t *testing.T) {
 		return n2.BuildResponse(ctx, request, &packet.Ping{}), nil
 	})
 	resolver2 := testutils.NewRoutingTableMock(t)
-	cm2.Inject(f2, n2, resolver2)
+	// pinging node is not in the network
+	resolver2.ResolveMock.Return(nil, errors.New("no such node"))
+	cm2.Inject(f2, n2, resolver2, newPeerGuard(t))
 	err = cm2.Init(ctx)
 	require.NoError(t, err)
 	err = cm2.Start(ctx)
@@ -152,7 +159,7 @@ func TestPing_Timeout(t *testing.T) {
 	defer n.Stop(ctx)
 	require.NoError(t, err)
 	resolver := testutils.NewRoutingTableMock(t)
-	cm.Inject(f, n, resolver)
+	cm.Inject(f, n, resolver, newPeerGuard(t))
 	err = cm.Init(ctx)
 	require.NoError(t, err)
 	err = cm.Start(ctx)
@@ -167,3 +174,9 @@ func TestPing_Timeout(t *testing.T) {
 	close(startRespondig)
 	<-responded
 }
+
+func newPeerGuard(t *testing.T) network.PeerGuard {
+	g, err := guard.NewGuard(configuration.NewHostNetwork().PeerGuard)
+	require.NoError(t, err)
+	return g
+}
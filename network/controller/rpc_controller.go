@@ -70,6 +70,7 @@ import (
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/cascade"
 	"github.com/insolar/insolar/network/controller/common"
+	"github.com/insolar/insolar/network/hostnetwork/compression"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
 )
 
@@ -88,6 +89,7 @@ type rpcController struct {
 	Scheme      insolar.PlatformCryptographyScheme `inject:""`
 	Network     network.HostNetwork                `inject:""`
 	NodeNetwork network.NodeNetwork                `inject:""`
+	Compression network.CompressionNegotiator      `inject:""`
 
 	options     *common.Options
 	methodTable map[string]insolar.RemoteProcedure
@@ -105,6 +107,27 @@ func (rpc *rpcController) invoke(ctx context.Context, name string, data []byte)
 	return method(ctx, data)
 }
 
+func (rpc *rpcController) newRequest(ctx context.Context, nodeID insolar.Reference, name string, data []byte) *packet.RPCRequest {
+	data, codec := rpc.Compression.Compress(ctx, nodeID, data)
+	return &packet.RPCRequest{
+		Method:      name,
+		Data:        data,
+		Compression: uint32(codec),
+	}
+}
+
+func (rpc *rpcController) requestData(ctx context.Context, request *packet.RPCRequest) ([]byte, error) {
+	data, err := rpc.Compression.Decompress(ctx, compression.Codec(request.Compression), request.Data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decompress RPC request %s", request.Method)
+	}
+	return data, nil
+}
+
+func (rpc *rpcController) learnCompression(nodeID insolar.Reference, response *packet.RPCResponse) {
+	rpc.Compression.Learn(nodeID, compression.Set(response.AcceptCompression))
+}
+
 func (rpc *rpcController) SendCascadeMessage(data insolar.Cascade, method string, msg insolar.Parcel) error {
 	if msg == nil {
 		return errors.New("message is nil")
@@ -117,6 +140,7 @@ func (rpc *rpcController) SendCascadeMessage(data insolar.Cascade, method string
 	)
 	defer span.End()
 	ctx = msg.Context(ctx)
+	ctx = compression.WithPayloadType(ctx, msg.Type().String())
 	return rpc.initCascadeSendMessage(ctx, data, false, method, message.ParcelToBytes(msg))
 }
 
@@ -174,10 +198,7 @@ func (rpc *rpcController) requestCascadeSendMessage(ctx context.Context, data in
 	defer span.End()
 	request := &packet.CascadeRequest{
 		TraceID: inslogger.TraceID(ctx),
-		RPC: &packet.RPCRequest{
-			Method: method,
-			Data:   args,
-		},
+		RPC:     rpc.newRequest(ctx, nodeID, method, args),
 		Cascade: &packet.Cascade{
 			NodeIds:           data.NodeIds,
 			Entropy:           data.Entropy,
@@ -213,10 +234,7 @@ func (rpc *rpcController) requestCascadeSendMessage(ctx context.Context, data in
 }
 
 func (rpc *rpcController) SendBytes(ctx context.Context, nodeID insolar.Reference, name string, msgBytes []byte) ([]byte, error) {
-	request := &packet.RPCRequest{
-		Method: name,
-		Data:   msgBytes,
-	}
+	request := rpc.newRequest(ctx, nodeID, name, msgBytes)
 
 	future, err := rpc.Network.SendRequest(ctx, types.RPC, request, nodeID)
 	if err != nil {
@@ -231,6 +249,7 @@ func (rpc *rpcController) SendBytes(ctx context.Context, nodeID insolar.Referenc
 			"got invalid response protobuf message: %s", nodeID, response)
 	}
 	data := response.GetResponse().GetRPC()
+	rpc.learnCompression(nodeID, data)
 	if data.Result == nil {
 		return nil, errors.New("RPC call returned error: " + data.Error)
 	}
@@ -243,10 +262,7 @@ func (rpc *rpcController) SendMessage(nodeID insolar.Reference, name string, msg
 	ctx := context.Background() // TODO: ctx as argument
 	ctx = insmetrics.InsertTag(ctx, tagMessageType, msg.Type().String())
 	stats.Record(ctx, statParcelsSentSizeBytes.M(int64(len(msgBytes))))
-	request := &packet.RPCRequest{
-		Method: name,
-		Data:   msgBytes,
-	}
+	request := rpc.newRequest(compression.WithPayloadType(ctx, msg.Type().String()), nodeID, name, msgBytes)
 
 	start := time.Now()
 	ctx = msg.Context(ctx)
@@ -269,6 +285,7 @@ func (rpc *rpcController) SendMessage(nodeID insolar.Reference, name string, msg
 			"got invalid response protobuf message: %s", nodeID, response)
 	}
 	data := response.GetResponse().GetRPC()
+	rpc.learnCompression(nodeID, data)
 	logger.Debugf("Inside SendParcel: type - '%s', target - %s, caller - %s, targetRole - %s, time - %s",
 		msg.Type(), msg.DefaultTarget(), msg.GetCaller(), msg.DefaultRole(), time.Since(start))
 	if data.Result == nil {
@@ -286,12 +303,17 @@ func (rpc *rpcController) processMessage(ctx context.Context, request network.Re
 	ctx = insmetrics.InsertTag(ctx, tagPacketType, request.GetType().String())
 	stats.Record(ctx, statPacketsReceived.M(1))
 
+	accepted := uint32(rpc.Compression.Accepted())
 	payload := request.GetRequest().GetRPC()
-	result, err := rpc.invoke(ctx, payload.Method, payload.Data)
+	args, err := rpc.requestData(ctx, payload)
+	if err != nil {
+		return rpc.Network.BuildResponse(ctx, request, &packet.RPCResponse{Error: err.Error(), AcceptCompression: accepted}), nil
+	}
+	result, err := rpc.invoke(ctx, payload.Method, args)
 	if err != nil {
-		return rpc.Network.BuildResponse(ctx, request, &packet.RPCResponse{Error: err.Error()}), nil
+		return rpc.Network.BuildResponse(ctx, request, &packet.RPCResponse{Error: err.Error(), AcceptCompression: accepted}), nil
 	}
-	return rpc.Network.BuildResponse(ctx, request, &packet.RPCResponse{Result: result}), nil
+	return rpc.Network.BuildResponse(ctx, request, &packet.RPCResponse{Result: result, AcceptCompression: accepted}), nil
 }
 
 func (rpc *rpcController) processCascade(ctx context.Context, request network.ReceivedPacket) (network.Packet, error) {
@@ -302,8 +324,13 @@ func (rpc *rpcController) processCascade(ctx context.Context, request network.Re
 	payload := request.GetRequest().GetCascade()
 	ctx, logger := inslogger.WithTraceField(ctx, payload.TraceID)
 
+	args, err := rpc.requestData(ctx, payload.RPC)
+	if err != nil {
+		return rpc.Network.BuildResponse(ctx, request, &packet.BasicResponse{Success: false, Error: err.Error()}), nil
+	}
+
 	generalError := ""
-	_, invokeErr := rpc.invoke(ctx, payload.RPC.Method, payload.RPC.Data)
+	_, invokeErr := rpc.invoke(ctx, payload.RPC.Method, args)
 	if invokeErr != nil {
 		logger.Debugf("failed to invoke RPC: %s", invokeErr.Error())
 		generalError += invokeErr.Error() + "; "
@@ -313,7 +340,7 @@ func (rpc *rpcController) processCascade(ctx context.Context, request network.Re
 		Entropy:           payload.Cascade.Entropy,
 		ReplicationFactor: uint(payload.Cascade.ReplicationFactor),
 	}
-	sendErr := rpc.initCascadeSendMessage(ctx, cascade, true, payload.RPC.Method, payload.RPC.Data)
+	sendErr := rpc.initCascadeSendMessage(ctx, cascade, true, payload.RPC.Method, args)
 	if sendErr != nil {
 		logger.Debugf("failed to send message to next cascade layer: %s", sendErr.Error())
 		generalError += sendErr.Error()
@@ -67,9 +67,12 @@ import (
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/network/gateway/bootstrap"
+	"github.com/insolar/insolar/network/hostnetwork/compression"
+	"github.com/insolar/insolar/network/hostnetwork/guard"
 	"github.com/insolar/insolar/network/hostnetwork/host"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
+	"github.com/insolar/insolar/network/node"
 	"github.com/insolar/insolar/platformpolicy"
 
 	"github.com/insolar/insolar/insolar"
@@ -82,23 +85,31 @@ type Base struct {
 	component.Initer
 
 	Self                network.Gateway
-	Gatewayer           network.Gatewayer           `inject:""`
-	NodeKeeper          network.NodeKeeper          `inject:""`
-	ContractRequester   insolar.ContractRequester   `inject:""`
-	CryptographyService insolar.CryptographyService `inject:""`
-	CertificateManager  insolar.CertificateManager  `inject:""`
-	HostNetwork         network.HostNetwork         `inject:""`
-	PulseAccessor       storage.PulseAccessor       `inject:""`
-	PulseAppender       storage.PulseAppender       `inject:""`
-	PulseManager        insolar.PulseManager        `inject:""`
-	BootstrapRequester  bootstrap.Requester         `inject:""`
-	KeyProcessor        insolar.KeyProcessor        `inject:""`
+	Gatewayer           network.Gatewayer             `inject:""`
+	NodeKeeper          network.NodeKeeper            `inject:""`
+	ContractRequester   insolar.ContractRequester     `inject:""`
+	CryptographyService insolar.CryptographyService   `inject:""`
+	CertificateManager  insolar.CertificateManager    `inject:""`
+	HostNetwork         network.HostNetwork           `inject:""`
+	PulseAccessor       storage.PulseAccessor         `inject:""`
+	PulseAppender       storage.PulseAppender         `inject:""`
+	PulseManager        insolar.PulseManager          `inject:""`
+	BootstrapRequester  bootstrap.Requester           `inject:""`
+	KeyProcessor        insolar.KeyProcessor          `inject:""`
+	Compression         network.CompressionNegotiator `inject:""`
+	PeerGuard           network.PeerGuard             `inject:""`
+	SnapshotStorage     storage.SnapshotStorage       `inject:""`
+	CloudHashStorage    storage.CloudHashStorage      `inject:""`
+
+	// ReconnectMaxAge is a maximum age of the last stored pulse that allows to rejoin using the stored snapshot.
+	ReconnectMaxAge time.Duration
 
 	ConsensusController   consensus.Controller
 	ConsensusPulseHandler network.PulseHandler
 
-	bootstrapETA    time.Duration
-	originCandidate *adapters.Candidate
+	bootstrapETA     time.Duration
+	originCandidate  *adapters.Candidate
+	restoredSnapshot *node.Snapshot
 }
 
 // NewGateway creates new gateway on top of existing
@@ -143,7 +154,7 @@ func (g *Base) OnPulseFromPulsar(ctx context.Context, pu insolar.Pulse, original
 
 func (g *Base) OnPulseFromConsensus(ctx context.Context, pu insolar.Pulse) {
 	g.NodeKeeper.MoveSyncToActive(ctx, pu.PulseNumber)
-	err := g.PulseAppender.(*storage.MemoryPulseStorage).AppendPulse(ctx, pu)
+	err := g.PulseAppender.AppendPulse(ctx, pu)
 	if err != nil {
 		panic("failed to append pulse:" + err.Error())
 	}
@@ -242,9 +253,18 @@ func (g *Base) HandleNodeAuthorizeRequest(ctx context.Context, request network.R
 	}
 
 	if request.GetRequest() == nil || request.GetRequest().GetAuthorize() == nil {
+		g.penalize(ctx, guard.Malformed)
 		return nil, errors.Errorf("process authorize: got invalid protobuf request message: %s", request)
 	}
-	data := request.GetRequest().GetAuthorize().AuthorizeData
+	response, err := g.authorizeJoiner(ctx, request, request.GetRequest().GetAuthorize().AuthorizeData)
+	if err != nil {
+		return nil, err
+	}
+	return g.HostNetwork.BuildResponse(ctx, request, response), nil
+}
+
+// authorizeJoiner checks authorize data of the joiner and issues a permit to bootstrap to this node.
+func (g *Base) authorizeJoiner(ctx context.Context, request network.ReceivedPacket, data *packet.AuthorizeData) (*packet.AuthorizeResponse, error) {
 	o := g.NodeKeeper.GetOrigin()
 
 	if data.Version != o.Version() {
@@ -253,15 +273,16 @@ func (g *Base) HandleNodeAuthorizeRequest(ctx context.Context, request network.R
 
 	// TODO: move time.Minute to config
 	if !validateTimestamp(data.Timestamp, time.Minute) {
-		return g.HostNetwork.BuildResponse(ctx, request, &packet.AuthorizeResponse{
+		return &packet.AuthorizeResponse{
 			Code:      packet.WrongTimestamp,
 			Timestamp: time.Now().UTC().Unix(),
-		}), nil
+		}, nil
 	}
 
 	cert, err := certificate.Deserialize(data.Certificate, platformpolicy.NewKeyProcessor())
 	if err != nil {
-		return g.HostNetwork.BuildResponse(ctx, request, &packet.AuthorizeResponse{Code: packet.WrongMandate, Error: err.Error()}), nil
+		g.penalize(ctx, guard.Unauthenticated)
+		return &packet.AuthorizeResponse{Code: packet.WrongMandate, Error: err.Error()}, nil
 	}
 
 	valid, err := g.Gatewayer.Gateway().Auther().ValidateCert(ctx, cert)
@@ -302,19 +323,29 @@ func (g *Base) HandleNodeAuthorizeRequest(ctx context.Context, request network.R
 		return nil, err
 	}
 
+	g.Compression.Learn(request.GetSender(), compression.Set(data.AcceptCompression))
+
 	bootstrapPulse := GetBootstrapPulse(ctx, g.PulseAccessor)
 	discoveryCount := len(network.FindDiscoveriesInNodeList(
 		g.NodeKeeper.GetAccessor(bootstrapPulse.PulseNumber).GetActiveNodes(),
 		g.CertificateManager.GetCertificate(),
 	))
 
-	return g.HostNetwork.BuildResponse(ctx, request, &packet.AuthorizeResponse{
-		Code:           packet.Success,
-		Timestamp:      time.Now().UTC().Unix(),
-		Permit:         permit,
-		DiscoveryCount: uint32(discoveryCount),
-		Pulse:          pulse.ToProto(&bootstrapPulse),
-	}), nil
+	return &packet.AuthorizeResponse{
+		Code:              packet.Success,
+		Timestamp:         time.Now().UTC().Unix(),
+		Permit:            permit,
+		DiscoveryCount:    uint32(discoveryCount),
+		Pulse:             pulse.ToProto(&bootstrapPulse),
+		AcceptCompression: uint32(g.Compression.Accepted()),
+	}, nil
+}
+
+// penalize lowers reputation of the peer the request in ctx is received from.
+func (g *Base) penalize(ctx context.Context, offense guard.Offense) {
+	if peer, ok := guard.PeerFromContext(ctx); ok {
+		g.PeerGuard.Penalize(ctx, peer, offense)
+	}
 }
 
 func (g *Base) HandleUpdateSchedule(ctx context.Context, request network.ReceivedPacket) (network.Packet, error) {
@@ -322,16 +353,32 @@ func (g *Base) HandleUpdateSchedule(ctx context.Context, request network.Receive
 	return g.HostNetwork.BuildResponse(ctx, request, &packet.UpdateScheduleResponse{}), nil
 }
 
+// HandleReconnect authorizes the node that rejoins the network after restart, see checkReconnect.
 func (g *Base) HandleReconnect(ctx context.Context, request network.ReceivedPacket) (network.Packet, error) {
-	if request.GetRequest() == nil || request.GetRequest().GetReconnect() == nil {
+	if !network.OriginIsDiscovery(g.CertificateManager.GetCertificate()) {
+		return nil, errors.New("only discovery nodes could reconnect other nodes, this is not a discovery node")
+	}
+
+	if request.GetRequest() == nil || request.GetRequest().GetReconnect() == nil ||
+		request.GetRequest().GetReconnect().Authorize == nil {
+		g.penalize(ctx, guard.Malformed)
 		return nil, errors.Errorf("process reconnect: got invalid protobuf request message: %s", request)
 	}
+	reconnect := request.GetRequest().GetReconnect()
 
-	// check permit, if permit from Discovery node
-	// request.GetRequest().GetReconnect().Permit
+	if err := g.checkReconnect(ctx, request.GetSender(), reconnect); err != nil {
+		inslogger.FromContext(ctx).Infof("Reconnect of %s rejected: %s", request.GetSender(), err.Error())
+		return g.HostNetwork.BuildResponse(ctx, request, &packet.ReconnectResponse{Error: err.Error()}), nil
+	}
 
-	// TODO:
-	return g.HostNetwork.BuildResponse(ctx, request, &packet.ReconnectResponse{}), nil
+	response, err := g.authorizeJoiner(ctx, request, reconnect.Authorize.AuthorizeData)
+	if err != nil {
+		return nil, err
+	}
+	return g.HostNetwork.BuildResponse(ctx, request, &packet.ReconnectResponse{
+		Accepted:  true,
+		Authorize: response,
+	}), nil
 }
 
 func (g *Base) OnConsensusFinished(ctx context.Context, report network.Report) {
@@ -66,6 +66,7 @@ import (
 	"github.com/insolar/insolar/instrumentation/instracer"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/controller/common"
+	"github.com/insolar/insolar/network/hostnetwork/compression"
 	"github.com/insolar/insolar/network/hostnetwork/host"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
@@ -75,7 +76,7 @@ type Requester interface {
 	Authorize(context.Context, *host.Host, insolar.AuthorizationCertificate) (*packet.AuthorizeResponse, error)
 	Bootstrap(context.Context, *packet.Permit, adapters.Candidate, *insolar.Pulse) (*packet.BootstrapResponse, error)
 	UpdateSchedule(context.Context, *packet.Permit, insolar.PulseNumber) (*packet.UpdateScheduleResponse, error)
-	Reconnect(context.Context, *host.Host, *packet.Permit) (*packet.ReconnectResponse, error)
+	Reconnect(context.Context, *host.Host, insolar.AuthorizationCertificate, insolar.PulseNumber, []byte) (*packet.AuthorizeResponse, error)
 }
 
 func NewRequester(options *common.Options) Requester {
@@ -83,9 +84,10 @@ func NewRequester(options *common.Options) Requester {
 }
 
 type requester struct {
-	HostNetwork         network.HostNetwork         `inject:""`
-	OriginProvider      network.OriginProvider      `inject:""`
-	CryptographyService insolar.CryptographyService `inject:""`
+	HostNetwork         network.HostNetwork           `inject:""`
+	OriginProvider      network.OriginProvider        `inject:""`
+	CryptographyService insolar.CryptographyService   `inject:""`
+	Compression         network.CompressionNegotiator `inject:""`
 
 	options *common.Options
 }
@@ -98,12 +100,10 @@ func (ac *requester) Authorize(ctx context.Context, host *host.Host, cert insola
 		trace.StringAttribute("node", host.NodeID.String()),
 	)
 	defer span.End()
-	serializedCert, err := certificate.Serialize(cert)
+	authData, err := ac.authorizeData(cert)
 	if err != nil {
-		return nil, errors.Wrap(err, "Error serializing certificate")
+		return nil, err
 	}
-
-	authData := &packet.AuthorizeData{Certificate: serializedCert, Version: ac.OriginProvider.GetOrigin().Version()}
 	response, err := ac.authorizeWithTimestamp(ctx, host, authData, time.Now().Unix())
 	if err != nil {
 		return nil, err
@@ -111,6 +111,7 @@ func (ac *requester) Authorize(ctx context.Context, host *host.Host, cert insola
 
 	switch response.Code {
 	case packet.Success:
+		ac.Compression.Learn(host.NodeID, compression.Set(response.AcceptCompression))
 		return response, nil
 	case packet.WrongMandate:
 		return response, errors.New("failed to authorize, wrong mandate")
@@ -124,8 +125,20 @@ func (ac *requester) Authorize(ctx context.Context, host *host.Host, cert insola
 	return response, err
 }
 
-func (ac *requester) authorizeWithTimestamp(ctx context.Context, h *host.Host, authData *packet.AuthorizeData, timestamp int64) (*packet.AuthorizeResponse, error) {
+func (ac *requester) authorizeData(cert insolar.AuthorizationCertificate) (*packet.AuthorizeData, error) {
+	serializedCert, err := certificate.Serialize(cert)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error serializing certificate")
+	}
+
+	return &packet.AuthorizeData{
+		Certificate:       serializedCert,
+		Version:           ac.OriginProvider.GetOrigin().Version(),
+		AcceptCompression: uint32(ac.Compression.Accepted()),
+	}, nil
+}
 
+func (ac *requester) signAuthorizeData(authData *packet.AuthorizeData, timestamp int64) (*packet.AuthorizeRequest, error) {
 	authData.Timestamp = timestamp
 
 	data, err := authData.Marshal()
@@ -138,7 +151,14 @@ func (ac *requester) authorizeWithTimestamp(ctx context.Context, h *host.Host, a
 		return nil, errors.Wrap(err, "failed to sign permit")
 	}
 
-	req := &packet.AuthorizeRequest{AuthorizeData: authData, Signature: signature.Bytes()}
+	return &packet.AuthorizeRequest{AuthorizeData: authData, Signature: signature.Bytes()}, nil
+}
+
+func (ac *requester) authorizeWithTimestamp(ctx context.Context, h *host.Host, authData *packet.AuthorizeData, timestamp int64) (*packet.AuthorizeResponse, error) {
+	req, err := ac.signAuthorizeData(authData, timestamp)
+	if err != nil {
+		return nil, err
+	}
 
 	f, err := ac.HostNetwork.SendRequestToHost(ctx, types.Authorize, req, h)
 	if err != nil {
@@ -219,10 +239,31 @@ func (ac *requester) UpdateSchedule(ctx context.Context, permit *packet.Permit,
 	return resp.GetResponse().GetUpdateSchedule(), nil
 }
 
-func (ac *requester) Reconnect(ctx context.Context, h *host.Host, permit *packet.Permit) (*packet.ReconnectResponse, error) {
+// Reconnect asks discovery node to authorize the node that was active in the stored snapshot of the pulse.
+// The node proves it with cloud hash it stored for the pulse, so the permit is issued without discovery majority check.
+func (ac *requester) Reconnect(
+	ctx context.Context,
+	h *host.Host,
+	cert insolar.AuthorizationCertificate,
+	pn insolar.PulseNumber,
+	cloudHash []byte,
+) (*packet.AuthorizeResponse, error) {
+	inslogger.FromContext(ctx).Infof("Reconnecting on host: %s", h.String())
+
+	authData, err := ac.authorizeData(cert)
+	if err != nil {
+		return nil, err
+	}
+	authorize, err := ac.signAuthorizeData(authData, time.Now().Unix())
+	if err != nil {
+		return nil, err
+	}
+
 	req := &packet.ReconnectRequest{
-		ReconnectTo: *permit.Payload.ReconnectTo,
-		Permit:      permit,
+		ReconnectTo: *h,
+		Authorize:   authorize,
+		Pulse:       pn,
+		CloudHash:   cloudHash,
 	}
 
 	f, err := ac.HostNetwork.SendRequestToHost(ctx, types.Reconnect, req, h)
@@ -235,5 +276,21 @@ func (ac *requester) Reconnect(ctx context.Context, h *host.Host, permit *packet
 		return nil, errors.Wrapf(err, "Error getting response for Reconnect request")
 	}
 
-	return resp.GetResponse().GetReconnect(), nil
+	if resp.GetResponse().GetError() != nil {
+		return nil, errors.New(resp.GetResponse().GetError().Error)
+	}
+
+	respData := resp.GetResponse().GetReconnect()
+	if respData == nil {
+		return nil, errors.New("bad response for reconnect")
+	}
+	if !respData.Accepted {
+		return nil, errors.Errorf("reconnect rejected: %s", respData.Error)
+	}
+	if respData.Authorize == nil || respData.Authorize.Code != packet.Success {
+		return nil, errors.Errorf("reconnect failed to authorize: %s", respData.Authorize)
+	}
+
+	ac.Compression.Learn(h.NodeID, compression.Set(respData.Authorize.AcceptCompression))
+	return respData.Authorize, nil
 }
@@ -63,7 +63,6 @@ import (
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/network"
-	"github.com/insolar/insolar/network/hostnetwork/host"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
 )
 
@@ -78,7 +77,11 @@ type JoinerBootstrap struct {
 
 func (g *JoinerBootstrap) Run(ctx context.Context, p insolar.Pulse) {
 	logger := inslogger.FromContext(ctx)
-	permit, err := g.authorize(ctx)
+	permit := g.reconnect(ctx)
+	var err error
+	if permit == nil {
+		permit, err = g.authorize(ctx)
+	}
 	if err != nil {
 		logger.Warn("Failed to authorize: ", err.Error())
 		g.Gatewayer.SwitchState(ctx, insolar.NoNetworkState, p)
@@ -119,9 +122,7 @@ func (g *JoinerBootstrap) authorize(ctx context.Context) (*packet.Permit, error)
 
 	bestResult := &packet.AuthorizeResponse{}
 
-	for _, n := range discoveryNodes {
-		h, _ := host.NewHostN(n.GetHost(), *n.GetNodeRef())
-
+	for _, h := range bootstrapHosts(discoveryNodes, g.restoredSnapshot) {
 		res, err := g.BootstrapRequester.Authorize(ctx, h, cert)
 		if err != nil {
 			inslogger.FromContext(ctx).Warnf("Error authorizing to host %s: %s", h.String(), err.Error())
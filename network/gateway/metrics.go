@@ -67,6 +67,16 @@ var (
 		"number of bootstrap requests that are required to be retried",
 		stats.UnitDimensionless,
 	)
+	statBootstrapRestored = stats.Int64(
+		"network/bootstrap/restored",
+		"number of bootstraps that use stored network snapshot",
+		stats.UnitDimensionless,
+	)
+	statBootstrapReconnected = stats.Int64(
+		"network/bootstrap/reconnected",
+		"number of nodes authorized by reconnect using stored network snapshot",
+		stats.UnitDimensionless,
+	)
 )
 
 func init() {
@@ -79,6 +89,18 @@ func init() {
 			Aggregation: view.Count(),
 			TagKeys:     tags,
 		},
+		&view.View{
+			Name:        statBootstrapRestored.Name(),
+			Description: statBootstrapRestored.Description(),
+			Measure:     statBootstrapRestored,
+			Aggregation: view.Count(),
+		},
+		&view.View{
+			Name:        statBootstrapReconnected.Name(),
+			Description: statBootstrapReconnected.Description(),
+			Measure:     statBootstrapReconnected,
+			Aggregation: view.Count(),
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -75,6 +75,7 @@ func (g *NoNetwork) Run(ctx context.Context, pulse insolar.Pulse) {
 	origin := g.NodeKeeper.GetOrigin()
 	discoveryNodes := network.ExcludeOrigin(cert.GetDiscoveryNodes(), origin.ID())
 
+	g.restoredSnapshot, _ = g.restoreSnapshot(ctx, pulse)
 	g.NodeKeeper.SetInitialSnapshot([]insolar.NetworkNode{origin})
 
 	if len(discoveryNodes) == 0 {
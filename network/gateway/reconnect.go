@@ -0,0 +1,212 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/hostnetwork/host"
+	"github.com/insolar/insolar/network/hostnetwork/packet"
+	"github.com/insolar/insolar/network/node"
+)
+
+// restoreSnapshot returns the snapshot stored for pulse p if the pulse is recent enough
+// to rejoin the network through discovery nodes that were active in it.
+func (g *Base) restoreSnapshot(ctx context.Context, p insolar.Pulse) (*node.Snapshot, bool) {
+	if g.ReconnectMaxAge <= 0 || g.SnapshotStorage == nil || p.PulseNumber <= insolar.FirstPulseNumber {
+		return nil, false
+	}
+
+	logger := inslogger.FromContext(ctx)
+	age := time.Since(time.Unix(0, p.PulseTimestamp))
+	if age > g.ReconnectMaxAge {
+		logger.Infof("Stored pulse %d is too old for reconnect: %s", p.PulseNumber, age)
+		return nil, false
+	}
+
+	snapshot, err := g.SnapshotStorage.ForPulseNumber(p.PulseNumber)
+	if err != nil {
+		logger.Infof("No stored snapshot for pulse %d: %s", p.PulseNumber, err.Error())
+		return nil, false
+	}
+
+	stats.Record(ctx, statBootstrapRestored.M(1))
+	logger.Infof("Restored snapshot of pulse %d, rejoining through its active discovery nodes", p.PulseNumber)
+	return snapshot, true
+}
+
+// reconnect authorizes the node through discovery nodes that were active in the restored snapshot. The node proves
+// it was in the network with the cloud hash it stored for the snapshot pulse, so the permit is issued by the first
+// discovery node that accepts it, without waiting for discovery majority. Returns nil if the node has to authorize
+// in a regular way.
+func (g *Base) reconnect(ctx context.Context) *packet.Permit {
+	if g.restoredSnapshot == nil || g.CloudHashStorage == nil {
+		return nil
+	}
+
+	logger := inslogger.FromContext(ctx)
+	pn := g.restoredSnapshot.GetPulse()
+	cloudHash, err := g.CloudHashStorage.ForPulseNumber(pn)
+	if err != nil {
+		logger.Infof("No stored cloud hash for pulse %d: %s", pn, err.Error())
+		return nil
+	}
+
+	cert := g.CertificateManager.GetCertificate()
+	discoveryNodes := network.ExcludeOrigin(cert.GetDiscoveryNodes(), g.NodeKeeper.GetOrigin().ID())
+	for _, h := range activeHosts(discoveryNodes, g.restoredSnapshot) {
+		res, err := g.BootstrapRequester.Reconnect(ctx, h, cert, pn, cloudHash)
+		if err != nil {
+			logger.Warnf("Error reconnecting to host %s: %s", h.String(), err.Error())
+			continue
+		}
+
+		stats.Record(ctx, statBootstrapReconnected.M(1))
+		logger.Infof("Reconnected to host %s using snapshot of pulse %d", h.String(), pn)
+		return res.Permit
+	}
+	return nil
+}
+
+// checkReconnect checks that the sender was active in the stored snapshot of the requested pulse, the pulse is not
+// older than ReconnectMaxAge, the cloud hash of the sender matches the stored one and the authorize data is signed
+// with the key the sender had in the snapshot.
+func (g *Base) checkReconnect(ctx context.Context, sender insolar.Reference, req *packet.ReconnectRequest) error {
+	if g.ReconnectMaxAge <= 0 {
+		return errors.New("reconnect is disabled")
+	}
+
+	p, err := g.PulseAccessor.GetPulse(ctx, req.Pulse)
+	if err != nil {
+		return errors.Wrapf(err, "unknown pulse %d", req.Pulse)
+	}
+	if age := time.Since(time.Unix(0, p.PulseTimestamp)); age > g.ReconnectMaxAge {
+		return errors.Errorf("pulse %d is too old for reconnect: %s", req.Pulse, age)
+	}
+
+	snapshot, err := g.SnapshotStorage.ForPulseNumber(req.Pulse)
+	if err != nil {
+		return errors.Wrapf(err, "no snapshot for pulse %d", req.Pulse)
+	}
+	active := node.NewAccessor(snapshot).GetActiveNode(sender)
+	if active == nil {
+		return errors.Errorf("node is not active in pulse %d", req.Pulse)
+	}
+
+	cloudHash, err := g.CloudHashStorage.ForPulseNumber(req.Pulse)
+	if err != nil {
+		return errors.Wrapf(err, "no cloud hash for pulse %d", req.Pulse)
+	}
+	if !bytes.Equal(cloudHash, req.CloudHash) {
+		return errors.Errorf("cloud hash mismatch for pulse %d", req.Pulse)
+	}
+
+	data, err := req.Authorize.AuthorizeData.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal authorize data")
+	}
+	if !g.CryptographyService.Verify(active.PublicKey(), insolar.SignatureFromBytes(req.Authorize.Signature), data) {
+		return errors.New("authorize data is not signed by the node key")
+	}
+	return nil
+}
+
+// activeHosts returns hosts of discovery nodes that are active in the snapshot with addresses they had in it.
+func activeHosts(discoveryNodes []insolar.DiscoveryNode, snapshot *node.Snapshot) []*host.Host {
+	hosts := make([]*host.Host, 0, len(discoveryNodes))
+	accessor := node.NewAccessor(snapshot)
+	for _, n := range discoveryNodes {
+		active := accessor.GetActiveNode(*n.GetNodeRef())
+		if active == nil {
+			continue
+		}
+		h, err := host.NewHostNS(active.Address(), active.ID(), active.ShortID())
+		if err == nil {
+			hosts = append(hosts, h)
+		}
+	}
+	return hosts
+}
+
+// bootstrapHosts returns hosts of discovery nodes to authorize to. Discovery nodes that are active in the snapshot go
+// first with addresses they had in the snapshot, so the node rejoins through nodes that were recently in the network
+// even if their addresses differ from the certificate. Certificate addresses of all discovery nodes follow in order.
+func bootstrapHosts(discoveryNodes []insolar.DiscoveryNode, snapshot *node.Snapshot) []*host.Host {
+	var hosts []*host.Host
+	if snapshot != nil {
+		hosts = activeHosts(discoveryNodes, snapshot)
+	}
+
+	for _, n := range discoveryNodes {
+		h, err := host.NewHostN(n.GetHost(), *n.GetNodeRef())
+		if err == nil && !containsHost(hosts, h) {
+			hosts = append(hosts, h)
+		}
+	}
+	return hosts
+}
+
+func containsHost(hosts []*host.Host, h *host.Host) bool {
+	for _, other := range hosts {
+		if other.Equal(*h) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,206 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/certificate"
+	"github.com/insolar/insolar/cryptography"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network/hostnetwork/host"
+	"github.com/insolar/insolar/network/hostnetwork/packet"
+	"github.com/insolar/insolar/network/node"
+	"github.com/insolar/insolar/network/storage"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestBase_RestoreSnapshot(t *testing.T) {
+	ctx := context.Background()
+
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber + 100)
+	n := node.NewNode(testutils.RandomRef(), insolar.StaticRoleVirtual, nil, "127.0.0.1:22", "")
+	snapshot := node.NewSnapshot(pn, []insolar.NetworkNode{n})
+
+	snapshots := storage.NewMemorySnapshotStorage()
+	require.NoError(t, snapshots.Append(pn, snapshot))
+
+	b := &Base{SnapshotStorage: snapshots, ReconnectMaxAge: time.Minute}
+
+	fresh := insolar.Pulse{PulseNumber: pn, PulseTimestamp: time.Now().UnixNano()}
+	restored, ok := b.restoreSnapshot(ctx, fresh)
+	require.True(t, ok)
+	assert.True(t, snapshot.Equal(restored))
+
+	old := insolar.Pulse{PulseNumber: pn, PulseTimestamp: time.Now().Add(-time.Hour).UnixNano()}
+	_, ok = b.restoreSnapshot(ctx, old)
+	assert.False(t, ok)
+
+	unknown := insolar.Pulse{PulseNumber: pn + 10, PulseTimestamp: time.Now().UnixNano()}
+	_, ok = b.restoreSnapshot(ctx, unknown)
+	assert.False(t, ok)
+
+	_, ok = b.restoreSnapshot(ctx, *insolar.EphemeralPulse)
+	assert.False(t, ok)
+
+	b.ReconnectMaxAge = 0
+	_, ok = b.restoreSnapshot(ctx, fresh)
+	assert.False(t, ok)
+}
+
+func TestBase_CheckReconnect(t *testing.T) {
+	ctx := context.Background()
+
+	keyProcessor := platformpolicy.NewKeyProcessor()
+	privateKey, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+	joinerCrypto := cryptography.NewKeyBoundCryptographyService(privateKey)
+	discoveryKey, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	ref := testutils.RandomRef()
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber + 100)
+	n := node.NewNode(ref, insolar.StaticRoleVirtual, keyProcessor.ExtractPublicKey(privateKey), "127.0.0.1:22", "")
+	cloudHash := []byte{1, 2, 3}
+
+	pulses := storage.NewMemoryPulseStorage()
+	require.NoError(t, pulses.AppendPulse(ctx, insolar.Pulse{PulseNumber: pn, PulseTimestamp: time.Now().UnixNano()}))
+	snapshots := storage.NewMemorySnapshotStorage()
+	require.NoError(t, snapshots.Append(pn, node.NewSnapshot(pn, []insolar.NetworkNode{n})))
+	cloudHashes := storage.NewMemoryCloudHashStorage()
+	require.NoError(t, cloudHashes.Append(pn, cloudHash))
+
+	b := &Base{
+		PulseAccessor:       pulses,
+		SnapshotStorage:     snapshots,
+		CloudHashStorage:    cloudHashes,
+		CryptographyService: cryptography.NewKeyBoundCryptographyService(discoveryKey),
+		ReconnectMaxAge:     time.Minute,
+	}
+
+	request := func(pn insolar.PulseNumber, cloudHash []byte) *packet.ReconnectRequest {
+		data := &packet.AuthorizeData{Version: "v1", Timestamp: time.Now().Unix()}
+		raw, err := data.Marshal()
+		require.NoError(t, err)
+		signature, err := joinerCrypto.Sign(raw)
+		require.NoError(t, err)
+		return &packet.ReconnectRequest{
+			Authorize: &packet.AuthorizeRequest{AuthorizeData: data, Signature: signature.Bytes()},
+			Pulse:     pn,
+			CloudHash: cloudHash,
+		}
+	}
+
+	assert.NoError(t, b.checkReconnect(ctx, ref, request(pn, cloudHash)))
+
+	// node was not active in the snapshot
+	assert.Error(t, b.checkReconnect(ctx, testutils.RandomRef(), request(pn, cloudHash)))
+	// cloud hash differs
+	assert.Error(t, b.checkReconnect(ctx, ref, request(pn, []byte{3, 2, 1})))
+	// unknown pulse
+	assert.Error(t, b.checkReconnect(ctx, ref, request(pn+10, cloudHash)))
+
+	// authorize data is not signed with the node key
+	forged := request(pn, cloudHash)
+	forged.Authorize.AuthorizeData.Version = "v2"
+	assert.Error(t, b.checkReconnect(ctx, ref, forged))
+
+	b.ReconnectMaxAge = time.Nanosecond
+	assert.Error(t, b.checkReconnect(ctx, ref, request(pn, cloudHash)))
+}
+
+func TestBootstrapHosts(t *testing.T) {
+	refs := []insolar.Reference{testutils.RandomRef(), testutils.RandomRef(), testutils.RandomRef()}
+
+	discoveryNodes := []insolar.DiscoveryNode{
+		certificate.NewBootstrapNode(nil, "", "127.0.0.1:1000", refs[0].String(), "virtual"),
+		certificate.NewBootstrapNode(nil, "", "127.0.0.1:1001", refs[1].String(), "virtual"),
+		certificate.NewBootstrapNode(nil, "", "127.0.0.1:1002", refs[2].String(), "virtual"),
+	}
+
+	active := []insolar.NetworkNode{
+		// address of the node has changed since certificate was issued
+		node.NewNode(refs[2], insolar.StaticRoleVirtual, nil, "127.0.0.1:2002", ""),
+		node.NewNode(refs[1], insolar.StaticRoleVirtual, nil, "127.0.0.1:1001", ""),
+	}
+	snapshot := node.NewSnapshot(insolar.PulseNumber(insolar.FirstPulseNumber+100), active)
+
+	addresses := func(hosts []*host.Host) []string {
+		result := make([]string, 0, len(hosts))
+		for _, h := range hosts {
+			result = append(result, h.NodeID.String()+"@"+h.Address.String())
+		}
+		return result
+	}
+	address := func(ref insolar.Reference, addr string) string {
+		return ref.String() + "@" + addr
+	}
+
+	assert.Equal(t, []string{
+		address(refs[0], "127.0.0.1:1000"),
+		address(refs[1], "127.0.0.1:1001"),
+		address(refs[2], "127.0.0.1:1002"),
+	}, addresses(bootstrapHosts(discoveryNodes, nil)))
+
+	// active discovery nodes go first with addresses from the snapshot, certificate addresses are kept as fallback
+	assert.Equal(t, []string{
+		address(refs[1], "127.0.0.1:1001"),
+		address(refs[2], "127.0.0.1:2002"),
+		address(refs[0], "127.0.0.1:1000"),
+		address(refs[2], "127.0.0.1:1002"),
+	}, addresses(bootstrapHosts(discoveryNodes, snapshot)))
+}
@@ -0,0 +1,172 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package compression
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+)
+
+//go:generate stringer -type=Codec
+
+// Codec is a compression algorithm of a payload.
+type Codec uint32
+
+const (
+	// None means payload is sent as is.
+	None Codec = iota
+	// Snappy is a fast compression with moderate ratio.
+	Snappy
+	// Flate is a DEFLATE compression, slower than Snappy but with better ratio.
+	Flate
+)
+
+var codecsByName = map[string]Codec{
+	"snappy": Snappy,
+	"flate":  Flate,
+}
+
+// Set is a bitmask of codecs a node is able to decode, it is advertised to peers in handshake.
+type Set uint32
+
+// NewSet returns set of given codecs.
+func NewSet(codecs ...Codec) Set {
+	var s Set
+	for _, c := range codecs {
+		if c != None {
+			s |= 1 << c
+		}
+	}
+	return s
+}
+
+// Has checks if codec is in set.
+func (s Set) Has(c Codec) bool {
+	return c == None || s&(1<<c) != 0
+}
+
+// ParseCodecs converts codec names from configuration to codecs list keeping the order of preference.
+func ParseCodecs(names []string) ([]Codec, error) {
+	result := make([]Codec, 0, len(names))
+	for _, name := range names {
+		c, ok := codecsByName[strings.ToLower(name)]
+		if !ok {
+			return nil, errors.Errorf("unknown compression codec %s", name)
+		}
+		result = append(result, c)
+	}
+	return result, nil
+}
+
+// Compress encodes data with codec.
+func Compress(c Codec, data []byte) ([]byte, error) {
+	switch c {
+	case None:
+		return data, nil
+	case Snappy:
+		return snappy.Encode(nil, data), nil
+	case Flate:
+		buf := &bytes.Buffer{}
+		w, err := flate.NewWriter(buf, flate.DefaultCompression)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create flate writer")
+		}
+		if _, err = w.Write(data); err != nil {
+			return nil, errors.Wrap(err, "failed to compress data")
+		}
+		if err = w.Close(); err != nil {
+			return nil, errors.Wrap(err, "failed to compress data")
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, errors.Errorf("unsupported compression codec %s", c)
+	}
+}
+
+// Decompress decodes data compressed with codec. Decoded data bigger than maxSize bytes is rejected.
+func Decompress(c Codec, data []byte, maxSize int) ([]byte, error) {
+	switch c {
+	case None:
+		return data, nil
+	case Snappy:
+		size, err := snappy.DecodedLen(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decompress data")
+		}
+		if size > maxSize {
+			return nil, errors.Errorf("decompressed size %d exceeds limit %d", size, maxSize)
+		}
+		result, err := snappy.Decode(nil, data)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decompress data")
+		}
+		return result, nil
+	case Flate:
+		r := flate.NewReader(bytes.NewReader(data))
+		defer r.Close()
+		result, err := ioutil.ReadAll(io.LimitReader(r, int64(maxSize)+1))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decompress data")
+		}
+		if len(result) > maxSize {
+			return nil, errors.Errorf("decompressed size exceeds limit %d", maxSize)
+		}
+		return result, nil
+	default:
+		return nil, errors.Errorf("unsupported compression codec %s", c)
+	}
+}
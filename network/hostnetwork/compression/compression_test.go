@@ -0,0 +1,109 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package compression
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressDecompress(t *testing.T) {
+	data := bytes.Repeat([]byte("replication payload "), 1000)
+
+	for _, c := range []Codec{None, Snappy, Flate} {
+		t.Run(c.String(), func(t *testing.T) {
+			compressed, err := Compress(c, data)
+			require.NoError(t, err)
+			if c != None {
+				assert.True(t, len(compressed) < len(data))
+			}
+
+			decompressed, err := Decompress(c, compressed, len(data))
+			require.NoError(t, err)
+			assert.Equal(t, data, decompressed)
+
+			if c != None {
+				_, err = Decompress(c, compressed, len(data)-1)
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDecompress_Corrupted(t *testing.T) {
+	for _, c := range []Codec{Snappy, Flate} {
+		_, err := Decompress(c, []byte{0xff, 0xff, 0xff, 0xff, 0xff}, 1024)
+		assert.Error(t, err, c.String())
+	}
+
+	_, err := Decompress(Codec(42), []byte{1}, 1024)
+	assert.Error(t, err)
+}
+
+func TestSet(t *testing.T) {
+	s := NewSet(Snappy)
+	assert.True(t, s.Has(None))
+	assert.True(t, s.Has(Snappy))
+	assert.False(t, s.Has(Flate))
+	assert.Equal(t, Set(0), NewSet(None))
+}
+
+func TestParseCodecs(t *testing.T) {
+	codecs, err := ParseCodecs([]string{"flate", "Snappy"})
+	require.NoError(t, err)
+	assert.Equal(t, []Codec{Flate, Snappy}, codecs)
+
+	_, err = ParseCodecs([]string{"zip"})
+	assert.Error(t, err)
+}
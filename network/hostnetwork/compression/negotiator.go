@@ -0,0 +1,156 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package compression
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+// Negotiator keeps sets of codecs accepted by peers and compresses payloads sent to them.
+type Negotiator struct {
+	preference []Codec
+	accepted   Set
+	threshold  int
+	maxSize    int
+
+	lock  sync.RWMutex
+	peers map[insolar.Reference]Set
+}
+
+// NewNegotiator creates Negotiator from configuration.
+func NewNegotiator(cfg configuration.Compression) (*Negotiator, error) {
+	preference, err := ParseCodecs(cfg.Codecs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse compression codecs")
+	}
+	if len(preference) > 0 && cfg.MaxSize <= 0 {
+		return nil, errors.New("compression max size should be positive")
+	}
+
+	return &Negotiator{
+		preference: preference,
+		accepted:   NewSet(preference...),
+		threshold:  cfg.Threshold,
+		maxSize:    cfg.MaxSize,
+		peers:      make(map[insolar.Reference]Set),
+	}, nil
+}
+
+// Accepted returns set of codecs this node is able to decode.
+func (n *Negotiator) Accepted() Set {
+	return n.accepted
+}
+
+// Learn remembers set of codecs accepted by peer.
+func (n *Negotiator) Learn(peer insolar.Reference, accepted Set) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.peers[peer] = accepted
+}
+
+// Codec returns the most preferable codec accepted by peer, None if peer is unknown or has nothing in common with us.
+func (n *Negotiator) Codec(peer insolar.Reference) Codec {
+	n.lock.RLock()
+	accepted := n.peers[peer]
+	n.lock.RUnlock()
+
+	for _, c := range n.preference {
+		if accepted.Has(c) {
+			return c
+		}
+	}
+	return None
+}
+
+// Compress compresses data with the codec negotiated with peer.
+// Data smaller than threshold or data that can't be compressed is returned as is with None codec.
+func (n *Negotiator) Compress(ctx context.Context, peer insolar.Reference, data []byte) ([]byte, Codec) {
+	if len(data) < n.threshold {
+		return data, None
+	}
+	codec := n.Codec(peer)
+	if codec == None {
+		return data, None
+	}
+
+	compressed, err := Compress(codec, data)
+	if err != nil {
+		inslogger.FromContext(ctx).Warnf("failed to compress payload with %s: %s", codec, err)
+		return data, None
+	}
+
+	ctx = insmetrics.InsertTag(ctx, tagCodec, codec.String())
+	if len(compressed) >= len(data) {
+		stats.Record(ctx, statIncompressible.M(1))
+		return data, None
+	}
+
+	stats.Record(ctx, statCompressed.M(1), statBytesSaved.M(int64(len(data)-len(compressed))))
+	return compressed, codec
+}
+
+// Decompress decodes data received from peer compressed with codec.
+func (n *Negotiator) Decompress(ctx context.Context, codec Codec, data []byte) ([]byte, error) {
+	if !n.accepted.Has(codec) {
+		return nil, errors.Errorf("compression codec %s is not accepted by this node", codec)
+	}
+	return Decompress(codec, data, n.maxSize)
+}
@@ -0,0 +1,136 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package compression
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/testutils"
+)
+
+func newNegotiator(t *testing.T, codecs ...string) *Negotiator {
+	n, err := NewNegotiator(configuration.Compression{Codecs: codecs, Threshold: 100, MaxSize: 1 << 20})
+	require.NoError(t, err)
+	return n
+}
+
+func TestNewNegotiator(t *testing.T) {
+	_, err := NewNegotiator(configuration.Compression{Codecs: []string{"lz4"}, MaxSize: 1 << 20})
+	assert.Error(t, err)
+
+	_, err = NewNegotiator(configuration.Compression{Codecs: []string{"snappy"}})
+	assert.Error(t, err)
+
+	n, err := NewNegotiator(configuration.Compression{})
+	require.NoError(t, err)
+	assert.Equal(t, Set(0), n.Accepted())
+}
+
+func TestNegotiator_Codec(t *testing.T) {
+	n := newNegotiator(t, "flate", "snappy")
+	peer := testutils.RandomRef()
+
+	assert.Equal(t, None, n.Codec(peer))
+
+	n.Learn(peer, NewSet(Snappy))
+	assert.Equal(t, Snappy, n.Codec(peer))
+
+	n.Learn(peer, NewSet(Snappy, Flate))
+	assert.Equal(t, Flate, n.Codec(peer))
+
+	n.Learn(peer, Set(0))
+	assert.Equal(t, None, n.Codec(peer))
+}
+
+func TestNegotiator_CompressDecompress(t *testing.T) {
+	ctx := WithPayloadType(context.Background(), "TypeReplication")
+	sender := newNegotiator(t, "snappy")
+	receiver := newNegotiator(t, "snappy", "flate")
+	peer := testutils.RandomRef()
+	sender.Learn(peer, receiver.Accepted())
+
+	small := []byte("small")
+	data, codec := sender.Compress(ctx, peer, small)
+	assert.Equal(t, None, codec)
+	assert.Equal(t, small, data)
+
+	big := bytes.Repeat([]byte("hot objects "), 100)
+	data, codec = sender.Compress(ctx, peer, big)
+	assert.Equal(t, Snappy, codec)
+	assert.True(t, len(data) < len(big))
+
+	decompressed, err := receiver.Decompress(ctx, codec, data)
+	require.NoError(t, err)
+	assert.Equal(t, big, decompressed)
+
+	_, err = newNegotiator(t, "flate").Decompress(ctx, codec, data)
+	assert.Error(t, err)
+}
+
+func TestNegotiator_Incompressible(t *testing.T) {
+	n := newNegotiator(t, "snappy")
+	peer := testutils.RandomRef()
+	n.Learn(peer, NewSet(Snappy))
+
+	data := make([]byte, 1024)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+	result, codec := n.Compress(context.Background(), peer, data)
+	assert.Equal(t, None, codec)
+	assert.Equal(t, data, result)
+}
@@ -0,0 +1,374 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package guard
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+	"github.com/insolar/insolar/network/hostnetwork/packet/types"
+)
+
+// cleanupInterval is a period of dropping state of peers that have neither used limits nor penalties.
+const cleanupInterval = time.Minute
+
+type bucketKey struct {
+	host       string
+	node       insolar.Reference
+	packetType types.PacketType
+}
+
+// Peer is a remote side of request. Node is set only if request is authenticated as sent by active node, such peers
+// are tracked by node reference. Unauthenticated peers are tracked by host of address.
+type Peer struct {
+	Address string
+	Node    insolar.Reference
+}
+
+// Authenticated reports whether peer is identified by node reference.
+func (p Peer) Authenticated() bool {
+	return !p.Node.IsEmpty()
+}
+
+type rate struct {
+	rate  float64
+	burst float64
+}
+
+// Guard limits request rates of remote peers per packet type and temporarily bans peers with bad reputation.
+// Authenticated peers are identified by node reference. Unauthenticated peers are identified by host of remote
+// address, since the port of inbound connection is ephemeral and a peer could reset its limits by reconnecting.
+// Guard also limits the number of inbound connections served at once.
+type Guard struct {
+	rates          map[types.PacketType]rate
+	defaultRate    rate
+	globalRates    map[types.PacketType]rate
+	maxConnections int
+	maxInbound     int
+	banScore       float64
+	banDuration    time.Duration
+	scoreDecay     float64
+	now            func() time.Time
+
+	lock        sync.Mutex
+	inbound     int
+	buckets     map[bucketKey]*bucket
+	global      map[types.PacketType]*bucket
+	peers       map[string]*reputation
+	nodes       map[insolar.Reference]*reputation
+	lastCleanup time.Time
+}
+
+// NewGuard creates Guard from configuration.
+func NewGuard(cfg configuration.PeerGuard) (*Guard, error) {
+	if cfg.Rate < 0 || cfg.BurstInterval < 0 {
+		return nil, errors.New("rate and burst interval should not be negative")
+	}
+	if cfg.BanScore <= 0 {
+		return nil, errors.New("ban score should be positive")
+	}
+
+	burst := cfg.BurstInterval.Seconds()
+	rates, err := parseRates(cfg.PacketRates, burst)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse packet rates")
+	}
+	globalRates, err := parseRates(cfg.GlobalPacketRates, burst)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse global packet rates")
+	}
+
+	return &Guard{
+		rates:          rates,
+		defaultRate:    newRate(cfg.Rate, burst),
+		globalRates:    globalRates,
+		maxConnections: cfg.MaxConnections,
+		maxInbound:     cfg.MaxInboundConnections,
+		banScore:       cfg.BanScore,
+		banDuration:    cfg.BanDuration,
+		scoreDecay:     cfg.ScoreDecay,
+		now:            time.Now,
+
+		buckets:     make(map[bucketKey]*bucket),
+		global:      make(map[types.PacketType]*bucket),
+		peers:       make(map[string]*reputation),
+		nodes:       make(map[insolar.Reference]*reputation),
+		lastCleanup: time.Now(),
+	}, nil
+}
+
+func newRate(perSecond float64, burstInterval float64) rate {
+	burst := perSecond * burstInterval
+	if burst < 1 {
+		burst = 1
+	}
+	return rate{rate: perSecond, burst: burst}
+}
+
+func parseRates(rates map[string]float64, burstInterval float64) (map[types.PacketType]rate, error) {
+	result := make(map[types.PacketType]rate, len(rates))
+	for name, perSecond := range rates {
+		t, err := ParsePacketType(name)
+		if err != nil {
+			return nil, err
+		}
+		if perSecond < 0 {
+			return nil, errors.Errorf("rate of %s should not be negative", name)
+		}
+		result[t] = newRate(perSecond, burstInterval)
+	}
+	return result, nil
+}
+
+// ParsePacketType returns packet type by its case insensitive name.
+func ParsePacketType(name string) (types.PacketType, error) {
+	// stringer names unknown values as PacketType(N), so iteration stops after the last declared type
+	for t := types.Unknown + 1; !strings.HasPrefix(t.String(), "PacketType("); t++ {
+		if strings.EqualFold(t.String(), name) {
+			return t, nil
+		}
+	}
+	return types.Unknown, errors.Errorf("unknown packet type %s", name)
+}
+
+// Allow reports whether request of packet type t from peer should be processed. Requests of banned peers and requests
+// above rate limits are not allowed, the latter also lowers reputation of unauthenticated peer.
+func (g *Guard) Allow(ctx context.Context, peer Peer, t types.PacketType) bool {
+	now := g.now()
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.cleanup(now)
+	if r, ok := g.reputation(peer); ok && r.banned(now) {
+		stats.Record(insmetrics.InsertTag(ctx, tagPacketType, t.String()), statRejected.M(1))
+		return false
+	}
+
+	if g.takeGlobal(now, t) && g.take(now, peer, t) {
+		return true
+	}
+
+	stats.Record(insmetrics.InsertTag(ctx, tagPacketType, t.String()), statRateLimited.M(1))
+	// active nodes may burst legitimately, e.g. after pulse change, so dropping their requests is enough
+	if !peer.Authenticated() {
+		g.penalize(ctx, now, peer, RateLimited)
+	}
+	return false
+}
+
+func (g *Guard) take(now time.Time, peer Peer, t types.PacketType) bool {
+	r, ok := g.rates[t]
+	if !ok {
+		r = g.defaultRate
+	}
+	if r.rate == 0 {
+		return true
+	}
+
+	key := bucketKey{host: hostOf(peer.Address), packetType: t}
+	if peer.Authenticated() {
+		key = bucketKey{node: peer.Node, packetType: t}
+	}
+	b, ok := g.buckets[key]
+	if !ok {
+		b = newBucket(r.rate, r.burst, now)
+		g.buckets[key] = b
+	}
+	return b.take(now)
+}
+
+func (g *Guard) takeGlobal(now time.Time, t types.PacketType) bool {
+	r, ok := g.globalRates[t]
+	if !ok || r.rate == 0 {
+		return true
+	}
+
+	b, ok := g.global[t]
+	if !ok {
+		b = newBucket(r.rate, r.burst, now)
+		g.global[t] = b
+	}
+	return b.take(now)
+}
+
+// Penalize lowers reputation of peer for offense, peer is banned when its penalty score reaches the limit.
+func (g *Guard) Penalize(ctx context.Context, peer Peer, offense Offense) {
+	now := g.now()
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.penalize(ctx, now, peer, offense)
+}
+
+func (g *Guard) penalize(ctx context.Context, now time.Time, peer Peer, offense Offense) {
+	r, ok := g.reputation(peer)
+	if !ok {
+		r = &reputation{last: now}
+		if peer.Authenticated() {
+			g.nodes[peer.Node] = r
+		} else {
+			g.peers[hostOf(peer.Address)] = r
+		}
+	}
+	if r.banned(now) {
+		return
+	}
+
+	ctx = insmetrics.InsertTag(ctx, tagOffense, offense.String())
+	stats.Record(ctx, statPenalties.M(1))
+	if r.penalize(now, penalties[offense], g) {
+		stats.Record(ctx, statBans.M(1))
+	}
+}
+
+// reputation returns reputation of node for authenticated peer and reputation of host otherwise.
+func (g *Guard) reputation(peer Peer) (*reputation, bool) {
+	if peer.Authenticated() {
+		r, ok := g.nodes[peer.Node]
+		return r, ok
+	}
+	r, ok := g.peers[hostOf(peer.Address)]
+	return r, ok
+}
+
+// Banned reports whether host of address is temporarily banned for unauthenticated requests.
+func (g *Guard) Banned(address string) bool {
+	now := g.now()
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	r, ok := g.peers[hostOf(address)]
+	return ok && r.banned(now)
+}
+
+// MaxConnections returns number of connections kept in connection pool, 0 means unlimited.
+func (g *Guard) MaxConnections() int {
+	return g.maxConnections
+}
+
+// AcceptConnection reports whether one more inbound connection can be served. Accepted connection
+// should be released by ReleaseConnection when it's closed.
+func (g *Guard) AcceptConnection(ctx context.Context) bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.maxInbound > 0 && g.inbound >= g.maxInbound {
+		stats.Record(ctx, statConnectionsRejected.M(1))
+		return false
+	}
+	g.inbound++
+	return true
+}
+
+// ReleaseConnection releases inbound connection accepted by AcceptConnection.
+func (g *Guard) ReleaseConnection() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.inbound--
+}
+
+// cleanup drops buckets and reputations equal to initial ones so idle peers don't consume memory.
+func (g *Guard) cleanup(now time.Time) {
+	if now.Sub(g.lastCleanup) < cleanupInterval {
+		return
+	}
+	g.lastCleanup = now
+
+	for key, b := range g.buckets {
+		if b.full(now) {
+			delete(g.buckets, key)
+		}
+	}
+	for peer, r := range g.peers {
+		if r.clean(now, g.scoreDecay) {
+			delete(g.peers, peer)
+		}
+	}
+	for node, r := range g.nodes {
+		if r.clean(now, g.scoreDecay) {
+			delete(g.nodes, node)
+		}
+	}
+}
+
+func hostOf(address string) string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	return host
+}
+
+type peerKey struct{}
+
+// WithPeer returns context with peer the request is received from.
+func WithPeer(ctx context.Context, peer Peer) context.Context {
+	return context.WithValue(ctx, peerKey{}, peer)
+}
+
+// PeerFromContext returns peer the request is received from.
+func PeerFromContext(ctx context.Context) (Peer, bool) {
+	peer, ok := ctx.Value(peerKey{}).(Peer)
+	return peer, ok
+}
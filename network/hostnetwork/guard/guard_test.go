@@ -0,0 +1,266 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package guard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/network/hostnetwork/packet/types"
+	"github.com/insolar/insolar/testutils"
+)
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func (c *clock) Add(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestGuard(t *testing.T, cfg configuration.PeerGuard) (*Guard, *clock) {
+	g, err := NewGuard(cfg)
+	require.NoError(t, err)
+	c := &clock{now: time.Now()}
+	g.now = c.Now
+	g.lastCleanup = c.now
+	return g, c
+}
+
+func testConfig() configuration.PeerGuard {
+	return configuration.PeerGuard{
+		Rate:              100,
+		PacketRates:       map[string]float64{"authorize": 1},
+		GlobalPacketRates: map[string]float64{},
+		BurstInterval:     2 * time.Second,
+		BanScore:          100,
+		BanDuration:       time.Minute,
+		ScoreDecay:        1,
+	}
+}
+
+func TestNewGuard(t *testing.T) {
+	_, err := NewGuard(configuration.NewHostNetwork().PeerGuard)
+	require.NoError(t, err)
+
+	cfg := testConfig()
+	cfg.PacketRates = map[string]float64{"Unknown packet": 1}
+	_, err = NewGuard(cfg)
+	require.Error(t, err)
+
+	cfg = testConfig()
+	cfg.BanScore = 0
+	_, err = NewGuard(cfg)
+	require.Error(t, err)
+}
+
+func TestParsePacketType(t *testing.T) {
+	pt, err := ParsePacketType("Authorize")
+	require.NoError(t, err)
+	assert.Equal(t, types.Authorize, pt)
+
+	pt, err = ParsePacketType("reconnect")
+	require.NoError(t, err)
+	assert.Equal(t, types.Reconnect, pt)
+
+	_, err = ParsePacketType("Unknown")
+	require.Error(t, err)
+}
+
+func TestGuard_Allow(t *testing.T) {
+	ctx := context.Background()
+	g, c := newTestGuard(t, testConfig())
+
+	// burst of Authorize is 2 requests
+	assert.True(t, g.Allow(ctx, Peer{Address: "127.0.0.1:1000"}, types.Authorize))
+	assert.True(t, g.Allow(ctx, Peer{Address: "127.0.0.1:1000"}, types.Authorize))
+	assert.False(t, g.Allow(ctx, Peer{Address: "127.0.0.1:1000"}, types.Authorize))
+
+	// limits are separate for every host and packet type, but shared by all ports of the host
+	assert.False(t, g.Allow(ctx, Peer{Address: "127.0.0.1:2000"}, types.Authorize))
+	assert.True(t, g.Allow(ctx, Peer{Address: "127.0.0.2:1000"}, types.Authorize))
+	assert.True(t, g.Allow(ctx, Peer{Address: "127.0.0.1:1000"}, types.Ping))
+
+	c.Add(time.Second)
+	assert.True(t, g.Allow(ctx, Peer{Address: "127.0.0.1:1000"}, types.Authorize))
+	assert.False(t, g.Allow(ctx, Peer{Address: "127.0.0.1:1000"}, types.Authorize))
+}
+
+func TestGuard_Allow_Global(t *testing.T) {
+	ctx := context.Background()
+	cfg := testConfig()
+	cfg.GlobalPacketRates = map[string]float64{"authorize": 1}
+	g, c := newTestGuard(t, cfg)
+
+	assert.True(t, g.Allow(ctx, Peer{Address: "127.0.0.1:1000"}, types.Authorize))
+	assert.True(t, g.Allow(ctx, Peer{Address: "127.0.0.2:1000"}, types.Authorize))
+	assert.False(t, g.Allow(ctx, Peer{Address: "127.0.0.3:1000"}, types.Authorize))
+
+	c.Add(time.Second)
+	assert.True(t, g.Allow(ctx, Peer{Address: "127.0.0.3:1000"}, types.Authorize))
+}
+
+func TestGuard_Allow_Unlimited(t *testing.T) {
+	ctx := context.Background()
+	cfg := testConfig()
+	cfg.Rate = 0
+	g, _ := newTestGuard(t, cfg)
+
+	for i := 0; i < 1000; i++ {
+		require.True(t, g.Allow(ctx, Peer{Address: "127.0.0.1:1000"}, types.RPC))
+	}
+}
+
+func TestGuard_Penalize(t *testing.T) {
+	ctx := context.Background()
+	g, c := newTestGuard(t, testConfig())
+
+	for i := 0; i < 9; i++ {
+		g.Penalize(ctx, Peer{Address: "127.0.0.1:1000"}, Malformed)
+	}
+	assert.False(t, g.Banned("127.0.0.1:1000"))
+
+	// score decays over time
+	c.Add(10 * time.Second)
+	g.Penalize(ctx, Peer{Address: "127.0.0.1:1000"}, Malformed)
+	assert.False(t, g.Banned("127.0.0.1:1000"))
+
+	g.Penalize(ctx, Peer{Address: "127.0.0.1:1000"}, Unauthenticated)
+	assert.True(t, g.Banned("127.0.0.1:1000"))
+
+	// ban applies to all ports of the host
+	assert.True(t, g.Banned("127.0.0.1:2000"))
+	assert.False(t, g.Allow(ctx, Peer{Address: "127.0.0.1:2000"}, types.Ping))
+	assert.False(t, g.Banned("127.0.0.2:1000"))
+
+	c.Add(time.Minute)
+	assert.False(t, g.Banned("127.0.0.1:1000"))
+	assert.True(t, g.Allow(ctx, Peer{Address: "127.0.0.1:2000"}, types.Ping))
+}
+
+func TestGuard_RateLimitedPeerIsBanned(t *testing.T) {
+	ctx := context.Background()
+	g, _ := newTestGuard(t, testConfig())
+
+	for i := 0; i < 2+100; i++ {
+		g.Allow(ctx, Peer{Address: "127.0.0.1:1000"}, types.Authorize)
+	}
+	assert.True(t, g.Banned("127.0.0.1:1000"))
+}
+
+func TestGuard_AuthenticatedPeer(t *testing.T) {
+	ctx := context.Background()
+	g, _ := newTestGuard(t, testConfig())
+	node := Peer{Address: "127.0.0.1:1000", Node: testutils.RandomRef()}
+
+	// rate limited requests of active node are dropped without penalty
+	for i := 0; i < 2+100; i++ {
+		g.Allow(ctx, node, types.Authorize)
+	}
+	assert.Empty(t, g.nodes)
+	assert.False(t, g.Banned("127.0.0.1:1000"))
+
+	// limits of node are separate from limits of its address
+	assert.True(t, g.Allow(ctx, Peer{Address: "127.0.0.1:1000"}, types.Authorize))
+
+	// node is penalized by reference, host stays allowed for other peers
+	for i := 0; i < 5; i++ {
+		g.Penalize(ctx, node, Unauthenticated)
+	}
+	assert.False(t, g.Allow(ctx, node, types.Ping))
+	assert.False(t, g.Allow(ctx, Peer{Address: "127.0.0.2:1000", Node: node.Node}, types.Ping))
+	assert.False(t, g.Banned("127.0.0.1:1000"))
+	assert.True(t, g.Allow(ctx, Peer{Address: "127.0.0.1:1000"}, types.Ping))
+}
+
+func TestGuard_AcceptConnection(t *testing.T) {
+	ctx := context.Background()
+	cfg := testConfig()
+	cfg.MaxInboundConnections = 2
+	g, _ := newTestGuard(t, cfg)
+
+	assert.True(t, g.AcceptConnection(ctx))
+	assert.True(t, g.AcceptConnection(ctx))
+	assert.False(t, g.AcceptConnection(ctx))
+
+	g.ReleaseConnection()
+	assert.True(t, g.AcceptConnection(ctx))
+
+	cfg.MaxInboundConnections = 0
+	g, _ = newTestGuard(t, cfg)
+	for i := 0; i < 1000; i++ {
+		require.True(t, g.AcceptConnection(ctx))
+	}
+}
+
+func TestGuard_Cleanup(t *testing.T) {
+	ctx := context.Background()
+	g, c := newTestGuard(t, testConfig())
+
+	g.Allow(ctx, Peer{Address: "127.0.0.1:1000"}, types.Authorize)
+	g.Penalize(ctx, Peer{Address: "127.0.0.2:1000"}, Malformed)
+	assert.Len(t, g.buckets, 1)
+	assert.Len(t, g.peers, 1)
+
+	c.Add(cleanupInterval)
+	g.Allow(ctx, Peer{Address: "127.0.0.3:1000"}, types.Ping)
+	assert.Len(t, g.buckets, 1)
+	assert.Empty(t, g.peers)
+}
@@ -0,0 +1,124 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package guard
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+var (
+	tagPacketType = insmetrics.MustTagKey("packetType")
+	tagOffense    = insmetrics.MustTagKey("offense")
+)
+
+var (
+	statRateLimited = stats.Int64(
+		"network/guard/rate_limited",
+		"number of requests dropped because peer exceeded rate limit",
+		stats.UnitDimensionless,
+	)
+	statRejected = stats.Int64(
+		"network/guard/rejected",
+		"number of requests dropped because peer is banned",
+		stats.UnitDimensionless,
+	)
+	statPenalties = stats.Int64(
+		"network/guard/penalties",
+		"number of peer offenses",
+		stats.UnitDimensionless,
+	)
+	statBans = stats.Int64(
+		"network/guard/bans",
+		"number of peers banned",
+		stats.UnitDimensionless,
+	)
+	statConnectionsRejected = stats.Int64(
+		"network/guard/connections_rejected",
+		"number of inbound connections closed because of connection limit",
+		stats.UnitDimensionless,
+	)
+)
+
+func init() {
+	err := view.Register(
+		&view.View{
+			Measure:     statRateLimited,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagPacketType},
+		},
+		&view.View{
+			Measure:     statRejected,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagPacketType},
+		},
+		&view.View{
+			Measure:     statPenalties,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagOffense},
+		},
+		&view.View{
+			Measure:     statBans,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagOffense},
+		},
+		&view.View{
+			Measure:     statConnectionsRejected,
+			Aggregation: view.Count(),
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package guard
+
+import (
+	"time"
+)
+
+//go:generate stringer -type=Offense
+
+// Offense is a kind of peer misbehavior lowering its reputation.
+type Offense int
+
+const (
+	// RateLimited is sending requests faster than allowed.
+	RateLimited Offense = iota
+	// Malformed is sending packets that can't be parsed or processed.
+	Malformed
+	// Unauthenticated is sending requests with invalid credentials.
+	Unauthenticated
+)
+
+// penalties are penalty scores for offenses.
+var penalties = map[Offense]float64{
+	RateLimited:     1,
+	Malformed:       10,
+	Unauthenticated: 20,
+}
+
+// reputation is a penalty score of a peer. Score decreases over time, peer is banned when it reaches threshold.
+type reputation struct {
+	score       float64
+	last        time.Time
+	bannedUntil time.Time
+}
+
+func (r *reputation) decay(now time.Time, perSecond float64) {
+	if now.After(r.last) {
+		r.score -= perSecond * now.Sub(r.last).Seconds()
+		if r.score < 0 {
+			r.score = 0
+		}
+		r.last = now
+	}
+}
+
+// penalize adds penalty to score and bans peer if score reaches banScore. It returns true if peer was banned.
+func (r *reputation) penalize(now time.Time, penalty float64, g *Guard) bool {
+	r.decay(now, g.scoreDecay)
+	r.score += penalty
+	if r.score < g.banScore {
+		return false
+	}
+	r.score = 0
+	r.bannedUntil = now.Add(g.banDuration)
+	return true
+}
+
+func (r *reputation) banned(now time.Time) bool {
+	return now.Before(r.bannedUntil)
+}
+
+// clean reports whether peer has neither penalties nor ban, such reputation can be dropped.
+func (r *reputation) clean(now time.Time, perSecond float64) bool {
+	r.decay(now, perSecond)
+	return r.score == 0 && !r.banned(now)
+}
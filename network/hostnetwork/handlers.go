@@ -62,6 +62,8 @@ import (
 	"github.com/insolar/insolar/instrumentation/instracer"
 	"github.com/insolar/insolar/metrics"
 	"github.com/insolar/insolar/network/hostnetwork/future"
+	"github.com/insolar/insolar/network/hostnetwork/guard"
+	"github.com/insolar/insolar/network/hostnetwork/host"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/hostnetwork/pool"
 )
@@ -73,27 +75,56 @@ type RequestHandler func(ctx context.Context, p *packet.ReceivedPacket)
 type StreamHandler struct {
 	requestHandler  RequestHandler
 	responseHandler future.PacketHandler
+	guard           network.PeerGuard
+	resolver        network.RoutingTable
 }
 
-// NewStreamHandler creates new StreamHandler
-func NewStreamHandler(requestHandler RequestHandler, responseHandler future.PacketHandler) *StreamHandler {
+// NewStreamHandler creates new StreamHandler. Requests are rate limited by peerGuard, nil means no limits.
+// Sender of request is authenticated as active node via resolver, nil means requests are never authenticated.
+func NewStreamHandler(
+	requestHandler RequestHandler,
+	responseHandler future.PacketHandler,
+	peerGuard network.PeerGuard,
+	resolver network.RoutingTable,
+) *StreamHandler {
 	return &StreamHandler{
 		requestHandler:  requestHandler,
 		responseHandler: responseHandler,
+		guard:           peerGuard,
+		resolver:        resolver,
 	}
 }
 
 func (s *StreamHandler) HandleStream(ctx context.Context, address string, reader io.ReadWriteCloser) {
 	mainLogger := inslogger.FromContext(ctx)
 
+	if s.guard != nil {
+		if s.guard.Banned(address) {
+			mainLogger.Debugf("[ HandleStream ] Peer %s is banned, closing connection", address)
+			network.CloseVerbose(reader)
+			return
+		}
+		if !s.guard.AcceptConnection(ctx) {
+			mainLogger.Warnf("[ HandleStream ] Too many inbound connections, closing connection from %s", address)
+			network.CloseVerbose(reader)
+			return
+		}
+		defer s.guard.ReleaseConnection()
+	}
+
 	logLevel := inslogger.GetLoggerLevel(ctx)
 	// get only log level from context, discard TraceID in favor of packet TraceID
-	packetCtx := inslogger.WithLoggerLevel(context.Background(), logLevel)
+	packetCtx := network.WithComponent(inslogger.WithLoggerLevel(context.Background(), logLevel))
 
-	// context cancel monitoring
+	// context cancel monitoring, stops with the stream since streams may be opened per message
+	done := make(chan struct{})
+	defer close(done)
 	go func() {
-		<-ctx.Done()
-		network.CloseVerbose(reader)
+		select {
+		case <-ctx.Done():
+			network.CloseVerbose(reader)
+		case <-done:
+		}
 	}()
 
 	for {
@@ -112,6 +143,14 @@ func (s *StreamHandler) HandleStream(ctx context.Context, address string, reader
 			}
 
 			mainLogger.Warnf("[ HandleStream ] Failed to deserialize packet: ", err.Error())
+			if s.guard != nil {
+				s.guard.Penalize(ctx, guard.Peer{Address: address}, guard.Malformed)
+				if s.guard.Banned(address) {
+					mainLogger.Warnf("[ HandleStream ] Peer %s is banned, closing connection", address)
+					network.CloseVerbose(reader)
+					return
+				}
+			}
 		} else {
 			packetCtx, logger := inslogger.WithTraceField(packetCtx, p.TraceID)
 			span, err := instracer.Deserialize(p.TraceSpanData)
@@ -124,13 +163,39 @@ func (s *StreamHandler) HandleStream(ctx context.Context, address string, reader
 
 			if p.IsResponse() {
 				go s.responseHandler.Handle(packetCtx, p)
-			} else {
-				go s.requestHandler(packetCtx, p)
+				continue
+			}
+			peer := s.peer(address, p)
+			if s.guard != nil && !s.guard.Allow(packetCtx, peer, p.GetType()) {
+				logger.Warnf("[ HandleStream ] Request %s from %s is dropped by peer guard", p.GetType(), address)
+				continue
 			}
+			go s.requestHandler(guard.WithPeer(packetCtx, peer), p)
 		}
 	}
 }
 
+// peer returns remote side of request. Sender node is trusted only if it is active and its address matches
+// the remote address of stream, otherwise peer is identified by remote address only.
+func (s *StreamHandler) peer(address string, p *packet.ReceivedPacket) guard.Peer {
+	peer := guard.Peer{Address: address}
+	if s.resolver == nil || p.Sender == nil || p.Sender.NodeID.IsEmpty() {
+		return peer
+	}
+
+	remote, err := host.NewAddress(address)
+	if err != nil {
+		return peer
+	}
+	h, err := s.resolver.Resolve(p.Sender.NodeID)
+	if err != nil || h.Address == nil || !h.Address.IP.Equal(remote.IP) {
+		return peer
+	}
+
+	peer.Node = p.Sender.NodeID
+	return peer
+}
+
 // SendPacket sends packet using connection from pool
 func SendPacket(ctx context.Context, pool pool.ConnectionPool, p *packet.Packet) error {
 	data, err := packet.SerializePacket(p)
@@ -57,9 +57,17 @@ import (
 	"time"
 
 	"github.com/fortytw2/leaktest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
 
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/hostnetwork/guard"
+	"github.com/insolar/insolar/network/hostnetwork/host"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
+	"github.com/insolar/insolar/network/hostnetwork/packet/types"
+	"github.com/insolar/insolar/testutils"
+	mock "github.com/insolar/insolar/testutils/network"
 )
 
 func TestNewStreamHandler(t *testing.T) {
@@ -69,7 +77,7 @@ func TestNewStreamHandler(t *testing.T) {
 		inslogger.FromContext(ctx).Info("requestHandler")
 	}
 
-	h := NewStreamHandler(requestHandler, nil)
+	h := NewStreamHandler(requestHandler, nil, nil, nil)
 
 	con1, _ := net.Pipe()
 
@@ -90,3 +98,143 @@ func TestNewStreamHandler(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestStreamHandler_PeerGuard(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	received := make(chan types.PacketType, 2)
+	requestHandler := func(ctx context.Context, p *packet.ReceivedPacket) {
+		peer, ok := guard.PeerFromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, guard.Peer{Address: "127.0.0.1:8080"}, peer)
+		received <- p.GetType()
+	}
+
+	peerGuard := mock.NewPeerGuardMock(t)
+	peerGuard.BannedMock.Return(false)
+	peerGuard.AcceptConnectionMock.Return(true)
+	peerGuard.ReleaseConnectionMock.Return()
+	peerGuard.AllowMock.Set(func(ctx context.Context, peer guard.Peer, t types.PacketType) bool {
+		return t != types.Authorize
+	})
+	h := NewStreamHandler(requestHandler, nil, peerGuard, nil)
+
+	con1, con2 := net.Pipe()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		h.HandleStream(ctx, "127.0.0.1:8080", con1)
+		close(done)
+	}()
+
+	sender, _ := host.NewHost("127.0.0.1:8080")
+	for _, pt := range []types.PacketType{types.Authorize, types.Ping} {
+		data, err := packet.SerializePacket(packet.NewPacket(sender, sender, pt, 1))
+		require.NoError(t, err)
+		_, err = con2.Write(data)
+		require.NoError(t, err)
+	}
+
+	select {
+	case pt := <-received:
+		require.Equal(t, types.Ping, pt)
+	case <-time.After(5 * time.Second):
+		t.Fatal("request is not handled")
+	}
+	require.Empty(t, received)
+
+	cancel()
+	<-done
+}
+
+func TestStreamHandler_PeerGuard_Authenticated(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	active := testutils.RandomRef()
+	unknown := testutils.RandomRef()
+
+	peers := make(chan guard.Peer, 2)
+	requestHandler := func(ctx context.Context, p *packet.ReceivedPacket) {
+		peer, ok := guard.PeerFromContext(ctx)
+		require.True(t, ok)
+		peers <- peer
+	}
+
+	peerGuard := mock.NewPeerGuardMock(t)
+	peerGuard.BannedMock.Return(false)
+	peerGuard.AcceptConnectionMock.Return(true)
+	peerGuard.ReleaseConnectionMock.Return()
+	peerGuard.AllowMock.Return(true)
+	resolver := mock.NewRoutingTableMock(t)
+	resolver.ResolveMock.Set(func(ref insolar.Reference) (*host.Host, error) {
+		if ref.Equal(active) {
+			return host.NewHostN("127.0.0.1:9090", active)
+		}
+		return nil, errors.New("no such node")
+	})
+	h := NewStreamHandler(requestHandler, nil, peerGuard, resolver)
+
+	con1, con2 := net.Pipe()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		h.HandleStream(ctx, "127.0.0.1:8080", con1)
+		close(done)
+	}()
+
+	for _, ref := range []insolar.Reference{active, unknown} {
+		sender, err := host.NewHostN("127.0.0.1:8080", ref)
+		require.NoError(t, err)
+		data, err := packet.SerializePacket(packet.NewPacket(sender, sender, types.Ping, 1))
+		require.NoError(t, err)
+		_, err = con2.Write(data)
+		require.NoError(t, err)
+	}
+
+	received := make(map[guard.Peer]bool)
+	for i := 0; i < 2; i++ {
+		select {
+		case peer := <-peers:
+			received[peer] = true
+		case <-time.After(5 * time.Second):
+			t.Fatal("request is not handled")
+		}
+	}
+	// active node is identified by reference, unknown sender only by address
+	require.True(t, received[guard.Peer{Address: "127.0.0.1:8080", Node: active}])
+	require.True(t, received[guard.Peer{Address: "127.0.0.1:8080"}])
+
+	cancel()
+	<-done
+}
+
+func TestStreamHandler_PeerGuard_Banned(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	peerGuard := mock.NewPeerGuardMock(t)
+	peerGuard.BannedMock.Return(true)
+	h := NewStreamHandler(nil, nil, peerGuard, nil)
+
+	con1, con2 := net.Pipe()
+	h.HandleStream(context.Background(), "127.0.0.1:8080", con1)
+
+	// connection of banned peer is closed
+	_, err := con2.Write([]byte{1})
+	require.Error(t, err)
+}
+
+func TestStreamHandler_PeerGuard_TooManyConnections(t *testing.T) {
+	defer leaktest.Check(t)()
+
+	peerGuard := mock.NewPeerGuardMock(t)
+	peerGuard.BannedMock.Return(false)
+	peerGuard.AcceptConnectionMock.Return(false)
+	h := NewStreamHandler(nil, nil, peerGuard, nil)
+
+	con1, con2 := net.Pipe()
+	h.HandleStream(context.Background(), "127.0.0.1:8080", con1)
+
+	// connection over the limit is closed
+	_, err := con2.Write([]byte{1})
+	require.Error(t, err)
+}
@@ -64,6 +64,7 @@ import (
 	"github.com/insolar/insolar/metrics"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/hostnetwork/future"
+	"github.com/insolar/insolar/network/hostnetwork/guard"
 	"github.com/insolar/insolar/network/hostnetwork/host"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
@@ -96,6 +97,7 @@ func NewHostNetwork(nodeRef string) (network.HostNetwork, error) {
 type hostNetwork struct {
 	Resolver network.RoutingTable `inject:""`
 	Factory  transport.Factory    `inject:""`
+	Guard    network.PeerGuard    `inject:""`
 
 	nodeID            insolar.Reference
 	started           uint32
@@ -118,7 +120,7 @@ func (hn *hostNetwork) Start(ctx context.Context) error {
 		return nil
 	}
 
-	handler := NewStreamHandler(hn.handleRequest, hn.responseHandler)
+	handler := NewStreamHandler(hn.handleRequest, hn.responseHandler, hn.Guard, hn.Resolver)
 
 	var err error
 	hn.transport, err = hn.Factory.CreateStreamTransport(handler)
@@ -126,7 +128,7 @@ func (hn *hostNetwork) Start(ctx context.Context) error {
 		return errors.Wrap(err, "Failed to create stream transport")
 	}
 
-	hn.pool = pool.NewConnectionPool(hn.transport)
+	hn.pool = pool.NewConnectionPool(hn.transport, hn.Guard.MaxConnections())
 
 	hn.muOrigin.Lock()
 	defer hn.muOrigin.Unlock()
@@ -186,6 +188,9 @@ func (hn *hostNetwork) handleRequest(ctx context.Context, p *packet.ReceivedPack
 
 	if !exist {
 		logger.Errorf("No handler set for packet type %s from node %s", p.GetType(), p.Sender.NodeID)
+		if peer, ok := guard.PeerFromContext(ctx); ok {
+			hn.Guard.Penalize(ctx, peer, guard.Malformed)
+		}
 		ep := hn.BuildResponse(ctx, p, &packet.ErrorResponse{Error: "UNKNOWN RPC ENDPOINT"}).(*packet.Packet)
 		ep.RequestID = p.RequestID
 		if err := SendPacket(ctx, hn.pool, ep); err != nil {
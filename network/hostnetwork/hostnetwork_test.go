@@ -67,6 +67,7 @@ import (
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/hostnetwork/guard"
 	"github.com/insolar/insolar/network/hostnetwork/host"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
@@ -174,7 +175,7 @@ func newHostSuite(t *testing.T) *hostSuite {
 	f1 := transport.NewFactory(configuration.NewHostNetwork().Transport)
 	n1, err := NewHostNetwork(id1)
 	require.NoError(t, err)
-	cm1.Inject(f1, n1, resolver)
+	cm1.Inject(f1, n1, resolver, newPeerGuard(t))
 
 	cm2 := component.NewManager(nil)
 	cfg2 := configuration.NewHostNetwork().Transport
@@ -182,7 +183,7 @@ func newHostSuite(t *testing.T) *hostSuite {
 	f2 := transport.NewFactory(cfg2)
 	n2, err := NewHostNetwork(id2)
 	require.NoError(t, err)
-	cm2.Inject(f2, n2, resolver)
+	cm2.Inject(f2, n2, resolver, newPeerGuard(t))
 
 	err = cm1.Init(ctx1)
 	require.NoError(t, err)
@@ -254,7 +255,7 @@ func TestHostNetwork_SendRequestPacket(t *testing.T) {
 	require.NoError(t, err)
 
 	cm := component.NewManager(nil)
-	cm.Register(m, n1, transport.NewFactory(configuration.NewHostNetwork().Transport))
+	cm.Register(m, n1, transport.NewFactory(configuration.NewHostNetwork().Transport), newPeerGuard(t))
 	cm.Inject()
 	err = cm.Init(ctx)
 	require.NoError(t, err)
@@ -459,3 +460,9 @@ func TestHostNetwork_SendRequestToHost_NotStarted(t *testing.T) {
 	require.EqualError(t, err, "host network is not started")
 	assert.Nil(t, f)
 }
+
+func newPeerGuard(t *testing.T) network.PeerGuard {
+	g, err := guard.NewGuard(configuration.NewHostNetwork().PeerGuard)
+	require.NoError(t, err)
+	return g
+}
@@ -1016,6 +1016,8 @@ var xxx_messageInfo_Ping proto.InternalMessageInfo
 type RPCRequest struct {
 	Method string `protobuf:"bytes,1,opt,name=Method,proto3" json:"Method,omitempty"`
 	Data   []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+	// compression.Codec of Data
+	Compression uint32 `protobuf:"varint,3,opt,name=Compression,proto3" json:"Compression,omitempty"`
 }
 
 func (m *RPCRequest) Reset()      { *m = RPCRequest{} }
@@ -1202,6 +1204,11 @@ var xxx_messageInfo_UpdateScheduleRequest proto.InternalMessageInfo
 type ReconnectRequest struct {
 	ReconnectTo github_com_insolar_insolar_network_hostnetwork_host.Host `protobuf:"bytes,1,opt,name=ReconnectTo,proto3,customtype=github.com/insolar/insolar/network/hostnetwork/host.Host" json:"ReconnectTo"`
 	Permit      *Permit                                                  `protobuf:"bytes,2,opt,name=Permit,proto3" json:"Permit,omitempty"`
+	Authorize   *AuthorizeRequest                                        `protobuf:"bytes,3,opt,name=Authorize,proto3" json:"Authorize,omitempty"`
+	// pulse of the stored snapshot the joiner was active in
+	Pulse github_com_insolar_insolar_insolar.PulseNumber `protobuf:"varint,4,opt,name=Pulse,proto3,customtype=github.com/insolar/insolar/insolar.PulseNumber" json:"Pulse"`
+	// cloud hash the joiner stored for the Pulse
+	CloudHash []byte `protobuf:"bytes,5,opt,name=CloudHash,proto3" json:"CloudHash,omitempty"`
 }
 
 func (m *ReconnectRequest) Reset()      { *m = ReconnectRequest{} }
@@ -1278,6 +1285,8 @@ type AuthorizeData struct {
 	Certificate []byte `protobuf:"bytes,1,opt,name=Certificate,proto3" json:"Certificate,omitempty"`
 	Timestamp   int64  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
 	Version     string `protobuf:"bytes,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	// compression.Set of codecs accepted by joiner
+	AcceptCompression uint32 `protobuf:"varint,4,opt,name=AcceptCompression,proto3" json:"AcceptCompression,omitempty"`
 }
 
 func (m *AuthorizeData) Reset()      { *m = AuthorizeData{} }
@@ -1388,6 +1397,8 @@ var xxx_messageInfo_SignCertRequest proto.InternalMessageInfo
 type RPCResponse struct {
 	Result []byte `protobuf:"bytes,1,opt,name=Result,proto3" json:"Result,omitempty"`
 	Error  string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	// compression.Set of codecs accepted by responder
+	AcceptCompression uint32 `protobuf:"varint,3,opt,name=AcceptCompression,proto3" json:"AcceptCompression,omitempty"`
 }
 
 func (m *RPCResponse) Reset()      { *m = RPCResponse{} }
@@ -1580,6 +1591,8 @@ type AuthorizeResponse struct {
 	Permit         *Permit               `protobuf:"bytes,4,opt,name=Permit,proto3" json:"Permit,omitempty"`
 	DiscoveryCount uint32                `protobuf:"varint,5,opt,name=DiscoveryCount,proto3" json:"DiscoveryCount,omitempty"`
 	Pulse          *pulse.PulseProto     `protobuf:"bytes,6,opt,name=Pulse,proto3" json:"Pulse,omitempty"`
+	// compression.Set of codecs accepted by discovery node
+	AcceptCompression uint32 `protobuf:"varint,7,opt,name=AcceptCompression,proto3" json:"AcceptCompression,omitempty"`
 }
 
 func (m *AuthorizeResponse) Reset()      { *m = AuthorizeResponse{} }
@@ -1722,6 +1735,9 @@ func (m *UpdateScheduleResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_UpdateScheduleResponse proto.InternalMessageInfo
 
 type ReconnectResponse struct {
+	Accepted  bool               `protobuf:"varint,1,opt,name=Accepted,proto3" json:"Accepted,omitempty"`
+	Error     string             `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	Authorize *AuthorizeResponse `protobuf:"bytes,3,opt,name=Authorize,proto3" json:"Authorize,omitempty"`
 }
 
 func (m *ReconnectResponse) Reset()      { *m = ReconnectResponse{} }
@@ -1790,101 +1806,106 @@ func init() {
 }
 
 var fileDescriptor_c3f826366adfd81c = []byte{
-	// 1491 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xc1, 0x73, 0xdb, 0xc4,
-	0x17, 0xb6, 0x62, 0xc7, 0x89, 0x5f, 0xec, 0x44, 0xd9, 0x36, 0xa9, 0xda, 0x5f, 0x7f, 0x8a, 0x47,
-	0x03, 0xa9, 0x29, 0xad, 0x03, 0xa5, 0x2d, 0xed, 0xb4, 0x33, 0x50, 0x27, 0x81, 0xa4, 0xb4, 0x1d,
-	0xcf, 0x26, 0x40, 0x0f, 0x85, 0x41, 0x91, 0x37, 0xb1, 0xa8, 0xad, 0x15, 0xd2, 0xba, 0x60, 0xb8,
-	0xf0, 0x27, 0x70, 0xe1, 0x08, 0x37, 0x66, 0xb8, 0xc1, 0x9f, 0xd1, 0x63, 0xb9, 0x75, 0x7a, 0xc8,
-	0x10, 0xf7, 0xc2, 0xb1, 0x33, 0x70, 0xe0, 0xc8, 0xec, 0x6a, 0xb5, 0x92, 0xe5, 0x24, 0x0d, 0xa5,
-	0x97, 0x58, 0xfb, 0xed, 0x7b, 0x6f, 0xdf, 0xee, 0xf7, 0xf6, 0xdb, 0x17, 0x38, 0xe3, 0x11, 0xf6,
-	0x25, 0x0d, 0xee, 0x2f, 0xb5, 0x69, 0xc8, 0xe2, 0x6f, 0xdf, 0x76, 0xee, 0x13, 0x26, 0x7f, 0xea,
-	0x7e, 0x40, 0x19, 0x45, 0xc5, 0x68, 0x74, 0xea, 0xfc, 0x8e, 0xcb, 0xda, 0xbd, 0xad, 0xba, 0x43,
-	0xbb, 0x4b, 0x3b, 0x74, 0x87, 0x2e, 0x89, 0xe9, 0xad, 0xde, 0xb6, 0x18, 0x89, 0x81, 0xf8, 0x8a,
-	0xdc, 0x4e, 0x5d, 0x4c, 0x99, 0xbb, 0x5e, 0x48, 0x3b, 0x76, 0x30, 0xf2, 0xeb, 0xf7, 0x3a, 0x21,
-	0x89, 0xfe, 0x4a, 0xaf, 0xdb, 0x87, 0x78, 0xc5, 0x49, 0x3a, 0xd4, 0x0b, 0x89, 0x17, 0xf6, 0xc2,
-	0x25, 0xbb, 0x65, 0xfb, 0x8c, 0x04, 0xe1, 0x92, 0x63, 0x7b, 0x2d, 0xb7, 0x65, 0x33, 0xc2, 0x93,
-	0xda, 0x76, 0x3b, 0x32, 0x9c, 0xf5, 0x6b, 0x1e, 0x8a, 0x4d, 0x91, 0x3e, 0x3a, 0x0d, 0x25, 0x9f,
-	0x76, 0xfa, 0x5d, 0x1a, 0xf8, 0x6d, 0x43, 0xaf, 0x6a, 0xb5, 0x71, 0x9c, 0x00, 0x68, 0x13, 0x8a,
-	0x1b, 0xc4, 0x6b, 0x91, 0xc0, 0x38, 0x5e, 0xd5, 0x6a, 0xe5, 0xc6, 0xf5, 0x27, 0xbb, 0x0b, 0x57,
-	0x8e, 0x90, 0x4b, 0xfa, 0xf0, 0xf8, 0x77, 0x7d, 0x8d, 0x86, 0x0c, 0xcb, 0x58, 0xe8, 0x2e, 0x4c,
-	0x62, 0xe2, 0x10, 0xf7, 0x01, 0x09, 0x8c, 0xb9, 0x97, 0x10, 0x57, 0x45, 0xe3, 0xbb, 0xc1, 0xe4,
-	0x8b, 0x1e, 0x09, 0xd9, 0xfa, 0x8a, 0x31, 0x5f, 0xd5, 0x6a, 0x05, 0x9c, 0x00, 0xc8, 0x80, 0x89,
-	0xcd, 0xc0, 0x76, 0xc8, 0xfa, 0x8a, 0x71, 0xa2, 0xaa, 0xd5, 0x4a, 0x38, 0x1e, 0xa2, 0x57, 0xa0,
-	0x22, 0x3e, 0x37, 0x7c, 0xdb, 0x5b, 0xb1, 0x99, 0x6d, 0x18, 0x3c, 0x2d, 0x3c, 0x0c, 0x22, 0x04,
-	0x85, 0xcd, 0xbe, 0x4f, 0x8c, 0x53, 0x55, 0xad, 0x56, 0xc1, 0xe2, 0x1b, 0xbd, 0x0e, 0x13, 0x72,
-	0x01, 0xe3, 0x7f, 0x55, 0xad, 0x36, 0x75, 0x61, 0xa6, 0x2e, 0xcb, 0x44, 0xc2, 0x6b, 0x39, 0x1c,
-	0x5b, 0xa0, 0x3a, 0xdf, 0x78, 0xe8, 0x73, 0xa2, 0x8c, 0xd3, 0xc2, 0x5a, 0x4f, 0xac, 0x23, 0x7c,
-	0x2d, 0x87, 0x95, 0x4d, 0xa3, 0x04, 0x13, 0x4d, 0xbb, 0xdf, 0xa1, 0x76, 0xcb, 0x7a, 0x96, 0x57,
-	0x0b, 0x21, 0x0b, 0x0a, 0x4d, 0xd7, 0xdb, 0x31, 0x34, 0x11, 0xa2, 0x1c, 0x87, 0xe0, 0xd8, 0x5a,
-	0x0e, 0x8b, 0x39, 0xb4, 0x08, 0x79, 0xdc, 0x5c, 0x36, 0xc6, 0x84, 0x09, 0x52, 0xab, 0x34, 0x97,
-	0x93, 0xb4, 0xb8, 0x01, 0xba, 0x00, 0x13, 0xcb, 0x76, 0xe8, 0xd8, 0x2d, 0x62, 0xe4, 0x85, 0xed,
-	0x7c, 0x6c, 0x2b, 0xe1, 0xd4, 0x36, 0x24, 0x82, 0xce, 0xc1, 0x78, 0x93, 0x17, 0xa7, 0x51, 0x10,
-	0x1e, 0xc7, 0x55, 0x02, 0x1c, 0x4c, 0xec, 0x23, 0x23, 0x74, 0x05, 0x4a, 0x0d, 0x4a, 0x59, 0xc8,
-	0x02, 0xdb, 0x37, 0xc6, 0x85, 0x87, 0x11, 0x7b, 0xa8, 0x89, 0xc4, 0x2b, 0x31, 0xe6, 0x9e, 0x37,
-	0x7a, 0xac, 0x4d, 0x03, 0xf7, 0x6b, 0x62, 0x14, 0x87, 0x3d, 0xd5, 0x44, 0xca, 0x53, 0x61, 0xe8,
-	0x12, 0x4c, 0x6e, 0xb8, 0x3b, 0xde, 0x32, 0x09, 0x98, 0x31, 0x21, 0x1c, 0x4f, 0xc4, 0x8e, 0x31,
-	0x9e, 0xf8, 0x29, 0x53, 0xf4, 0x3e, 0x4c, 0x7f, 0xe8, 0xf3, 0xfb, 0xb2, 0xe1, 0xb4, 0x49, 0xab,
-	0xd7, 0x21, 0xc6, 0xa4, 0x70, 0xfe, 0x7f, 0xec, 0x3c, 0x3c, 0x9b, 0x84, 0xc8, 0xb8, 0xf1, 0xcc,
-	0x31, 0x71, 0xa8, 0xe7, 0x11, 0x87, 0x19, 0xa5, 0xe1, 0xcc, 0xd5, 0x44, 0x2a, 0x73, 0x85, 0x71,
-	0xca, 0x25, 0x6e, 0xfd, 0x95, 0x4f, 0xca, 0xe5, 0x48, 0x9c, 0x9f, 0x49, 0x73, 0x7e, 0x6c, 0x88,
-	0x73, 0x55, 0x5c, 0x82, 0xf4, 0xf3, 0x30, 0xde, 0xb0, 0x43, 0xd7, 0x91, 0x94, 0xcf, 0x29, 0x3a,
-	0x38, 0x98, 0x32, 0x8e, 0xac, 0xd0, 0xd5, 0x34, 0x83, 0x11, 0xe7, 0x27, 0xf7, 0x61, 0x50, 0xb9,
-	0xa5, 0x28, 0xbc, 0x9a, 0xa6, 0x70, 0x7c, 0xd8, 0x35, 0x45, 0x61, 0xe2, 0x9a, 0x70, 0x78, 0x39,
-	0xc5, 0x61, 0x86, 0xfc, 0x84, 0xc3, 0xe4, 0xd2, 0x28, 0x12, 0xcf, 0xc3, 0xf8, 0x6a, 0x10, 0xd0,
-	0x40, 0x12, 0xaf, 0x36, 0x27, 0xc0, 0xf4, 0xe6, 0x04, 0x80, 0xd6, 0x0e, 0xe0, 0xdc, 0x3c, 0x88,
-	0x73, 0x15, 0x20, 0x4b, 0xfa, 0xd5, 0x51, 0xd2, 0x4f, 0xee, 0x43, 0x7a, 0xb2, 0xd7, 0x84, 0x75,
-	0x48, 0x98, 0xb6, 0x8a, 0x11, 0xd3, 0xd6, 0x15, 0x80, 0xe4, 0xba, 0xa2, 0x79, 0x28, 0xde, 0x26,
-	0xac, 0x4d, 0x5b, 0xa2, 0x02, 0x4a, 0x58, 0x8e, 0xb8, 0x26, 0x09, 0xc1, 0x1a, 0x13, 0x82, 0x25,
-	0xbe, 0xad, 0xdf, 0x34, 0x75, 0xa9, 0xd1, 0x4d, 0x98, 0xb8, 0x43, 0x5b, 0x64, 0xbd, 0x15, 0x1a,
-	0x5a, 0x35, 0x5f, 0x2b, 0x37, 0xde, 0x78, 0xb2, 0xbb, 0x70, 0xee, 0xf9, 0x8f, 0x50, 0x1d, 0x93,
-	0x6d, 0x12, 0x10, 0xcf, 0x21, 0x38, 0x0e, 0x80, 0x6e, 0xc1, 0xc4, 0xaa, 0xc7, 0x02, 0xea, 0xf7,
-	0xa3, 0xe5, 0x1a, 0x17, 0x1e, 0xee, 0x2e, 0xe4, 0x9e, 0xec, 0x2e, 0x9c, 0x3d, 0x42, 0x3c, 0xe9,
-	0x89, 0xe3, 0x10, 0xe8, 0x1c, 0xcc, 0x62, 0xe2, 0x77, 0x5c, 0xc7, 0x66, 0x2e, 0xf5, 0xde, 0xb3,
-	0x1d, 0x46, 0x03, 0x51, 0x90, 0x15, 0x3c, 0x3a, 0x61, 0x7d, 0x03, 0xd3, 0xc3, 0x82, 0x94, 0x56,
-	0x73, 0x2d, 0xab, 0xe6, 0x87, 0x6b, 0x5f, 0x74, 0x09, 0x5e, 0xcb, 0x2a, 0xdf, 0x4c, 0x56, 0xf9,
-	0xe2, 0x79, 0xeb, 0x6d, 0x28, 0xa7, 0xb5, 0x0d, 0x9d, 0x89, 0x05, 0x30, 0xba, 0x8d, 0xb3, 0xf5,
-	0xe8, 0xad, 0x16, 0x58, 0x93, 0xbf, 0xb0, 0x52, 0xfb, 0xac, 0x1f, 0x34, 0x98, 0xdb, 0x57, 0x33,
-	0xd0, 0x3d, 0xa8, 0xdc, 0xb2, 0x43, 0xc6, 0x8f, 0x36, 0x09, 0x55, 0x69, 0x5c, 0x96, 0x27, 0x5a,
-	0x3f, 0xc2, 0x89, 0x0a, 0xbf, 0x3b, 0xbd, 0xee, 0x16, 0x09, 0xf0, 0x70, 0x30, 0xb4, 0x08, 0xc5,
-	0x26, 0x09, 0xba, 0x2e, 0x93, 0x87, 0x30, 0xad, 0xf4, 0x42, 0xa0, 0x58, 0xce, 0x5a, 0x3f, 0x6a,
-	0xa0, 0x67, 0xf5, 0x08, 0x6d, 0xc1, 0x94, 0xc2, 0x36, 0xa9, 0x48, 0xac, 0xdc, 0x78, 0x57, 0x26,
-	0xf6, 0xe2, 0xaf, 0x74, 0x3a, 0xe8, 0x91, 0x13, 0xfc, 0x45, 0x03, 0x3d, 0xfb, 0x48, 0xa0, 0x15,
-	0xd0, 0x97, 0xe3, 0xce, 0xa6, 0x19, 0x35, 0x36, 0x8a, 0x6c, 0xd5, 0xf2, 0xd4, 0xe5, 0x4c, 0xa3,
-	0xc0, 0x33, 0xc7, 0x23, 0x1e, 0x5c, 0x27, 0xa2, 0x93, 0xcf, 0x1f, 0x40, 0xa2, 0xf4, 0x1c, 0xcf,
-	0x1e, 0x69, 0xe1, 0xd0, 0x8c, 0x5d, 0xa8, 0x28, 0x0d, 0x13, 0x5d, 0x43, 0x15, 0xa6, 0xb8, 0x2e,
-	0xb9, 0xdb, 0xbc, 0xa0, 0x23, 0x9e, 0xcb, 0x38, 0x0d, 0xf1, 0xae, 0x65, 0xd3, 0xed, 0x92, 0x90,
-	0xd9, 0x5d, 0x5f, 0x6c, 0x24, 0x8f, 0x13, 0x80, 0xd7, 0xf9, 0x47, 0x24, 0x08, 0x5d, 0xea, 0x89,
-	0x4c, 0x4b, 0x38, 0x1e, 0x5a, 0x5d, 0xd0, 0xb3, 0xcf, 0x20, 0xba, 0x96, 0x59, 0x5e, 0x96, 0xe8,
-	0xdc, 0x88, 0xe8, 0xf2, 0x49, 0x9c, 0x49, 0xf5, 0x34, 0x94, 0xb8, 0x8c, 0xda, 0xac, 0x17, 0x10,
-	0xa9, 0x28, 0x09, 0x60, 0xd9, 0x30, 0x93, 0x79, 0x3c, 0xd1, 0x9d, 0x48, 0x5d, 0x30, 0xd9, 0x96,
-	0x65, 0x72, 0x51, 0x96, 0xc9, 0x0b, 0x28, 0x0c, 0x26, 0xdb, 0xd6, 0x35, 0x98, 0x4a, 0x3d, 0x57,
-	0x5c, 0xf4, 0x30, 0x09, 0x7b, 0x1d, 0x26, 0x4f, 0x4d, 0x8e, 0xd0, 0xf1, 0x58, 0xe2, 0xc7, 0xc4,
-	0x81, 0x44, 0x03, 0xeb, 0x93, 0x98, 0x21, 0x74, 0x49, 0xf5, 0x4d, 0xd9, 0xed, 0x47, 0x06, 0x72,
-	0x52, 0x12, 0x1c, 0xdb, 0x3e, 0x67, 0xfb, 0x3f, 0x8d, 0x41, 0x65, 0xc8, 0x1d, 0xd5, 0x60, 0xe6,
-	0x26, 0x75, 0x3d, 0x12, 0x34, 0x7b, 0x5b, 0x1d, 0xd7, 0xf9, 0x80, 0xf4, 0x65, 0x9e, 0x59, 0x98,
-	0x5b, 0xae, 0x7e, 0xe5, 0xbb, 0x01, 0xc9, 0xf2, 0x9c, 0x85, 0xd1, 0xa7, 0xc3, 0x97, 0x2f, 0xff,
-	0x12, 0xda, 0xe3, 0xa1, 0x8b, 0xf7, 0x99, 0xaa, 0x19, 0xd6, 0x8f, 0xa9, 0x2b, 0xfc, 0x07, 0xea,
-	0x46, 0xa2, 0x59, 0xdf, 0x6b, 0x30, 0x3b, 0xd2, 0x15, 0xa0, 0x37, 0xa1, 0xb0, 0x4c, 0x5b, 0x51,
-	0xf9, 0x4f, 0x27, 0x0d, 0xd5, 0x88, 0x21, 0x37, 0xc2, 0xc2, 0x14, 0x99, 0x00, 0xab, 0x9b, 0x37,
-	0x36, 0x78, 0xf2, 0xad, 0x50, 0x9c, 0x57, 0x05, 0xa7, 0x90, 0x7f, 0x79, 0x81, 0xad, 0x77, 0xa0,
-	0x32, 0xd4, 0xdf, 0xf0, 0x8b, 0xb5, 0xd1, 0x73, 0x1c, 0x12, 0x86, 0x22, 0xab, 0x49, 0x1c, 0x0f,
-	0x0f, 0xa8, 0xaf, 0x3f, 0x35, 0x98, 0x1d, 0xe9, 0x59, 0x0e, 0xda, 0xd8, 0x88, 0x61, 0x6a, 0x63,
-	0x87, 0xdf, 0x77, 0xb5, 0x78, 0x3e, 0xb5, 0xf8, 0x51, 0xe5, 0x07, 0x2d, 0xc2, 0xf4, 0x8a, 0x1b,
-	0x3a, 0xf4, 0x01, 0x09, 0xfa, 0xcb, 0xb4, 0xe7, 0x31, 0xd1, 0x75, 0x55, 0x70, 0x06, 0x4d, 0x9e,
-	0xb0, 0xe2, 0x73, 0x9e, 0xb0, 0x45, 0xd0, 0xb3, 0xed, 0x16, 0x6f, 0x3a, 0x38, 0x26, 0xab, 0x5d,
-	0x7c, 0x5b, 0xaf, 0x42, 0x65, 0xa8, 0xc3, 0x4a, 0xf6, 0xa1, 0xa5, 0x0f, 0xd1, 0x80, 0xf9, 0xfd,
-	0x1b, 0x2a, 0xeb, 0x18, 0xef, 0x07, 0x32, 0x5d, 0xd2, 0xd9, 0xbb, 0x30, 0xb7, 0x6f, 0x89, 0xa0,
-	0x32, 0x4c, 0xde, 0x70, 0x1c, 0xe2, 0x33, 0xd2, 0xd2, 0x73, 0x08, 0x65, 0x9b, 0x38, 0x5d, 0x43,
-	0xb3, 0x50, 0x91, 0x58, 0x9b, 0x06, 0x6c, 0x7d, 0x45, 0x1f, 0x43, 0xc0, 0xf5, 0xe4, 0x73, 0xe2,
-	0x30, 0x3d, 0x7f, 0xf6, 0x1e, 0xcc, 0xed, 0xcb, 0x11, 0x9a, 0x52, 0x65, 0x11, 0x05, 0xfe, 0x38,
-	0xa0, 0xde, 0x8e, 0xa2, 0x47, 0x1f, 0x43, 0x3a, 0x94, 0x05, 0x76, 0xdb, 0xf6, 0x78, 0x78, 0x3d,
-	0xaf, 0x10, 0x29, 0xcc, 0x7a, 0xa1, 0x71, 0xfd, 0xe1, 0x9e, 0x99, 0x7b, 0xb4, 0x67, 0xe6, 0x1e,
-	0xef, 0x99, 0xb9, 0x67, 0x7b, 0xa6, 0xf6, 0xf7, 0x9e, 0x99, 0xfb, 0x76, 0x60, 0x6a, 0x3f, 0x0f,
-	0x4c, 0xed, 0xe1, 0xc0, 0xd4, 0x1e, 0x0d, 0x4c, 0xed, 0xf7, 0x81, 0xa9, 0xfd, 0x31, 0x30, 0x73,
-	0xcf, 0x06, 0xa6, 0xf6, 0xdd, 0x53, 0x33, 0xf7, 0xe8, 0xa9, 0x99, 0x7b, 0xfc, 0xd4, 0xcc, 0x6d,
-	0x15, 0xc5, 0xbf, 0xe9, 0x6f, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0x46, 0x31, 0x00, 0x1a, 0x8d,
-	0x10, 0x00, 0x00,
+	// 1584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x73, 0x1b, 0xc5,
+	0x12, 0xd6, 0x4a, 0xb2, 0x64, 0xb5, 0x25, 0x5b, 0x9e, 0x17, 0x3b, 0x1b, 0x3f, 0xbf, 0xb5, 0x6a,
+	0xeb, 0x3d, 0x47, 0x2f, 0x24, 0x32, 0x84, 0xfc, 0x2c, 0x52, 0x05, 0x91, 0x6c, 0xb0, 0x43, 0x92,
+	0x52, 0x8d, 0x0d, 0xa4, 0xa8, 0x40, 0xb1, 0x5e, 0x8d, 0xad, 0x25, 0xd2, 0xce, 0xb2, 0xbb, 0x0a,
+	0x18, 0xaa, 0x28, 0xfe, 0x04, 0x2e, 0x14, 0x27, 0x0e, 0x1c, 0xa8, 0xe2, 0x06, 0x7f, 0x03, 0xa7,
+	0x1c, 0xc3, 0x2d, 0x95, 0x83, 0x0b, 0x3b, 0x17, 0x8e, 0x39, 0x70, 0xe0, 0x48, 0xcd, 0xec, 0xec,
+	0xec, 0x0f, 0x49, 0x8e, 0x09, 0xb9, 0xe0, 0x9d, 0x6f, 0xba, 0x5b, 0x3d, 0xf3, 0x75, 0x7f, 0x3d,
+	0x04, 0x4e, 0xdb, 0xc4, 0xff, 0x94, 0xba, 0xf7, 0x56, 0xba, 0xd4, 0xf3, 0xc3, 0x6f, 0xc7, 0x30,
+	0xef, 0x11, 0x5f, 0xfc, 0x69, 0x38, 0x2e, 0xf5, 0x29, 0x2a, 0x04, 0xab, 0x85, 0x73, 0xbb, 0x96,
+	0xdf, 0x1d, 0x6c, 0x37, 0x4c, 0xda, 0x5f, 0xd9, 0xa5, 0xbb, 0x74, 0x85, 0x6f, 0x6f, 0x0f, 0x76,
+	0xf8, 0x8a, 0x2f, 0xf8, 0x57, 0xe0, 0xb6, 0x70, 0x21, 0x66, 0x6e, 0xd9, 0x1e, 0xed, 0x19, 0xee,
+	0xd0, 0x5f, 0x67, 0xd0, 0xf3, 0x48, 0xf0, 0x5f, 0xe1, 0x75, 0xeb, 0x08, 0xaf, 0x30, 0x49, 0x93,
+	0xda, 0x1e, 0xb1, 0xbd, 0x81, 0xb7, 0x62, 0x74, 0x0c, 0xc7, 0x27, 0xae, 0xb7, 0x62, 0x1a, 0x76,
+	0xc7, 0xea, 0x18, 0x3e, 0x61, 0x49, 0xed, 0x58, 0x3d, 0x11, 0x4e, 0xff, 0x39, 0x07, 0x85, 0x36,
+	0x4f, 0x1f, 0x2d, 0x42, 0xc9, 0xa1, 0xbd, 0xbd, 0x3e, 0x75, 0x9d, 0xae, 0x5a, 0xad, 0x29, 0xf5,
+	0x09, 0x1c, 0x01, 0x68, 0x0b, 0x0a, 0x9b, 0xc4, 0xee, 0x10, 0x57, 0x3d, 0x51, 0x53, 0xea, 0xe5,
+	0xe6, 0xb5, 0xc7, 0xfb, 0x4b, 0x57, 0x8e, 0x91, 0x4b, 0xfc, 0xf2, 0xd8, 0x77, 0x63, 0x9d, 0x7a,
+	0x3e, 0x16, 0xb1, 0xd0, 0x1d, 0x98, 0xc4, 0xc4, 0x24, 0xd6, 0x7d, 0xe2, 0xaa, 0x73, 0x2f, 0x20,
+	0xae, 0x8c, 0xc6, 0x4e, 0x83, 0xc9, 0x27, 0x03, 0xe2, 0xf9, 0x1b, 0xab, 0xea, 0x7c, 0x4d, 0xa9,
+	0xe7, 0x71, 0x04, 0x20, 0x15, 0x8a, 0x5b, 0xae, 0x61, 0x92, 0x8d, 0x55, 0xf5, 0x64, 0x4d, 0xa9,
+	0x97, 0x70, 0xb8, 0x44, 0xff, 0x85, 0x0a, 0xff, 0xdc, 0x74, 0x0c, 0x7b, 0xd5, 0xf0, 0x0d, 0x55,
+	0x65, 0x69, 0xe1, 0x24, 0x88, 0x10, 0xe4, 0xb7, 0xf6, 0x1c, 0xa2, 0x2e, 0xd4, 0x94, 0x7a, 0x05,
+	0xf3, 0x6f, 0xf4, 0x12, 0x14, 0xc5, 0x0f, 0xa8, 0xff, 0xae, 0x29, 0xf5, 0xa9, 0xf3, 0x33, 0x0d,
+	0x51, 0x26, 0x02, 0x5e, 0xcf, 0xe0, 0xd0, 0x02, 0x35, 0xd8, 0xc1, 0x3d, 0x87, 0x11, 0xa5, 0x2e,
+	0x72, 0xeb, 0x6a, 0x64, 0x1d, 0xe0, 0xeb, 0x19, 0x2c, 0x6d, 0x9a, 0x25, 0x28, 0xb6, 0x8d, 0xbd,
+	0x1e, 0x35, 0x3a, 0xfa, 0xd3, 0x9c, 0xfc, 0x21, 0xa4, 0x43, 0xbe, 0x6d, 0xd9, 0xbb, 0xaa, 0xc2,
+	0x43, 0x94, 0xc3, 0x10, 0x0c, 0x5b, 0xcf, 0x60, 0xbe, 0x87, 0x96, 0x21, 0x87, 0xdb, 0x2d, 0x35,
+	0xcb, 0x4d, 0x90, 0xfc, 0x95, 0x76, 0x2b, 0x4a, 0x8b, 0x19, 0xa0, 0xf3, 0x50, 0x6c, 0x19, 0x9e,
+	0x69, 0x74, 0x88, 0x9a, 0xe3, 0xb6, 0xf3, 0xa1, 0xad, 0x80, 0x63, 0xc7, 0x10, 0x08, 0x3a, 0x0b,
+	0x13, 0x6d, 0x56, 0x9c, 0x6a, 0x9e, 0x7b, 0x9c, 0x90, 0x09, 0x30, 0x30, 0xb2, 0x0f, 0x8c, 0xd0,
+	0x15, 0x28, 0x35, 0x29, 0xf5, 0x3d, 0xdf, 0x35, 0x1c, 0x75, 0x82, 0x7b, 0xa8, 0xa1, 0x87, 0xdc,
+	0x88, 0xbc, 0x22, 0x63, 0xe6, 0x79, 0x7d, 0xe0, 0x77, 0xa9, 0x6b, 0x7d, 0x4e, 0xd4, 0x42, 0xd2,
+	0x53, 0x6e, 0xc4, 0x3c, 0x25, 0x86, 0x2e, 0xc2, 0xe4, 0xa6, 0xb5, 0x6b, 0xb7, 0x88, 0xeb, 0xab,
+	0x45, 0xee, 0x78, 0x32, 0x74, 0x0c, 0xf1, 0xc8, 0x4f, 0x9a, 0xa2, 0xb7, 0x60, 0xfa, 0x1d, 0x87,
+	0xf5, 0xcb, 0xa6, 0xd9, 0x25, 0x9d, 0x41, 0x8f, 0xa8, 0x93, 0xdc, 0xf9, 0x3f, 0xa1, 0x73, 0x72,
+	0x37, 0x0a, 0x91, 0x72, 0x63, 0x99, 0x63, 0x62, 0x52, 0xdb, 0x26, 0xa6, 0xaf, 0x96, 0x92, 0x99,
+	0xcb, 0x8d, 0x58, 0xe6, 0x12, 0x63, 0x94, 0x0b, 0x5c, 0xff, 0x23, 0x17, 0x95, 0xcb, 0xb1, 0x38,
+	0x3f, 0x1d, 0xe7, 0xfc, 0x5f, 0x09, 0xce, 0x65, 0x71, 0x71, 0xd2, 0xcf, 0xc1, 0x44, 0xd3, 0xf0,
+	0x2c, 0x53, 0x50, 0x3e, 0x27, 0xe9, 0x60, 0x60, 0xcc, 0x38, 0xb0, 0x42, 0x57, 0xe3, 0x0c, 0x06,
+	0x9c, 0x9f, 0x1a, 0xc1, 0xa0, 0x74, 0x8b, 0x51, 0x78, 0x35, 0x4e, 0xe1, 0x44, 0xd2, 0x35, 0x46,
+	0x61, 0xe4, 0x1a, 0x71, 0x78, 0x29, 0xc6, 0x61, 0x8a, 0xfc, 0x88, 0xc3, 0xa8, 0x69, 0x24, 0x89,
+	0xe7, 0x60, 0x62, 0xcd, 0x75, 0xa9, 0xab, 0x16, 0x93, 0x87, 0xe3, 0x60, 0xfc, 0x70, 0x1c, 0x40,
+	0xeb, 0x63, 0x38, 0xd7, 0xc6, 0x71, 0x2e, 0x03, 0xa4, 0x49, 0xbf, 0x3a, 0x4c, 0xfa, 0xa9, 0x11,
+	0xa4, 0x47, 0x67, 0x8d, 0x58, 0x87, 0x88, 0x69, 0xbd, 0x10, 0x30, 0xad, 0xbf, 0x0f, 0x10, 0xb5,
+	0x2b, 0x9a, 0x87, 0xc2, 0x2d, 0xe2, 0x77, 0x69, 0x87, 0x57, 0x40, 0x09, 0x8b, 0x15, 0xd3, 0x24,
+	0x2e, 0x58, 0x59, 0x2e, 0x58, 0xfc, 0x1b, 0xd5, 0x60, 0xaa, 0x45, 0xfb, 0x8e, 0x4b, 0x3c, 0xcf,
+	0xa2, 0x36, 0x27, 0xb9, 0x82, 0xe3, 0x90, 0xfe, 0xab, 0x22, 0xdb, 0x1e, 0xdd, 0x80, 0xe2, 0x6d,
+	0xda, 0x21, 0x1b, 0x1d, 0x4f, 0x55, 0x6a, 0xb9, 0x7a, 0xb9, 0xf9, 0xf2, 0xe3, 0xfd, 0xa5, 0xb3,
+	0xcf, 0x1e, 0x53, 0x0d, 0x4c, 0x76, 0x88, 0x4b, 0x6c, 0x93, 0xe0, 0x30, 0x00, 0xba, 0x09, 0xc5,
+	0x35, 0xdb, 0x77, 0xa9, 0xb3, 0x17, 0x24, 0xd4, 0x3c, 0xff, 0x60, 0x7f, 0x29, 0xf3, 0x78, 0x7f,
+	0xe9, 0xcc, 0x31, 0xe2, 0x09, 0x4f, 0x1c, 0x86, 0x40, 0x67, 0x61, 0x16, 0x13, 0xa7, 0x67, 0x99,
+	0x86, 0x6f, 0x51, 0xfb, 0x4d, 0xc3, 0xf4, 0xa9, 0x2b, 0x4e, 0x33, 0xbc, 0xa1, 0x7f, 0x01, 0xd3,
+	0x49, 0xc9, 0x8a, 0xeb, 0xbd, 0x92, 0xd6, 0xfb, 0xa3, 0xd5, 0x31, 0x68, 0x93, 0xff, 0xa7, 0xb5,
+	0x71, 0x26, 0xad, 0x8d, 0xe1, 0xbe, 0x7e, 0x19, 0xca, 0x71, 0xf5, 0x43, 0xa7, 0x43, 0x89, 0x0c,
+	0xfa, 0x75, 0xb6, 0x11, 0x4c, 0x73, 0x8e, 0xb5, 0xd9, 0x0c, 0x16, 0xea, 0xa8, 0x7f, 0xa7, 0xc0,
+	0xdc, 0x48, 0x55, 0x41, 0x77, 0xa1, 0x72, 0xd3, 0xf0, 0x7c, 0x76, 0xb5, 0x51, 0xa8, 0x4a, 0xf3,
+	0x92, 0xb8, 0xd1, 0xc6, 0x31, 0x6e, 0x94, 0xfb, 0xdd, 0x1e, 0xf4, 0xb7, 0x89, 0x8b, 0x93, 0xc1,
+	0xd0, 0x32, 0x14, 0xda, 0xc4, 0xed, 0x5b, 0xbe, 0xb8, 0x84, 0x69, 0xa9, 0x28, 0x1c, 0xc5, 0x62,
+	0x57, 0xff, 0x25, 0x0b, 0xd5, 0xb4, 0x62, 0xa1, 0x6d, 0x98, 0x92, 0xd8, 0x16, 0xe5, 0x89, 0x95,
+	0x9b, 0x6f, 0x88, 0xc4, 0x9e, 0x7f, 0x8e, 0xc7, 0x83, 0x1e, 0x37, 0x41, 0x74, 0x29, 0xae, 0x30,
+	0xb9, 0xa3, 0x87, 0x44, 0x5c, 0x5e, 0x6e, 0xc6, 0x87, 0xd8, 0xf3, 0x5f, 0xab, 0x18, 0x72, 0x8b,
+	0x50, 0x6a, 0xf5, 0xe8, 0xa0, 0xb3, 0x6e, 0x78, 0x5d, 0xae, 0x73, 0x65, 0x1c, 0x01, 0xfa, 0x4f,
+	0x0a, 0x54, 0xd3, 0xa3, 0x0e, 0xad, 0x42, 0xb5, 0x15, 0xbe, 0xcf, 0xda, 0xc1, 0xf3, 0x4c, 0x16,
+	0xa4, 0x7c, 0xb8, 0x35, 0xc4, 0x4e, 0x33, 0xcf, 0xf2, 0xc3, 0x43, 0x1e, 0x4c, 0xed, 0x82, 0x63,
+	0xe4, 0xc6, 0x14, 0x9a, 0xf0, 0x9c, 0x48, 0xd3, 0x9e, 0x3f, 0x92, 0xf6, 0x6f, 0x15, 0xa8, 0xc8,
+	0xbb, 0x92, 0xa2, 0x42, 0x5c, 0xdf, 0xda, 0x61, 0x5d, 0x17, 0x14, 0x63, 0x19, 0xc7, 0x21, 0x76,
+	0x07, 0x5b, 0x56, 0x9f, 0x78, 0xbe, 0xd1, 0x77, 0xf8, 0x49, 0x72, 0x38, 0x02, 0x58, 0x33, 0xbe,
+	0x4b, 0x5c, 0x29, 0x48, 0x25, 0x1c, 0x2e, 0x59, 0x9b, 0x5f, 0x37, 0x4d, 0xe2, 0xf8, 0x71, 0xd1,
+	0xca, 0x07, 0x6d, 0x3e, 0xb4, 0xa1, 0xf7, 0xa1, 0x9a, 0xa6, 0x15, 0xbd, 0x96, 0x4a, 0x56, 0x55,
+	0x92, 0xd2, 0x9f, 0xd8, 0xc4, 0xa9, 0x83, 0x2d, 0x42, 0x89, 0xcd, 0x0e, 0xc3, 0x1f, 0xb8, 0x44,
+	0xc8, 0x68, 0x04, 0xe8, 0x06, 0xcc, 0xa4, 0x5e, 0x0c, 0xe8, 0x76, 0x20, 0x98, 0x98, 0xec, 0x88,
+	0xca, 0xbf, 0x20, 0x6a, 0xe7, 0x39, 0x44, 0x13, 0x93, 0x1d, 0xdd, 0x82, 0xa9, 0xd8, 0x8c, 0x66,
+	0x4a, 0x8f, 0x89, 0x37, 0xe8, 0xf9, 0xe2, 0x8e, 0xc5, 0x0a, 0x9d, 0x08, 0xe7, 0x5a, 0x96, 0x5f,
+	0x5f, 0xb0, 0x18, 0x7d, 0x79, 0xb9, 0x71, 0x97, 0xf7, 0x41, 0x48, 0x3f, 0xba, 0x28, 0x9f, 0x96,
+	0xe9, 0xcb, 0x0a, 0x0c, 0xc4, 0xa6, 0xa8, 0x9e, 0xd0, 0xf6, 0x19, 0x97, 0xf5, 0x43, 0x16, 0x2a,
+	0x09, 0x77, 0x54, 0x87, 0x99, 0x1b, 0xd4, 0xb2, 0x89, 0xdb, 0x1e, 0x6c, 0xf7, 0x2c, 0xf3, 0x6d,
+	0xb2, 0x27, 0x4e, 0x95, 0x86, 0x99, 0xe5, 0xda, 0x67, 0x8e, 0xe5, 0x92, 0x74, 0x0d, 0xa5, 0x61,
+	0xf4, 0x61, 0x52, 0x7d, 0x72, 0x2f, 0xe0, 0xff, 0x20, 0x12, 0xca, 0xf3, 0x91, 0xac, 0x30, 0x7f,
+	0x2f, 0x24, 0x3a, 0xff, 0x0f, 0x88, 0x1e, 0x8a, 0xa6, 0x7f, 0xa3, 0xc0, 0xec, 0xd0, 0xc3, 0x09,
+	0xbd, 0x02, 0xf9, 0x16, 0xed, 0x04, 0xad, 0x35, 0x1d, 0xbd, 0x39, 0x87, 0x0c, 0x99, 0x11, 0xe6,
+	0xa6, 0x48, 0x03, 0x58, 0xdb, 0xba, 0xbe, 0xc9, 0x92, 0xef, 0x78, 0xfc, 0xbe, 0x2a, 0x38, 0x86,
+	0xfc, 0x4d, 0x75, 0xd0, 0x5f, 0x87, 0x4a, 0xe2, 0x09, 0xc8, 0x9a, 0x76, 0x73, 0x60, 0x9a, 0xc4,
+	0xf3, 0x78, 0x56, 0x93, 0x38, 0x5c, 0x8e, 0xae, 0x46, 0xfd, 0xfb, 0x2c, 0xcc, 0x0e, 0x3d, 0xeb,
+	0xc6, 0x1d, 0x6c, 0xc8, 0x30, 0x76, 0xb0, 0xa3, 0xb5, 0x44, 0xfe, 0x78, 0x2e, 0xde, 0x0a, 0xc7,
+	0xd4, 0x36, 0xb4, 0x0c, 0xd3, 0xab, 0x96, 0x67, 0xd2, 0xfb, 0xc4, 0xdd, 0x6b, 0xd1, 0x81, 0xed,
+	0x73, 0xc1, 0xae, 0xe0, 0x14, 0x1a, 0xcd, 0xf0, 0xc2, 0xd1, 0x33, 0x7c, 0x74, 0x0f, 0x16, 0xc7,
+	0xf5, 0xe0, 0x32, 0x54, 0xd3, 0xef, 0x57, 0xf6, 0x8a, 0x63, 0x98, 0xe8, 0x0d, 0xfe, 0xad, 0xff,
+	0x0f, 0x2a, 0x89, 0x27, 0x6b, 0x74, 0x6a, 0x25, 0x7e, 0xe5, 0x2a, 0xcc, 0x8f, 0x7e, 0xa1, 0xea,
+	0x5f, 0xc2, 0xac, 0x2c, 0x6b, 0x19, 0x64, 0x01, 0x26, 0x83, 0x94, 0x48, 0x47, 0x50, 0x2a, 0xd7,
+	0x63, 0x14, 0xe6, 0xf2, 0xf0, 0x80, 0x1d, 0xff, 0x84, 0x8f, 0x4d, 0xd8, 0x33, 0x77, 0x60, 0x6e,
+	0x64, 0xed, 0xa2, 0x72, 0x94, 0x43, 0x35, 0x83, 0x50, 0xfa, 0x01, 0x5e, 0x55, 0xd0, 0x2c, 0x54,
+	0x04, 0xd6, 0xa5, 0xae, 0xbf, 0xb1, 0x5a, 0xcd, 0x22, 0x60, 0xb2, 0xf8, 0x31, 0x31, 0xfd, 0x6a,
+	0xee, 0xcc, 0x5d, 0x98, 0x1b, 0x59, 0x3c, 0x68, 0x4a, 0xd6, 0x6b, 0x10, 0xf8, 0x3d, 0x97, 0xda,
+	0xbb, 0xb2, 0x6e, 0xaa, 0x59, 0x54, 0x85, 0x32, 0xc7, 0x6e, 0x19, 0x36, 0x0b, 0x5f, 0xcd, 0x49,
+	0x44, 0x4c, 0xa3, 0x6a, 0xbe, 0x79, 0xed, 0xc1, 0x81, 0x96, 0x79, 0x78, 0xa0, 0x65, 0x1e, 0x1d,
+	0x68, 0x99, 0xa7, 0x07, 0x9a, 0xf2, 0xe7, 0x81, 0x96, 0xf9, 0xea, 0x50, 0x53, 0x7e, 0x3c, 0xd4,
+	0x94, 0x07, 0x87, 0x9a, 0xf2, 0xf0, 0x50, 0x53, 0x7e, 0x3b, 0xd4, 0x94, 0xdf, 0x0f, 0xb5, 0xcc,
+	0xd3, 0x43, 0x4d, 0xf9, 0xfa, 0x89, 0x96, 0x79, 0xf8, 0x44, 0xcb, 0x3c, 0x7a, 0xa2, 0x65, 0xb6,
+	0x0b, 0xfc, 0x9f, 0x58, 0x5e, 0xfd, 0x6b, 0x00, 0x38, 0x08, 0x1b, 0x48, 0x49, 0x12, 0x00, 0x00,
 }
 
 func (x BootstrapResponseCode) String() string {
@@ -2546,6 +2567,9 @@ func (this *RPCRequest) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Data, that1.Data) {
 		return false
 	}
+	if this.Compression != that1.Compression {
+		return false
+	}
 	return true
 }
 func (this *Cascade) Equal(that interface{}) bool {
@@ -2689,6 +2713,15 @@ func (this *ReconnectRequest) Equal(that interface{}) bool {
 	if !this.Permit.Equal(that1.Permit) {
 		return false
 	}
+	if !this.Authorize.Equal(that1.Authorize) {
+		return false
+	}
+	if !this.Pulse.Equal(that1.Pulse) {
+		return false
+	}
+	if !bytes.Equal(this.CloudHash, that1.CloudHash) {
+		return false
+	}
 	return true
 }
 func (this *BootstrapRequest) Equal(that interface{}) bool {
@@ -2749,6 +2782,9 @@ func (this *AuthorizeData) Equal(that interface{}) bool {
 	if this.Version != that1.Version {
 		return false
 	}
+	if this.AcceptCompression != that1.AcceptCompression {
+		return false
+	}
 	return true
 }
 func (this *AuthorizeRequest) Equal(that interface{}) bool {
@@ -2827,6 +2863,9 @@ func (this *RPCResponse) Equal(that interface{}) bool {
 	if this.Error != that1.Error {
 		return false
 	}
+	if this.AcceptCompression != that1.AcceptCompression {
+		return false
+	}
 	return true
 }
 func (this *Permit) Equal(that interface{}) bool {
@@ -2987,6 +3026,9 @@ func (this *AuthorizeResponse) Equal(that interface{}) bool {
 	if !this.Pulse.Equal(that1.Pulse) {
 		return false
 	}
+	if this.AcceptCompression != that1.AcceptCompression {
+		return false
+	}
 	return true
 }
 func (this *SignCertResponse) Equal(that interface{}) bool {
@@ -3077,6 +3119,15 @@ func (this *ReconnectResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.Accepted != that1.Accepted {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if !this.Authorize.Equal(that1.Authorize) {
+		return false
+	}
 	return true
 }
 func (this *Packet) GoString() string {
@@ -3295,10 +3346,11 @@ func (this *RPCRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&packet.RPCRequest{")
 	s = append(s, "Method: "+fmt.Sprintf("%#v", this.Method)+",\n")
 	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Compression: "+fmt.Sprintf("%#v", this.Compression)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3359,12 +3411,17 @@ func (this *ReconnectRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 9)
 	s = append(s, "&packet.ReconnectRequest{")
 	s = append(s, "ReconnectTo: "+fmt.Sprintf("%#v", this.ReconnectTo)+",\n")
 	if this.Permit != nil {
 		s = append(s, "Permit: "+fmt.Sprintf("%#v", this.Permit)+",\n")
 	}
+	if this.Authorize != nil {
+		s = append(s, "Authorize: "+fmt.Sprintf("%#v", this.Authorize)+",\n")
+	}
+	s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
+	s = append(s, "CloudHash: "+fmt.Sprintf("%#v", this.CloudHash)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3386,11 +3443,12 @@ func (this *AuthorizeData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&packet.AuthorizeData{")
 	s = append(s, "Certificate: "+fmt.Sprintf("%#v", this.Certificate)+",\n")
 	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "AcceptCompression: "+fmt.Sprintf("%#v", this.AcceptCompression)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3421,10 +3479,11 @@ func (this *RPCResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&packet.RPCResponse{")
 	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
 	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "AcceptCompression: "+fmt.Sprintf("%#v", this.AcceptCompression)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3479,7 +3538,7 @@ func (this *AuthorizeResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 10)
+	s := make([]string, 0, 11)
 	s = append(s, "&packet.AuthorizeResponse{")
 	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
 	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
@@ -3491,6 +3550,7 @@ func (this *AuthorizeResponse) GoString() string {
 	if this.Pulse != nil {
 		s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
 	}
+	s = append(s, "AcceptCompression: "+fmt.Sprintf("%#v", this.AcceptCompression)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3527,8 +3587,13 @@ func (this *ReconnectResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 4)
+	s := make([]string, 0, 7)
 	s = append(s, "&packet.ReconnectResponse{")
+	s = append(s, "Accepted: "+fmt.Sprintf("%#v", this.Accepted)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	if this.Authorize != nil {
+		s = append(s, "Authorize: "+fmt.Sprintf("%#v", this.Authorize)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -4005,6 +4070,11 @@ func (m *RPCRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
 		i += copy(dAtA[i:], m.Data)
 	}
+	if m.Compression != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.Compression))
+	}
 	return i, nil
 }
 
@@ -4189,6 +4259,27 @@ func (m *ReconnectRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n32
 	}
+	if m.Authorize != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.Authorize.Size()))
+		n33, err := m.Authorize.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	if m.Pulse != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.Pulse))
+	}
+	if len(m.CloudHash) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.CloudHash)))
+		i += copy(dAtA[i:], m.CloudHash)
+	}
 	return i, nil
 }
 
@@ -4210,28 +4301,28 @@ func (m *BootstrapRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintPacket(dAtA, i, uint64(m.CandidateProfile.Size()))
-	n33, err := m.CandidateProfile.MarshalTo(dAtA[i:])
+	n34, err := m.CandidateProfile.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n33
+	i += n34
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintPacket(dAtA, i, uint64(m.Pulse.Size()))
-	n34, err := m.Pulse.MarshalTo(dAtA[i:])
+	n35, err := m.Pulse.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n34
+	i += n35
 	if m.Permit != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintPacket(dAtA, i, uint64(m.Permit.Size()))
-		n35, err := m.Permit.MarshalTo(dAtA[i:])
+		n36, err := m.Permit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n36
 	}
 	return i, nil
 }
@@ -4268,6 +4359,11 @@ func (m *AuthorizeData) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPacket(dAtA, i, uint64(len(m.Version)))
 		i += copy(dAtA[i:], m.Version)
 	}
+	if m.AcceptCompression != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.AcceptCompression))
+	}
 	return i, nil
 }
 
@@ -4290,11 +4386,11 @@ func (m *AuthorizeRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPacket(dAtA, i, uint64(m.AuthorizeData.Size()))
-		n36, err := m.AuthorizeData.MarshalTo(dAtA[i:])
+		n37, err := m.AuthorizeData.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n37
 	}
 	if len(m.Signature) > 0 {
 		dAtA[i] = 0x12
@@ -4323,11 +4419,11 @@ func (m *SignCertRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintPacket(dAtA, i, uint64(m.NodeRef.Size()))
-	n37, err := m.NodeRef.MarshalTo(dAtA[i:])
+	n38, err := m.NodeRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n37
+	i += n38
 	return i, nil
 }
 
@@ -4358,6 +4454,11 @@ func (m *RPCResponse) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
 		i += copy(dAtA[i:], m.Error)
 	}
+	if m.AcceptCompression != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.AcceptCompression))
+	}
 	return i, nil
 }
 
@@ -4379,11 +4480,11 @@ func (m *Permit) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintPacket(dAtA, i, uint64(m.Payload.Size()))
-	n38, err := m.Payload.MarshalTo(dAtA[i:])
+	n39, err := m.Payload.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n38
+	i += n39
 	if len(m.Signature) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -4423,20 +4524,20 @@ func (m *PermitPayload) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPacket(dAtA, i, uint64(m.ReconnectTo.Size()))
-		n39, err := m.ReconnectTo.MarshalTo(dAtA[i:])
+		n40, err := m.ReconnectTo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n40
 	}
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintPacket(dAtA, i, uint64(m.AuthorityNodeRef.Size()))
-	n40, err := m.AuthorityNodeRef.MarshalTo(dAtA[i:])
+	n41, err := m.AuthorityNodeRef.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
+	i += n41
 	return i, nil
 }
 
@@ -4468,11 +4569,11 @@ func (m *BootstrapResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintPacket(dAtA, i, uint64(m.Pulse.Size()))
-	n41, err := m.Pulse.MarshalTo(dAtA[i:])
+	n42, err := m.Pulse.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n41
+	i += n42
 	return i, nil
 }
 
@@ -4545,11 +4646,11 @@ func (m *AuthorizeResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintPacket(dAtA, i, uint64(m.Permit.Size()))
-		n42, err := m.Permit.MarshalTo(dAtA[i:])
+		n43, err := m.Permit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n43
 	}
 	if m.DiscoveryCount != 0 {
 		dAtA[i] = 0x28
@@ -4560,11 +4661,16 @@ func (m *AuthorizeResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintPacket(dAtA, i, uint64(m.Pulse.Size()))
-		n43, err := m.Pulse.MarshalTo(dAtA[i:])
+		n44, err := m.Pulse.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n44
+	}
+	if m.AcceptCompression != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.AcceptCompression))
 	}
 	return i, nil
 }
@@ -4650,6 +4756,32 @@ func (m *ReconnectResponse) MarshalTo(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Accepted {
+		dAtA[i] = 0x8
+		i++
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.Authorize != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.Authorize.Size()))
+		n45, err := m.Authorize.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
+	}
 	return i, nil
 }
 
@@ -4986,6 +5118,9 @@ func (m *RPCRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if m.Compression != 0 {
+		n += 1 + sovPacket(uint64(m.Compression))
+	}
 	return n
 }
 
@@ -5071,6 +5206,17 @@ func (m *ReconnectRequest) Size() (n int) {
 		l = m.Permit.Size()
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if m.Authorize != nil {
+		l = m.Authorize.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Pulse != 0 {
+		n += 1 + sovPacket(uint64(m.Pulse))
+	}
+	l = len(m.CloudHash)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -5108,6 +5254,9 @@ func (m *AuthorizeData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if m.AcceptCompression != 0 {
+		n += 1 + sovPacket(uint64(m.AcceptCompression))
+	}
 	return n
 }
 
@@ -5153,6 +5302,9 @@ func (m *RPCResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if m.AcceptCompression != 0 {
+		n += 1 + sovPacket(uint64(m.AcceptCompression))
+	}
 	return n
 }
 
@@ -5253,6 +5405,9 @@ func (m *AuthorizeResponse) Size() (n int) {
 		l = m.Pulse.Size()
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	if m.AcceptCompression != 0 {
+		n += 1 + sovPacket(uint64(m.AcceptCompression))
+	}
 	return n
 }
 
@@ -5297,6 +5452,17 @@ func (m *ReconnectResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Accepted {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Authorize != nil {
+		l = m.Authorize.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -5566,6 +5732,7 @@ func (this *RPCRequest) String() string {
 	s := strings.Join([]string{`&RPCRequest{`,
 		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
 		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5622,6 +5789,9 @@ func (this *ReconnectRequest) String() string {
 	s := strings.Join([]string{`&ReconnectRequest{`,
 		`ReconnectTo:` + fmt.Sprintf("%v", this.ReconnectTo) + `,`,
 		`Permit:` + strings.Replace(fmt.Sprintf("%v", this.Permit), "Permit", "Permit", 1) + `,`,
+		`Authorize:` + strings.Replace(fmt.Sprintf("%v", this.Authorize), "AuthorizeRequest", "AuthorizeRequest", 1) + `,`,
+		`Pulse:` + fmt.Sprintf("%v", this.Pulse) + `,`,
+		`CloudHash:` + fmt.Sprintf("%v", this.CloudHash) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5646,6 +5816,7 @@ func (this *AuthorizeData) String() string {
 		`Certificate:` + fmt.Sprintf("%v", this.Certificate) + `,`,
 		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`AcceptCompression:` + fmt.Sprintf("%v", this.AcceptCompression) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5678,6 +5849,7 @@ func (this *RPCResponse) String() string {
 	s := strings.Join([]string{`&RPCResponse{`,
 		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
 		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`AcceptCompression:` + fmt.Sprintf("%v", this.AcceptCompression) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5740,6 +5912,7 @@ func (this *AuthorizeResponse) String() string {
 		`Permit:` + strings.Replace(fmt.Sprintf("%v", this.Permit), "Permit", "Permit", 1) + `,`,
 		`DiscoveryCount:` + fmt.Sprintf("%v", this.DiscoveryCount) + `,`,
 		`Pulse:` + strings.Replace(fmt.Sprintf("%v", this.Pulse), "PulseProto", "pulse.PulseProto", 1) + `,`,
+		`AcceptCompression:` + fmt.Sprintf("%v", this.AcceptCompression) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5778,6 +5951,9 @@ func (this *ReconnectResponse) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ReconnectResponse{`,
+		`Accepted:` + fmt.Sprintf("%v", this.Accepted) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Authorize:` + strings.Replace(fmt.Sprintf("%v", this.Authorize), "AuthorizeResponse", "AuthorizeResponse", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6990,6 +7166,25 @@ func (m *RPCRequest) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -7606,6 +7801,95 @@ func (m *ReconnectRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorize == nil {
+				m.Authorize = &AuthorizeRequest{}
+			}
+			if err := m.Authorize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
+			}
+			m.Pulse = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pulse |= github_com_insolar_insolar_insolar.PulseNumber(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CloudHash = append(m.CloudHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CloudHash == nil {
+				m.CloudHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -7899,6 +8183,25 @@ func (m *AuthorizeData) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptCompression", wireType)
+			}
+			m.AcceptCompression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptCompression |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -8227,6 +8530,25 @@ func (m *RPCResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptCompression", wireType)
+			}
+			m.AcceptCompression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptCompression |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -8964,6 +9286,25 @@ func (m *AuthorizeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptCompression", wireType)
+			}
+			m.AcceptCompression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AcceptCompression |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -9242,6 +9583,94 @@ func (m *ReconnectResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ReconnectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorize == nil {
+				m.Authorize = &AuthorizeResponse{}
+			}
+			if err := m.Authorize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -54,6 +54,8 @@ import (
 	"context"
 	"io"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -71,6 +73,8 @@ type entry struct {
 	host      *host.Host
 	onClose   onClose
 	conn      io.ReadWriteCloser
+	// lastUsed is unix time in nanoseconds of the last open call
+	lastUsed int64
 }
 
 func newEntry(t transport.StreamTransport, conn io.ReadWriteCloser, host *host.Host, onClose onClose) *entry {
@@ -79,6 +83,7 @@ func newEntry(t transport.StreamTransport, conn io.ReadWriteCloser, host *host.H
 		conn:      conn,
 		host:      host,
 		onClose:   onClose,
+		lastUsed:  time.Now().UnixNano(),
 	}
 }
 
@@ -95,12 +100,19 @@ func (e *entry) watchRemoteClose(ctx context.Context) {
 }
 
 func (e *entry) open(ctx context.Context) (io.ReadWriteCloser, error) {
+	atomic.StoreInt64(&e.lastUsed, time.Now().UnixNano())
+
 	e.Lock()
 	defer e.Unlock()
 	if e.conn != nil {
 		return e.conn, nil
 	}
 
+	if mt, ok := e.transport.(transport.MultiplexedStreamTransport); ok {
+		e.conn = newMessageStreams(mt, e.host.Address.String())
+		return e.conn, nil
+	}
+
 	conn, err := e.dial(ctx)
 	if err != nil {
 		return nil, err
@@ -120,6 +132,10 @@ func (e *entry) dial(ctx context.Context) (io.ReadWriteCloser, error) {
 	return conn, nil
 }
 
+func (e *entry) used() int64 {
+	return atomic.LoadInt64(&e.lastUsed)
+}
+
 func (e *entry) close() {
 	e.Lock()
 	defer e.Unlock()
@@ -98,6 +98,30 @@ func (eh *entryHolder) add(host fmt.Stringer, entry *entry) {
 	eh.entries[eh.key(host)] = entry
 }
 
+// evictLeastRecentlyUsed closes and removes entry that was used the earliest except the one for host.
+func (eh *entryHolder) evictLeastRecentlyUsed(except fmt.Stringer) (string, bool) {
+	eh.Lock()
+	defer eh.Unlock()
+
+	var oldestKey string
+	var oldest *entry
+	for key, e := range eh.entries {
+		if key == eh.key(except) {
+			continue
+		}
+		if oldest == nil || e.used() < oldest.used() {
+			oldestKey, oldest = key, e
+		}
+	}
+	if oldest == nil {
+		return "", false
+	}
+
+	oldest.close()
+	delete(eh.entries, oldestKey)
+	return oldestKey, true
+}
+
 func (eh *entryHolder) clear() {
 	eh.Lock()
 	defer eh.Unlock()
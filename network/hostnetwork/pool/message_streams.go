@@ -0,0 +1,103 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package pool
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/transport"
+)
+
+// messageStreamTimeout limits time to open a stream and write a message to it.
+const messageStreamTimeout = 10 * time.Second
+
+// messageStreams writes every message into a separate stream of multiplexed transport,
+// so large messages don't delay small ones.
+type messageStreams struct {
+	transport transport.MultiplexedStreamTransport
+	address   string
+}
+
+func newMessageStreams(t transport.MultiplexedStreamTransport, address string) *messageStreams {
+	return &messageStreams{
+		transport: t,
+		address:   address,
+	}
+}
+
+// Write sends p as a single message in a new stream.
+func (m *messageStreams) Write(p []byte) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), messageStreamTimeout)
+	defer cancel()
+
+	stream, err := m.transport.Dial(ctx, m.address)
+	if err != nil {
+		return 0, errors.Wrap(err, "[ Write ] Failed to open stream")
+	}
+	defer network.CloseVerbose(stream)
+
+	return stream.Write(p)
+}
+
+// Read is not supported, responses are received in streams opened by the remote host.
+func (m *messageStreams) Read(p []byte) (int, error) {
+	return 0, errors.New("reading from message streams is not supported")
+}
+
+// Close closes session to the remote host.
+func (m *messageStreams) Close() error {
+	m.transport.CloseSession(m.address)
+	return nil
+}
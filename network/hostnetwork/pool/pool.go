@@ -67,21 +67,24 @@ type ConnectionPool interface {
 	Reset()
 }
 
-// NewConnectionPool constructor creates new ConnectionPool
-func NewConnectionPool(t transport.StreamTransport) ConnectionPool {
-	return newConnectionPool(t)
+// NewConnectionPool constructor creates new ConnectionPool. Pool keeps no more than maxConnections connections
+// closing the least recently used ones, 0 means unlimited.
+func NewConnectionPool(t transport.StreamTransport, maxConnections int) ConnectionPool {
+	return newConnectionPool(t, maxConnections)
 }
 
 type connectionPool struct {
-	transport transport.StreamTransport
+	transport      transport.StreamTransport
+	maxConnections int
 
 	entryHolder *entryHolder
 }
 
-func newConnectionPool(t transport.StreamTransport) *connectionPool {
+func newConnectionPool(t transport.StreamTransport, maxConnections int) *connectionPool {
 	return &connectionPool{
-		transport:   t,
-		entryHolder: newEntryHolder(),
+		transport:      t,
+		maxConnections: maxConnections,
+		entryHolder:    newEntryHolder(),
 	}
 }
 
@@ -127,6 +130,13 @@ func (cp *connectionPool) getOrCreateEntry(ctx context.Context, host *host.Host)
 	)
 	metrics.NetworkConnections.Inc()
 
+	if cp.maxConnections > 0 && size > cp.maxConnections {
+		if evicted, ok := cp.entryHolder.evictLeastRecentlyUsed(host); ok {
+			logger.Infof("[ getOrCreateEntry ] Pool size limit %d exceeded, closed connection to %s", cp.maxConnections, evicted)
+			metrics.NetworkConnections.Dec()
+		}
+	}
+
 	return e
 }
 
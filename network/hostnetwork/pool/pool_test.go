@@ -53,7 +53,9 @@ package pool
 import (
 	"context"
 	"io"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -91,7 +93,7 @@ func TestNewConnectionPool(t *testing.T) {
 	ctx := context.Background()
 	tr := newTransportMock(t)
 
-	pool := NewConnectionPool(tr)
+	pool := NewConnectionPool(tr, 0)
 
 	h, err := host.NewHost("127.0.0.1:8080")
 	h2, err := host.NewHost("127.0.0.1:4200")
@@ -111,3 +113,97 @@ func TestNewConnectionPool(t *testing.T) {
 	pool.CloseConnection(ctx, h)
 	pool.Reset()
 }
+
+type multiplexedTransportMock struct {
+	*network.StreamTransportMock
+	closed []string
+}
+
+func (m *multiplexedTransportMock) CloseSession(address string) {
+	m.closed = append(m.closed, address)
+}
+
+type recordingConnection struct {
+	fakeConnection
+	written [][]byte
+	closed  bool
+}
+
+func (c *recordingConnection) Write(p []byte) (int, error) {
+	c.written = append(c.written, p)
+	return len(p), nil
+}
+
+func (c *recordingConnection) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestConnectionPool_MessageStreams(t *testing.T) {
+	ctx := context.Background()
+
+	var streams []*recordingConnection
+	tr := &multiplexedTransportMock{StreamTransportMock: network.NewStreamTransportMock(t)}
+	tr.DialMock.Set(func(p context.Context, p1 string) (r io.ReadWriteCloser, r1 error) {
+		c := &recordingConnection{}
+		streams = append(streams, c)
+		return c, nil
+	})
+
+	pool := NewConnectionPool(tr, 0)
+	h, err := host.NewHost("127.0.0.1:8080")
+	assert.NoError(t, err)
+
+	conn, err := pool.GetConnection(ctx, h)
+	assert.NoError(t, err)
+	_, err = conn.Write([]byte{1})
+	assert.NoError(t, err)
+	_, err = conn.Write([]byte{2})
+	assert.NoError(t, err)
+
+	// every message is sent in its own stream
+	assert.Len(t, streams, 2)
+	for i, s := range streams {
+		assert.Equal(t, [][]byte{{byte(i + 1)}}, s.written)
+		assert.True(t, s.closed)
+	}
+
+	pool.CloseConnection(ctx, h)
+	assert.Equal(t, []string{"127.0.0.1:8080"}, tr.closed)
+}
+
+func TestConnectionPool_MaxConnections(t *testing.T) {
+	ctx := context.Background()
+
+	lock := sync.Mutex{}
+	conns := map[string]*recordingConnection{}
+	tr := network.NewStreamTransportMock(t)
+	tr.DialMock.Set(func(p context.Context, address string) (r io.ReadWriteCloser, r1 error) {
+		lock.Lock()
+		defer lock.Unlock()
+		c := &recordingConnection{}
+		conns[address] = c
+		return c, nil
+	})
+
+	pool := newConnectionPool(tr, 2)
+	h1, _ := host.NewHost("127.0.0.1:8080")
+	h2, _ := host.NewHost("127.0.0.1:8081")
+	h3, _ := host.NewHost("127.0.0.1:8082")
+
+	for _, h := range []*host.Host{h1, h2, h1, h3} {
+		_, err := pool.GetConnection(ctx, h)
+		assert.NoError(t, err)
+		time.Sleep(time.Millisecond)
+	}
+
+	// h2 is the least recently used one
+	assert.Equal(t, 2, pool.entryHolder.size())
+	_, ok := pool.entryHolder.get(h2)
+	assert.False(t, ok)
+
+	lock.Lock()
+	assert.True(t, conns[h2.Address.String()].closed)
+	assert.False(t, conns[h1.Address.String()].closed)
+	lock.Unlock()
+}
@@ -73,6 +73,14 @@ func newFixedAddressResolver(publicAddress string) *fixedAddressResolver {
 }
 
 func (r *fixedAddressResolver) Resolve(address string) (string, error) {
+	port, err := extractPort(address)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s:%s", r.publicAddress, port), nil
+}
+
+func extractPort(address string) (string, error) {
 	url, err := url.Parse(address)
 
 	var port string
@@ -85,5 +93,5 @@ func (r *fixedAddressResolver) Resolve(address string) (string, error) {
 	if port == "" {
 		return "", errors.New("Failed to extract port from uri: " + address)
 	}
-	return fmt.Sprintf("%s:%s", r.publicAddress, port), nil
+	return port, nil
 }
@@ -0,0 +1,176 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package resolver
+
+import (
+	"bytes"
+	"crypto/rand"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	nonceSize = 8
+	// reflectTimeout is a time to wait for responses of reflectors.
+	reflectTimeout = 2 * time.Second
+)
+
+var (
+	reflectionRequestMagic  = []byte("INSREFL?")
+	reflectionResponseMagic = []byte("INSREFL!")
+)
+
+// IsReflectionRequest checks if datagram is a request of public address discovery.
+func IsReflectionRequest(buf []byte) bool {
+	return len(buf) == len(reflectionRequestMagic)+nonceSize && bytes.HasPrefix(buf, reflectionRequestMagic)
+}
+
+// ReflectionResponse returns response to reflection request containing address the request was received from.
+func ReflectionResponse(request []byte, observedAddress string) []byte {
+	resp := make([]byte, 0, len(reflectionResponseMagic)+nonceSize+len(observedAddress))
+	resp = append(resp, reflectionResponseMagic...)
+	resp = append(resp, request[len(reflectionRequestMagic):]...)
+	return append(resp, observedAddress...)
+}
+
+type reflectorResolver struct {
+	conn       net.PacketConn
+	reflectors []string
+	timeout    time.Duration
+}
+
+// NewReflectorResolver creates resolver which asks reflectors from the bound socket, so the resolved address
+// includes the port mapped by NAT for the socket. Nothing else may read from the socket while resolving.
+func NewReflectorResolver(conn net.PacketConn, reflectors []string) PublicAddressResolver {
+	return newReflectorResolver(conn, reflectors, reflectTimeout)
+}
+
+func newReflectorResolver(conn net.PacketConn, reflectors []string, timeout time.Duration) *reflectorResolver {
+	return &reflectorResolver{
+		conn:       conn,
+		reflectors: reflectors,
+		timeout:    timeout,
+	}
+}
+
+// Resolve ignores given address, public address is the address reflectors observe the socket from.
+func (r *reflectorResolver) Resolve(address string) (string, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", errors.Wrap(err, "failed to generate nonce")
+	}
+	request := append(append([]byte{}, reflectionRequestMagic...), nonce...)
+
+	for _, reflector := range r.reflectors {
+		addr, err := net.ResolveUDPAddr("udp", reflector)
+		if err != nil {
+			continue
+		}
+		_, _ = r.conn.WriteTo(request, addr)
+	}
+
+	if err := r.conn.SetReadDeadline(time.Now().Add(r.timeout)); err != nil {
+		return "", errors.Wrap(err, "failed to set deadline")
+	}
+	defer func() { _ = r.conn.SetReadDeadline(time.Time{}) }()
+
+	votes := map[string]int{}
+	responded := map[string]bool{}
+	buf := make([]byte, 512)
+	for len(responded) < len(r.reflectors) {
+		n, from, err := r.conn.ReadFrom(buf)
+		if err != nil {
+			break
+		}
+		observed, ok := parseReflectionResponse(buf[:n], nonce)
+		if !ok || responded[from.String()] {
+			continue
+		}
+		responded[from.String()] = true
+		votes[observed]++
+	}
+
+	best, bestVotes := "", 0
+	for observed, v := range votes {
+		if v > bestVotes {
+			best, bestVotes = observed, v
+		}
+	}
+	if bestVotes == 0 {
+		return "", errors.New("no reflector responded")
+	}
+	if bestVotes*2 <= len(responded) && len(responded) > 1 {
+		return "", errors.Errorf("reflectors disagree on public address: %v", votes)
+	}
+	return best, nil
+}
+
+func parseReflectionResponse(buf, nonce []byte) (string, bool) {
+	prefixLen := len(reflectionResponseMagic) + nonceSize
+	if len(buf) <= prefixLen || !bytes.HasPrefix(buf, reflectionResponseMagic) {
+		return "", false
+	}
+	if !bytes.Equal(buf[len(reflectionResponseMagic):prefixLen], nonce) {
+		return "", false
+	}
+	observed := string(buf[prefixLen:])
+	host, port, err := net.SplitHostPort(observed)
+	if err != nil || net.ParseIP(host) == nil {
+		return "", false
+	}
+	if p, err := strconv.Atoi(port); err != nil || p <= 0 || p > 0xFFFF {
+		return "", false
+	}
+	return observed, true
+}
@@ -0,0 +1,149 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package resolver
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func startReflector(t *testing.T, observed string) net.PacketConn {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if !IsReflectionRequest(buf[:n]) {
+				continue
+			}
+			reflected := observed
+			if reflected == "" {
+				reflected = addr.String()
+			}
+			_, _ = conn.WriteTo(ReflectionResponse(buf[:n], reflected), addr)
+		}
+	}()
+	return conn
+}
+
+func listenClient(t *testing.T) net.PacketConn {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	return conn
+}
+
+func TestReflectorResolver(t *testing.T) {
+	var reflectors []string
+	for _, observed := range []string{"", "", "10.0.0.1:1000"} {
+		conn := startReflector(t, observed)
+		defer conn.Close()
+		reflectors = append(reflectors, conn.LocalAddr().String())
+	}
+
+	client := listenClient(t)
+	defer client.Close()
+
+	r := newReflectorResolver(client, reflectors, time.Second)
+	address, err := r.Resolve("0.0.0.0:12345")
+	require.NoError(t, err)
+	require.Equal(t, client.LocalAddr().String(), address)
+}
+
+func TestReflectorResolver_Disagree(t *testing.T) {
+	var reflectors []string
+	for _, observed := range []string{"", "10.0.0.1:1000"} {
+		conn := startReflector(t, observed)
+		defer conn.Close()
+		reflectors = append(reflectors, conn.LocalAddr().String())
+	}
+
+	client := listenClient(t)
+	defer client.Close()
+
+	r := newReflectorResolver(client, reflectors, time.Second)
+	_, err := r.Resolve("0.0.0.0:12345")
+	require.Error(t, err)
+}
+
+func TestReflectorResolver_NoResponse(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := listenClient(t)
+	defer client.Close()
+
+	r := newReflectorResolver(client, []string{conn.LocalAddr().String()}, 100*time.Millisecond)
+	_, err = r.Resolve("0.0.0.0:12345")
+	require.Error(t, err)
+}
+
+func TestReflectionRequest(t *testing.T) {
+	require.False(t, IsReflectionRequest([]byte("INSREFL?")))
+	require.False(t, IsReflectionRequest([]byte("INSREFL!12345678")))
+	require.True(t, IsReflectionRequest([]byte("INSREFL?12345678")))
+
+	resp := ReflectionResponse([]byte("INSREFL?12345678"), "1.2.3.4:5")
+	address, ok := parseReflectionResponse(resp, []byte("12345678"))
+	require.True(t, ok)
+	require.Equal(t, "1.2.3.4:5", address)
+
+	_, ok = parseReflectionResponse(resp, []byte("87654321"))
+	require.False(t, ok)
+}
@@ -50,6 +50,8 @@
 
 package resolver
 
+import "net"
+
 // PublicAddressResolver is network address resolver interface.
 type PublicAddressResolver interface {
 
@@ -68,3 +70,12 @@ func Resolve(fixedPublicAddress, address string) (string, error) {
 
 	return r.Resolve(address)
 }
+
+// ResolvePacketConn resolves public address of the bound socket. Reflectors are asked from the socket
+// when fixed address is not set.
+func ResolvePacketConn(fixedPublicAddress string, conn net.PacketConn, reflectors []string) (string, error) {
+	if fixedPublicAddress == "" && len(reflectors) > 0 {
+		return NewReflectorResolver(conn, reflectors).Resolve(conn.LocalAddr().String())
+	}
+	return Resolve(fixedPublicAddress, conn.LocalAddr().String())
+}
@@ -57,6 +57,8 @@ import (
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/member"
+	"github.com/insolar/insolar/network/hostnetwork/compression"
+	"github.com/insolar/insolar/network/hostnetwork/guard"
 	"github.com/insolar/insolar/network/hostnetwork/host"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
@@ -181,6 +183,39 @@ type RoutingTable interface {
 	Resolve(insolar.Reference) (*host.Host, error)
 }
 
+//go:generate minimock -i github.com/insolar/insolar/network.CompressionNegotiator -o ../testutils/network -s _mock.go -g
+
+// CompressionNegotiator keeps compression codecs accepted by peers and compresses payloads sent to them.
+type CompressionNegotiator interface {
+	// Accepted returns set of codecs this node is able to decode, it is advertised to peers.
+	Accepted() compression.Set
+	// Learn remembers set of codecs accepted by peer.
+	Learn(peer insolar.Reference, accepted compression.Set)
+	// Compress compresses data with the codec negotiated with peer, small data is returned as is with compression.None.
+	Compress(ctx context.Context, peer insolar.Reference, data []byte) ([]byte, compression.Codec)
+	// Decompress decodes data compressed with codec.
+	Decompress(ctx context.Context, codec compression.Codec, data []byte) ([]byte, error)
+}
+
+//go:generate minimock -i github.com/insolar/insolar/network.PeerGuard -o ../testutils/network -s _mock.go -g
+
+// PeerGuard limits request rates of remote peers and temporarily bans misbehaving ones.
+// Authenticated peers are identified by node reference, others by their remote address.
+type PeerGuard interface {
+	// Allow reports whether request of packet type t from peer should be processed.
+	Allow(ctx context.Context, peer guard.Peer, t types.PacketType) bool
+	// Penalize lowers reputation of peer for offense, peer is banned when its reputation gets too low.
+	Penalize(ctx context.Context, peer guard.Peer, offense guard.Offense)
+	// Banned reports whether host of address is temporarily banned for unauthenticated requests.
+	Banned(address string) bool
+	// MaxConnections returns number of connections kept in connection pool, 0 means unlimited.
+	MaxConnections() int
+	// AcceptConnection reports whether one more inbound connection can be served.
+	AcceptConnection(ctx context.Context) bool
+	// ReleaseConnection releases inbound connection accepted by AcceptConnection.
+	ReleaseConnection()
+}
+
 //go:generate minimock -i github.com/insolar/insolar/network.Accessor -o ../testutils/network -s _mock.go -g
 
 // Accessor is interface that provides read access to nodekeeper internal snapshot
@@ -60,6 +60,8 @@ import (
 var (
 	// TagPhase is a tag for consensus metrics.
 	TagPhase = insmetrics.MustTagKey("phase")
+	// TagMisbehaviorCategory is a tag for misbehavior reports metrics.
+	TagMisbehaviorCategory = insmetrics.MustTagKey("category")
 )
 
 var (
@@ -78,6 +80,11 @@ var (
 
 	// ActiveNodes active nodes count after consensus.
 	ActiveNodes = stats.Int64("consensus/activenodes/count", "Active nodes count after consensus", stats.UnitDimensionless)
+
+	// MisbehaviorReports consensus misbehavior reports counter.
+	MisbehaviorReports = stats.Int64("consensus/misbehavior/reports", "Consensus misbehavior reports counter", stats.UnitDimensionless)
+	// ExcludedNodes count of nodes excluded from consensus by penalty policy.
+	ExcludedNodes = stats.Int64("consensus/misbehavior/excluded", "Count of nodes excluded from consensus by penalty policy", stats.UnitDimensionless)
 )
 
 func init() {
@@ -128,6 +135,19 @@ func init() {
 			Measure:     ActiveNodes,
 			Aggregation: view.LastValue(),
 		},
+		&view.View{
+			Name:        MisbehaviorReports.Name(),
+			Description: MisbehaviorReports.Description(),
+			Measure:     MisbehaviorReports,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{TagMisbehaviorCategory},
+		},
+		&view.View{
+			Name:        ExcludedNodes.Name(),
+			Description: ExcludedNodes.Description(),
+			Measure:     ExcludedNodes,
+			Aggregation: view.LastValue(),
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -139,6 +139,12 @@ type nodekeeper struct {
 	CloudHashStorage storage.CloudHashStorage // `inject:""`
 }
 
+// SetStorages replaces in-memory storages of snapshots and cloud hashes, e.g. with persistent ones.
+func (nk *nodekeeper) SetStorages(snapshots storage.SnapshotStorage, cloudHashes storage.CloudHashStorage) {
+	nk.SnapshotStorage = snapshots
+	nk.CloudHashStorage = cloudHashes
+}
+
 func (nk *nodekeeper) SetInitialSnapshot(nodes []insolar.NetworkNode) {
 	ctx := context.TODO()
 	nk.Sync(ctx, insolar.FirstPulseNumber, nodes)
@@ -117,14 +117,14 @@ func NewDistributor(conf configuration.PulseDistributor) (insolar.PulseDistribut
 }
 
 func (d *distributor) Init(ctx context.Context) error {
-	handler := hostnetwork.NewStreamHandler(func(context.Context, *packet.ReceivedPacket) {}, d.responseHandler)
+	handler := hostnetwork.NewStreamHandler(func(context.Context, *packet.ReceivedPacket) {}, d.responseHandler, nil, nil)
 
 	var err error
 	d.transport, err = d.Factory.CreateStreamTransport(handler)
 	if err != nil {
 		return errors.Wrap(err, "Failed to create transport")
 	}
-	d.pool = pool.NewConnectionPool(d.transport)
+	d.pool = pool.NewConnectionPool(d.transport, 0)
 
 	return nil
 }
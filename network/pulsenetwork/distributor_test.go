@@ -63,6 +63,7 @@ import (
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/hostnetwork"
+	"github.com/insolar/insolar/network/hostnetwork/guard"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
 	"github.com/insolar/insolar/network/transport"
@@ -84,7 +85,7 @@ func createHostNetwork(t *testing.T) (network.HostNetwork, error) {
 	if err != nil {
 		return nil, err
 	}
-	cm1.Inject(f1, n1, m)
+	cm1.Inject(f1, n1, m, newPeerGuard(t))
 
 	ctx := context.Background()
 
@@ -145,3 +146,9 @@ func TestDistributor_Distribute(t *testing.T) {
 	d.Distribute(ctx, insolar.Pulse{PulseNumber: PULSENUMBER})
 	d.Distribute(ctx, insolar.Pulse{PulseNumber: PULSENUMBER})
 }
+
+func newPeerGuard(t *testing.T) network.PeerGuard {
+	g, err := guard.NewGuard(configuration.NewHostNetwork().PeerGuard)
+	require.NoError(t, err)
+	return g
+}
@@ -0,0 +1,109 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package servicenetwork
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/certificate"
+	"github.com/insolar/insolar/insolar"
+)
+
+// relayAuthenticator signs relay challenge with the node key and verifies authorization certificates of relay clients.
+type relayAuthenticator struct {
+	certificateManager  insolar.CertificateManager
+	cryptographyService insolar.CryptographyService
+	keyProcessor        insolar.KeyProcessor
+}
+
+func newRelayAuthenticator(
+	certificateManager insolar.CertificateManager,
+	cryptographyService insolar.CryptographyService,
+	keyProcessor insolar.KeyProcessor,
+) *relayAuthenticator {
+	return &relayAuthenticator{
+		certificateManager:  certificateManager,
+		cryptographyService: cryptographyService,
+		keyProcessor:        keyProcessor,
+	}
+}
+
+// Sign implements transport.RelayAuthenticator
+func (a *relayAuthenticator) Sign(challenge []byte) ([]byte, []byte, error) {
+	cert, err := certificate.Serialize(a.certificateManager.GetCertificate())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to serialize certificate")
+	}
+	sign, err := a.cryptographyService.Sign(challenge)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to sign challenge")
+	}
+	return cert, sign.Bytes(), nil
+}
+
+// Verify implements transport.RelayAuthenticator
+func (a *relayAuthenticator) Verify(challenge, cert, sign []byte) error {
+	authCert, err := certificate.Deserialize(cert, a.keyProcessor)
+	if err != nil {
+		return errors.Wrap(err, "failed to deserialize certificate")
+	}
+	valid, err := a.certificateManager.VerifyAuthorizationCertificate(authCert)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify certificate")
+	}
+	if !valid {
+		return errors.New("certificate is not signed by discovery nodes")
+	}
+	if !a.cryptographyService.Verify(authCert.GetPublicKey(), insolar.SignatureFromBytes(sign), challenge) {
+		return errors.New("challenge sign is invalid")
+	}
+	return nil
+}
@@ -58,6 +58,7 @@ import (
 	"github.com/insolar/insolar/network/storage"
 
 	"github.com/insolar/insolar/cryptography"
+	"github.com/insolar/insolar/insolar/store"
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/pkg/errors"
@@ -69,6 +70,7 @@ import (
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/consensus"
 	"github.com/insolar/insolar/network/consensus/adapters"
+	"github.com/insolar/insolar/network/consensus/common/capacity"
 	"github.com/insolar/insolar/network/consensus/common/endpoints"
 	"github.com/insolar/insolar/network/consensus/gcpv2/api/member"
 	"github.com/insolar/insolar/network/consensus/serialization"
@@ -77,6 +79,8 @@ import (
 	"github.com/insolar/insolar/network/gateway"
 	"github.com/insolar/insolar/network/gateway/bootstrap"
 	"github.com/insolar/insolar/network/hostnetwork"
+	"github.com/insolar/insolar/network/hostnetwork/compression"
+	"github.com/insolar/insolar/network/hostnetwork/guard"
 	"github.com/insolar/insolar/network/node"
 	"github.com/insolar/insolar/network/routing"
 	"github.com/insolar/insolar/network/transport"
@@ -125,6 +129,13 @@ type ServiceNetwork struct {
 	consensusInstaller  consensus.Installer
 	consensusController consensus.Controller
 
+	// MisbehaviorRegistry keeps consensus misbehavior reports.
+	MisbehaviorRegistry *adapters.MisbehaviorRegistry
+	// db keeps persistent network state, it's opened only if persistence is enabled in configuration.
+	db *store.BadgerDB
+	// pruner removes persisted network state that is too old for reconnect, it's nil if state is kept in memory.
+	pruner *storage.Pruner
+
 	ConsensusMode consensus.Mode
 }
 
@@ -151,38 +162,57 @@ func (n *ServiceNetwork) RemoteProcedureRegister(name string, method insolar.Rem
 
 // Init implements component.Initer
 func (n *ServiceNetwork) Init(ctx context.Context) error {
+	ctx = network.WithComponent(ctx)
 	hostNetwork, err := hostnetwork.NewHostNetwork(n.CertificateManager.GetCertificate().GetNodeRef().String())
 	if err != nil {
 		return errors.Wrap(err, "failed to create hostnetwork")
 	}
 	n.HostNetwork = hostNetwork
 
+	negotiator, err := compression.NewNegotiator(n.cfg.Host.Compression)
+	if err != nil {
+		return errors.Wrap(err, "failed to create compression negotiator")
+	}
+
+	peerGuard, err := guard.NewGuard(n.cfg.Host.PeerGuard)
+	if err != nil {
+		return errors.Wrap(err, "failed to create peer guard")
+	}
+
 	options := common.ConfigureOptions(n.cfg)
 
 	cert := n.CertificateManager.GetCertificate()
 
-	n.BaseGateway = &gateway.Base{}
+	n.BaseGateway = &gateway.Base{ReconnectMaxAge: n.cfg.Service.Reconnect.MaxAge}
 	n.Gatewayer = gateway.NewGatewayer(n.BaseGateway.NewGateway(ctx, insolar.NoNetworkState), func(ctx context.Context, isNetworkOperable bool) {
 		if n.operableFunc != nil {
 			n.operableFunc(ctx, isNetworkOperable)
 		}
 	})
 
-	pulseStorage := storage.NewMemoryPulseStorage()
+	if n.cfg.Service.Misbehavior.Persist || n.cfg.Service.Reconnect.Persist {
+		n.db, err = storage.NewBadgerDB(n.cfg.Service)
+		if err != nil {
+			return errors.Wrap(err, "failed to open network storage")
+		}
+	}
+
+	pulseStorage, snapshotStorage, cloudHashStorage := n.newStateStorages()
 	table := &routing.Table{}
 
 	n.cm.Inject(n,
 		table,
 		cert,
-		transport.NewFactory(n.cfg.Host.Transport),
+		transport.NewFactoryWithRelayAuth(n.cfg.Host.Transport, newRelayAuthenticator(n.CertificateManager, n.CryptographyService, n.KeyProcessor)),
 		hostNetwork,
+		negotiator,
+		peerGuard,
 		controller.NewRPCController(options),
 		controller.NewPulseController(),
 		bootstrap.NewRequester(options),
-		// db,
 		pulseStorage,
-		storage.NewMemoryCloudHashStorage(),
-		storage.NewMemorySnapshotStorage(),
+		cloudHashStorage,
+		snapshotStorage,
 		n.BaseGateway,
 		n.Gatewayer,
 	)
@@ -225,12 +255,19 @@ func (n *ServiceNetwork) Init(ctx context.Context) error {
 
 	n.CurrentPulse = *insolar.GenesisPulse
 
+	misbehaviorStorage, err := n.newMisbehaviorStorage()
+	if err != nil {
+		return errors.Wrap(err, "failed to create misbehavior storage")
+	}
+	n.MisbehaviorRegistry = adapters.NewMisbehaviorRegistry(ctx, misbehaviorStorage, n.cfg.Service.Misbehavior)
+
 	n.consensusInstaller = consensus.New(ctx, consensus.Dep{
 		KeyProcessor:        n.KeyProcessor,
 		Scheme:              n.CryptographyScheme,
 		CertificateManager:  n.CertificateManager,
 		KeyStore:            keyStore,
 		NodeKeeper:          n.NodeKeeper,
+		MisbehaviorRegistry: n.MisbehaviorRegistry,
 		StateGetter:         n,
 		PulseChanger:        n,
 		StateUpdater:        n,
@@ -241,6 +278,50 @@ func (n *ServiceNetwork) Init(ctx context.Context) error {
 	return nil
 }
 
+func (n *ServiceNetwork) newMisbehaviorStorage() (storage.MisbehaviorStorage, error) {
+	if !n.cfg.Service.Misbehavior.Persist {
+		return storage.NewMemoryMisbehaviorStorage(), nil
+	}
+	return storage.NewMisbehaviorStorage(n.db), nil
+}
+
+type pulseAccessAppender interface {
+	storage.PulseAccessor
+	storage.PulseAppender
+}
+
+// stateStorageSetter is implemented by NodeKeeper that allows to replace its storages.
+type stateStorageSetter interface {
+	SetStorages(snapshots storage.SnapshotStorage, cloudHashes storage.CloudHashStorage)
+}
+
+// newStateStorages creates storages of pulses, snapshots and cloud hashes and sets them to NodeKeeper, so gateways
+// check reconnecting nodes against the network state NodeKeeper stores.
+// Persistent storages let the network state survive restart of the node.
+func (n *ServiceNetwork) newStateStorages() (pulseAccessAppender, storage.SnapshotStorage, storage.CloudHashStorage) {
+	if !n.cfg.Service.Reconnect.Persist {
+		snapshots, cloudHashes := storage.NewMemorySnapshotStorage(), storage.NewMemoryCloudHashStorage()
+		if setter, ok := n.NodeKeeper.(stateStorageSetter); ok {
+			setter.SetStorages(snapshots, cloudHashes)
+		}
+		return storage.NewMemoryPulseStorage(), snapshots, cloudHashes
+	}
+
+	// DB is set explicitly, the parent component manager could provide another store.DB for injection.
+	pulses := storage.NewPulseStorage()
+	pulses.DB = n.db
+	snapshots := storage.NewSnapshotStorage()
+	snapshots.DB = n.db
+	cloudHashes := storage.NewCloudHashStorage()
+	cloudHashes.DB = n.db
+
+	if setter, ok := n.NodeKeeper.(stateStorageSetter); ok {
+		setter.SetStorages(snapshots, cloudHashes)
+	}
+	n.pruner = storage.NewPruner(pulses, snapshots, cloudHashes, n.cfg.Service.Reconnect.MaxAge)
+	return pulses, snapshots, cloudHashes
+}
+
 func (n *ServiceNetwork) initConsensus() {
 
 	if n.NodeKeeper.GetOrigin().Role() == insolar.StaticRoleHeavyMaterial {
@@ -258,6 +339,7 @@ func (n *ServiceNetwork) initConsensus() {
 
 // Start implements component.Starter
 func (n *ServiceNetwork) Start(ctx context.Context) error {
+	ctx = network.WithComponent(ctx)
 	err := n.cm.Start(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to start component manager")
@@ -300,7 +382,18 @@ func (n *ServiceNetwork) Stop(ctx context.Context) error {
 		return errors.Wrap(err, "failed to stop datagram transport")
 	}
 
-	return n.cm.Stop(ctx)
+	err = n.cm.Stop(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n.db != nil {
+		err = n.db.Stop(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to stop network storage")
+		}
+	}
+	return nil
 }
 
 func (n *ServiceNetwork) GetState() insolar.NetworkState {
@@ -322,6 +415,12 @@ func (n *ServiceNetwork) HandlePulse(ctx context.Context, pulse insolar.Pulse, o
 func (n *ServiceNetwork) ChangePulse(ctx context.Context, pulse insolar.Pulse) {
 	n.CurrentPulse = pulse
 	n.Gatewayer.Gateway().OnPulseFromConsensus(ctx, pulse)
+
+	if n.pruner != nil {
+		if err := n.pruner.Prune(ctx, pulse); err != nil {
+			inslogger.FromContext(ctx).Warn("Failed to prune network storage: ", err.Error())
+		}
+	}
 }
 
 func (n *ServiceNetwork) UpdateState(ctx context.Context, pulseNumber insolar.PulseNumber, nodes []insolar.NetworkNode, cloudStateHash []byte) {
@@ -386,6 +485,17 @@ func getAnnounceSignature(
 	return digest, sign, nil
 }
 
+// ChangePower requests consensus to change capacity of this node, new power is applied in the next pulses.
+func (n *ServiceNetwork) ChangePower(ctx context.Context, level capacity.Level) error {
+	if n.consensusController == nil {
+		return errors.New("consensus is not started")
+	}
+
+	inslogger.FromContext(ctx).Infof("Changing node power to %s", level)
+	n.consensusController.ChangePower(level)
+	return nil
+}
+
 // RegisterConsensusFinishedNotifier for integrtest TODO: remove
 func (n *ServiceNetwork) RegisterConsensusFinishedNotifier(fn network.OnConsensusFinished) {
 	n.consensusController.RegisterFinishedNotifier(fn)
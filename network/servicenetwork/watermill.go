@@ -62,6 +62,8 @@ import (
 	"github.com/insolar/insolar/insolar/bus"
 	"github.com/insolar/insolar/insolar/payload"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/hostnetwork/compression"
 )
 
 const deliverWatermillMsg = "ServiceNetwork.processIncoming"
@@ -70,7 +72,7 @@ var ack = []byte{1}
 
 // SendMessageHandler async sends message with confirmation of delivery.
 func (n *ServiceNetwork) SendMessageHandler(msg *message.Message) ([]*message.Message, error) {
-	ctx := inslogger.ContextWithTrace(context.Background(), msg.Metadata.Get(bus.MetaTraceID))
+	ctx := network.WithComponent(inslogger.ContextWithTrace(context.Background(), msg.Metadata.Get(bus.MetaTraceID)))
 	parentSpan, err := instracer.Deserialize([]byte(msg.Metadata.Get(bus.MetaSpanData)))
 	if err == nil {
 		ctx = instracer.WithParentSpan(ctx, parentSpan)
@@ -109,6 +111,9 @@ func (n *ServiceNetwork) sendMessage(ctx context.Context, msg *message.Message)
 	if err != nil {
 		return errors.Wrap(err, "error while converting message to bytes")
 	}
+	if payloadType, err := payload.UnmarshalType(meta.Payload); err == nil {
+		ctx = compression.WithPayloadType(ctx, payloadType.String())
+	}
 	res, err := n.RPC.SendBytes(ctx, node, deliverWatermillMsg, msgBytes)
 	if err != nil {
 		return errors.Wrap(err, "error while sending watermillMsg to controller")
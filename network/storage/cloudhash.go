@@ -54,6 +54,7 @@ import (
 	"sync"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/pkg/errors"
 )
 
 //go:generate minimock -i github.com/insolar/insolar/network/storage.CloudHashStorage -o ../../testutils/network -s _mock.go -g
@@ -64,9 +65,9 @@ type CloudHashStorage interface {
 	Append(pulse insolar.PulseNumber, cloudHash []byte) error
 }
 
-// newCloudHashStorage constructor creates cloudHashStorage
-func newCloudHashStorage() *cloudHashStorage { // nolint
-	return &cloudHashStorage{}
+// NewCloudHashStorage constructor creates CloudHashStorage that keeps cloud hashes in the DB.
+func NewCloudHashStorage() *DBCloudHashStorage {
+	return &DBCloudHashStorage{}
 }
 
 // NewMemoryCloudHashStorage constructor creates cloudHashStorage
@@ -76,27 +77,47 @@ func NewMemoryCloudHashStorage() *MemoryCloudHashStorage {
 	}
 }
 
-type cloudHashStorage struct { // nolint
+type cloudHashKey insolar.PulseNumber
+
+func (k cloudHashKey) Scope() Scope {
+	return ScopeCloudHash
+}
+
+func (k cloudHashKey) ID() []byte {
+	return insolar.PulseNumber(k).Bytes()
+}
+
+type DBCloudHashStorage struct {
 	DB   DB `inject:""`
 	lock sync.RWMutex
 }
 
-func (c *cloudHashStorage) ForPulseNumber(pulse insolar.PulseNumber) ([]byte, error) {
+func (c *DBCloudHashStorage) ForPulseNumber(pulse insolar.PulseNumber) ([]byte, error) {
 	c.lock.RLock()
 	defer c.lock.RUnlock()
 
-	result, err := c.DB.Get(pulseKey(pulse))
+	result, err := c.DB.Get(cloudHashKey(pulse))
 	if err != nil {
 		return nil, err
 	}
 	return result, err
 }
 
-func (c *cloudHashStorage) Append(pulse insolar.PulseNumber, cloudHash []byte) error {
+func (c *DBCloudHashStorage) Append(pulse insolar.PulseNumber, cloudHash []byte) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.DB.Set(cloudHashKey(pulse), cloudHash)
+}
+
+// TruncateTail removes cloud hashes of pulses older than until.
+func (c *DBCloudHashStorage) TruncateTail(until insolar.PulseNumber) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	return c.DB.Set(pulseKey(pulse), cloudHash)
+	return errors.Wrap(truncateTail(c.DB, func(pn insolar.PulseNumber) Key {
+		return cloudHashKey(pn)
+	}, until), "[cloudHashStorage] Failed to truncate cloud hashes")
 }
 
 type MemoryCloudHashStorage struct {
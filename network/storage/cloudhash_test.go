@@ -73,8 +73,8 @@ func TestCloudHashStorage(t *testing.T) {
 	ctx := inslogger.TestContext(t)
 	cm := component.NewManager(nil)
 	badgerDB, err := NewBadgerDB(configuration.ServiceNetwork{CacheDirectory: tmpdir})
-	defer badgerDB.Stop(ctx)
-	cs := newCloudHashStorage()
+	require.NoError(t, err)
+	cs := NewCloudHashStorage()
 
 	cm.Register(badgerDB, cs)
 	cm.Inject()
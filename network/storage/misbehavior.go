@@ -0,0 +1,226 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package storage
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/pkg/errors"
+)
+
+// MisbehaviorStorage provides methods for accessing consensus misbehavior reports.
+type MisbehaviorStorage interface {
+	ForPulseNumber(insolar.PulseNumber) ([]MisbehaviorReport, error)
+	Append(report MisbehaviorReport) error
+	// Commit registers the pulse even if it has no reports, so the last pulses can be restored after restart.
+	Commit(insolar.PulseNumber) error
+	// LastPulses returns up to count last committed pulses in ascending order.
+	LastPulses(count int) ([]insolar.PulseNumber, error)
+}
+
+// MisbehaviorReport is a misbehavior of a node detected by consensus.
+type MisbehaviorReport struct {
+	PulseNumber insolar.PulseNumber
+	NodeID      insolar.ShortNodeID
+	// Host is an address of the violator, it's set when the node is unknown.
+	Host    string
+	Fraud   bool
+	Type    int
+	Details string
+}
+
+type misbehaviorKey insolar.PulseNumber
+
+func (k misbehaviorKey) Scope() Scope {
+	return ScopeMisbehavior
+}
+
+func (k misbehaviorKey) ID() []byte {
+	return insolar.PulseNumber(k).Bytes()
+}
+
+// NewMisbehaviorStorage creates MisbehaviorStorage that keeps reports in the DB.
+func NewMisbehaviorStorage(db DB) *DBMisbehaviorStorage {
+	return &DBMisbehaviorStorage{db: db}
+}
+
+// DBMisbehaviorStorage stores all reports of a pulse under one key.
+type DBMisbehaviorStorage struct {
+	lock sync.Mutex
+	db   DB
+}
+
+func (s *DBMisbehaviorStorage) Append(report MisbehaviorReport) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	reports, err := s.forPulseNumber(report.PulseNumber)
+	if err != nil {
+		return errors.Wrap(err, "[misbehaviorStorage] Failed to append report")
+	}
+	buf, err := json.Marshal(append(reports, report))
+	if err != nil {
+		return errors.Wrap(err, "[misbehaviorStorage] Failed to encode reports")
+	}
+	return s.db.Set(misbehaviorKey(report.PulseNumber), buf)
+}
+
+func (s *DBMisbehaviorStorage) Commit(pulse insolar.PulseNumber) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, err := s.db.Get(misbehaviorKey(pulse))
+	if err == nil {
+		return nil
+	}
+	if err != ErrNotFound {
+		return errors.Wrap(err, "[misbehaviorStorage] Failed to get reports from DB")
+	}
+	return s.db.Set(misbehaviorKey(pulse), []byte("[]"))
+}
+
+func (s *DBMisbehaviorStorage) LastPulses(count int) ([]insolar.PulseNumber, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	it := s.db.NewIterator(misbehaviorKey(insolar.PulseNumber(0xFFFFFFFF)), true)
+	defer it.Close()
+
+	pulses := make([]insolar.PulseNumber, 0, count)
+	for len(pulses) < count && it.Next() {
+		pulses = append(pulses, insolar.NewPulseNumber(it.Key()))
+	}
+	for i, j := 0, len(pulses)-1; i < j; i, j = i+1, j-1 {
+		pulses[i], pulses[j] = pulses[j], pulses[i]
+	}
+	return pulses, nil
+}
+
+func (s *DBMisbehaviorStorage) ForPulseNumber(pulse insolar.PulseNumber) ([]MisbehaviorReport, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.forPulseNumber(pulse)
+}
+
+func (s *DBMisbehaviorStorage) forPulseNumber(pulse insolar.PulseNumber) ([]MisbehaviorReport, error) {
+	buf, err := s.db.Get(misbehaviorKey(pulse))
+	if err == ErrNotFound {
+		return []MisbehaviorReport{}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "[misbehaviorStorage] Failed to get reports from DB")
+	}
+
+	var reports []MisbehaviorReport
+	err = json.Unmarshal(buf, &reports)
+	if err != nil {
+		return nil, errors.Wrap(err, "[misbehaviorStorage] Failed to decode reports")
+	}
+	return reports, nil
+}
+
+// NewMemoryMisbehaviorStorage creates MisbehaviorStorage that keeps reports in memory.
+func NewMemoryMisbehaviorStorage() *MemoryMisbehaviorStorage {
+	return &MemoryMisbehaviorStorage{
+		entries: make(map[insolar.PulseNumber][]MisbehaviorReport),
+	}
+}
+
+type MemoryMisbehaviorStorage struct {
+	lock    sync.RWMutex
+	entries map[insolar.PulseNumber][]MisbehaviorReport
+}
+
+func (m *MemoryMisbehaviorStorage) Append(report MisbehaviorReport) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.entries[report.PulseNumber] = append(m.entries[report.PulseNumber], report)
+	return nil
+}
+
+func (m *MemoryMisbehaviorStorage) Commit(pulse insolar.PulseNumber) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.entries[pulse]; !ok {
+		m.entries[pulse] = []MisbehaviorReport{}
+	}
+	return nil
+}
+
+func (m *MemoryMisbehaviorStorage) LastPulses(count int) ([]insolar.PulseNumber, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	pulses := make([]insolar.PulseNumber, 0, len(m.entries))
+	for pn := range m.entries {
+		pulses = append(pulses, pn)
+	}
+	sort.Slice(pulses, func(i, j int) bool { return pulses[i] < pulses[j] })
+	if len(pulses) > count {
+		pulses = pulses[len(pulses)-count:]
+	}
+	return pulses, nil
+}
+
+func (m *MemoryMisbehaviorStorage) ForPulseNumber(pulse insolar.PulseNumber) ([]MisbehaviorReport, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	reports := make([]MisbehaviorReport, len(m.entries[pulse]))
+	copy(reports, m.entries[pulse])
+	return reports, nil
+}
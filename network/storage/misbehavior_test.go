@@ -0,0 +1,115 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/stretchr/testify/require"
+)
+
+func testMisbehaviorStorage(t *testing.T, ms MisbehaviorStorage) {
+	reports, err := ms.ForPulseNumber(insolar.PulseNumber(15))
+	require.NoError(t, err)
+	require.Empty(t, reports)
+
+	first := MisbehaviorReport{PulseNumber: 15, NodeID: 1, Fraud: true, Type: 2, Details: "fraud"}
+	second := MisbehaviorReport{PulseNumber: 15, Host: "127.0.0.1:22", Type: 1, Details: "blame"}
+	other := MisbehaviorReport{PulseNumber: 25, NodeID: 3, Type: 1}
+	require.NoError(t, ms.Append(first))
+	require.NoError(t, ms.Append(second))
+	require.NoError(t, ms.Append(other))
+
+	reports, err = ms.ForPulseNumber(insolar.PulseNumber(15))
+	require.NoError(t, err)
+	require.Equal(t, []MisbehaviorReport{first, second}, reports)
+
+	reports, err = ms.ForPulseNumber(insolar.PulseNumber(25))
+	require.NoError(t, err)
+	require.Equal(t, []MisbehaviorReport{other}, reports)
+
+	require.NoError(t, ms.Commit(insolar.PulseNumber(15)))
+	require.NoError(t, ms.Commit(insolar.PulseNumber(35)))
+
+	reports, err = ms.ForPulseNumber(insolar.PulseNumber(15))
+	require.NoError(t, err)
+	require.Equal(t, []MisbehaviorReport{first, second}, reports)
+
+	pulses, err := ms.LastPulses(2)
+	require.NoError(t, err)
+	require.Equal(t, []insolar.PulseNumber{25, 35}, pulses)
+
+	pulses, err = ms.LastPulses(10)
+	require.NoError(t, err)
+	require.Equal(t, []insolar.PulseNumber{15, 25, 35}, pulses)
+}
+
+func TestMisbehaviorStorage(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	ctx := inslogger.TestContext(t)
+	badgerDB, err := NewBadgerDB(configuration.ServiceNetwork{CacheDirectory: tmpdir})
+	require.NoError(t, err)
+	defer badgerDB.Stop(ctx)
+
+	testMisbehaviorStorage(t, NewMisbehaviorStorage(badgerDB))
+}
+
+func TestMemoryMisbehaviorStorage(t *testing.T) {
+	testMisbehaviorStorage(t, NewMemoryMisbehaviorStorage())
+}
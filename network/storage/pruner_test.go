@@ -0,0 +1,135 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/node"
+)
+
+func TestPruner_Prune(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	db, err := NewBadgerDB(configuration.ServiceNetwork{CacheDirectory: tmpdir})
+	require.NoError(t, err)
+	defer db.Stop(ctx)
+
+	ps := NewPulseStorage()
+	ps.DB = db
+	ss := NewSnapshotStorage()
+	ss.DB = db
+	cs := NewCloudHashStorage()
+	cs.DB = db
+	pruner := NewPruner(ps, ss, cs, time.Minute)
+
+	start := time.Now()
+	pulses := make([]insolar.Pulse, 0, 4)
+	for i, age := range []time.Duration{0, 30 * time.Second, 90 * time.Second, 100 * time.Second} {
+		p := insolar.Pulse{
+			PulseNumber:    insolar.FirstPulseNumber + insolar.PulseNumber(i*10),
+			PulseTimestamp: start.Add(age).UnixNano(),
+		}
+		pulses = append(pulses, p)
+		require.NoError(t, ps.AppendPulse(ctx, p))
+		require.NoError(t, ss.Append(p.PulseNumber, node.NewSnapshot(p.PulseNumber, nil)))
+		require.NoError(t, cs.Append(p.PulseNumber, []byte{byte(i)}))
+	}
+
+	require.NoError(t, pruner.Prune(ctx, pulses[3]))
+
+	// state of pulses older than a minute is removed
+	for _, p := range pulses[:2] {
+		_, err := ps.GetPulse(ctx, p.PulseNumber)
+		assert.Equal(t, ErrNotFound, err)
+		_, err = ss.ForPulseNumber(p.PulseNumber)
+		assert.Error(t, err)
+		_, err = cs.ForPulseNumber(p.PulseNumber)
+		assert.Equal(t, ErrNotFound, err)
+	}
+	for _, p := range pulses[2:] {
+		stored, err := ps.GetPulse(ctx, p.PulseNumber)
+		require.NoError(t, err)
+		assert.Equal(t, p, stored)
+		_, err = ss.ForPulseNumber(p.PulseNumber)
+		assert.NoError(t, err)
+		_, err = cs.ForPulseNumber(p.PulseNumber)
+		assert.NoError(t, err)
+	}
+
+	// the oldest kept pulse is the tail now
+	_, err = ps.Backwards(ctx, pulses[3].PulseNumber, 2)
+	assert.Equal(t, insolar.ErrNotFound, err)
+	prev, err := ps.Backwards(ctx, pulses[3].PulseNumber, 1)
+	require.NoError(t, err)
+	assert.Equal(t, pulses[2], prev)
+
+	// the latest pulse is kept regardless of age
+	require.NoError(t, pruner.Prune(ctx, insolar.Pulse{PulseTimestamp: start.Add(time.Hour).UnixNano()}))
+	latest, err := ps.GetLatestPulse(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, pulses[3], latest)
+	_, err = ss.ForPulseNumber(pulses[3].PulseNumber)
+	assert.NoError(t, err)
+}
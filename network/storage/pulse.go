@@ -53,8 +53,12 @@ package storage
 import (
 	"context"
 	"sync"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
 )
 
 //go:generate minimock -i github.com/insolar/insolar/network/storage.PulseAccessor -o ../../testutils/network -s _mock.go -g
@@ -211,6 +215,9 @@ func (p *PulseStorage) AppendPulse(ctx context.Context, pulse insolar.Pulse) err
 }
 
 func (p *PulseStorage) GetPulse(ctx context.Context, pn insolar.PulseNumber) (pulse insolar.Pulse, err error) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
 	nd, err := p.get(pn)
 	if err != nil {
 		return
@@ -233,6 +240,54 @@ func (p *PulseStorage) GetLatestPulse(ctx context.Context) (insolar.Pulse, error
 	return nd.Pulse, nil
 }
 
+// TruncateTail removes pulses with timestamp before until, the latest pulse is always kept. Returns number of the
+// oldest kept pulse, so state of older pulses could be removed from other storages.
+func (p *PulseStorage) TruncateTail(ctx context.Context, until time.Time) (insolar.PulseNumber, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	head, err := p.head()
+	if err != nil {
+		return 0, err
+	}
+
+	oldest := head
+	var old []insolar.PulseNumber
+	it := p.DB.NewIterator(pulseKey(0), false)
+	for it.Next() {
+		key := it.Key()
+		if key[0] != prefixPulse {
+			break
+		}
+		pn := insolar.NewPulseNumber(key[1:])
+		buf, err := it.Value()
+		if err != nil || pn >= head || !time.Unix(0, deserialize(buf).Pulse.PulseTimestamp).Before(until) {
+			oldest = pn
+			break
+		}
+		old = append(old, pn)
+	}
+	it.Close()
+
+	if len(old) == 0 {
+		return oldest, nil
+	}
+	for _, pn := range old {
+		if err := p.DB.Delete(pulseKey(pn)); err != nil {
+			return 0, errors.Wrapf(err, "failed to delete pulse %s", pn)
+		}
+	}
+	inslogger.FromContext(ctx).Debugf("Removed %d pulses before %s", len(old), oldest)
+
+	// oldest kept pulse has no previous one anymore
+	nd, err := p.get(oldest)
+	if err != nil {
+		return 0, err
+	}
+	nd.Prev = nil
+	return oldest, p.set(oldest, nd)
+}
+
 type metaKey byte
 
 func (k metaKey) Scope() Scope {
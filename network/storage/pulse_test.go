@@ -72,7 +72,7 @@ func TestNewPulseStorage(t *testing.T) {
 	ctx := inslogger.TestContext(t)
 	cm := component.NewManager(nil)
 	badgerDB, err := NewBadgerDB(configuration.ServiceNetwork{CacheDirectory: tmpdir})
-	defer badgerDB.Stop(ctx)
+	require.NoError(t, err)
 	ps := NewPulseStorage()
 
 	cm.Register(badgerDB, ps)
@@ -66,9 +66,9 @@ type SnapshotStorage interface {
 	Append(pulse insolar.PulseNumber, snapshot *node.Snapshot) error
 }
 
-// newSnapshotStorage constructor creates PulseStorage
-func newSnapshotStorage() *snapshotStorage { // nolint
-	return &snapshotStorage{}
+// NewSnapshotStorage constructor creates SnapshotStorage that keeps snapshots in the DB.
+func NewSnapshotStorage() *DBSnapshotStorage {
+	return &DBSnapshotStorage{}
 }
 
 // NewMemorySnapshotStorage constructor creates PulseStorage
@@ -78,12 +78,22 @@ func NewMemorySnapshotStorage() *MemorySnapshotStorage {
 	}
 }
 
-type snapshotStorage struct { // nolint
+type snapshotKey insolar.PulseNumber
+
+func (k snapshotKey) Scope() Scope {
+	return ScopeSnapshot
+}
+
+func (k snapshotKey) ID() []byte {
+	return insolar.PulseNumber(k).Bytes()
+}
+
+type DBSnapshotStorage struct {
 	DB   DB `inject:""`
 	lock sync.RWMutex
 }
 
-func (s *snapshotStorage) Append(pulse insolar.PulseNumber, snapshot *node.Snapshot) error {
+func (s *DBSnapshotStorage) Append(pulse insolar.PulseNumber, snapshot *node.Snapshot) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
@@ -91,14 +101,14 @@ func (s *snapshotStorage) Append(pulse insolar.PulseNumber, snapshot *node.Snaps
 	if err != nil {
 		return errors.Wrap(err, "[snapshotStorage] Failed to append snapshot")
 	}
-	return s.DB.Set(pulseKey(pulse), buff)
+	return s.DB.Set(snapshotKey(pulse), buff)
 }
 
-func (s *snapshotStorage) ForPulseNumber(pulse insolar.PulseNumber) (*node.Snapshot, error) {
+func (s *DBSnapshotStorage) ForPulseNumber(pulse insolar.PulseNumber) (*node.Snapshot, error) {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
 
-	buf, err := s.DB.Get(pulseKey(pulse))
+	buf, err := s.DB.Get(snapshotKey(pulse))
 	if err != nil {
 		return nil, errors.Wrap(err, "[snapshotStorage] Failed to get snapshot from DB")
 	}
@@ -110,6 +120,16 @@ func (s *snapshotStorage) ForPulseNumber(pulse insolar.PulseNumber) (*node.Snaps
 	return result, nil
 }
 
+// TruncateTail removes snapshots of pulses older than until.
+func (s *DBSnapshotStorage) TruncateTail(until insolar.PulseNumber) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return errors.Wrap(truncateTail(s.DB, func(pn insolar.PulseNumber) Key {
+		return snapshotKey(pn)
+	}, until), "[snapshotStorage] Failed to truncate snapshots")
+}
+
 type MemorySnapshotStorage struct {
 	lock    sync.RWMutex
 	entries map[insolar.PulseNumber]*node.Snapshot
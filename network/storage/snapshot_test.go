@@ -74,8 +74,8 @@ func TestNewSnapshotStorage(t *testing.T) {
 	ctx := inslogger.TestContext(t)
 	cm := component.NewManager(nil)
 	badgerDB, err := NewBadgerDB(configuration.ServiceNetwork{CacheDirectory: tmpdir})
-	defer badgerDB.Stop(ctx)
-	ss := newSnapshotStorage()
+	require.NoError(t, err)
+	ss := NewSnapshotStorage()
 
 	cm.Register(badgerDB, ss)
 	cm.Inject()
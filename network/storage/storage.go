@@ -51,18 +51,15 @@
 package storage
 
 import (
-	"context"
-	"path/filepath"
-
-	"github.com/dgraph-io/badger"
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/store"
 	"github.com/pkg/errors"
 )
 
 var (
 	// ErrNotFound is returned when value was not found.
-	ErrNotFound = errors.New("value not found")
+	ErrNotFound = store.ErrNotFound
 	ErrBadPulse = errors.New("pulse should be bigger than latest")
 )
 
@@ -77,102 +74,49 @@ func (k pulseKey) ID() []byte {
 }
 
 // DB provides a simple key-value store interface for persisting data.
-type DB interface {
-	Get(key Key) (value []byte, err error)
-	Set(key Key, value []byte) error
-}
+type DB = store.DB
 
 // Key represents a key for the key-value store. Scope is required to separate different DB clients and should be
 // unique.
-type Key interface {
-	Scope() Scope
-	ID() []byte
-}
+type Key = store.Key
 
 // Scope separates DB clients.
-type Scope byte
-
-// Bytes returns binary scope representation.
-func (s Scope) Bytes() []byte {
-	return []byte{byte(s)}
-}
+type Scope = store.Scope
 
 const (
 	// ScopePulse is the scope for pulse storage.
 	ScopePulse Scope = 1
+	// ScopeMisbehavior is the scope for misbehavior reports storage.
+	ScopeMisbehavior Scope = 2
+	// ScopeSnapshot is the scope for snapshot storage.
+	ScopeSnapshot Scope = 3
+	// ScopeCloudHash is the scope for cloud hash storage.
+	ScopeCloudHash Scope = 4
 )
 
-type BadgerDB struct {
-	db *badger.DB
-}
-
-// NewBadgerDB creates new badger DB instance. Configuration should contain DataDirectory option. Badger will create
-// files there.
-func NewBadgerDB(conf configuration.ServiceNetwork) (*BadgerDB, error) {
-	dir, err := filepath.Abs(conf.CacheDirectory)
-	if err != nil {
-		return nil, err
-	}
-
-	ops := badger.DefaultOptions(dir)
-	bdb, err := badger.Open(ops)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to open badger")
-	}
-
-	db := &BadgerDB{
-		db: bdb,
-	}
-	return db, nil
-}
-
-// Get returns value for specified key or an error. A copy of a value will be returned (i.e. getting large value can be
-// long).
-func (b *BadgerDB) Get(key Key) (value []byte, err error) {
-	fullKey := append(key.Scope().Bytes(), key.ID()...)
-
-	err = b.db.View(func(txn *badger.Txn) error {
-		item, err := txn.Get(fullKey)
-		if err != nil {
-			return err
-		}
-		value, err = item.ValueCopy(nil)
-		if err != nil {
-			return err
-		}
-
-		return nil
-	})
-
-	if err != nil {
-		if err == badger.ErrKeyNotFound {
-			return nil, ErrNotFound
+// truncateTail removes entries keyed by pulse number that are older than until.
+func truncateTail(db DB, key func(insolar.PulseNumber) Key, until insolar.PulseNumber) error {
+	var old []insolar.PulseNumber
+	it := db.NewIterator(key(0), false)
+	for it.Next() {
+		pn := insolar.NewPulseNumber(it.Key())
+		if pn >= until {
+			break
 		}
-		return nil, err
+		old = append(old, pn)
 	}
+	it.Close()
 
-	return
-}
-
-// Set stores value for a key.
-func (b *BadgerDB) Set(key Key, value []byte) error {
-	fullKey := append(key.Scope().Bytes(), key.ID()...)
-
-	err := b.db.Update(func(txn *badger.Txn) error {
-		err := txn.Set(fullKey, value)
-		if err != nil {
-			return err
+	for _, pn := range old {
+		if err := db.Delete(key(pn)); err != nil {
+			return errors.Wrapf(err, "failed to delete entry of pulse %s", pn)
 		}
-		return nil
-	})
-	if err != nil {
-		return err
 	}
-
 	return nil
 }
 
-// Stop gracefully stops all disk writes. After calling this, it's safe to kill the process without losing data.
-func (b *BadgerDB) Stop(ctx context.Context) error {
-	return b.db.Close()
+// NewBadgerDB creates new badger DB instance. Configuration should contain CacheDirectory option. Badger will create
+// files there.
+func NewBadgerDB(conf configuration.ServiceNetwork) (*store.BadgerDB, error) {
+	return store.NewBadgerDB(conf.CacheDirectory)
 }
@@ -55,10 +55,14 @@ import (
 	"os"
 	"testing"
 
-	"github.com/dgraph-io/badger"
 	fuzz "github.com/google/gofuzz"
+	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/node"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -77,7 +81,7 @@ func (k testBadgerKey) ID() []byte {
 	return k.id
 }
 
-func TestBadgerDB_Get(t *testing.T) {
+func TestBadgerDB_SetGet(t *testing.T) {
 	t.Parallel()
 
 	ctx := inslogger.TestContext(t)
@@ -87,8 +91,8 @@ func TestBadgerDB_Get(t *testing.T) {
 	require.NoError(t, err)
 
 	db, err := NewBadgerDB(configuration.ServiceNetwork{CacheDirectory: tmpdir})
-	defer db.Stop(ctx)
 	require.NoError(t, err)
+	defer db.Stop(ctx)
 
 	var (
 		key           testBadgerKey
@@ -97,46 +101,67 @@ func TestBadgerDB_Get(t *testing.T) {
 	f := fuzz.New().NilChance(0)
 	f.Fuzz(&key)
 	f.Fuzz(&expectedValue)
-	err = db.db.Update(func(txn *badger.Txn) error {
-		return txn.Set(append(key.Scope().Bytes(), key.ID()...), expectedValue)
-	})
+
+	_, err = db.Get(key)
+	assert.Equal(t, ErrNotFound, err)
+
+	err = db.Set(key, expectedValue)
 	require.NoError(t, err)
+
 	value, err := db.Get(key)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedValue, value)
 }
 
-func TestBadgerDB_Set(t *testing.T) {
-	t.Parallel()
+func TestStorages_SharedDB(t *testing.T) {
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
 
 	ctx := inslogger.TestContext(t)
+	cm := component.NewManager(nil)
+	badgerDB, err := NewBadgerDB(configuration.ServiceNetwork{CacheDirectory: tmpdir})
+	require.NoError(t, err)
 
-	tmpdir, err := ioutil.TempDir("", "bdb-test-")
-	defer os.RemoveAll(tmpdir)
+	ps := NewPulseStorage()
+	ss := NewSnapshotStorage()
+	cs := NewCloudHashStorage()
+	ms := NewMisbehaviorStorage(badgerDB)
+
+	cm.Register(badgerDB, ps, ss, cs)
+	cm.Inject()
+
+	ks := platformpolicy.NewKeyProcessor()
+	p1, err := ks.GeneratePrivateKey()
 	require.NoError(t, err)
+	n := node.NewNode(testutils.RandomRef(), insolar.StaticRoleVirtual, ks.ExtractPublicKey(p1), "127.0.0.1:22", "ver2")
 
-	db, err := NewBadgerDB(configuration.ServiceNetwork{CacheDirectory: tmpdir})
-	defer db.Stop(ctx)
+	pulse := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 10}
+	snap := node.NewSnapshot(pulse.PulseNumber, []insolar.NetworkNode{n})
+	cloudHash := []byte{1, 2, 3, 4, 5}
+	report := MisbehaviorReport{PulseNumber: pulse.PulseNumber, NodeID: n.ShortID(), Fraud: true}
+
+	require.NoError(t, ps.AppendPulse(ctx, pulse))
+	require.NoError(t, ss.Append(pulse.PulseNumber, snap))
+	require.NoError(t, cs.Append(pulse.PulseNumber, cloudHash))
+	require.NoError(t, ms.Append(report))
+
+	pulse2, err := ps.GetPulse(ctx, pulse.PulseNumber)
 	require.NoError(t, err)
+	assert.Equal(t, pulse, pulse2)
 
-	var (
-		key           testBadgerKey
-		expectedValue []byte
-		value         []byte
-	)
-	f := fuzz.New().NilChance(0)
-	f.Fuzz(&key)
-	f.Fuzz(&expectedValue)
-	err = db.Set(key, expectedValue)
-	assert.NoError(t, err)
+	snap2, err := ss.ForPulseNumber(pulse.PulseNumber)
+	require.NoError(t, err)
+	assert.True(t, snap.Equal(snap2))
+
+	cloudHash2, err := cs.ForPulseNumber(pulse.PulseNumber)
+	require.NoError(t, err)
+	assert.Equal(t, cloudHash, cloudHash2)
+
+	reports, err := ms.ForPulseNumber(pulse.PulseNumber)
+	require.NoError(t, err)
+	assert.Equal(t, []MisbehaviorReport{report}, reports)
 
-	err = db.db.View(func(txn *badger.Txn) error {
-		item, err := txn.Get(append(key.Scope().Bytes(), key.ID()...))
-		require.NoError(t, err)
-		value, err = item.ValueCopy(nil)
-		require.NoError(t, err)
-		return nil
-	})
+	err = cm.Stop(ctx)
 	assert.NoError(t, err)
-	assert.Equal(t, expectedValue, value)
 }
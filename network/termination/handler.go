@@ -53,25 +53,50 @@ package termination
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sync"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/consensus/common/capacity"
 
 	"github.com/insolar/insolar/insolar"
 )
 
+const (
+	// drainRolePulses is amount of pulses after which zero power of the node is applied by consensus
+	// and the node is not chosen as executor anymore.
+	drainRolePulses = 2
+	// drainMaxPulses is amount of pulses after which node leaves even if some work is still pending.
+	drainMaxPulses = 20
+)
+
+var drainCheckInterval = time.Second
+
+// powerChanger is implemented by network able to change power of the node.
+type powerChanger interface {
+	ChangePower(ctx context.Context, level capacity.Level) error
+}
+
 type terminationHandler struct {
 	sync.Mutex
 	done        chan insolar.LeaveApproved
 	terminating bool
 
+	drainLock   sync.RWMutex
+	drainStatus insolar.DrainStatus
+	drainers    []insolar.Drainer
+
 	Network       insolar.Network `inject:""`
 	PulseAccessor pulse.Accessor  `inject:""`
 }
 
-func NewHandler(nw insolar.Network) insolar.TerminationHandler {
-	return &terminationHandler{Network: nw}
+// NewHandler creates termination handler. Drainers are asked to finish their work before the node leaves on Drain.
+func NewHandler(nw insolar.Network, drainers ...insolar.Drainer) insolar.TerminationHandler {
+	return &terminationHandler{Network: nw, drainers: drainers}
 }
 
 // TODO take ETA by role of node
@@ -123,3 +148,130 @@ func (t *terminationHandler) Abort(reason string) {
 func (t *terminationHandler) Terminating() bool {
 	return t.terminating
 }
+
+// Drain makes drainers stop accepting new work and sets zero power of the node, so it is not chosen as
+// executor in the next pulses. After the node lost its roles and all pending work was finished or handed off,
+// it leaves the network.
+func (t *terminationHandler) Drain(ctx context.Context, leaveAfterPulses insolar.PulseNumber) {
+	logger := inslogger.FromContext(ctx)
+
+	startPulse, err := t.PulseAccessor.Latest(ctx)
+	if err != nil {
+		logger.Error(errors.Wrap(err, "failed to start draining, leaving immediately"))
+		t.Leave(ctx, 0)
+		return
+	}
+
+	t.drainLock.Lock()
+	if t.drainStatus.Stage != insolar.DrainIdle {
+		t.drainLock.Unlock()
+		logger.Warn("terminationHandler.Drain() is already in progress")
+		return
+	}
+	t.drainStatus = insolar.DrainStatus{
+		Stage:      insolar.DrainStarted,
+		StartPulse: startPulse.PulseNumber,
+	}
+	t.drainLock.Unlock()
+
+	logger.Infof("terminationHandler.Drain() started in pulse %v", startPulse.PulseNumber)
+
+	if pc, ok := t.Network.(powerChanger); ok {
+		if err := pc.ChangePower(ctx, capacity.LevelZero); err != nil {
+			logger.Warn(errors.Wrap(err, "failed to set zero power"))
+		}
+	}
+	for _, d := range t.drainers {
+		d.StartDrain(ctx)
+	}
+
+	t.waitDrained(ctx, startPulse)
+
+	t.setDrainStage(insolar.DrainLeaving)
+	t.Leave(ctx, leaveAfterPulses)
+	t.setDrainStage(insolar.DrainDone)
+	logger.Info("terminationHandler.Drain() finished")
+}
+
+func (t *terminationHandler) waitDrained(ctx context.Context, startPulse insolar.Pulse) {
+	logger := inslogger.FromContext(ctx)
+
+	pulseDelta := startPulse.NextPulseNumber - startPulse.PulseNumber
+	if pulseDelta == 0 {
+		pulseDelta = 1
+	}
+
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		passed := insolar.PulseNumber(0)
+		if latest, err := t.PulseAccessor.Latest(ctx); err == nil && latest.PulseNumber > startPulse.PulseNumber {
+			passed = (latest.PulseNumber - startPulse.PulseNumber) / pulseDelta
+		}
+
+		pending, total := t.pendingWork(ctx)
+		stage := insolar.DrainStarted
+		if passed >= drainRolePulses {
+			stage = insolar.DrainHandoff
+		}
+
+		t.drainLock.Lock()
+		t.drainStatus.Stage = stage
+		t.drainStatus.Pending = pending
+		t.drainLock.Unlock()
+
+		if stage == insolar.DrainHandoff && total == 0 {
+			return
+		}
+		if passed >= drainMaxPulses {
+			logger.Warnf("terminationHandler.Drain() timed out, leaving with pending work: %v", pending)
+			return
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (t *terminationHandler) pendingWork(ctx context.Context) (map[string]int, int) {
+	pending := make(map[string]int, len(t.drainers))
+	total := 0
+	for _, d := range t.drainers {
+		n := d.PendingWork(ctx)
+		pending[drainerName(d)] += n
+		total += n
+	}
+	return pending, total
+}
+
+func drainerName(d insolar.Drainer) string {
+	tp := reflect.TypeOf(d)
+	if tp.Kind() == reflect.Ptr {
+		tp = tp.Elem()
+	}
+	return tp.String()
+}
+
+func (t *terminationHandler) setDrainStage(stage insolar.DrainStage) {
+	t.drainLock.Lock()
+	defer t.drainLock.Unlock()
+
+	t.drainStatus.Stage = stage
+}
+
+// DrainStatus returns progress of draining.
+func (t *terminationHandler) DrainStatus() insolar.DrainStatus {
+	t.drainLock.RLock()
+	defer t.drainLock.RUnlock()
+
+	res := t.drainStatus
+	res.Pending = make(map[string]int, len(t.drainStatus.Pending))
+	for name, n := range t.drainStatus.Pending {
+		res.Pending[name] = n
+	}
+	return res
+}
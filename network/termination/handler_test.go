@@ -170,3 +170,65 @@ func (s *AbortTestSuite) TestBasicUsage() {
 
 	s.handler.Abort("abort")
 }
+
+type testDrainer struct {
+	started bool
+	pending []int
+}
+
+func (d *testDrainer) StartDrain(ctx context.Context) {
+	d.started = true
+}
+
+func (d *testDrainer) PendingWork(ctx context.Context) int {
+	if len(d.pending) == 0 {
+		return 0
+	}
+	n := d.pending[0]
+	d.pending = d.pending[1:]
+	return n
+}
+
+func TestDrain(t *testing.T) {
+	suite.Run(t, new(DrainTestSuite))
+}
+
+type DrainTestSuite struct {
+	CommonTestSuite
+}
+
+func (s *DrainTestSuite) TestDrainAndLeave() {
+	defer func(interval time.Duration) { drainCheckInterval = interval }(drainCheckInterval)
+	drainCheckInterval = time.Millisecond
+
+	start := insolar.Pulse{PulseNumber: 65537, NextPulseNumber: 65547}
+	current := start
+	s.pulseAccessor.LatestMock.Set(func(ctx context.Context) (insolar.Pulse, error) {
+		res := current
+		current.PulseNumber = current.NextPulseNumber
+		current.NextPulseNumber += 10
+		return res, nil
+	})
+
+	drainer := &testDrainer{pending: []int{3, 2, 1}}
+	s.handler.drainers = []insolar.Drainer{drainer}
+	s.network.LeaveMock.Set(func(ctx context.Context, eta insolar.PulseNumber) {
+		s.Equal(insolar.PulseNumber(0), eta)
+		s.Equal(insolar.DrainLeaving, s.handler.DrainStatus().Stage)
+		go s.handler.OnLeaveApproved(ctx)
+	})
+
+	s.handler.Drain(s.ctx, 0)
+
+	s.True(drainer.started)
+	status := s.handler.DrainStatus()
+	s.Equal(insolar.DrainDone, status.Stage)
+	s.Equal(start.PulseNumber, status.StartPulse)
+	s.Equal(map[string]int{"termination.testDrainer": 0}, status.Pending)
+}
+
+func (s *DrainTestSuite) TestDrainStatusIdle() {
+	status := s.handler.DrainStatus()
+	s.Equal(insolar.DrainIdle, status.Stage)
+	s.Empty(status.Pending)
+}
@@ -52,6 +52,7 @@ package transport
 
 import (
 	"errors"
+	"sync"
 
 	"github.com/insolar/insolar/configuration"
 )
@@ -64,24 +65,114 @@ type Factory interface {
 
 // NewFactory constructor creates new transport factory
 func NewFactory(cfg configuration.Transport) Factory {
-	return &factory{cfg: cfg}
+	return NewFactoryWithRelayAuth(cfg, nil)
+}
+
+// NewFactoryWithRelayAuth creates new transport factory, auth is used to register on the relay server
+// and to verify relay clients. Relay can't be configured without auth.
+func NewFactoryWithRelayAuth(cfg configuration.Transport, auth RelayAuthenticator) Factory {
+	f := &factory{cfg: cfg, relayAuth: auth}
+	if cfg.FixedPublicAddress == "" && len(cfg.Reflectors) > 0 {
+		f.reflected = &reflectedAddress{}
+	}
+	if cfg.Relay.Server != "" {
+		f.relayClient = newRelayClient(cfg.Relay.Server, auth)
+	}
+	return f
+}
+
+// reflectedAddress is a public address discovered by reflectors for UDP socket.
+// TCP transport listens on the same port and shares the address.
+type reflectedAddress struct {
+	lock    sync.RWMutex
+	address string
+}
+
+func (a *reflectedAddress) set(address string) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.address = address
+}
+
+func (a *reflectedAddress) get() string {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return a.address
 }
 
 type factory struct {
-	cfg configuration.Transport
+	cfg       configuration.Transport
+	relayAuth RelayAuthenticator
+	reflected *reflectedAddress
+	// relayClient is shared by transports when node receives traffic through the relay
+	relayClient *relayClient
+	// quic is shared by datagram and stream transports when QUIC protocol is used
+	quic *quicTransport
+}
+
+func (f *factory) relayConfigured() bool {
+	return f.cfg.Relay.Server != "" || f.cfg.Relay.Listen != ""
 }
 
-// CreateStreamTransport creates new TCP transport
+func (f *factory) getQUIC() *quicTransport {
+	if f.quic == nil {
+		f.quic = newQUICTransport(f.cfg.Address, f.cfg.FixedPublicAddress, f.cfg.Reflectors)
+	}
+	return f.quic
+}
+
+// CreateStreamTransport creates new TCP or QUIC transport
 func (f *factory) CreateStreamTransport(handler StreamHandler) (StreamTransport, error) {
+	if f.relayConfigured() && f.relayAuth == nil {
+		return nil, errors.New("relay requires authentication of nodes")
+	}
+
+	var t StreamTransport
 	switch f.cfg.Protocol {
 	case "TCP":
-		return newTCPTransport(f.cfg.Address, f.cfg.FixedPublicAddress, handler), nil
+		t = newTCPTransport(f.cfg.Address, f.cfg.FixedPublicAddress, f.reflected, handler)
+	case "QUIC":
+		if f.relayConfigured() {
+			return nil, errors.New("relay is supported only for TCP transport")
+		}
+		q := f.getQUIC()
+		q.setStreamHandler(handler)
+		return &quicStreamTransport{quicTransport: q}, nil
 	default:
 		return nil, errors.New("invalid transport configuration")
 	}
+
+	if f.cfg.Relay.Listen != "" {
+		t = &relayingStreamTransport{StreamTransport: t, server: newRelayServer(f.cfg, f.relayAuth)}
+	}
+	if f.relayClient != nil {
+		f.relayClient.setStreamHandler(handler)
+		t = &relayedStreamTransport{StreamTransport: t, client: f.relayClient}
+	}
+	return t, nil
 }
 
-// CreateDatagramTransport creates new UDP transport
+// CreateDatagramTransport creates new UDP transport, datagrams are sent over the same socket with streams for QUIC
 func (f *factory) CreateDatagramTransport(handler DatagramHandler) (DatagramTransport, error) {
-	return newUDPTransport(f.cfg.Address, f.cfg.FixedPublicAddress, handler), nil
+	if f.relayConfigured() && f.relayAuth == nil {
+		return nil, errors.New("relay requires authentication of nodes")
+	}
+
+	if f.cfg.Protocol == "QUIC" {
+		if f.relayConfigured() {
+			return nil, errors.New("relay is supported only for TCP transport")
+		}
+		q := f.getQUIC()
+		q.setDatagramHandler(handler)
+		return &quicDatagramTransport{quicTransport: q}, nil
+	}
+
+	var t DatagramTransport = newUDPTransport(f.cfg.Address, f.cfg.FixedPublicAddress, f.cfg.Reflectors, f.reflected, handler)
+	if f.relayClient != nil {
+		f.relayClient.setDatagramHandler(handler)
+		t = &relayedDatagramTransport{DatagramTransport: t, client: f.relayClient}
+	}
+	return t, nil
 }
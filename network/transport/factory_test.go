@@ -79,6 +79,10 @@ func TestFactory_Positive(t *testing.T) {
 			name: "FixedPublicAddress",
 			cfg:  configuration.Transport{Address: "localhost:0", FixedPublicAddress: "192.168.1.1", Protocol: "TCP"},
 		},
+		{
+			name: "QUIC",
+			cfg:  configuration.Transport{Address: "localhost:0", Protocol: "QUIC"},
+		},
 	}
 
 	for _, test := range table {
@@ -127,6 +131,10 @@ func TestFactoryStreamTransport_Negative(t *testing.T) {
 			name: "invalid protocol",
 			cfg:  configuration.Transport{Address: "localhost:0", FixedPublicAddress: "192.168.1.1", Protocol: "HTTP"},
 		},
+		{
+			name: "QUIC with relay",
+			cfg:  configuration.Transport{Address: "localhost:0", Protocol: "QUIC", Relay: configuration.Relay{Server: "127.0.0.1:1"}},
+		},
 	}
 
 	for _, test := range table {
@@ -0,0 +1,487 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package transport
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	quic "github.com/lucas-clemente/quic-go"
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/hostnetwork/resolver"
+)
+
+const (
+	// quicDatagramMarker prefixes raw datagrams sent over QUIC socket. QUIC packets always have fixed bit set in the first byte.
+	quicDatagramMarker     byte = 0
+	quicALPN                    = "insolar"
+	quicIdleTimeout             = 30 * time.Second
+	quicHandshakeTimeout        = 5 * time.Second
+	quicConnectionIDLength      = 8
+	// quicMaxIncomingStreams is high since every message is sent in its own stream.
+	quicMaxIncomingStreams = 10000
+)
+
+// quicTransport serves QUIC streams and raw datagrams over one UDP socket.
+// It is shared by datagram and stream transports created by the same factory.
+type quicTransport struct {
+	listenAddress      string
+	fixedPublicAddress string
+	reflectors         []string
+
+	mutex           sync.RWMutex
+	refs            int
+	conn            *quicPacketConn
+	listener        quic.Listener
+	clientTLS       *tls.Config
+	address         string
+	cancel          context.CancelFunc
+	datagramHandler DatagramHandler
+	streamHandler   StreamHandler
+
+	sessionsLock sync.Mutex
+	sessions     map[string]*quicSession
+}
+
+type quicSession struct {
+	sync.Mutex
+	session quic.Session
+}
+
+func newQUICTransport(listenAddress, fixedPublicAddress string, reflectors []string) *quicTransport {
+	return &quicTransport{
+		listenAddress:      listenAddress,
+		fixedPublicAddress: fixedPublicAddress,
+		reflectors:         reflectors,
+		sessions:           map[string]*quicSession{},
+	}
+}
+
+func (t *quicTransport) setDatagramHandler(handler DatagramHandler) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.datagramHandler = handler
+}
+
+func (t *quicTransport) setStreamHandler(handler StreamHandler) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.streamHandler = handler
+}
+
+func (t *quicTransport) Address() string {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.address
+}
+
+func (t *quicTransport) start(ctx context.Context) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.refs++
+	if t.refs > 1 {
+		return nil
+	}
+
+	if err := t.listen(ctx); err != nil {
+		t.refs--
+		return err
+	}
+	return nil
+}
+
+// listen must be called under lock.
+func (t *quicTransport) listen(ctx context.Context) error {
+	serverTLS, err := newQUICServerTLSConfig()
+	if err != nil {
+		return errors.Wrap(err, "failed to create TLS config")
+	}
+
+	udp, err := net.ListenPacket("udp", t.listenAddress)
+	if err != nil {
+		return errors.Wrap(err, "failed to listen UDP")
+	}
+
+	// reflectors are asked before QUIC starts reading the socket
+	address, err := resolver.ResolvePacketConn(t.fixedPublicAddress, udp, t.reflectors)
+	if err != nil {
+		udp.Close()
+		return errors.Wrap(err, "failed to resolve public address")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	conn := &quicPacketConn{PacketConn: udp, ctx: ctx, onDatagram: t.handleDatagram}
+	listener, err := quic.Listen(conn, serverTLS, newQUICConfig())
+	if err != nil {
+		cancel()
+		udp.Close()
+		return errors.Wrap(err, "failed to listen QUIC")
+	}
+
+	inslogger.FromContext(ctx).Info("[ Start ] Start QUIC transport")
+	t.conn = conn
+	t.listener = listener
+	t.clientTLS = &tls.Config{
+		// nodes don't have certificates, identity of a node is verified by the network protocol
+		// TLS is used for encryption and streams multiplexing only
+		InsecureSkipVerify: true,
+		NextProtos:         []string{quicALPN},
+	}
+	t.address = address
+	t.cancel = cancel
+
+	go t.acceptSessions(ctx, listener)
+	return nil
+}
+
+func (t *quicTransport) stop(ctx context.Context) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.refs == 0 {
+		return nil
+	}
+	t.refs--
+	if t.refs > 0 {
+		return nil
+	}
+
+	inslogger.FromContext(ctx).Info("[ Stop ] Stop QUIC transport")
+	t.cancel()
+
+	t.sessionsLock.Lock()
+	sessions := t.sessions
+	t.sessions = map[string]*quicSession{}
+	t.sessionsLock.Unlock()
+	for _, s := range sessions {
+		s.close()
+	}
+
+	err := t.listener.Close()
+	if err != nil && !network.IsConnectionClosed(err) {
+		return err
+	}
+	err = t.conn.Close()
+	t.conn = nil
+	if err != nil && !network.IsConnectionClosed(err) {
+		return err
+	}
+	return nil
+}
+
+// SendDatagram sends raw datagram to remote host over QUIC socket.
+func (t *quicTransport) SendDatagram(ctx context.Context, address string, data []byte) error {
+	t.mutex.RLock()
+	conn := t.conn
+	t.mutex.RUnlock()
+
+	if conn == nil {
+		return errors.New("failed to send datagram: transport is not started")
+	}
+	if len(data) > udpMaxPacketSize {
+		return errors.Errorf(
+			"failed to send datagram: too big input data. Maximum: %d. Current: %d",
+			udpMaxPacketSize,
+			len(data),
+		)
+	}
+
+	udpAddr, err := net.ResolveUDPAddr("udp", address)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve UDP address")
+	}
+
+	buf := make([]byte, 0, len(data)+1)
+	buf = append(buf, quicDatagramMarker)
+	buf = append(buf, data...)
+	n, err := conn.WriteTo(buf, udpAddr)
+	if err != nil {
+		return errors.Wrap(err, "failed to write data")
+	}
+	stats.Record(ctx, network.SentSize.M(int64(n)))
+	return nil
+}
+
+func (t *quicTransport) handleDatagram(ctx context.Context, address string, data []byte) {
+	t.mutex.RLock()
+	handler := t.datagramHandler
+	t.mutex.RUnlock()
+
+	stats.Record(ctx, network.RecvSize.M(int64(len(data))))
+	if handler != nil {
+		go handler.HandleDatagram(ctx, address, data)
+	}
+}
+
+// Dial opens a new stream over the session to the address, the session is created if needed.
+func (t *quicTransport) Dial(ctx context.Context, address string) (io.ReadWriteCloser, error) {
+	var lastErr error
+	// the second attempt is made with a new session if the cached one is broken
+	for attempt := 0; attempt < 2; attempt++ {
+		session, err := t.session(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+		stream, err := session.OpenStreamSync(ctx)
+		if err == nil {
+			return &quicStream{Stream: stream}, nil
+		}
+		lastErr = err
+		t.CloseSession(address)
+	}
+	return nil, errors.Wrap(lastErr, "[ Dial ] Failed to open QUIC stream")
+}
+
+func (t *quicTransport) session(ctx context.Context, address string) (quic.Session, error) {
+	t.mutex.RLock()
+	conn, clientTLS := t.conn, t.clientTLS
+	t.mutex.RUnlock()
+	if conn == nil {
+		return nil, errors.New("[ Dial ] transport is not started")
+	}
+
+	t.sessionsLock.Lock()
+	s, ok := t.sessions[address]
+	if !ok {
+		s = &quicSession{}
+		t.sessions[address] = s
+	}
+	t.sessionsLock.Unlock()
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.session != nil {
+		return s.session, nil
+	}
+
+	udpAddr, err := net.ResolveUDPAddr("udp", address)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Dial ] Failed to resolve UDP address")
+	}
+	session, err := quic.DialContext(ctx, conn, udpAddr, quicALPN, clientTLS, newQUICConfig())
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Dial ] Failed to open QUIC session")
+	}
+	s.session = session
+	return session, nil
+}
+
+// CloseSession closes session to the address with all its streams.
+func (t *quicTransport) CloseSession(address string) {
+	t.sessionsLock.Lock()
+	s, ok := t.sessions[address]
+	delete(t.sessions, address)
+	t.sessionsLock.Unlock()
+
+	if ok {
+		s.close()
+	}
+}
+
+func (s *quicSession) close() {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.session != nil {
+		_ = s.session.Close()
+		s.session = nil
+	}
+}
+
+func (t *quicTransport) acceptSessions(ctx context.Context, listener quic.Listener) {
+	logger := inslogger.FromContext(ctx)
+	for {
+		session, err := listener.Accept(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Warn("[ acceptSessions ] Failed to accept QUIC session: ", err)
+			}
+			return
+		}
+		go t.acceptStreams(ctx, session)
+	}
+}
+
+func (t *quicTransport) acceptStreams(ctx context.Context, session quic.Session) {
+	address := session.RemoteAddr().String()
+	for {
+		stream, err := session.AcceptStream(ctx)
+		if err != nil {
+			inslogger.FromContext(ctx).Debugf("[ acceptStreams ] QUIC session from %s closed: %s", address, err)
+			return
+		}
+
+		t.mutex.RLock()
+		handler := t.streamHandler
+		t.mutex.RUnlock()
+		if handler == nil {
+			stream.CancelRead(0)
+			continue
+		}
+		go handler.HandleStream(ctx, address, &quicStream{Stream: stream})
+	}
+}
+
+// quicStream closes both directions of QUIC stream on Close.
+type quicStream struct {
+	quic.Stream
+}
+
+func (s *quicStream) Close() error {
+	s.Stream.CancelRead(0)
+	return s.Stream.Close()
+}
+
+// quicPacketConn passes QUIC packets to QUIC implementation and handles raw datagrams and reflection requests itself.
+type quicPacketConn struct {
+	net.PacketConn
+	ctx        context.Context
+	onDatagram func(ctx context.Context, address string, data []byte)
+}
+
+func (c *quicPacketConn) ReadFrom(p []byte) (int, net.Addr, error) {
+	buf := make([]byte, udpMaxPacketSize+1)
+	for {
+		n, addr, err := c.PacketConn.ReadFrom(buf)
+		if err != nil {
+			return 0, addr, err
+		}
+		if n == 0 {
+			continue
+		}
+
+		switch {
+		case resolver.IsReflectionRequest(buf[:n]):
+			_, _ = c.PacketConn.WriteTo(resolver.ReflectionResponse(buf[:n], addr.String()), addr)
+		case buf[0] == quicDatagramMarker:
+			data := make([]byte, n-1)
+			copy(data, buf[1:n])
+			c.onDatagram(c.ctx, addr.String(), data)
+		default:
+			return copy(p, buf[:n]), addr, nil
+		}
+	}
+}
+
+func newQUICConfig() *quic.Config {
+	return &quic.Config{
+		ConnectionIDLength: quicConnectionIDLength,
+		HandshakeTimeout:   quicHandshakeTimeout,
+		IdleTimeout:        quicIdleTimeout,
+		MaxIncomingStreams: quicMaxIncomingStreams,
+		KeepAlive:          true,
+	}
+}
+
+// newQUICServerTLSConfig creates TLS config with self-signed certificate.
+func newQUICServerTLSConfig() (*tls.Config, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate key")
+	}
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(10 * 365 * 24 * time.Hour),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create certificate")
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{cert}, PrivateKey: key}},
+		NextProtos:   []string{quicALPN},
+	}, nil
+}
+
+// quicDatagramTransport is a datagram view of shared QUIC transport.
+type quicDatagramTransport struct {
+	*quicTransport
+}
+
+func (t *quicDatagramTransport) Start(ctx context.Context) error {
+	return t.start(ctx)
+}
+
+func (t *quicDatagramTransport) Stop(ctx context.Context) error {
+	return t.stop(ctx)
+}
+
+// quicStreamTransport is a stream view of shared QUIC transport.
+type quicStreamTransport struct {
+	*quicTransport
+}
+
+func (t *quicStreamTransport) Start(ctx context.Context) error {
+	return t.start(ctx)
+}
+
+func (t *quicStreamTransport) Stop(ctx context.Context) error {
+	return t.stop(ctx)
+}
@@ -0,0 +1,568 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package transport
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/hostnetwork/resolver"
+)
+
+// RelayAuthenticator proves that relay client is a node of the network. Relay server allocates ports
+// only for clients which signed the server challenge with the key of their authorization certificate.
+type RelayAuthenticator interface {
+	// Sign returns serialized authorization certificate of the node and signature of the challenge.
+	Sign(challenge []byte) (cert []byte, sign []byte, err error)
+	// Verify checks that certificate is valid and challenge is signed by the certificate key.
+	Verify(challenge, cert, sign []byte) error
+}
+
+// Relay protocol. Client behind NAT keeps a control connection to the relay server. Server allocates
+// a port on its public address and listens it with both TCP and UDP, so the client is reachable by
+// the relayed address. Datagrams received on the allocated port are forwarded via the control
+// connection. For every stream accepted on the allocated port server asks the client to open a new
+// connection to the server and pipes streams to each other. Outgoing traffic of the client goes directly.
+// Registration is answered by a challenge the client has to sign, see RelayAuthenticator.
+type relayMsgType uint8
+
+const (
+	relayRegister relayMsgType = iota + 1
+	relayAllocated
+	relayDatagram
+	relayOpen
+	relayAccept
+	relayChallenge
+	relayAuth
+)
+
+const (
+	relayHeaderSize = 1 + 2 + 4
+	// relayMaxPayload is enough for datagrams and authorization certificates with signs of discovery nodes
+	relayMaxPayload    = 0xFFFF
+	relayHandshakeTime = 10 * time.Second
+	relayChallengeSize = 32
+)
+
+type relayMsg struct {
+	Type    relayMsgType
+	Address string
+	Payload []byte
+}
+
+func writeRelayMsg(w io.Writer, msg relayMsg) error {
+	if len(msg.Address) > 0xFFFF || len(msg.Payload) > relayMaxPayload {
+		return errors.New("relay message is too big")
+	}
+	buf := make([]byte, relayHeaderSize, relayHeaderSize+len(msg.Address)+len(msg.Payload))
+	buf[0] = byte(msg.Type)
+	binary.BigEndian.PutUint16(buf[1:3], uint16(len(msg.Address)))
+	binary.BigEndian.PutUint32(buf[3:7], uint32(len(msg.Payload)))
+	buf = append(buf, msg.Address...)
+	buf = append(buf, msg.Payload...)
+	_, err := w.Write(buf)
+	return err
+}
+
+func readRelayMsg(r io.Reader) (relayMsg, error) {
+	header := make([]byte, relayHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return relayMsg{}, err
+	}
+	addrLen := int(binary.BigEndian.Uint16(header[1:3]))
+	payloadLen := int(binary.BigEndian.Uint32(header[3:7]))
+	if payloadLen > relayMaxPayload {
+		return relayMsg{}, errors.Errorf("relay message payload is too big: %d", payloadLen)
+	}
+	body := make([]byte, addrLen+payloadLen)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return relayMsg{}, err
+	}
+	return relayMsg{
+		Type:    relayMsgType(header[0]),
+		Address: string(body[:addrLen]),
+		Payload: body[addrLen:],
+	}, nil
+}
+
+func encodePort(port int) []byte {
+	buf := make([]byte, 2)
+	binary.BigEndian.PutUint16(buf, uint16(port))
+	return buf
+}
+
+func decodePort(buf []byte) int {
+	if len(buf) != 2 {
+		return 0
+	}
+	return int(binary.BigEndian.Uint16(buf))
+}
+
+func encodeRelayAuth(cert, sign []byte) []byte {
+	buf := make([]byte, 2, 2+len(cert)+len(sign))
+	binary.BigEndian.PutUint16(buf, uint16(len(cert)))
+	buf = append(buf, cert...)
+	return append(buf, sign...)
+}
+
+func decodeRelayAuth(buf []byte) ([]byte, []byte, bool) {
+	if len(buf) < 2 {
+		return nil, nil, false
+	}
+	certLen := int(binary.BigEndian.Uint16(buf))
+	if len(buf) < 2+certLen {
+		return nil, nil, false
+	}
+	return buf[2 : 2+certLen], buf[2+certLen:], true
+}
+
+func encodeStreamID(id uint64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, id)
+	return buf
+}
+
+func decodeStreamID(buf []byte) (uint64, bool) {
+	if len(buf) != 8 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(buf), true
+}
+
+// relayServer forwards datagrams and streams to nodes that are not reachable directly.
+type relayServer struct {
+	cfg   configuration.Relay
+	fixed string
+	auth  RelayAuthenticator
+
+	started    uint32
+	cancel     context.CancelFunc
+	listener   *net.TCPListener
+	publicHost string
+
+	lock        sync.Mutex
+	allocations map[int]*relayAllocation
+	pending     map[uint64]chan net.Conn
+}
+
+func newRelayServer(cfg configuration.Transport, auth RelayAuthenticator) *relayServer {
+	return &relayServer{
+		cfg:         cfg.Relay,
+		fixed:       cfg.FixedPublicAddress,
+		auth:        auth,
+		allocations: map[int]*relayAllocation{},
+		pending:     map[uint64]chan net.Conn{},
+	}
+}
+
+// Start starts serving relay clients.
+func (s *relayServer) Start(ctx context.Context) error {
+	if !atomic.CompareAndSwapUint32(&s.started, 0, 1) {
+		return nil
+	}
+	if s.cfg.MinPort <= 0 || s.cfg.MaxPort < s.cfg.MinPort || s.cfg.MaxPort > 0xFFFF {
+		return errors.Errorf("invalid relay port range [%d, %d]", s.cfg.MinPort, s.cfg.MaxPort)
+	}
+
+	addr, err := net.ResolveTCPAddr("tcp", s.cfg.Listen)
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve relay address")
+	}
+	s.listener, err = net.ListenTCP("tcp", addr)
+	if err != nil {
+		return errors.Wrap(err, "failed to listen relay address")
+	}
+
+	public, err := resolver.Resolve(s.fixed, s.listener.Addr().String())
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve relay public address")
+	}
+	s.publicHost, _, err = net.SplitHostPort(public)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse relay public address")
+	}
+
+	inslogger.FromContext(ctx).Info("[ Start ] Start relay server on ", s.listener.Addr().String())
+	ctx, s.cancel = context.WithCancel(ctx)
+	go s.listen(ctx)
+	return nil
+}
+
+// Stop stops serving relay clients and closes all allocations.
+func (s *relayServer) Stop(ctx context.Context) error {
+	if !atomic.CompareAndSwapUint32(&s.started, 1, 0) {
+		return nil
+	}
+	inslogger.FromContext(ctx).Info("[ Stop ] Stop relay server")
+	s.cancel()
+	err := s.listener.Close()
+
+	s.lock.Lock()
+	allocations := s.allocations
+	s.allocations = map[int]*relayAllocation{}
+	s.lock.Unlock()
+
+	for _, a := range allocations {
+		a.close()
+	}
+
+	if err != nil && !network.IsConnectionClosed(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *relayServer) listen(ctx context.Context) {
+	logger := inslogger.FromContext(ctx)
+	for {
+		conn, err := s.listener.AcceptTCP()
+		if err != nil {
+			if !network.IsConnectionClosed(err) {
+				logger.Warn("[ listen ] Relay failed to accept connection: ", err)
+			}
+			return
+		}
+		setupConnection(ctx, conn)
+		go s.handleConn(ctx, conn)
+	}
+}
+
+func (s *relayServer) handleConn(ctx context.Context, conn net.Conn) {
+	logger := inslogger.FromContext(ctx).WithField("address", conn.RemoteAddr().String())
+
+	_ = conn.SetReadDeadline(time.Now().Add(relayHandshakeTime))
+	msg, err := readRelayMsg(conn)
+	if err != nil {
+		logger.Warn("[ handleConn ] Failed to read relay handshake: ", err)
+		conn.Close()
+		return
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	switch msg.Type {
+	case relayRegister:
+		s.register(ctx, conn, decodePort(msg.Payload))
+	case relayAccept:
+		id, ok := decodeStreamID(msg.Payload)
+		if !ok || !s.deliver(id, conn) {
+			logger.Warn("[ handleConn ] Unexpected relayed stream")
+			conn.Close()
+		}
+	default:
+		logger.Warn("[ handleConn ] Unexpected relay message type: ", msg.Type)
+		conn.Close()
+	}
+}
+
+func (s *relayServer) register(ctx context.Context, control net.Conn, desiredPort int) {
+	logger := inslogger.FromContext(ctx).WithField("address", control.RemoteAddr().String())
+
+	if err := s.authenticate(control); err != nil {
+		logger.Warn("[ register ] Relay client is not authenticated: ", err)
+		control.Close()
+		return
+	}
+
+	a, err := s.allocate(control, desiredPort)
+	if err != nil {
+		logger.Warn("[ register ] Failed to allocate relay port: ", err)
+		control.Close()
+		return
+	}
+
+	address := net.JoinHostPort(s.publicHost, strconv.Itoa(a.port))
+	if err := a.send(relayMsg{Type: relayAllocated, Address: address}); err != nil {
+		logger.Warn("[ register ] Failed to send relay allocation: ", err)
+		s.release(a)
+		return
+	}
+	logger.Info("[ register ] Relaying traffic of ", address)
+
+	go a.serveDatagrams(ctx)
+	go a.serveStreams(ctx, s)
+
+	// client doesn't send anything after registration, reading detects closed control connection
+	_, _ = io.Copy(ioutil.Discard, control)
+	logger.Info("[ register ] Relay client disconnected, releasing ", address)
+	s.release(a)
+}
+
+func (s *relayServer) authenticate(control net.Conn) error {
+	challenge := make([]byte, relayChallengeSize)
+	if _, err := rand.Read(challenge); err != nil {
+		return errors.Wrap(err, "failed to generate challenge")
+	}
+	if err := writeRelayMsg(control, relayMsg{Type: relayChallenge, Payload: challenge}); err != nil {
+		return errors.Wrap(err, "failed to send challenge")
+	}
+
+	_ = control.SetReadDeadline(time.Now().Add(relayHandshakeTime))
+	msg, err := readRelayMsg(control)
+	if err != nil {
+		return errors.Wrap(err, "failed to read challenge response")
+	}
+	_ = control.SetReadDeadline(time.Time{})
+
+	if msg.Type != relayAuth {
+		return errors.Errorf("unexpected relay message type %d", msg.Type)
+	}
+	cert, sign, ok := decodeRelayAuth(msg.Payload)
+	if !ok {
+		return errors.New("malformed challenge response")
+	}
+	return s.auth.Verify(challenge, cert, sign)
+}
+
+func (s *relayServer) allocate(control net.Conn, desiredPort int) (*relayAllocation, error) {
+	host, _, err := net.SplitHostPort(s.cfg.Listen)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse relay address")
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	candidates := make([]int, 0, s.cfg.MaxPort-s.cfg.MinPort+2)
+	if desiredPort >= s.cfg.MinPort && desiredPort <= s.cfg.MaxPort {
+		candidates = append(candidates, desiredPort)
+	}
+	for port := s.cfg.MinPort; port <= s.cfg.MaxPort; port++ {
+		candidates = append(candidates, port)
+	}
+
+	for _, port := range candidates {
+		if _, ok := s.allocations[port]; ok {
+			continue
+		}
+		address := net.JoinHostPort(host, strconv.Itoa(port))
+		tcpAddr, err := net.ResolveTCPAddr("tcp", address)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to resolve relay address")
+		}
+		listener, err := net.ListenTCP("tcp", tcpAddr)
+		if err != nil {
+			continue
+		}
+		udp, err := net.ListenPacket("udp", address)
+		if err != nil {
+			listener.Close()
+			continue
+		}
+		a := &relayAllocation{port: port, control: control, listener: listener, udp: udp}
+		s.allocations[port] = a
+		return a, nil
+	}
+	return nil, errors.New("no free relay ports")
+}
+
+func (s *relayServer) release(a *relayAllocation) {
+	s.lock.Lock()
+	if s.allocations[a.port] == a {
+		delete(s.allocations, a.port)
+	}
+	s.lock.Unlock()
+	a.close()
+}
+
+// expect registers relayed stream, id is random so the stream can't be accepted by anyone but the client.
+func (s *relayServer) expect() (uint64, chan net.Conn, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return 0, nil, errors.Wrap(err, "failed to generate stream id")
+	}
+	id := binary.BigEndian.Uint64(buf)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	ch := make(chan net.Conn, 1)
+	s.pending[id] = ch
+	return id, ch, nil
+}
+
+func (s *relayServer) forget(id uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.pending, id)
+}
+
+func (s *relayServer) deliver(id uint64, conn net.Conn) bool {
+	s.lock.Lock()
+	ch, ok := s.pending[id]
+	delete(s.pending, id)
+	s.lock.Unlock()
+
+	if ok {
+		ch <- conn
+	}
+	return ok
+}
+
+type relayAllocation struct {
+	port     int
+	control  net.Conn
+	listener *net.TCPListener
+	udp      net.PacketConn
+
+	sendLock sync.Mutex
+	closed   uint32
+}
+
+func (a *relayAllocation) send(msg relayMsg) error {
+	a.sendLock.Lock()
+	defer a.sendLock.Unlock()
+
+	return writeRelayMsg(a.control, msg)
+}
+
+func (a *relayAllocation) close() {
+	if !atomic.CompareAndSwapUint32(&a.closed, 0, 1) {
+		return
+	}
+	a.control.Close()
+	a.listener.Close()
+	a.udp.Close()
+}
+
+func (a *relayAllocation) serveDatagrams(ctx context.Context) {
+	logger := inslogger.FromContext(ctx)
+	buf := make([]byte, udpMaxPacketSize)
+	for {
+		n, from, err := a.udp.ReadFrom(buf)
+		if err != nil {
+			if !network.IsConnectionClosed(err) {
+				logger.Warn("[ serveDatagrams ] Failed to read relayed datagram: ", err)
+			}
+			return
+		}
+		err = a.send(relayMsg{Type: relayDatagram, Address: from.String(), Payload: buf[:n]})
+		if err != nil {
+			logger.Warn("[ serveDatagrams ] Failed to forward datagram: ", err)
+			return
+		}
+	}
+}
+
+func (a *relayAllocation) serveStreams(ctx context.Context, s *relayServer) {
+	logger := inslogger.FromContext(ctx)
+	for {
+		conn, err := a.listener.AcceptTCP()
+		if err != nil {
+			if !network.IsConnectionClosed(err) {
+				logger.Warn("[ serveStreams ] Failed to accept relayed connection: ", err)
+			}
+			return
+		}
+		setupConnection(ctx, conn)
+		go a.relayStream(ctx, s, conn)
+	}
+}
+
+func (a *relayAllocation) relayStream(ctx context.Context, s *relayServer, conn net.Conn) {
+	logger := inslogger.FromContext(ctx).WithField("address", conn.RemoteAddr().String())
+
+	id, ch, err := s.expect()
+	if err != nil {
+		logger.Warn("[ relayStream ] Failed to request relayed stream: ", err)
+		conn.Close()
+		return
+	}
+	err = a.send(relayMsg{Type: relayOpen, Address: conn.RemoteAddr().String(), Payload: encodeStreamID(id)})
+	if err != nil {
+		logger.Warn("[ relayStream ] Failed to request relayed stream: ", err)
+		s.forget(id)
+		conn.Close()
+		return
+	}
+
+	select {
+	case peer := <-ch:
+		pipe(conn, peer)
+	case <-time.After(relayHandshakeTime):
+		logger.Warn("[ relayStream ] Relay client didn't open stream in time")
+		s.forget(id)
+		conn.Close()
+	case <-ctx.Done():
+		s.forget(id)
+		conn.Close()
+	}
+}
+
+// pipe copies data between connections until one of them is closed.
+func pipe(a, b net.Conn) {
+	var once sync.Once
+	closeBoth := func() {
+		once.Do(func() {
+			a.Close()
+			b.Close()
+		})
+	}
+	go func() {
+		_, _ = io.Copy(a, b)
+		closeBoth()
+	}()
+	_, _ = io.Copy(b, a)
+	closeBoth()
+}
@@ -0,0 +1,357 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package transport
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// relayReconnectInterval is a delay between attempts to restore control connection to the relay server.
+const relayReconnectInterval = time.Second
+
+// relayClient receives traffic of a node behind NAT through the relay server.
+// It is shared by datagram and stream transports created by the same factory.
+type relayClient struct {
+	server string
+	auth   RelayAuthenticator
+
+	lock            sync.RWMutex
+	refs            int
+	conn            net.Conn
+	address         string
+	port            int
+	cancel          context.CancelFunc
+	datagramHandler DatagramHandler
+	streamHandler   StreamHandler
+}
+
+func newRelayClient(server string, auth RelayAuthenticator) *relayClient {
+	return &relayClient{server: server, auth: auth}
+}
+
+func (c *relayClient) setDatagramHandler(handler DatagramHandler) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.datagramHandler = handler
+}
+
+func (c *relayClient) setStreamHandler(handler StreamHandler) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.streamHandler = handler
+}
+
+// Address returns relayed address the node is reachable by.
+func (c *relayClient) Address() string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.address
+}
+
+func (c *relayClient) start(ctx context.Context) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.refs++
+	if c.refs > 1 {
+		return nil
+	}
+
+	conn, err := c.connect()
+	if err != nil {
+		c.refs--
+		return err
+	}
+	inslogger.FromContext(ctx).Info("[ Start ] Receiving traffic through relay on ", c.address)
+
+	ctx, c.cancel = context.WithCancel(ctx)
+	go c.loop(ctx, conn)
+	return nil
+}
+
+func (c *relayClient) stop(ctx context.Context) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.refs == 0 {
+		return
+	}
+	c.refs--
+	if c.refs > 0 {
+		return
+	}
+
+	inslogger.FromContext(ctx).Info("[ Stop ] Stop receiving traffic through relay")
+	c.cancel()
+	c.conn.Close()
+}
+
+// connect registers on the relay server. It asks for previously allocated port, so the address is preserved on reconnect.
+// Must be called under lock.
+func (c *relayClient) connect() (net.Conn, error) {
+	conn, err := net.DialTimeout("tcp", c.server, relayHandshakeTime)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to relay")
+	}
+
+	var desired []byte
+	if c.port != 0 {
+		desired = encodePort(c.port)
+	}
+	if err := writeRelayMsg(conn, relayMsg{Type: relayRegister, Payload: desired}); err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to register on relay")
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(relayHandshakeTime))
+	defer func() { _ = conn.SetReadDeadline(time.Time{}) }()
+
+	msg, err := readRelayMsg(conn)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to get relay challenge")
+	}
+	if msg.Type != relayChallenge {
+		conn.Close()
+		return nil, errors.Errorf("unexpected relay message type %d", msg.Type)
+	}
+	cert, sign, err := c.auth.Sign(msg.Payload)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to sign relay challenge")
+	}
+	if err := writeRelayMsg(conn, relayMsg{Type: relayAuth, Payload: encodeRelayAuth(cert, sign)}); err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to authenticate on relay")
+	}
+
+	msg, err = readRelayMsg(conn)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to get relay allocation")
+	}
+	if msg.Type != relayAllocated {
+		conn.Close()
+		return nil, errors.Errorf("unexpected relay message type %d", msg.Type)
+	}
+
+	_, port, err := net.SplitHostPort(msg.Address)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "invalid relayed address")
+	}
+	c.port, _ = strconv.Atoi(port)
+	c.address = msg.Address
+	c.conn = conn
+	return conn, nil
+}
+
+func (c *relayClient) loop(ctx context.Context, conn net.Conn) {
+	logger := inslogger.FromContext(ctx)
+	for {
+		msg, err := readRelayMsg(conn)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Warn("[ loop ] Lost connection to relay: ", err)
+			conn = c.reconnect(ctx)
+			if conn == nil {
+				return
+			}
+			continue
+		}
+
+		switch msg.Type {
+		case relayDatagram:
+			c.lock.RLock()
+			handler := c.datagramHandler
+			c.lock.RUnlock()
+			if handler != nil {
+				go handler.HandleDatagram(ctx, msg.Address, msg.Payload)
+			}
+		case relayOpen:
+			go c.accept(ctx, msg)
+		default:
+			logger.Warn("[ loop ] Unexpected relay message type: ", msg.Type)
+		}
+	}
+}
+
+func (c *relayClient) reconnect(ctx context.Context) net.Conn {
+	logger := inslogger.FromContext(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(relayReconnectInterval):
+		}
+
+		c.lock.Lock()
+		if ctx.Err() != nil {
+			c.lock.Unlock()
+			return nil
+		}
+		previous := c.address
+		conn, err := c.connect()
+		c.lock.Unlock()
+
+		if err != nil {
+			logger.Warn("[ reconnect ] Failed to reconnect to relay: ", err)
+			continue
+		}
+		if c.Address() != previous {
+			logger.Errorf("[ reconnect ] Relayed address changed from %s to %s", previous, c.Address())
+		}
+		return conn
+	}
+}
+
+func (c *relayClient) accept(ctx context.Context, msg relayMsg) {
+	logger := inslogger.FromContext(ctx).WithField("address", msg.Address)
+
+	c.lock.RLock()
+	handler := c.streamHandler
+	c.lock.RUnlock()
+	if handler == nil {
+		return
+	}
+
+	conn, err := net.DialTimeout("tcp", c.server, relayHandshakeTime)
+	if err != nil {
+		logger.Warn("[ accept ] Failed to open relayed stream: ", err)
+		return
+	}
+	if tcpConn, ok := conn.(*net.TCPConn); ok {
+		setupConnection(ctx, tcpConn)
+	}
+	if err := writeRelayMsg(conn, relayMsg{Type: relayAccept, Payload: msg.Payload}); err != nil {
+		logger.Warn("[ accept ] Failed to open relayed stream: ", err)
+		conn.Close()
+		return
+	}
+	handler.HandleStream(ctx, msg.Address, conn)
+}
+
+// relayedDatagramTransport sends datagrams directly and receives them through the relay.
+type relayedDatagramTransport struct {
+	DatagramTransport
+	client *relayClient
+}
+
+func (t *relayedDatagramTransport) Start(ctx context.Context) error {
+	if err := t.DatagramTransport.Start(ctx); err != nil {
+		return err
+	}
+	return errors.Wrap(t.client.start(ctx), "failed to start relay client")
+}
+
+func (t *relayedDatagramTransport) Stop(ctx context.Context) error {
+	t.client.stop(ctx)
+	return t.DatagramTransport.Stop(ctx)
+}
+
+func (t *relayedDatagramTransport) Address() string {
+	return t.client.Address()
+}
+
+// relayedStreamTransport dials directly and accepts streams through the relay.
+type relayedStreamTransport struct {
+	StreamTransport
+	client *relayClient
+}
+
+func (t *relayedStreamTransport) Start(ctx context.Context) error {
+	if err := t.StreamTransport.Start(ctx); err != nil {
+		return err
+	}
+	return errors.Wrap(t.client.start(ctx), "failed to start relay client")
+}
+
+func (t *relayedStreamTransport) Stop(ctx context.Context) error {
+	t.client.stop(ctx)
+	return t.StreamTransport.Stop(ctx)
+}
+
+func (t *relayedStreamTransport) Address() string {
+	return t.client.Address()
+}
+
+// relayingStreamTransport serves relay clients along with the stream transport.
+type relayingStreamTransport struct {
+	StreamTransport
+	server *relayServer
+}
+
+func (t *relayingStreamTransport) Start(ctx context.Context) error {
+	if err := t.StreamTransport.Start(ctx); err != nil {
+		return err
+	}
+	return errors.Wrap(t.server.Start(ctx), "failed to start relay server")
+}
+
+func (t *relayingStreamTransport) Stop(ctx context.Context) error {
+	if err := t.server.Stop(ctx); err != nil {
+		return err
+	}
+	return t.StreamTransport.Stop(ctx)
+}
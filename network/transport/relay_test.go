@@ -0,0 +1,216 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package transport
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+)
+
+type relayTestHandler struct {
+	datagrams chan []byte
+}
+
+func (h *relayTestHandler) HandleDatagram(ctx context.Context, address string, buf []byte) {
+	h.datagrams <- buf
+}
+
+// HandleStream echoes received data.
+func (h *relayTestHandler) HandleStream(ctx context.Context, address string, stream io.ReadWriteCloser) {
+	defer stream.Close()
+	_, _ = io.Copy(stream, stream)
+}
+
+// relayTestAuth signs challenge with a shared key, cert is used as the key.
+type relayTestAuth struct {
+	key []byte
+}
+
+func (a *relayTestAuth) Sign(challenge []byte) ([]byte, []byte, error) {
+	return a.key, a.sign(a.key, challenge), nil
+}
+
+func (a *relayTestAuth) Verify(challenge, cert, sign []byte) error {
+	if !bytes.Equal(cert, a.key) || !hmac.Equal(sign, a.sign(cert, challenge)) {
+		return errors.New("invalid sign")
+	}
+	return nil
+}
+
+func (a *relayTestAuth) sign(key, challenge []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(challenge)
+	return mac.Sum(nil)
+}
+
+func startRelay(t *testing.T, port int) (StreamTransport, string) {
+	relayCfg := configuration.NewHostNetwork().Transport
+	relayCfg.FixedPublicAddress = "127.0.0.1"
+	relayCfg.Relay = configuration.Relay{Listen: "127.0.0.1:" + strconv.Itoa(freePort(t)), MinPort: port, MaxPort: port}
+
+	relay, err := NewFactoryWithRelayAuth(relayCfg, &relayTestAuth{key: []byte("network")}).CreateStreamTransport(&relayTestHandler{})
+	require.NoError(t, err)
+	require.NoError(t, relay.Start(context.Background()))
+	return relay, relayCfg.Relay.Listen
+}
+
+func freePort(t *testing.T) int {
+	l, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func TestRelay(t *testing.T) {
+	ctx := context.Background()
+
+	port := freePort(t)
+	relay, server := startRelay(t, port)
+	defer relay.Stop(ctx)
+
+	cfg := configuration.NewHostNetwork().Transport
+	cfg.Relay.Server = server
+	f := NewFactoryWithRelayAuth(cfg, &relayTestAuth{key: []byte("network")})
+
+	handler := &relayTestHandler{datagrams: make(chan []byte, 1)}
+	udp, err := f.CreateDatagramTransport(handler)
+	require.NoError(t, err)
+	tcp, err := f.CreateStreamTransport(handler)
+	require.NoError(t, err)
+
+	require.NoError(t, udp.Start(ctx))
+	require.NoError(t, tcp.Start(ctx))
+	defer udp.Stop(ctx)
+	defer tcp.Stop(ctx)
+
+	relayed := "127.0.0.1:" + strconv.Itoa(port)
+	require.Equal(t, relayed, udp.Address())
+	require.Equal(t, relayed, tcp.Address())
+
+	// datagram
+	sender, err := net.Dial("udp", relayed)
+	require.NoError(t, err)
+	defer sender.Close()
+	_, err = sender.Write([]byte{1, 2, 3})
+	require.NoError(t, err)
+
+	select {
+	case data := <-handler.datagrams:
+		require.Equal(t, []byte{1, 2, 3}, data)
+	case <-time.After(5 * time.Second):
+		t.Fatal("datagram is not relayed")
+	}
+
+	// stream
+	conn, err := net.Dial("tcp", relayed)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("ping"))
+	require.NoError(t, err)
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	require.Equal(t, "ping", string(buf))
+}
+
+func TestRelay_NotAuthenticated(t *testing.T) {
+	ctx := context.Background()
+
+	port := freePort(t)
+	relay, server := startRelay(t, port)
+	defer relay.Stop(ctx)
+
+	cfg := configuration.NewHostNetwork().Transport
+	cfg.Relay.Server = server
+
+	_, err := NewFactory(cfg).CreateDatagramTransport(&relayTestHandler{})
+	require.Error(t, err)
+
+	udp, err := NewFactoryWithRelayAuth(cfg, &relayTestAuth{key: []byte("stranger")}).CreateDatagramTransport(&relayTestHandler{})
+	require.NoError(t, err)
+	require.Error(t, udp.Start(ctx))
+
+	// port is not allocated to the stranger
+	_, err = net.DialTimeout("tcp", "127.0.0.1:"+strconv.Itoa(port), time.Second)
+	require.Error(t, err)
+}
+
+func TestRelayMsg(t *testing.T) {
+	r, w := net.Pipe()
+	defer r.Close()
+	defer w.Close()
+
+	msg := relayMsg{Type: relayDatagram, Address: "127.0.0.1:1234", Payload: []byte{1, 2, 3}}
+	go func() {
+		_ = writeRelayMsg(w, msg)
+	}()
+
+	res, err := readRelayMsg(r)
+	require.NoError(t, err)
+	require.Equal(t, msg, res)
+
+	err = writeRelayMsg(w, relayMsg{Type: relayDatagram, Payload: make([]byte, relayMaxPayload+1)})
+	require.Error(t, err)
+}
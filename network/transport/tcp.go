@@ -73,14 +73,16 @@ type tcpTransport struct {
 	address            string
 	started            uint32
 	fixedPublicAddress string
+	reflected          *reflectedAddress
 	handler            StreamHandler
 	cancel             context.CancelFunc
 }
 
-func newTCPTransport(listenAddress, fixedPublicAddress string, handler StreamHandler) *tcpTransport {
+func newTCPTransport(listenAddress, fixedPublicAddress string, reflected *reflectedAddress, handler StreamHandler) *tcpTransport {
 	return &tcpTransport{
 		address:            listenAddress,
 		fixedPublicAddress: fixedPublicAddress,
+		reflected:          reflected,
 		handler:            handler,
 	}
 }
@@ -125,9 +127,17 @@ func (t *tcpTransport) Start(ctx context.Context) error {
 			return errors.Wrap(err, "Failed to Listen TCP ")
 		}
 
-		t.address, err = resolver.Resolve(t.fixedPublicAddress, t.listener.Addr().String())
-		if err != nil {
-			return errors.Wrap(err, "Failed to resolve public address")
+		if t.reflected != nil {
+			// TCP listens on the same port with UDP and is reachable by the address reflected for UDP socket
+			t.address = t.reflected.get()
+			if t.address == "" {
+				return errors.New("Public address is not discovered by datagram transport")
+			}
+		} else {
+			t.address, err = resolver.Resolve(t.fixedPublicAddress, t.listener.Addr().String())
+			if err != nil {
+				return errors.Wrap(err, "Failed to resolve public address")
+			}
 		}
 
 		go t.listen(ctx)
@@ -86,3 +86,12 @@ type StreamTransport interface {
 	Dial(ctx context.Context, address string) (io.ReadWriteCloser, error)
 	Address() string
 }
+
+// MultiplexedStreamTransport opens streams over a shared session with remote host. Opening a stream is cheap,
+// so a separate stream is used for every message to avoid head-of-line blocking.
+type MultiplexedStreamTransport interface {
+	StreamTransport
+
+	// CloseSession closes session to the address with all its streams.
+	CloseSession(address string)
+}
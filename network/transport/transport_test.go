@@ -204,3 +204,12 @@ func TestTransport(t *testing.T) {
 	f2 := NewFactory(cfg2)
 	suite.Run(t, &suiteTest{factory1: f1, factory2: f2})
 }
+
+func TestQUICTransport(t *testing.T) {
+	cfg1 := configuration.Transport{Protocol: "QUIC", Address: "127.0.0.1:0"}
+	cfg2 := configuration.Transport{Protocol: "QUIC", Address: "127.0.0.1:0"}
+
+	f1 := NewFactory(cfg1)
+	f2 := NewFactory(cfg2)
+	suite.Run(t, &suiteTest{factory1: f1, factory2: f2})
+}
@@ -75,12 +75,20 @@ type udpTransport struct {
 	handler            DatagramHandler
 	started            uint32
 	fixedPublicAddress string
+	reflectors         []string
+	reflected          *reflectedAddress
 	cancel             context.CancelFunc
 	address            string
 }
 
-func newUDPTransport(listenAddress, fixedPublicAddress string, handler DatagramHandler) *udpTransport {
-	return &udpTransport{address: listenAddress, fixedPublicAddress: fixedPublicAddress, handler: handler}
+func newUDPTransport(listenAddress, fixedPublicAddress string, reflectors []string, reflected *reflectedAddress, handler DatagramHandler) *udpTransport {
+	return &udpTransport{
+		address:            listenAddress,
+		fixedPublicAddress: fixedPublicAddress,
+		reflectors:         reflectors,
+		reflected:          reflected,
+		handler:            handler,
+	}
 }
 
 // SendDatagram sends datagram to remote host
@@ -130,10 +138,13 @@ func (t *udpTransport) Start(ctx context.Context) error {
 			return errors.Wrap(err, "failed to listen UDP")
 		}
 
-		t.address, err = resolver.Resolve(t.fixedPublicAddress, t.conn.LocalAddr().String())
+		t.address, err = resolver.ResolvePacketConn(t.fixedPublicAddress, t.conn, t.reflectors)
 		if err != nil {
 			return errors.Wrap(err, "failed to resolve public address")
 		}
+		if t.reflected != nil {
+			t.reflected.set(t.address)
+		}
 
 		logger.Info("[ Start ] Start UDP transport")
 		ctx, t.cancel = context.WithCancel(ctx)
@@ -170,6 +181,15 @@ func (t *udpTransport) loop(ctx context.Context) {
 		}
 
 		stats.Record(ctx, network.RecvSize.M(int64(n)))
+
+		// every node serves as a reflector for public address discovery of other nodes
+		if resolver.IsReflectionRequest(buf[:n]) {
+			if _, err := t.conn.WriteTo(resolver.ReflectionResponse(buf[:n], addr.String()), addr); err != nil {
+				logger.Warn("[ loop ] failed to send reflection response: ", err)
+			}
+			continue
+		}
+
 		go t.handler.HandleDatagram(ctx, addr.String(), buf[:n])
 	}
 }
@@ -207,10 +207,19 @@ func IsClosedPipe(err error) bool {
 	return strings.Contains(err.Error(), "read/write on closed pipe")
 }
 
+// LogComponent is a name of network logger, its level can be set in Log.Components configuration.
+const LogComponent = "network"
+
+// WithComponent returns context with logger bound to network component.
+func WithComponent(ctx context.Context) context.Context {
+	ctx, _ = inslogger.WithComponent(ctx, LogComponent)
+	return ctx
+}
+
 func NewPulseContext(ctx context.Context, pulseNumber uint32) context.Context {
 	insTraceID := "pulse_" + strconv.FormatUint(uint64(pulseNumber), 10)
 	ctx = inslogger.ContextWithTrace(ctx, insTraceID)
-	return ctx
+	return WithComponent(ctx)
 }
 
 type CapturingReader struct {
@@ -155,7 +155,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 	var (
 		Requester       insolar.ContractRequester
 		GenesisProvider insolar.GenesisDataProvider
-		API             insolar.APIRunner
+		API             *api.Runner
 	)
 	{
 		var err error
@@ -193,7 +193,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		Pulses = pulse.NewDB(DB)
 		Jets = jet.NewStore()
 
-		c := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit)
+		c := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit, cfg.Ledger.Retention.KeepPulses, cfg.Ledger.HeavyReplicas)
 		c.PulseCalculator = Pulses
 		c.PulseAccessor = Pulses
 		c.JetAccessor = Jets
@@ -247,6 +247,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		drops := drop.NewDB(DB)
 		jets := jet.NewDBStore(DB)
 		JetKeeper = executor.NewJetKeeper(jets, DB, Pulses)
+		mismatches := object.NewMismatchDB(DB)
 		c.rollback = executor.NewDBRollback(JetKeeper, Pulses, drops, Records, indexes, jets, Pulses)
 
 		sp := pulse.NewStartPulse()
@@ -261,10 +262,22 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		pm.JetModifier = jets
 		pm.StartPulse = sp
 		pm.FinalizationKeeper = executor.NewFinalizationKeeperDefault(JetKeeper, Termination, Pulses, cfg.Ledger.LightChainLimit)
+		pm.Reconciler = executor.NewReconciler(
+			JetKeeper,
+			Pulses,
+			Coordinator,
+			WmBus,
+			Records,
+			RecordPosition,
+			indexes,
+			drops,
+			jets,
+		)
 
 		h := handler.New(cfg.Ledger)
 		h.RecordAccessor = Records
 		h.RecordPositions = RecordPosition
+		h.RecordPositionAccessor = RecordPosition
 		h.RecordModifier = Records
 		h.JetCoordinator = Coordinator
 		h.IndexAccessor = indexes
@@ -280,8 +293,11 @@ func newComponents(ctx context.Context, cfg configuration.Configuration, genesis
 		h.JetTree = jets
 		h.DropDB = drops
 		h.JetKeeper = JetKeeper
+		h.Mismatches = mismatches
 		h.Sender = WmBus
 
+		API.Mismatches = mismatches
+
 		PulseManager = pm
 		Handler = h
 
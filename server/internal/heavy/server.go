@@ -81,7 +81,7 @@ func (s *Server) Serve() {
 
 	traceID := "main_" + utils.RandTraceID()
 	ctx, inslog := internal.Logger(ctx, cfg.Log, traceID, cmp.NodeRef, cmp.NodeRole)
-	ctx, jaegerFlush := internal.Jaeger(ctx, cfg.Tracer.Jaeger, traceID, cmp.NodeRef, cmp.NodeRole)
+	ctx, jaegerFlush := internal.Jaeger(ctx, cfg.Tracer, traceID, cmp.NodeRef, cmp.NodeRole)
 	defer jaegerFlush()
 
 	ctx, inslog = inslogger.WithField(ctx, "nodeid", cmp.NodeRef)
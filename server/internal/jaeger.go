@@ -11,22 +11,28 @@ import (
 // Jaeger is a default insolar tracer preset.
 func Jaeger(
 	ctx context.Context,
-	cfg configuration.JaegerConfig,
+	cfg configuration.Tracer,
 	traceID, nodeRef, nodeRole string,
 ) (context.Context, func()) {
 	inslogger.FromContext(ctx).Infof(
-		"Tracing enabled. Agent endpoint: '%s', collector endpoint: '%s'\n",
-		cfg.AgentEndpoint,
-		cfg.CollectorEndpoint,
+		"Tracing enabled. Agent endpoint: '%s', collector endpoint: '%s', OTLP endpoint: '%s'\n",
+		cfg.Jaeger.AgentEndpoint,
+		cfg.Jaeger.CollectorEndpoint,
+		cfg.OTLP.Endpoint,
 	)
-	flush := instracer.ShouldRegisterJaeger(
+	instracer.ApplySamplingRules(cfg.SamplingRules, cfg.Jaeger.ProbabilityRate)
+	jaegerFlush := instracer.ShouldRegisterJaeger(
 		ctx,
 		nodeRole,
 		nodeRef,
-		cfg.AgentEndpoint,
-		cfg.CollectorEndpoint,
-		cfg.ProbabilityRate,
+		cfg.Jaeger.AgentEndpoint,
+		cfg.Jaeger.CollectorEndpoint,
+		cfg.Jaeger.ProbabilityRate,
 	)
+	otlpFlush := instracer.ShouldRegisterOTLP(ctx, nodeRole, nodeRef, cfg.OTLP)
 	ctx = instracer.SetBaggage(ctx, instracer.Entry{Key: "traceid", Value: traceID})
-	return ctx, flush
+	return ctx, func() {
+		jaegerFlush()
+		otlpFlush()
+	}
 }
@@ -64,6 +64,7 @@ type components struct {
 	NodeRef, NodeRole string
 	replicator        executor.LightReplicator
 	cleaner           executor.Cleaner
+	termination       insolar.TerminationHandler
 }
 
 func newComponents(ctx context.Context, cfg configuration.Configuration) (*components, error) {
@@ -126,8 +127,6 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 			return nil, errors.Wrap(err, "failed to start Network")
 		}
 
-		Termination = termination.NewHandler(NetworkService)
-
 		// Node info.
 		NodeNetwork, err = nodenetwork.NewNodeNetwork(cfg.Host.Transport, CertManager.GetCertificate())
 		if err != nil {
@@ -140,7 +139,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 	var (
 		Requester insolar.ContractRequester
 		Genesis   insolar.GenesisDataProvider
-		API       insolar.APIRunner
+		API       *api.Runner
 	)
 	{
 		var err error
@@ -172,7 +171,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		Pulses = pulse.NewStorageMem()
 		Jets = jet.NewStore()
 
-		c := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit)
+		c := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit, cfg.Ledger.Retention.KeepPulses, cfg.Ledger.HeavyReplicas)
 		c.PulseCalculator = Pulses
 		c.PulseAccessor = Pulses
 		c.JetAccessor = Jets
@@ -221,7 +220,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		records := object.NewRecordMemory()
 		indexes := object.NewIndexStorageMemory()
 		writeController := executor.NewWriteController()
-		hotWaitReleaser := executor.NewChannelWaiter()
+		hotWaitReleaser := executor.NewChannelWaiter(conf.HotWaitersLimit)
 
 		c := component.Manager{}
 		c.Inject(CryptoScheme)
@@ -238,6 +237,10 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		requestChecker := executor.NewRequestChecker(filamentCalculator, Coordinator, jetFetcher, Sender)
 
 		jetCalculator := executor.NewJetCalculator(Coordinator, Jets)
+		var archiver executor.Archiver
+		if conf.Retention.ArchiveDirectory != "" {
+			archiver = executor.NewFileArchiver(conf.Retention.ArchiveDirectory, Jets, drops, records, indexes)
+		}
 		lightCleaner := executor.NewCleaner(
 			Jets.(jet.Cleaner),
 			Nodes,
@@ -250,6 +253,8 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 			filamentCalculator,
 			conf.LightChainLimit,
 			conf.CleanerDelay,
+			conf.Retention.KeepPulses,
+			archiver,
 		)
 		comps.cleaner = lightCleaner
 
@@ -257,6 +262,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 			jetCalculator,
 			lightCleaner,
 			Sender,
+			Coordinator,
 			Pulses,
 			drops,
 			records,
@@ -315,7 +321,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 			},
 		)
 
-		PulseManager = executor.NewPulseManager(
+		pm := executor.NewPulseManager(
 			NodeNetwork,
 			FlowDispatcher,
 			Nodes,
@@ -328,6 +334,13 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 			writeController,
 			stateIniter,
 		)
+		PulseManager = pm
+
+		Termination = termination.NewHandler(NetworkService, pm)
+		comps.termination = Termination
+
+		API.Handoff = pm
+		API.Retention = lightCleaner
 	}
 
 	comps.cmp.Inject(
@@ -368,6 +381,11 @@ func (c *components) Start(ctx context.Context) error {
 	return c.cmp.Start(ctx)
 }
 
+// Drain hands off hot data, waits until heavies confirm replicated data and leaves the network.
+func (c *components) Drain(ctx context.Context) {
+	c.termination.Drain(ctx, 10)
+}
+
 func (c *components) Stop(ctx context.Context) error {
 	c.replicator.Stop()
 	c.cleaner.Stop()
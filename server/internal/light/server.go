@@ -65,7 +65,7 @@ func (s *Server) Serve() {
 
 	traceID := "main_" + utils.RandTraceID()
 	ctx, inslog := internal.Logger(ctx, cfg.Log, traceID, cmp.NodeRef, cmp.NodeRole)
-	ctx, jaegerFlush := internal.Jaeger(ctx, cfg.Tracer.Jaeger, traceID, cmp.NodeRef, cmp.NodeRole)
+	ctx, jaegerFlush := internal.Jaeger(ctx, cfg.Tracer, traceID, cmp.NodeRef, cmp.NodeRole)
 	defer jaegerFlush()
 
 	var gracefulStop = make(chan os.Signal, 1)
@@ -79,6 +79,8 @@ func (s *Server) Serve() {
 		inslog.Debug("caught sig: ", sig)
 
 		inslog.Warn("GRACEFUL STOP APP")
+		cmp.Drain(ctx)
+		inslog.Info("main leave ends ")
 		err = cmp.Stop(ctx)
 		fatal(ctx, err, "failed to graceful stop components")
 		close(waitChannel)
@@ -123,8 +123,6 @@ func initComponents(
 	nw, err := servicenetwork.NewServiceNetwork(cfg, &cm)
 	checkError(ctx, err, "failed to start Network")
 
-	terminationHandler := termination.NewHandler(nw)
-
 	delegationTokenFactory := delegationtoken.NewDelegationTokenFactory()
 	parcelFactory := messagebus.NewParcelFactory()
 
@@ -143,13 +141,16 @@ func initComponents(
 	_, err = manager.NewVersionManager(cfg.VersionManager)
 	checkError(ctx, err, "failed to load VersionManager: ")
 
-	jc := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit)
+	jc := jetcoordinator.NewJetCoordinator(cfg.Ledger.LightChainLimit, cfg.Ledger.Retention.KeepPulses, cfg.Ledger.HeavyReplicas)
 	pulses := pulse.NewStorageMem()
 	b := bus.NewBus(cfg.Bus, pubSub, pulses, jc, pcs)
 
 	logicRunner, err := logicrunner.NewLogicRunner(&cfg.LogicRunner, pubSub, b)
 	checkError(ctx, err, "failed to start LogicRunner")
 
+	terminationHandler := termination.NewHandler(nw, logicRunner)
+	apiRunner.SagaAccessor = logicRunner
+
 	contractRequester, err := contractrequester.New(logicRunner)
 	checkError(ctx, err, "failed to start ContractRequester")
 
@@ -165,6 +166,7 @@ func initComponents(
 		logicRunner,
 		logicrunner.NewLogicExecutor(),
 		logicrunner.NewRequestsExecutor(),
+		logicrunner.NewValidator(),
 		logicrunner.NewMachinesManager(),
 		apiRunner,
 		nodeNetwork,
@@ -76,13 +76,23 @@ func (s *Server) Serve() {
 	if s.trace {
 		jconf := cfg.Tracer.Jaeger
 		log.Infof("Tracing enabled. Agent endpoint: '%s', collector endpoint: '%s'\n", jconf.AgentEndpoint, jconf.CollectorEndpoint)
-		jaegerflush = instracer.ShouldRegisterJaeger(
+		instracer.ApplySamplingRules(cfg.Tracer.SamplingRules, jconf.ProbabilityRate)
+		jflush := instracer.ShouldRegisterJaeger(
 			ctx,
 			certManager.GetCertificate().GetRole().String(),
 			certManager.GetCertificate().GetNodeRef().String(),
 			jconf.AgentEndpoint,
 			jconf.CollectorEndpoint,
 			jconf.ProbabilityRate)
+		oflush := instracer.ShouldRegisterOTLP(
+			ctx,
+			certManager.GetCertificate().GetRole().String(),
+			certManager.GetCertificate().GetNodeRef().String(),
+			cfg.Tracer.OTLP)
+		jaegerflush = func() {
+			jflush()
+			oflush()
+		}
 		ctx = instracer.SetBaggage(ctx, instracer.Entry{Key: "traceid", Value: traceID})
 	}
 	defer jaegerflush()
@@ -113,7 +123,7 @@ func (s *Server) Serve() {
 		inslog.Debug("caught sig: ", sig)
 
 		inslog.Warn("GRACEFUL STOP APP")
-		th.Leave(ctx, 10)
+		th.Drain(ctx, 10)
 		inslog.Info("main leave ends ")
 		err = cm.GracefulStop(ctx)
 		checkError(ctx, err, "failed to graceful stop components")
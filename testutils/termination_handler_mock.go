@@ -22,6 +22,18 @@ type TerminationHandlerMock struct {
 	beforeAbortCounter uint64
 	AbortMock          mTerminationHandlerMockAbort
 
+	funcDrain          func(ctx context.Context, p1 mm_insolar.PulseNumber)
+	inspectFuncDrain   func(ctx context.Context, p1 mm_insolar.PulseNumber)
+	afterDrainCounter  uint64
+	beforeDrainCounter uint64
+	DrainMock          mTerminationHandlerMockDrain
+
+	funcDrainStatus          func() (d1 mm_insolar.DrainStatus)
+	inspectFuncDrainStatus   func()
+	afterDrainStatusCounter  uint64
+	beforeDrainStatusCounter uint64
+	DrainStatusMock          mTerminationHandlerMockDrainStatus
+
 	funcLeave          func(ctx context.Context, p1 mm_insolar.PulseNumber)
 	inspectFuncLeave   func(ctx context.Context, p1 mm_insolar.PulseNumber)
 	afterLeaveCounter  uint64
@@ -51,6 +63,11 @@ func NewTerminationHandlerMock(t minimock.Tester) *TerminationHandlerMock {
 	m.AbortMock = mTerminationHandlerMockAbort{mock: m}
 	m.AbortMock.callArgs = []*TerminationHandlerMockAbortParams{}
 
+	m.DrainMock = mTerminationHandlerMockDrain{mock: m}
+	m.DrainMock.callArgs = []*TerminationHandlerMockDrainParams{}
+
+	m.DrainStatusMock = mTerminationHandlerMockDrainStatus{mock: m}
+
 	m.LeaveMock = mTerminationHandlerMockLeave{mock: m}
 	m.LeaveMock.callArgs = []*TerminationHandlerMockLeaveParams{}
 
@@ -249,6 +266,337 @@ func (m *TerminationHandlerMock) MinimockAbortInspect() {
 	}
 }
 
+type mTerminationHandlerMockDrain struct {
+	mock               *TerminationHandlerMock
+	defaultExpectation *TerminationHandlerMockDrainExpectation
+	expectations       []*TerminationHandlerMockDrainExpectation
+
+	callArgs []*TerminationHandlerMockDrainParams
+	mutex    sync.RWMutex
+}
+
+// TerminationHandlerMockDrainExpectation specifies expectation struct of the TerminationHandler.Drain
+type TerminationHandlerMockDrainExpectation struct {
+	mock   *TerminationHandlerMock
+	params *TerminationHandlerMockDrainParams
+
+	Counter uint64
+}
+
+// TerminationHandlerMockDrainParams contains parameters of the TerminationHandler.Drain
+type TerminationHandlerMockDrainParams struct {
+	ctx context.Context
+	p1  mm_insolar.PulseNumber
+}
+
+// Expect sets up expected params for TerminationHandler.Drain
+func (mmDrain *mTerminationHandlerMockDrain) Expect(ctx context.Context, p1 mm_insolar.PulseNumber) *mTerminationHandlerMockDrain {
+	if mmDrain.mock.funcDrain != nil {
+		mmDrain.mock.t.Fatalf("TerminationHandlerMock.Drain mock is already set by Set")
+	}
+
+	if mmDrain.defaultExpectation == nil {
+		mmDrain.defaultExpectation = &TerminationHandlerMockDrainExpectation{}
+	}
+
+	mmDrain.defaultExpectation.params = &TerminationHandlerMockDrainParams{ctx, p1}
+	for _, e := range mmDrain.expectations {
+		if minimock.Equal(e.params, mmDrain.defaultExpectation.params) {
+			mmDrain.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDrain.defaultExpectation.params)
+		}
+	}
+
+	return mmDrain
+}
+
+// Inspect accepts an inspector function that has same arguments as the TerminationHandler.Drain
+func (mmDrain *mTerminationHandlerMockDrain) Inspect(f func(ctx context.Context, p1 mm_insolar.PulseNumber)) *mTerminationHandlerMockDrain {
+	if mmDrain.mock.inspectFuncDrain != nil {
+		mmDrain.mock.t.Fatalf("Inspect function is already set for TerminationHandlerMock.Drain")
+	}
+
+	mmDrain.mock.inspectFuncDrain = f
+
+	return mmDrain
+}
+
+// Return sets up results that will be returned by TerminationHandler.Drain
+func (mmDrain *mTerminationHandlerMockDrain) Return() *TerminationHandlerMock {
+	if mmDrain.mock.funcDrain != nil {
+		mmDrain.mock.t.Fatalf("TerminationHandlerMock.Drain mock is already set by Set")
+	}
+
+	if mmDrain.defaultExpectation == nil {
+		mmDrain.defaultExpectation = &TerminationHandlerMockDrainExpectation{mock: mmDrain.mock}
+	}
+
+	return mmDrain.mock
+}
+
+//Set uses given function f to mock the TerminationHandler.Drain method
+func (mmDrain *mTerminationHandlerMockDrain) Set(f func(ctx context.Context, p1 mm_insolar.PulseNumber)) *TerminationHandlerMock {
+	if mmDrain.defaultExpectation != nil {
+		mmDrain.mock.t.Fatalf("Default expectation is already set for the TerminationHandler.Drain method")
+	}
+
+	if len(mmDrain.expectations) > 0 {
+		mmDrain.mock.t.Fatalf("Some expectations are already set for the TerminationHandler.Drain method")
+	}
+
+	mmDrain.mock.funcDrain = f
+	return mmDrain.mock
+}
+
+// Drain implements insolar.TerminationHandler
+func (mmDrain *TerminationHandlerMock) Drain(ctx context.Context, p1 mm_insolar.PulseNumber) {
+	mm_atomic.AddUint64(&mmDrain.beforeDrainCounter, 1)
+	defer mm_atomic.AddUint64(&mmDrain.afterDrainCounter, 1)
+
+	if mmDrain.inspectFuncDrain != nil {
+		mmDrain.inspectFuncDrain(ctx, p1)
+	}
+
+	params := &TerminationHandlerMockDrainParams{ctx, p1}
+
+	// Record call args
+	mmDrain.DrainMock.mutex.Lock()
+	mmDrain.DrainMock.callArgs = append(mmDrain.DrainMock.callArgs, params)
+	mmDrain.DrainMock.mutex.Unlock()
+
+	for _, e := range mmDrain.DrainMock.expectations {
+		if minimock.Equal(e.params, params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmDrain.DrainMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDrain.DrainMock.defaultExpectation.Counter, 1)
+		want := mmDrain.DrainMock.defaultExpectation.params
+		got := TerminationHandlerMockDrainParams{ctx, p1}
+		if want != nil && !minimock.Equal(*want, got) {
+			mmDrain.t.Errorf("TerminationHandlerMock.Drain got unexpected parameters, want: %#v, got: %#v%s\n", *want, got, minimock.Diff(*want, got))
+		}
+
+		return
+
+	}
+	if mmDrain.funcDrain != nil {
+		mmDrain.funcDrain(ctx, p1)
+		return
+	}
+	mmDrain.t.Fatalf("Unexpected call to TerminationHandlerMock.Drain. %v %v", ctx, p1)
+
+}
+
+// DrainAfterCounter returns a count of finished TerminationHandlerMock.Drain invocations
+func (mmDrain *TerminationHandlerMock) DrainAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDrain.afterDrainCounter)
+}
+
+// DrainBeforeCounter returns a count of TerminationHandlerMock.Drain invocations
+func (mmDrain *TerminationHandlerMock) DrainBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDrain.beforeDrainCounter)
+}
+
+// Calls returns a list of arguments used in each call to TerminationHandlerMock.Drain.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDrain *mTerminationHandlerMockDrain) Calls() []*TerminationHandlerMockDrainParams {
+	mmDrain.mutex.RLock()
+
+	argCopy := make([]*TerminationHandlerMockDrainParams, len(mmDrain.callArgs))
+	copy(argCopy, mmDrain.callArgs)
+
+	mmDrain.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDrainDone returns true if the count of the Drain invocations corresponds
+// the number of defined expectations
+func (m *TerminationHandlerMock) MinimockDrainDone() bool {
+	for _, e := range m.DrainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DrainMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDrainCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDrain != nil && mm_atomic.LoadUint64(&m.afterDrainCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDrainInspect logs each unmet expectation
+func (m *TerminationHandlerMock) MinimockDrainInspect() {
+	for _, e := range m.DrainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TerminationHandlerMock.Drain with params: %#v", *e.params)
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DrainMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDrainCounter) < 1 {
+		if m.DrainMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to TerminationHandlerMock.Drain")
+		} else {
+			m.t.Errorf("Expected call to TerminationHandlerMock.Drain with params: %#v", *m.DrainMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDrain != nil && mm_atomic.LoadUint64(&m.afterDrainCounter) < 1 {
+		m.t.Error("Expected call to TerminationHandlerMock.Drain")
+	}
+}
+
+type mTerminationHandlerMockDrainStatus struct {
+	mock               *TerminationHandlerMock
+	defaultExpectation *TerminationHandlerMockDrainStatusExpectation
+	expectations       []*TerminationHandlerMockDrainStatusExpectation
+}
+
+// TerminationHandlerMockDrainStatusExpectation specifies expectation struct of the TerminationHandler.DrainStatus
+type TerminationHandlerMockDrainStatusExpectation struct {
+	mock *TerminationHandlerMock
+
+	results *TerminationHandlerMockDrainStatusResults
+	Counter uint64
+}
+
+// TerminationHandlerMockDrainStatusResults contains results of the TerminationHandler.DrainStatus
+type TerminationHandlerMockDrainStatusResults struct {
+	d1 mm_insolar.DrainStatus
+}
+
+// Expect sets up expected params for TerminationHandler.DrainStatus
+func (mmDrainStatus *mTerminationHandlerMockDrainStatus) Expect() *mTerminationHandlerMockDrainStatus {
+	if mmDrainStatus.mock.funcDrainStatus != nil {
+		mmDrainStatus.mock.t.Fatalf("TerminationHandlerMock.DrainStatus mock is already set by Set")
+	}
+
+	if mmDrainStatus.defaultExpectation == nil {
+		mmDrainStatus.defaultExpectation = &TerminationHandlerMockDrainStatusExpectation{}
+	}
+
+	return mmDrainStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the TerminationHandler.DrainStatus
+func (mmDrainStatus *mTerminationHandlerMockDrainStatus) Inspect(f func()) *mTerminationHandlerMockDrainStatus {
+	if mmDrainStatus.mock.inspectFuncDrainStatus != nil {
+		mmDrainStatus.mock.t.Fatalf("Inspect function is already set for TerminationHandlerMock.DrainStatus")
+	}
+
+	mmDrainStatus.mock.inspectFuncDrainStatus = f
+
+	return mmDrainStatus
+}
+
+// Return sets up results that will be returned by TerminationHandler.DrainStatus
+func (mmDrainStatus *mTerminationHandlerMockDrainStatus) Return(d1 mm_insolar.DrainStatus) *TerminationHandlerMock {
+	if mmDrainStatus.mock.funcDrainStatus != nil {
+		mmDrainStatus.mock.t.Fatalf("TerminationHandlerMock.DrainStatus mock is already set by Set")
+	}
+
+	if mmDrainStatus.defaultExpectation == nil {
+		mmDrainStatus.defaultExpectation = &TerminationHandlerMockDrainStatusExpectation{mock: mmDrainStatus.mock}
+	}
+	mmDrainStatus.defaultExpectation.results = &TerminationHandlerMockDrainStatusResults{d1}
+	return mmDrainStatus.mock
+}
+
+//Set uses given function f to mock the TerminationHandler.DrainStatus method
+func (mmDrainStatus *mTerminationHandlerMockDrainStatus) Set(f func() (d1 mm_insolar.DrainStatus)) *TerminationHandlerMock {
+	if mmDrainStatus.defaultExpectation != nil {
+		mmDrainStatus.mock.t.Fatalf("Default expectation is already set for the TerminationHandler.DrainStatus method")
+	}
+
+	if len(mmDrainStatus.expectations) > 0 {
+		mmDrainStatus.mock.t.Fatalf("Some expectations are already set for the TerminationHandler.DrainStatus method")
+	}
+
+	mmDrainStatus.mock.funcDrainStatus = f
+	return mmDrainStatus.mock
+}
+
+// DrainStatus implements insolar.TerminationHandler
+func (mmDrainStatus *TerminationHandlerMock) DrainStatus() (d1 mm_insolar.DrainStatus) {
+	mm_atomic.AddUint64(&mmDrainStatus.beforeDrainStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmDrainStatus.afterDrainStatusCounter, 1)
+
+	if mmDrainStatus.inspectFuncDrainStatus != nil {
+		mmDrainStatus.inspectFuncDrainStatus()
+	}
+
+	if mmDrainStatus.DrainStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDrainStatus.DrainStatusMock.defaultExpectation.Counter, 1)
+
+		results := mmDrainStatus.DrainStatusMock.defaultExpectation.results
+		if results == nil {
+			mmDrainStatus.t.Fatal("No results are set for the TerminationHandlerMock.DrainStatus")
+		}
+		return (*results).d1
+	}
+	if mmDrainStatus.funcDrainStatus != nil {
+		return mmDrainStatus.funcDrainStatus()
+	}
+	mmDrainStatus.t.Fatalf("Unexpected call to TerminationHandlerMock.DrainStatus.")
+	return
+}
+
+// DrainStatusAfterCounter returns a count of finished TerminationHandlerMock.DrainStatus invocations
+func (mmDrainStatus *TerminationHandlerMock) DrainStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDrainStatus.afterDrainStatusCounter)
+}
+
+// DrainStatusBeforeCounter returns a count of TerminationHandlerMock.DrainStatus invocations
+func (mmDrainStatus *TerminationHandlerMock) DrainStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDrainStatus.beforeDrainStatusCounter)
+}
+
+// MinimockDrainStatusDone returns true if the count of the DrainStatus invocations corresponds
+// the number of defined expectations
+func (m *TerminationHandlerMock) MinimockDrainStatusDone() bool {
+	for _, e := range m.DrainStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DrainStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDrainStatusCounter) < 1 {
+		return false
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDrainStatus != nil && mm_atomic.LoadUint64(&m.afterDrainStatusCounter) < 1 {
+		return false
+	}
+	return true
+}
+
+// MinimockDrainStatusInspect logs each unmet expectation
+func (m *TerminationHandlerMock) MinimockDrainStatusInspect() {
+	for _, e := range m.DrainStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Error("Expected call to TerminationHandlerMock.DrainStatus")
+		}
+	}
+
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DrainStatusMock.defaultExpectation != nil && mm_atomic.LoadUint64(&m.afterDrainStatusCounter) < 1 {
+		m.t.Error("Expected call to TerminationHandlerMock.DrainStatus")
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDrainStatus != nil && mm_atomic.LoadUint64(&m.afterDrainStatusCounter) < 1 {
+		m.t.Error("Expected call to TerminationHandlerMock.DrainStatus")
+	}
+}
+
 type mTerminationHandlerMockLeave struct {
 	mock               *TerminationHandlerMock
 	defaultExpectation *TerminationHandlerMockLeaveExpectation
@@ -772,6 +1120,10 @@ func (m *TerminationHandlerMock) MinimockFinish() {
 	if !m.minimockDone() {
 		m.MinimockAbortInspect()
 
+		m.MinimockDrainInspect()
+
+		m.MinimockDrainStatusInspect()
+
 		m.MinimockLeaveInspect()
 
 		m.MinimockOnLeaveApprovedInspect()
@@ -801,6 +1153,8 @@ func (m *TerminationHandlerMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAbortDone() &&
+		m.MinimockDrainDone() &&
+		m.MinimockDrainStatusDone() &&
 		m.MinimockLeaveDone() &&
 		m.MinimockOnLeaveApprovedDone() &&
 		m.MinimockTerminatingDone()